@@ -14,6 +14,7 @@ STATS       : [Ss][Tt][Aa][Tt][Ss] ;
 TABLE       : [Tt][Aa][Bb][Ll][Ee] ;
 FIELDS      : [Ff][Ii][Ee][Ll][Dd][Ss] ;
 RENAME      : [Rr][Ee][Nn][Aa][Mm][Ee] ;
+REGEX       : [Rr][Ee][Gg][Ee][Xx] ;
 REX         : [Rr][Ee][Xx] ;
 DEDUP       : [Dd][Ee][Dd][Uu][Pp] ;
 SORT        : [Ss][Oo][Rr][Tt] ;
@@ -24,6 +25,10 @@ RARE        : [Rr][Aa][Rr][Ee] ;
 LOOKUP      : [Ll][Oo][Oo][Kk][Uu][Pp] ;
 JOIN        : [Jj][Oo][Ii][Nn] ;
 APPEND      : [Aa][Pp][Pp][Ee][Nn][Dd] ;
+APPENDCOLS  : [Aa][Pp][Pp][Ee][Nn][Dd][Cc][Oo][Ll][Ss] ;
+APPENDPIPE  : [Aa][Pp][Pp][Ee][Nn][Dd][Pp][Ii][Pp][Ee] ;
+UNION       : [Uu][Nn][Ii][Oo][Nn] ;
+MULTISEARCH : [Mm][Uu][Ll][Tt][Ii][Ss][Ee][Aa][Rr][Cc][Hh] ;
 TRANSACTION : [Tt][Rr][Aa][Nn][Ss][Aa][Cc][Tt][Ii][Oo][Nn] ;
 SPATH       : [Ss][Pp][Aa][Tt][Hh] ;
 EVENTSTATS  : [Ee][Vv][Ee][Nn][Tt][Ss][Tt][Aa][Tt][Ss] ;
@@ -34,6 +39,9 @@ FILLNULL    : [Ff][Ii][Ll][Ll][Nn][Uu][Ll][Ll] ;
 MAKEMV      : [Mm][Aa][Kk][Ee][Mm][Vv] ;
 MVEXPAND    : [Mm][Vv][Ee][Xx][Pp][Aa][Nn][Dd] ;
 FORMAT      : [Ff][Oo][Rr][Mm][Aa][Tt] ;
+RETURN      : [Rr][Ee][Tt][Uu][Rr][Nn] ;
+FOREACH     : [Ff][Oo][Rr][Ee][Aa][Cc][Hh] ;
+MAP         : [Mm][Aa][Pp] ;
 CONVERT     : [Cc][Oo][Nn][Vv][Ee][Rr][Tt] ;
 BUCKET      : [Bb][Uu][Cc][Kk][Ee][Tt] ;
 BIN         : [Bb][Ii][Nn] ;
@@ -44,9 +52,12 @@ FROM        : [Ff][Rr][Oo][Mm] ;
 GROUPBY     : [Gg][Rr][Oo][Uu][Pp][Bb][Yy] ;
 MSTATS      : [Mm][Ss][Tt][Aa][Tt][Ss] ;
 INPUTLOOKUP : [Ii][Nn][Pp][Uu][Tt][Ll][Oo][Oo][Kk][Uu][Pp] ;
+OUTPUT      : [Oo][Uu][Tt][Pp][Uu][Tt] ;
+OUTPUTNEW   : [Oo][Uu][Tt][Pp][Uu][Tt][Nn][Ee][Ww] ;
 
 // Comparison operators
 EQ          : '=' ;
+EQEQ        : '==' ;
 NEQ         : '!=' ;
 LT          : '<' ;
 GT          : '>' ;
@@ -67,6 +78,7 @@ RBRACKET    : ']' ;
 LBRACE      : '{' ;
 RBRACE      : '}' ;
 COMMA       : ',' ;
+DOUBLE_COLON : '::' ;     // Indexed field match: sourcetype::access_combined
 COLON       : ':' ;
 DQUOTE      : '"' ;
 PLUS        : '+' ;
@@ -77,29 +89,78 @@ PERCENT     : '%' ;
 // String literals (allow embedded newlines for multiline regex in match() etc.)
 QUOTED_STRING
     : '"' (~["\\] | '\\' .)* '"'
-    | '\'' (~['\\] | '\\' .)* '\''
+    ;
+
+// Single quotes name a field in eval and where ('Account Name'), not a string
+SINGLE_QUOTED
+    : '\'' (~['\\] | '\\' .)* '\''
+    ;
+
+// Search directives: TERM(10.0.0.1) matches an exact indexed term and
+// CASE(Admin) matches case-sensitively. Like Splunk they are uppercase only.
+// The argument has no spaces, commas or '=' (unless quoted), so eval's
+// CASE(x=1, "a") still lexes as a function call.
+TERM_DIRECTIVE
+    : 'TERM(' DIRECTIVE_ARG ')'
+    ;
+
+CASE_DIRECTIVE
+    : 'CASE(' DIRECTIVE_ARG ')'
+    ;
+
+fragment DIRECTIVE_ARG
+    : (~[ \t\r\n()"',=|[\]] | '"' (~["\\] | '\\' .)* '"')+
     ;
 
 // Time span values (must be before NUMBER to match span=1h, -24h, etc.)
+// Also covers relative time modifiers: -7d@d, -1mon@mon, -1d@w1, -24h@h+6h, +0s
 TIME_SPAN
-    : '-'? [0-9]+ [smhdwMy]
-    | '-'? [0-9]+ [smhdwMy] '@' [a-zA-Z]+
+    : [+-]? [0-9]+ TIME_UNIT ('@' [a-zA-Z]+ [0-9]?)? ([+-] [0-9]+ TIME_UNIT)*
+    ;
+
+fragment TIME_UNIT
+    : 's' | 'sec' | 'secs' | 'second' | 'seconds'
+    | 'm' | 'min' | 'mins' | 'minute' | 'minutes'
+    | 'h' | 'hr' | 'hrs' | 'hour' | 'hours'
+    | 'd' | 'day' | 'days'
+    | 'w' | 'week' | 'weeks'
+    | 'mon' | 'month' | 'months' | 'M'
+    | 'q' | 'qtr' | 'qtrs' | 'quarter' | 'quarters'
+    | 'y' | 'yr' | 'yrs' | 'year' | 'years'
+    ;
+
+// Absolute times in Splunk's default format: 03/14/2024:15:09:26 or 03/14/2024
+TIME_ABSOLUTE
+    : [0-9] [0-9]? '/' [0-9] [0-9]? '/' [0-9] [0-9] [0-9] [0-9] (':' [0-9] [0-9] ':' [0-9] [0-9] ':' [0-9] [0-9])?
+    ;
+
+// IPv4 addresses and CIDR networks (e.g., 10.0.0.1, 10.0.0.0/8), which
+// would otherwise lex as a number followed by stray dots
+IP_ADDRESS
+    : DIGIT+ '.' DIGIT+ '.' DIGIT+ '.' DIGIT+ ('/' DIGIT+)?
     ;
 
 // Numbers (negative numbers handled in parser with MINUS token)
 NUMBER
-    : DIGIT+ ('.' DIGIT+)?
-    | '.' DIGIT+
+    : DIGIT+ ('.' DIGIT+)? EXPONENT?
+    | '.' DIGIT+ EXPONENT?
     ;
 
 fragment DIGIT : [0-9] ;
 
+fragment EXPONENT : [eE] [+-]? DIGIT+ ;
+
 // Wildcards and patterns
 WILDCARD    : '*' ;
 
 // Special pattern suffix (for Windows account patterns like *$)
 DOLLAR      : '$' ;
 
+// Tokens substituted from result rows by map (e.g., $user$)
+TOKEN_VAR
+    : '$' [a-zA-Z_] [a-zA-Z0-9_.]* '$'
+    ;
+
 // Template variables for foreach (e.g., <<FIELD>>, <<field>>)
 TEMPLATE_VAR
     : '<<' [a-zA-Z_] [a-zA-Z0-9_]* '>>'
@@ -119,7 +180,7 @@ DOT         : '.' ;
 // First segment must start with a letter to avoid consuming arithmetic like /60/60
 // e.g., /services/endpoint NOT /60/60
 REST_PATH
-    : '/' [a-zA-Z] [a-zA-Z0-9_\-]* '/' [a-zA-Z0-9_\-*]+ ('/' [a-zA-Z0-9_\-*]+)*
+    : '/' [a-zA-Z] [a-zA-Z0-9_\-]* '/' [a-zA-Z0-9_\-*:%.]+ ('/' [a-zA-Z0-9_\-*:%.]+)*
     ;
 
 // Backtick macros
@@ -127,15 +188,22 @@ MACRO
     : '`' (~[`])+ '`'
     ;
 
-// Time alignment modifier (e.g., @d, @h)
+// Time alignment modifier (e.g., @d, @h, @w1, @d+6h)
 TIME_MODIFIER
-    : '@' [a-zA-Z]+
+    : '@' [a-zA-Z]+ [0-9]? ([+-] [0-9]+ TIME_UNIT)*
     ;
 
 // Whitespace
 WS          : [ \t\r\n]+ -> skip ;
 
-// Line comments (useful for formatted queries)
+// Block comments (```...```) may span lines or sit mid-line. They are kept
+// on the hidden channel so that annotations can be read back per stage.
+BLOCK_COMMENT
+    : '```' .*? '```' -> channel(HIDDEN)
+    ;
+
+// An unterminated comment runs to the end of the line. It cannot contain
+// ```, so a terminated block comment is always the longer match.
 LINE_COMMENT
-    : '```' ~[\r\n]* -> skip
+    : '```' (~[`\r\n] | '`' ~[`\r\n] | '``' ~[`\r\n])* '`'? '`'? -> channel(HIDDEN)
     ;
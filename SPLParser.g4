@@ -18,6 +18,7 @@ pipelineStage
     | fieldsCommand
     | renameCommand
     | rexCommand
+    | regexCommand
     | dedupCommand
     | sortCommand
     | headCommand
@@ -27,6 +28,10 @@ pipelineStage
     | lookupCommand
     | joinCommand
     | appendCommand
+    | appendcolsCommand
+    | appendpipeCommand
+    | unionCommand
+    | multisearchCommand
     | transactionCommand
     | spathCommand
     | eventstatsCommand
@@ -37,6 +42,9 @@ pipelineStage
     | makemvCommand
     | mvexpandCommand
     | formatCommand
+    | returnCommand
+    | foreachCommand
+    | mapCommand
     | convertCommand
     | bucketCommand
     | restCommand
@@ -87,7 +95,7 @@ fieldsCommand
 
 // Rename command
 renameCommand
-    : RENAME renameSpec (COMMA renameSpec)*
+    : RENAME renameSpec (COMMA? renameSpec)*
     ;
 
 renameSpec
@@ -103,6 +111,12 @@ rexOption
     : IDENTIFIER EQ (QUOTED_STRING | fieldName | NUMBER)
     ;
 
+// Regex command: keeps (=) or drops (!=) events whose field matches the pattern
+// Syntax: | regex [<field>=|<field>!=]"<regex>"   (field defaults to _raw)
+regexCommand
+    : REGEX (fieldName (EQ | NEQ))? QUOTED_STRING
+    ;
+
 // Dedup command
 dedupCommand
     : DEDUP NUMBER? fieldList (dedupOption)*
@@ -118,7 +132,7 @@ sortCommand
     ;
 
 sortField
-    : (PLUS | MINUS)? fieldName
+    : (PLUS | MINUS)? (fieldName | QUOTED_STRING)
     ;
 
 // Head command
@@ -133,17 +147,38 @@ tailCommand
 
 // Top command
 topCommand
-    : TOP NUMBER? fieldList (BY fieldList)?
+    : TOP NUMBER? topOption* fieldList (BY fieldList)?
+    ;
+
+// limit=20, countfield=requests, showperc=f, useother=t
+topOption
+    : IDENTIFIER EQ (QUOTED_STRING | fieldName | NUMBER)
     ;
 
 // Rare command
 rareCommand
-    : RARE NUMBER? fieldList (BY fieldList)?
+    : RARE NUMBER? topOption* fieldList (BY fieldList)?
     ;
 
 // Lookup command
+// Syntax: lookup [local=bool] [update=bool] <table> (<table-field> [AS <event-field>])...
+//         [(OUTPUT | OUTPUTNEW) (<table-field> [AS <event-field>])...]
 lookupCommand
-    : LOOKUP lookupOption* IDENTIFIER fieldList
+    : LOOKUP lookupOption* (IDENTIFIER | QUOTED_STRING) lookupFieldMapping+ lookupOutput?
+    ;
+
+lookupOutput
+    : (OUTPUT | OUTPUTNEW) lookupFieldMapping*
+    ;
+
+// Uses lookupField rather than fieldName so OUTPUT/OUTPUTNEW end the input list
+lookupFieldMapping
+    : lookupField (AS lookupField)? COMMA?
+    ;
+
+lookupField
+    : IDENTIFIER (MINUS IDENTIFIER)*
+    | QUOTED_STRING
     ;
 
 lookupOption
@@ -165,6 +200,30 @@ appendCommand
     : APPEND subsearch
     ;
 
+// Appendcols command: adds the subsearch's columns to the results row by row
+// Syntax: | appendcols [override=<bool>] [subsearch]
+appendcolsCommand
+    : APPENDCOLS joinOption* subsearch
+    ;
+
+// Appendpipe command: appends the output of a subpipeline run over the results
+// Syntax: | appendpipe [run_in_preview=<bool>] [<subpipeline>]
+appendpipeCommand
+    : APPENDPIPE joinOption* subsearch
+    ;
+
+// Union command: merges the results of several datasets
+// Syntax: | union [maxtime=<int>] [maxout=<int>] <dataset> <dataset>...   (dataset: [subsearch] or a name)
+unionCommand
+    : UNION joinOption* (subsearch | value)+
+    ;
+
+// Multisearch command: runs several streaming searches at the same time
+// Syntax: | multisearch [search ...] [search ...]...
+multisearchCommand
+    : MULTISEARCH subsearch+
+    ;
+
 // Transaction command
 transactionCommand
     : TRANSACTION fieldList (transactionOption)*
@@ -172,30 +231,44 @@ transactionCommand
 
 transactionOption
     : IDENTIFIER EQ (QUOTED_STRING | fieldName | NUMBER | TIME_SPAN)
+    | IDENTIFIER EQ LPAREN searchExpression RPAREN   // startswith=(EventCode=4624)
+    | IDENTIFIER EQ EVAL LPAREN expression RPAREN    // endswith=eval(action="logout")
+    | IDENTIFIER EQ condition                        // startswith=EventCode=4624
     ;
 
 // Spath command
 spathCommand
-    : SPATH (spathOption)*
+    : SPATH (spathOption | spathPath)*
+    ;
+
+// Positional path: spath Operation, spath "a.b{}.c"
+spathPath
+    : fieldName
+    | QUOTED_STRING
     ;
 
 spathOption
-    : IDENTIFIER EQ (QUOTED_STRING | fieldName)
+    : (IDENTIFIER | OUTPUT) EQ (QUOTED_STRING | fieldName)
     ;
 
 // Eventstats command
 eventstatsCommand
-    : EVENTSTATS statsFunction (COMMA? statsFunction)* (BY fieldList)?
+    : EVENTSTATS statsOption* statsFunction (COMMA? statsFunction)* statsOption* (BY fieldList)?
     ;
 
 // Streamstats command
 streamstatsCommand
-    : STREAMSTATS statsFunction (COMMA? statsFunction)* (BY fieldList)?
+    : STREAMSTATS statsOption* statsFunction (COMMA? statsFunction)* statsOption* (BY fieldList)?
+    ;
+
+// window=5, time_window=1h, current=f, reset_on_change=t, reset_after="(...)"
+statsOption
+    : IDENTIFIER EQ (QUOTED_STRING | fieldName | NUMBER | TIME_SPAN)
     ;
 
 // Timechart command
 timechartCommand
-    : TIMECHART (timechartOption)* statsFunction (BY fieldName)?
+    : TIMECHART (timechartOption)* statsFunction (COMMA? statsFunction)* (BY fieldName)? (timechartOption)*
     ;
 
 timechartOption
@@ -204,7 +277,8 @@ timechartOption
 
 // Chart command
 chartCommand
-    : CHART statsFunction (BY fieldList)? (OVER fieldName)?
+    : CHART statsOption* statsFunction (COMMA? statsFunction)*
+      ((BY fieldList)? (OVER fieldName)? | OVER fieldName BY fieldList) statsOption*
     ;
 
 // Fillnull command
@@ -213,7 +287,7 @@ fillnullCommand
     ;
 
 fillnullOption
-    : IDENTIFIER EQ (QUOTED_STRING | NUMBER)
+    : IDENTIFIER EQ (QUOTED_STRING | NUMBER | IDENTIFIER)
     ;
 
 // Makemv command
@@ -230,15 +304,57 @@ mvexpandCommand
     : MVEXPAND fieldName
     ;
 
-// Format command
+// Format command: renders the results as a search string
+// Syntax: | format [mvsep=<sep>] [maxresults=<N>] ["<row prefix>" "<column prefix>" "<column separator>" "<column end>" "<row separator>" "<row end>"] [emptystr=<str>]
 formatCommand
-    : FORMAT (formatOption)*
+    : FORMAT formatArg*
+    ;
+
+formatArg
+    : formatOption
+    | QUOTED_STRING   // Positional separators, all six or none
     ;
 
 formatOption
     : IDENTIFIER EQ (QUOTED_STRING | NUMBER)
     ;
 
+// Return command: emits the first <count> results of a subsearch as a search
+// Syntax: | return [<count>] [<alias>=<field>] [<field>] [$<field>]
+returnCommand
+    : RETURN NUMBER? returnItem*
+    ;
+
+returnItem
+    : fieldName EQ fieldName    // alias=field: searched as alias="value"
+    | DOLLAR fieldName          // $field: the value alone
+    | fieldName                 // field="value"
+    ;
+
+// Foreach command: runs a templated subsearch once per matching field,
+// substituting <<FIELD>>, <<MATCHSTR>> and <<MATCHSEG1>>..<<MATCHSEG3>>
+// Syntax: | foreach [mode=<mode>] <wc-field>... [fieldstr=<str>] [matchstr=<str>] [matchseg1=<str>] [<subsearch>]
+foreachCommand
+    : FOREACH foreachArg* subsearch
+    ;
+
+foreachArg
+    : IDENTIFIER EQ (QUOTED_STRING | fieldName | NUMBER)
+    | wildcardValue
+    | fieldName
+    | QUOTED_STRING
+    ;
+
+// Map command: runs a search once per result row, substituting $field$ tokens
+// Syntax: | map search="<search>" [maxsearches=<int>]  or  | map [<subsearch>] [maxsearches=<int>]
+mapCommand
+    : MAP (mapOption | subsearch)+
+    ;
+
+mapOption
+    : (IDENTIFIER | SEARCH) EQ (QUOTED_STRING | NUMBER | fieldName)
+    ;
+
 // Convert command
 // Supports: convert timeformat="%Y-%m-%d" ctime(_time) AS date
 convertCommand
@@ -250,12 +366,13 @@ convertOption
     ;
 
 convertFunction
-    : IDENTIFIER LPAREN fieldName RPAREN (AS (fieldName | QUOTED_STRING))?
+    : IDENTIFIER LPAREN (fieldName | QUOTED_STRING) RPAREN (AS (fieldName | QUOTED_STRING))?
     ;
 
 // Bucket/bin command
+// Syntax: | bin [<option>]... <field> [<option>]... [AS <newfield>]
 bucketCommand
-    : (BUCKET | BIN) fieldName (bucketOption)*
+    : (BUCKET | BIN) (bucketOption)* fieldName (bucketOption)* (AS fieldName)?
     ;
 
 bucketOption
@@ -270,7 +387,7 @@ restCommand
     ;
 
 restArg
-    : IDENTIFIER EQ MINUS? (value | IDENTIFIER)   // option=value
+    : IDENTIFIER EQ MINUS? value ((MINUS | SLASH) IDENTIFIER)*   // option=value, splunk_server=splunk-idx01
     | REST_PATH                                    // REST API path like /servicesNS/-/-/saved/searches
     | IDENTIFIER                                   // endpoint without leading slash
     ;
@@ -324,6 +441,7 @@ genericArg
     : IDENTIFIER (EQ MINUS? (value | IDENTIFIER))?
     | MINUS? value
     | LPAREN genericArg* RPAREN
+    | AS                          // accum revenue AS running_total
     ;
 
 // Subsearch
@@ -340,6 +458,7 @@ searchExpression
 searchTerm
     : NOT searchTerm
     | LPAREN searchExpression RPAREN
+    | LPAREN RPAREN               // Empty group, e.g. format's NOT ( ) for a subsearch without results
     | condition
     | subsearch
     | MACRO
@@ -349,6 +468,7 @@ searchTerm
 // Field conditions
 condition
     : fieldName comparisonOp value
+    | fieldName DOUBLE_COLON value              // Indexed field: sourcetype::access_combined
     | fieldName IN LPAREN valueList RPAREN      // field IN ("val1", "val2")
     | fieldName IN subsearch                     // field IN [search ...]
     | functionCall
@@ -357,6 +477,7 @@ condition
 // Comparison operators
 comparisonOp
     : EQ
+    | EQEQ
     | NEQ
     | LT
     | GT
@@ -389,12 +510,20 @@ notExpression
     ;
 
 // Comparison expression with arithmetic on both sides
-// NOTE: condition must come first to properly parse field=value in where clauses
+// NOTE: condition must come before the general comparison to properly parse field=value in where clauses
 comparisonExpression
-    : condition  // Field conditions like field=value - must be first!
+    : additiveExpression comparisonOp productExpression  // where a > b*10 - before condition, see productExpression
+    | condition  // Field conditions like field=value
     | additiveExpression (comparisonOp additiveExpression)?
     ;
 
+// An additive expression whose first term is a multiplication. With implicit
+// AND, where a > b*10 could also read as the wildcard condition a > b*
+// followed by 10; trying this alternative first keeps it arithmetic.
+productExpression
+    : unaryExpression WILDCARD unaryExpression ((WILDCARD | SLASH | PERCENT) unaryExpression)* ((PLUS | MINUS | DOT) multiplicativeExpression)*
+    ;
+
 // Additive expressions: + - . (DOT is string concatenation in SPL)
 additiveExpression
     : multiplicativeExpression ((PLUS | MINUS | DOT) multiplicativeExpression)*
@@ -415,8 +544,11 @@ primaryExpression
     : LPAREN expression RPAREN
     | subsearch                   // Allow subsearch in expressions (e.g., where NOT [search ...])
     | functionCall
-    | value
-    | fieldName
+    | QUOTED_STRING
+    | NUMBER
+    | TIME_SPAN
+    | colonValue
+    | fieldName                   // Bare words are field references; '*' is multiplication, not a wildcard
     ;
 
 // Function call
@@ -439,10 +571,32 @@ argumentList
 value
     : QUOTED_STRING
     | NUMBER
+    | IP_ADDRESS      // 10.0.0.1, 10.0.0.0/8
     | TIME_SPAN       // Time values like -24h, 5m, 1d@d
+    | TIME_MODIFIER   // Snap-only time modifiers like @d, @w1
+    | TIME_ABSOLUTE   // Absolute times like 03/14/2024:00:00:00
     | wildcardValue   // Must come before others to match access*
     | colonValue      // Handle colon-separated values like o365:management:activity
     | IDENTIFIER
+    | TOKEN_VAR       // $field$ in map searches
+    | SINGLE_QUOTED   // Field reference in eval/where: user='other_field'
+    | TERM_DIRECTIVE  // user=TERM(admin@example.com)
+    | CASE_DIRECTIVE  // user=CASE(Admin)
+    | keywordAsValue  // action=output
+    ;
+
+// Command keywords are plain words when used as a value
+keywordAsValue
+    : OUTPUT
+    | OUTPUTNEW
+    | REGEX
+    | RETURN
+    | UNION
+    | APPENDCOLS
+    | APPENDPIPE
+    | MULTISEARCH
+    | MAP
+    | FOREACH
     ;
 
 // Colon-separated values (common in SPL for sourcetypes, eventtypes, etc.)
@@ -473,8 +627,12 @@ wildcardValue
 bareWord
     : IDENTIFIER
     | NUMBER
+    | IP_ADDRESS
     | QUOTED_STRING
+    | SINGLE_QUOTED
     | wildcardValue  // Allows bare * or *value patterns as search terms
+    | TERM_DIRECTIVE // TERM(10.0.0.1)
+    | CASE_DIRECTIVE // CASE(Admin)
     ;
 
 // Field name (NUMBER included for Sysmon-style numeric field names like 3=3)
@@ -485,11 +643,13 @@ fieldName
     : fieldNameBase (fieldNameSuffix (DOT fieldNameBase fieldNameSuffix?)*)?
     | NUMBER
     | TEMPLATE_VAR IDENTIFIER?     // <<FIELD>> or <<FIELD>>_pct
+    | SINGLE_QUOTED                // 'Account Name'
     ;
 
 // Optional suffix on a field path segment: {} (curly brace), [*] (array wildcard), or [N] (array index)
 fieldNameSuffix
     : LBRACE RBRACE                                // ModifiedProperties{}.NewValue
+    | LBRACE NUMBER RBRACE                         // ModifiedProperties{0}.NewValue
     | LBRACKET WILDCARD RBRACKET                    // targetResources[*].displayName
     | LBRACKET NUMBER RBRACKET                      // items[0].value
     ;
@@ -499,6 +659,17 @@ fieldNameBase
     | FROM
     | MSTATS
     | INPUTLOOKUP
+    | OUTPUT
+    | OUTPUTNEW
+    | REGEX
+    | RETURN
+    | FOREACH
+    | MAP
+    | SEARCH          // Saved search field from | rest: table title search
+    | UNION
+    | APPENDCOLS
+    | APPENDPIPE
+    | MULTISEARCH
     ;
 
 // Field list (SPL allows both space-separated and comma-separated)
@@ -510,6 +681,7 @@ fieldList
 fieldOrQuoted
     : fieldName
     | QUOTED_STRING
+    | wildcardValue   // fields inputs*
     ;
 
 // Value list (for IN operator)
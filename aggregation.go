@@ -0,0 +1,137 @@
+package spl
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Aggregation is a stats-family stage: stats, eventstats, streamstats,
+// chart, timechart, tstats or mstats
+type Aggregation struct {
+	Command   string                `json:"command"`
+	Functions []AggregationFunction `json:"functions"`
+	GroupBy   []string              `json:"group_by,omitempty"` // BY fields; chart puts the OVER field first, timechart _time
+	Options   map[string]string     `json:"options,omitempty"`  // span=, limit=, useother=, summariesonly=, ...
+	PipeStage int                   `json:"pipe_stage"`
+}
+
+// AggregationFunction is one aggregate of a stats-family command, such as
+// count(eval(match(Keywords,"Audit Failure"))) AS Failed
+type AggregationFunction struct {
+	Function        string   `json:"function"`                   // Lowercase name: count, dc, values, perc95, earliest, ...
+	Field           string   `json:"field,omitempty"`            // Argument when it is a plain field, e.g. bytes in avg(bytes)
+	Argument        string   `json:"argument,omitempty"`         // Argument as written
+	Condition       string   `json:"condition,omitempty"`        // Inner expression of an eval(...) argument
+	ConditionFields []string `json:"condition_fields,omitempty"` // Fields referenced by Condition
+	Alias           string   `json:"alias,omitempty"`            // AS name
+	Name            string   `json:"name"`                       // Output field: Alias, or function(argument)
+}
+
+// newAggregationFunctions describes the statsFunction contexts of a command
+func newAggregationFunctions(fns []IStatsFunctionContext) []AggregationFunction {
+	out := make([]AggregationFunction, 0, len(fns))
+	for _, f := range fns {
+		fn := AggregationFunction{Function: strings.ToLower(f.IDENTIFIER().GetText())}
+		switch {
+		case f.FieldName() != nil:
+			fn.Alias = f.FieldName().GetText()
+		case f.QUOTED_STRING() != nil:
+			fn.Alias = unquoteSPLString(f.QUOTED_STRING().GetText())
+		}
+
+		if expr := f.Expression(); expr != nil {
+			fn.Argument = ruleText(expr)
+			if tstatsFieldPattern.MatchString(fn.Argument) {
+				fn.Field = fn.Argument
+			} else if inner := evalArgument(expr); inner != nil {
+				fn.Condition = ruleText(inner)
+				fn.ConditionFields = expressionFields(inner)
+			}
+		}
+
+		fn.Name = fn.Alias
+		if fn.Name == "" {
+			fn.Name = f.IDENTIFIER().GetText()
+			if f.Expression() != nil {
+				fn.Name += "(" + f.Expression().GetText() + ")"
+			}
+		}
+		out = append(out, fn)
+	}
+	return out
+}
+
+// evalArgument returns the condition of an eval(<condition>) argument, or
+// nil when the argument is something else
+func evalArgument(expr IExpressionContext) IExpressionContext {
+	var call IFunctionCallContext
+	antlr.ParseTreeWalkerDefault.Walk(&functionCallFinder{found: &call}, expr)
+	if call == nil || call.EVAL() == nil || call.ArgumentList() == nil || len(call.ArgumentList().AllExpression()) != 1 {
+		return nil
+	}
+	// The eval call must be the whole argument, not part of it
+	if call.GetStart() != expr.GetStart() || call.GetStop() != expr.GetStop() {
+		return nil
+	}
+	return call.ArgumentList().Expression(0)
+}
+
+// functionCallFinder records the first function call of a tree
+type functionCallFinder struct {
+	BaseSPLParserListener
+	found *IFunctionCallContext
+}
+
+func (f *functionCallFinder) EnterFunctionCall(ctx *FunctionCallContext) {
+	if *f.found == nil {
+		*f.found = ctx
+	}
+}
+
+// expressionFields returns the distinct fields an expression references
+func expressionFields(expr antlr.ParseTree) []string {
+	finder := &fieldRefFinder{seen: make(map[string]bool)}
+	antlr.ParseTreeWalkerDefault.Walk(finder, expr)
+	return finder.fields
+}
+
+// fieldRefFinder collects the field references of an expression
+type fieldRefFinder struct {
+	BaseSPLParserListener
+	seen   map[string]bool
+	fields []string
+}
+
+func (f *fieldRefFinder) EnterPrimaryExpression(ctx *PrimaryExpressionContext) {
+	if ctx.FieldName() != nil {
+		f.add(fieldNameText(ctx.FieldName()))
+	}
+}
+
+// EnterCondition collects both sides of field=value style comparisons, where
+// bare words and 'name' on the right are fields as in eval and where
+func (f *fieldRefFinder) EnterCondition(ctx *ConditionContext) {
+	if ctx.FieldName() != nil && ctx.FieldName().NUMBER() == nil {
+		f.add(fieldNameText(ctx.FieldName()))
+	}
+	if v := ctx.Value(); v != nil && (v.IDENTIFIER() != nil || v.KeywordAsValue() != nil || v.SINGLE_QUOTED() != nil) {
+		f.add(unquoteSPLString(v.GetText()))
+	}
+}
+
+func (f *fieldRefFinder) add(name string) {
+	if !f.seen[strings.ToLower(name)] {
+		f.seen[strings.ToLower(name)] = true
+		f.fields = append(f.fields, name)
+	}
+}
+
+// ruleText returns the query text of a rule as written, whitespace included
+func ruleText(ctx antlr.ParserRuleContext) string {
+	start, stop := ctx.GetStart(), ctx.GetStop()
+	if start == nil || stop == nil || stop.GetStop() < start.GetStart() {
+		return ctx.GetText()
+	}
+	return start.GetInputStream().GetText(start.GetStart(), stop.GetStop())
+}
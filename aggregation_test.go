@@ -0,0 +1,159 @@
+package spl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAggregations(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []Aggregation
+	}{
+		{
+			name:  "count eval condition",
+			query: `index=wineventlog | bin _time span=1m | rename _time AS minute | stats count(eval(match(Keywords, "Audit Failure"))) AS Failed, dc(host) by minute username`,
+			want: []Aggregation{{
+				Command: "stats",
+				Functions: []AggregationFunction{
+					{Function: "count", Argument: `eval(match(Keywords, "Audit Failure"))`, Condition: `match(Keywords, "Audit Failure")`, ConditionFields: []string{"Keywords"}, Alias: "Failed", Name: "Failed"},
+					{Function: "dc", Field: "host", Argument: "host", Name: "dc(host)"},
+				},
+				GroupBy:   []string{"minute", "username"},
+				PipeStage: 3,
+			}},
+		},
+		{
+			name:  "count eval field comparison",
+			query: `index=auth | stats count(eval(action="failure")) AS failures by user`,
+			want: []Aggregation{{
+				Command: "stats",
+				Functions: []AggregationFunction{
+					{Function: "count", Argument: `eval(action="failure")`, Condition: `action="failure"`, ConditionFields: []string{"action"}, Alias: "failures", Name: "failures"},
+				},
+				GroupBy:   []string{"user"},
+				PipeStage: 1,
+			}},
+		},
+		{
+			name:  "count eval compound condition",
+			query: `index=web | stats count(eval(bytes>100 AND user="x")) AS big, count(eval(src=dest)) AS local`,
+			want: []Aggregation{{
+				Command: "stats",
+				Functions: []AggregationFunction{
+					{Function: "count", Argument: `eval(bytes>100 AND user="x")`, Condition: `bytes>100 AND user="x"`, ConditionFields: []string{"bytes", "user"}, Alias: "big", Name: "big"},
+					{Function: "count", Argument: `eval(src=dest)`, Condition: `src=dest`, ConditionFields: []string{"src", "dest"}, Alias: "local", Name: "local"},
+				},
+				PipeStage: 1,
+			}},
+		},
+		{
+			name:  "functions without arguments and percentiles",
+			query: `index=web | stats count, perc95(duration) AS p95, earliest(_time) AS first_seen, latest(_time), values(uri) by src`,
+			want: []Aggregation{{
+				Command: "stats",
+				Functions: []AggregationFunction{
+					{Function: "count", Name: "count"},
+					{Function: "perc95", Field: "duration", Argument: "duration", Alias: "p95", Name: "p95"},
+					{Function: "earliest", Field: "_time", Argument: "_time", Alias: "first_seen", Name: "first_seen"},
+					{Function: "latest", Field: "_time", Argument: "_time", Name: "latest(_time)"},
+					{Function: "values", Field: "uri", Argument: "uri", Name: "values(uri)"},
+				},
+				GroupBy:   []string{"src"},
+				PipeStage: 1,
+			}},
+		},
+		{
+			name:  "timechart options",
+			query: `index=web | timechart span=1h limit=5 count, avg(bytes) AS avg_bytes by host useother=f`,
+			want: []Aggregation{{
+				Command: "timechart",
+				Functions: []AggregationFunction{
+					{Function: "count", Name: "count"},
+					{Function: "avg", Field: "bytes", Argument: "bytes", Alias: "avg_bytes", Name: "avg_bytes"},
+				},
+				GroupBy:   []string{"_time", "host"},
+				Options:   map[string]string{"span": "1h", "limit": "5", "useother": "f"},
+				PipeStage: 1,
+			}},
+		},
+		{
+			name:  "chart over by",
+			query: `index=web | chart limit=10 count over status by method useother=f`,
+			want: []Aggregation{{
+				Command:   "chart",
+				Functions: []AggregationFunction{{Function: "count", Name: "count"}},
+				GroupBy:   []string{"status", "method"},
+				Options:   map[string]string{"limit": "10", "useother": "f"},
+				PipeStage: 1,
+			}},
+		},
+		{
+			name:  "tstats",
+			query: `| tstats summariesonly=t count, values(Processes.process) AS procs from datamodel=Endpoint.Processes by Processes.dest span=1h`,
+			want: []Aggregation{{
+				Command: "tstats",
+				Functions: []AggregationFunction{
+					{Function: "count", Name: "count"},
+					{Function: "values", Field: "Processes.process", Argument: "Processes.process", Alias: "procs", Name: "procs"},
+				},
+				GroupBy:   []string{"Processes.dest"},
+				Options:   map[string]string{"summariesonly": "t", "span": "1h"},
+				PipeStage: 0,
+			}},
+		},
+		{
+			name:  "mstats",
+			query: `| mstats avg(_value) AS cpu WHERE metric_name="cpu.percent" by host span=30s`,
+			want: []Aggregation{{
+				Command:   "mstats",
+				Functions: []AggregationFunction{{Function: "avg", Field: "_value", Argument: "_value", Alias: "cpu", Name: "cpu"}},
+				GroupBy:   []string{"host"},
+				Options:   map[string]string{"span": "30s"},
+				PipeStage: 0,
+			}},
+		},
+		{
+			name:  "eventstats and streamstats",
+			query: `index=auth | eventstats avg(bytes) AS avg_bytes by user | streamstats window=3 sum(bytes) by user`,
+			want: []Aggregation{
+				{
+					Command:   "eventstats",
+					Functions: []AggregationFunction{{Function: "avg", Field: "bytes", Argument: "bytes", Alias: "avg_bytes", Name: "avg_bytes"}},
+					GroupBy:   []string{"user"},
+					PipeStage: 1,
+				},
+				{
+					Command:   "streamstats",
+					Functions: []AggregationFunction{{Function: "sum", Field: "bytes", Argument: "bytes", Name: "sum(bytes)"}},
+					GroupBy:   []string{"user"},
+					Options:   map[string]string{"window": "3"},
+					PipeStage: 2,
+				},
+			},
+		},
+		{
+			name:  "subsearch aggregations are not recorded",
+			query: `index=auth | stats count by src | append [search index=vpn | stats count by user]`,
+			want: []Aggregation{{
+				Command:   "stats",
+				Functions: []AggregationFunction{{Function: "count", Name: "count"}},
+				GroupBy:   []string{"src"},
+				PipeStage: 1,
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ExtractConditions(tc.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("unexpected errors: %v", result.Errors)
+			}
+			if !reflect.DeepEqual(result.Aggregations, tc.want) {
+				t.Errorf("Aggregations =\n%+v\nwant\n%+v", result.Aggregations, tc.want)
+			}
+		})
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	spl "github.com/craftedsignal/spl-parser"
 	"gopkg.in/yaml.v3"
 )
 
@@ -24,7 +26,7 @@ type Detection struct {
 func main() {
 	if len(os.Args) < 2 {
 		fmt.Fprintf(os.Stderr, "Usage: scrape-corpus <detections-dir> [corpus.json]\n")
-		fmt.Fprintf(os.Stderr, "  Reads Splunk Security Content YAML files and appends to corpus.\n")
+		fmt.Fprintf(os.Stderr, "  Reads Splunk Security Content YAML files and savedsearches.conf files and appends to corpus.\n")
 		os.Exit(1)
 	}
 
@@ -53,6 +55,15 @@ func main() {
 
 	// Walk the detections directory
 	var added int
+	addQuery := func(source, name, query string) {
+		query = strings.TrimSpace(query)
+		if query == "" || existing[query] {
+			return
+		}
+		existing[query] = true
+		corpus = append(corpus, QueryEntry{Source: source, Name: name, Query: query})
+		added++
+	}
 	err = filepath.Walk(detectionsDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return nil // skip errors
@@ -60,6 +71,23 @@ func main() {
 		if info.IsDir() {
 			return nil
 		}
+		if strings.EqualFold(filepath.Base(path), "savedsearches.conf") {
+			f, err := os.Open(path)
+			if err != nil {
+				return nil
+			}
+			defer f.Close()
+			searches, err := spl.ParseSavedSearches(f, time.Now())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Skipping %s: %v\n", path, err)
+				return nil
+			}
+			for _, s := range searches {
+				addQuery("savedsearches_conf", s.Name, s.Search)
+			}
+			return nil
+		}
+
 		ext := strings.ToLower(filepath.Ext(path))
 		if ext != ".yml" && ext != ".yaml" {
 			return nil
@@ -75,28 +103,11 @@ func main() {
 			return nil
 		}
 
-		query := strings.TrimSpace(det.Search)
-		if query == "" {
-			return nil
-		}
-
-		// Deduplicate
-		if existing[query] {
-			return nil
-		}
-		existing[query] = true
-
 		name := det.Name
 		if name == "" {
 			name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
 		}
-
-		corpus = append(corpus, QueryEntry{
-			Source: "splunk_security_content",
-			Name:   name,
-			Query:  query,
-		})
-		added++
+		addQuery("splunk_security_content", name, det.Search)
 
 		return nil
 	})
@@ -0,0 +1,59 @@
+package spl
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// stageComments returns the comments of each top-level stage. A comment
+// inside a stage belongs to it. A comment between stages belongs to the
+// stage it follows, or to the next one when a pipe comes before it:
+//
+//	index=x ```belongs to 0``` | ```belongs to 1``` stats count
+func stageComments(stream *antlr.CommonTokenStream, stages []IPipelineStageContext) [][]string {
+	comments := make([][]string, len(stages))
+	if len(stages) == 0 {
+		return comments
+	}
+	stream.Fill()
+
+	afterPipe := true // Before the first stage
+	for i := 0; i < stream.Size(); i++ {
+		tok := stream.Get(i)
+		switch tok.GetTokenType() {
+		case SPLLexerBLOCK_COMMENT, SPLLexerLINE_COMMENT:
+		default:
+			if tok.GetChannel() == antlr.TokenDefaultChannel {
+				afterPipe = tok.GetTokenType() == SPLLexerPIPE
+			}
+			continue
+		}
+
+		stage := -1
+		for j, s := range stages {
+			if s.GetStart() == nil || s.GetStart().GetTokenIndex() > i {
+				break
+			}
+			stage = j
+			if s.GetStop() != nil && s.GetStop().GetTokenIndex() > i {
+				break // Inside the stage
+			}
+		}
+		if afterPipe && stage+1 < len(stages) && (stage < 0 || stages[stage].GetStop().GetTokenIndex() < i) {
+			stage++
+		}
+		if stage < 0 {
+			stage = 0
+		}
+		comments[stage] = append(comments[stage], commentText(tok.GetText()))
+	}
+	return comments
+}
+
+// commentText strips the ``` delimiters and surrounding space of a comment
+func commentText(text string) string {
+	text = strings.TrimPrefix(text, "```")
+	text = strings.TrimSuffix(text, "```")
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,141 @@
+package spl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// ComparisonKind says what a condition's field is compared with
+type ComparisonKind string
+
+const (
+	CompareValue      ComparisonKind = ""           // A literal Value (field=value)
+	CompareField      ComparisonKind = "field"      // Another field, in ValueField (where src_ip=dest_ip)
+	CompareExpression ComparisonKind = "expression" // An expression on either side (where bytes_out > bytes_in*10)
+)
+
+// flippedOperators turn 10 < count into count > 10
+var flippedOperators = map[string]string{
+	"<": ">", ">": "<", "<=": ">=", ">=": "<=", "=": "=", "!=": "!=",
+}
+
+// comparisonSide is one operand of an eval/where comparison
+type comparisonSide struct {
+	text    string // As written; literals are unquoted
+	raw     string // Literal token as written
+	field   string // Set when the operand is a single field
+	literal bool   // String or number
+	fields  []string
+}
+
+// newComparisonSide classifies an operand of a comparison, an additive or
+// a product expression
+func newComparisonSide(operand antlr.ParserRuleContext) comparisonSide {
+	side := comparisonSide{text: ruleText(operand)}
+	ctx, ok := operand.(IAdditiveExpressionContext)
+	if !ok {
+		side.fields = expressionFields(operand)
+		return side
+	}
+	if _, err := strconv.ParseFloat(ctx.GetText(), 64); err == nil {
+		side.text, side.raw, side.literal = ctx.GetText(), ctx.GetText(), true
+		return side
+	}
+	if terms := ctx.AllMultiplicativeExpression(); len(terms) == 1 {
+		if units := terms[0].AllUnaryExpression(); len(units) == 1 && units[0].PrimaryExpression() != nil {
+			primary := units[0].PrimaryExpression()
+			switch {
+			case primary.QUOTED_STRING() != nil:
+				side.text, side.raw, side.literal = unquoteSPLString(primary.GetText()), primary.GetText(), true
+				return side
+			case primary.FieldName() != nil && primary.FieldName().NUMBER() == nil && primary.FieldName().TEMPLATE_VAR() == nil:
+				side.field = fieldNameText(primary.FieldName())
+				side.fields = []string{side.field}
+				return side
+			}
+		}
+	}
+	side.fields = expressionFields(ctx)
+	return side
+}
+
+// EnterComparisonExpression records where comparisons that have arithmetic
+// or a function on either side. Plain field=value comparisons are
+// conditions and handled by EnterCondition.
+func (e *conditionExtractor) EnterComparisonExpression(ctx *ComparisonExpressionContext) {
+	if ctx.ComparisonOp() == nil || e.inSubsearch > 0 || e.inFunctionCall > 0 || e.inStatsFunction > 0 || !inWhereCommand(ctx) {
+		return
+	}
+	left := newComparisonSide(ctx.AdditiveExpression(0))
+	var right comparisonSide
+	if ctx.ProductExpression() != nil {
+		right = newComparisonSide(ctx.ProductExpression())
+	} else {
+		right = newComparisonSide(ctx.AdditiveExpression(1))
+	}
+	op := ctx.ComparisonOp().GetText()
+	if op == "==" {
+		op = "="
+	}
+	if left.literal || (left.field == "" && right.field != "") {
+		left, right, op = right, left, flippedOperators[op]
+	}
+	if len(left.fields) == 0 {
+		return // Nothing but literals
+	}
+
+	cond := Condition{
+		Field:     left.fields[0],
+		Operator:  op,
+		Negated:   e.negated,
+		PipeStage: e.currentStage,
+		LogicalOp: e.lastLogicalOp,
+	}
+	if left.field == "" {
+		cond.Comparison, cond.FieldExpression = CompareExpression, left.text
+	}
+	switch {
+	case right.literal && left.field == "":
+		// A literal constrains the expression, not Field: len(message)>100
+		// says nothing about message=100
+		cond.ValueExpression = right.raw
+	case right.literal:
+		setValue(&cond, right.raw, true)
+		cond.Value = stripQuotes(right.raw)
+		e.recordThreshold(cond.Field, op, cond.Value)
+	case right.field != "":
+		cond.ValueField = right.field
+		if cond.Comparison == CompareValue {
+			cond.Comparison = CompareField
+		}
+		if left.field != "" {
+			e.recordThreshold(cond.Field, op, right.field)
+		}
+	default:
+		cond.Comparison, cond.ValueExpression = CompareExpression, right.text
+		if left.field != "" {
+			e.recordThreshold(cond.Field, op, right.text)
+		}
+	}
+	if isExcludedField(strings.ToLower(cond.Field)) {
+		return
+	}
+	cond.SourceField, cond.IsComputed = e.computedSource(cond.Field)
+	e.conditions = append(e.conditions, cond)
+	e.lastLogicalOp = "AND"
+}
+
+// inWhereCommand reports whether ctx is part of a where command
+func inWhereCommand(ctx antlr.Tree) bool {
+	for p := ctx.GetParent(); p != nil; p = p.GetParent() {
+		switch p.(type) {
+		case *WhereCommandContext:
+			return true
+		case *PipelineStageContext:
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package spl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldComparisons(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  Condition
+	}{
+		{
+			name:  "field to field",
+			query: `index=fw | where src_ip=dest_ip`,
+			want:  Condition{Field: "src_ip", Operator: "=", Comparison: CompareField, ValueField: "dest_ip"},
+		},
+		{
+			name:  "single-quoted field",
+			query: `index=fw | where 'src ip'!='dest ip'`,
+			want:  Condition{Field: "src ip", Operator: "!=", Comparison: CompareField, ValueField: "dest ip"},
+		},
+		{
+			name:  "arithmetic on the right",
+			query: `index=proxy | where bytes_out > bytes_in*10`,
+			want:  Condition{Field: "bytes_out", Operator: ">", Comparison: CompareExpression, ValueExpression: "bytes_in*10"},
+		},
+		{
+			name:  "function on the left",
+			query: `index=auth | where len(user) >= 20`,
+			want:  Condition{Field: "user", Operator: ">=", Comparison: CompareExpression, FieldExpression: "len(user)", ValueExpression: "20"},
+		},
+		{
+			name:  "function on the left with a string",
+			query: `index=proc | where tonumber(x)="5"`,
+			want:  Condition{Field: "x", Operator: "=", Comparison: CompareExpression, FieldExpression: "tonumber(x)", ValueExpression: `"5"`},
+		},
+		{
+			name:  "literal on the left",
+			query: `index=auth | where 5 < failures`,
+			want:  Condition{Field: "failures", Operator: ">", Value: "5", RawValue: "5", Unescaped: "5", ValueKind: ValueNumber},
+		},
+		{
+			name:  "field on the right of an expression",
+			query: `index=proxy | where bytes_in / 2 < bytes_out`,
+			want:  Condition{Field: "bytes_out", Operator: ">", Comparison: CompareExpression, ValueExpression: "bytes_in / 2"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ExtractConditions(tc.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("unexpected errors: %v", result.Errors)
+			}
+			var got []Condition
+			for _, c := range result.Conditions {
+				if c.PipeStage == 1 {
+					c.PipeStage, c.LogicalOp = 0, ""
+					got = append(got, c)
+				}
+			}
+			if len(got) != 1 || !reflect.DeepEqual(got[0], tc.want) {
+				t.Errorf("conditions = %+v\nwant %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFieldComparisons_SearchKeepsLiterals(t *testing.T) {
+	result := ExtractConditions(`index=fw src_ip=dest_ip`)
+	for _, c := range result.Conditions {
+		if c.Field == "src_ip" && (c.Value != "dest_ip" || c.Comparison != CompareValue) {
+			t.Errorf("search condition = %+v, want the literal dest_ip", c)
+		}
+	}
+}
+
+func TestFieldComparisons_Executor(t *testing.T) {
+	events := []Event{
+		{"src_ip": "10.0.0.1", "dest_ip": "10.0.0.1", "bytes_in": "10", "bytes_out": "500"},
+		{"src_ip": "10.0.0.1", "dest_ip": "10.0.0.2", "bytes_in": "100", "bytes_out": "500"},
+	}
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{`where src_ip=dest_ip`, 1},
+		{`where bytes_out > bytes_in*10`, 1},
+		{`where bytes_out > bytes_in*10 OR src_ip!=dest_ip`, 2},
+		{`where bytes_out=500 bytes_in>50`, 1},
+		{`where bytes_out > bytes_in * 10 src_ip=dest_ip`, 1},
+	}
+	for _, tc := range tests {
+		rs, err := NewExecutor().Run(tc.query, events)
+		if err != nil {
+			t.Fatalf("Run(%s): %v", tc.query, err)
+		}
+		if len(rs.Rows) != tc.want {
+			t.Errorf("Run(%s) = %d rows, want %d", tc.query, len(rs.Rows), tc.want)
+		}
+	}
+}
+
+func TestFieldComparisons_ImplicitAnd(t *testing.T) {
+	tests := []struct {
+		query    string
+		fields   []string
+		commands []string
+	}{
+		{`index=main | where a=1 b=2 | stats count by user`, []string{"a", "b"}, []string{"where", "stats"}},
+		{`index=main | where isnotnull(x) x>5`, []string{"x", "x"}, []string{"where"}},
+		{`index=main | where bytes_out > bytes_in*10 user=admin`, []string{"bytes_out", "user"}, []string{"where"}},
+		{`index=main | where user=adm* host=web`, []string{"user", "host"}, []string{"where"}},
+	}
+	for _, tc := range tests {
+		result := ExtractConditions(tc.query)
+		if len(result.Errors) > 0 {
+			t.Errorf("%s: unexpected errors: %v", tc.query, result.Errors)
+			continue
+		}
+		var fields []string
+		for _, c := range result.Conditions {
+			if c.Field != "index" {
+				fields = append(fields, c.Field)
+			}
+		}
+		if !reflect.DeepEqual(fields, tc.fields) {
+			t.Errorf("%s: condition fields = %v, want %v", tc.query, fields, tc.fields)
+		}
+		if !reflect.DeepEqual(result.Commands, tc.commands) {
+			t.Errorf("%s: commands = %v, want %v", tc.query, result.Commands, tc.commands)
+		}
+	}
+}
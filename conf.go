@@ -0,0 +1,66 @@
+package spl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// confStanza is one [stanza] of a Splunk .conf file
+type confStanza struct {
+	name     string
+	line     int
+	settings map[string]string
+}
+
+// readConf parses a Splunk .conf file. Settings in [default] or before the
+// first stanza are returned separately; a trailing backslash continues a
+// value on the next line. file names the file in error messages.
+func readConf(r io.Reader, file string) (map[string]string, []*confStanza, error) {
+	defaults := make(map[string]string)
+	var stanzas []*confStanza
+	current := defaults
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		start := lineNo
+		for strings.HasSuffix(line, `\`) && scanner.Scan() {
+			lineNo++
+			line = strings.TrimSuffix(line, `\`) + "\n" + scanner.Text()
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			if !strings.HasSuffix(trimmed, "]") {
+				return nil, nil, fmt.Errorf("%s line %d: unterminated stanza header %q", file, start, trimmed)
+			}
+			name := strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+			if name == "default" {
+				current = defaults
+				continue
+			}
+			stanza := &confStanza{name: name, line: start, settings: make(map[string]string)}
+			stanzas = append(stanzas, stanza)
+			current = stanza.settings
+			continue
+		}
+
+		key, value, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			return nil, nil, fmt.Errorf("%s line %d: expected key = value, got %q", file, start, trimmed)
+		}
+		current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("reading %s: %w", file, err)
+	}
+	return defaults, stanzas, nil
+}
@@ -0,0 +1,286 @@
+package spl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DataModel is a Splunk data model definition, such as the CIM Endpoint model
+type DataModel struct {
+	Name    string             `json:"name"`
+	Objects []*DataModelObject `json:"objects"`
+}
+
+// DataModelObject is one object (node) of a data model. Child objects inherit
+// the fields and constraints of their parent.
+type DataModelObject struct {
+	Name        string   `json:"name"`
+	Parent      string   `json:"parent,omitempty"`      // Parent object, empty for root objects
+	Fields      []string `json:"fields"`                // Fields defined on this object, including calculated outputs
+	Constraints []string `json:"constraints,omitempty"` // Constraint searches defined on this object
+
+	model *DataModel
+}
+
+// baseEventFields exist on every event-based data model object
+var baseEventFields = []string{"_time", "host", "source", "sourcetype"}
+
+// dataModelJSON mirrors the JSON Splunk stores in data model definition files
+type dataModelJSON struct {
+	ModelName string `json:"modelName"`
+	Objects   []struct {
+		ObjectName string `json:"objectName"`
+		ParentName string `json:"parentName"`
+		Fields     []struct {
+			FieldName string `json:"fieldName"`
+		} `json:"fields"`
+		Calculations []struct {
+			OutputFields []struct {
+				FieldName string `json:"fieldName"`
+			} `json:"outputFields"`
+		} `json:"calculations"`
+		Constraints []struct {
+			Search string `json:"search"`
+		} `json:"constraints"`
+	} `json:"objects"`
+}
+
+// ParseDataModel reads a data model definition in Splunk's JSON format
+// (the files under default/data/models, e.g. Endpoint.json)
+func ParseDataModel(r io.Reader) (*DataModel, error) {
+	var raw dataModelJSON
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decoding data model: %w", err)
+	}
+	if raw.ModelName == "" {
+		return nil, fmt.Errorf("data model has no modelName")
+	}
+
+	m := &DataModel{Name: raw.ModelName}
+	for _, o := range raw.Objects {
+		obj := &DataModelObject{Name: o.ObjectName, model: m}
+		if !strings.HasPrefix(o.ParentName, "Base") {
+			obj.Parent = o.ParentName // BaseEvent, BaseSearch and BaseTransaction are built in
+		}
+		for _, f := range o.Fields {
+			obj.Fields = append(obj.Fields, f.FieldName)
+		}
+		for _, c := range o.Calculations {
+			for _, f := range c.OutputFields {
+				obj.Fields = append(obj.Fields, f.FieldName)
+			}
+		}
+		for _, c := range o.Constraints {
+			if search := strings.TrimSpace(c.Search); search != "" {
+				obj.Constraints = append(obj.Constraints, search)
+			}
+		}
+		m.Objects = append(m.Objects, obj)
+	}
+	for _, obj := range m.Objects {
+		if obj.Parent != "" && m.Object(obj.Parent) == nil {
+			return nil, fmt.Errorf("data model %s: object %s has unknown parent %s", m.Name, obj.Name, obj.Parent)
+		}
+	}
+	return m, nil
+}
+
+// Object returns the named object, or nil
+func (m *DataModel) Object(name string) *DataModelObject {
+	for _, obj := range m.Objects {
+		if strings.EqualFold(obj.Name, name) {
+			return obj
+		}
+	}
+	return nil
+}
+
+// roots returns the objects without a parent
+func (m *DataModel) roots() []*DataModelObject {
+	var roots []*DataModelObject
+	for _, obj := range m.Objects {
+		if obj.Parent == "" {
+			roots = append(roots, obj)
+		}
+	}
+	return roots
+}
+
+// Lineage returns the object's path from its root object, e.g.
+// [Authentication Failed_Authentication]. tstats fields are prefixed with it.
+func (o *DataModelObject) Lineage() []string {
+	var path []string
+	for cur := o; cur != nil; cur = o.model.Object(cur.Parent) {
+		path = append([]string{cur.Name}, path...)
+		if cur.Parent == "" || len(path) > len(o.model.Objects) {
+			break
+		}
+	}
+	return path
+}
+
+// AllFields returns the object's own and inherited fields
+func (o *DataModelObject) AllFields() []string {
+	seen := make(map[string]bool)
+	var fields []string
+	add := func(names []string) {
+		for _, f := range names {
+			if !seen[f] {
+				seen[f] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+	add(baseEventFields)
+	for _, name := range o.Lineage() {
+		add(o.model.Object(name).Fields)
+	}
+	return fields
+}
+
+// HasField reports whether the object defines or inherits field
+func (o *DataModelObject) HasField(field string) bool {
+	for _, f := range o.AllFields() {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ConstraintSearch returns the base search an event must match to belong to
+// the object: its ancestors' constraints and its own, ANDed together
+func (o *DataModelObject) ConstraintSearch() string {
+	var parts []string
+	for _, name := range o.Lineage() {
+		for _, c := range o.model.Object(name).Constraints {
+			if strings.Contains(strings.ToUpper(c), " OR ") {
+				c = "(" + c + ")"
+			}
+			parts = append(parts, c)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// DataModelCatalog is a set of data models keyed by name
+type DataModelCatalog struct {
+	Models map[string]*DataModel
+}
+
+// LoadDataModels parses one data model definition from each reader
+func LoadDataModels(readers ...io.Reader) (*DataModelCatalog, error) {
+	c := &DataModelCatalog{Models: make(map[string]*DataModel)}
+	for _, r := range readers {
+		m, err := ParseDataModel(r)
+		if err != nil {
+			return nil, err
+		}
+		c.Models[m.Name] = m
+	}
+	return c, nil
+}
+
+// DataModelResolution is a tstats datamodel reference checked against the catalog
+type DataModelResolution struct {
+	Reference         DataModelReference `json:"reference"`
+	Model             *DataModel         `json:"-"`
+	Object            *DataModelObject   `json:"-"`
+	Fields            map[string]string  `json:"fields,omitempty"`             // Field as written -> data model field, e.g. Processes.process_name -> process_name
+	Constraint        string             `json:"constraint,omitempty"`         // Object's base constraint search, e.g. "tag=process tag=report"
+	ImpliedConditions []Condition        `json:"implied_conditions,omitempty"` // Conditions of Constraint, at the tstats pipe stage
+	Errors            []string           `json:"errors,omitempty"`             // Unknown data models, objects and fields
+}
+
+// Resolve checks every tstats datamodel reference in result against the
+// catalog. Node-prefixed fields are mapped to their data model fields and the
+// object's constraint search is returned as implied conditions.
+func (c *DataModelCatalog) Resolve(result *ParseResult) []DataModelResolution {
+	if result == nil {
+		return nil
+	}
+	resolutions := make([]DataModelResolution, 0, len(result.DataModels))
+	for _, ref := range result.DataModels {
+		resolutions = append(resolutions, c.resolve(ref))
+	}
+	return resolutions
+}
+
+func (c *DataModelCatalog) resolve(ref DataModelReference) DataModelResolution {
+	res := DataModelResolution{Reference: ref}
+	for name, m := range c.Models {
+		if strings.EqualFold(name, ref.Model) {
+			res.Model = m
+		}
+	}
+	if res.Model == nil {
+		res.Errors = append(res.Errors, fmt.Sprintf("unknown data model %q", ref.Model))
+		return res
+	}
+
+	if ref.Object != "" {
+		res.Object = res.Model.Object(ref.Object)
+		if res.Object == nil {
+			res.Errors = append(res.Errors, fmt.Sprintf("data model %s has no object %q", res.Model.Name, ref.Object))
+			return res
+		}
+	} else if roots := res.Model.roots(); len(roots) == 1 {
+		res.Object = roots[0] // datamodel=Authentication searches the Authentication root object
+	}
+
+	if res.Object != nil {
+		res.Constraint = res.Object.ConstraintSearch()
+		if res.Constraint != "" {
+			for _, cond := range ExtractConditions(res.Constraint).Conditions {
+				cond.PipeStage = ref.PipeStage
+				res.ImpliedConditions = append(res.ImpliedConditions, cond)
+			}
+		}
+	}
+
+	res.Fields = make(map[string]string)
+	for _, field := range ref.Fields {
+		if _, done := res.Fields[field]; done {
+			continue
+		}
+		name, err := res.resolveField(field)
+		if err != nil {
+			res.Errors = append(res.Errors, err.Error())
+			continue
+		}
+		res.Fields[field] = name
+	}
+	return res
+}
+
+// resolveField maps a tstats field to its data model field. Unprefixed fields
+// must be base or search scope fields (tstats only sees prefixed data model
+// fields); prefixed ones are looked up on the object whose lineage matches.
+func (res *DataModelResolution) resolveField(field string) (string, error) {
+	i := strings.LastIndex(field, ".")
+	if i < 0 {
+		if IsSearchScopeMetadata(field) {
+			return field, nil
+		}
+		for _, f := range baseEventFields {
+			if f == field {
+				return field, nil
+			}
+		}
+		return "", fmt.Errorf("field %q is not prefixed with a %s object name", field, res.Model.Name)
+	}
+
+	prefix, name := field[:i], field[i+1:]
+	for _, obj := range res.Model.Objects {
+		if !strings.EqualFold(strings.Join(obj.Lineage(), "."), prefix) {
+			continue
+		}
+		if !obj.HasField(name) {
+			return "", fmt.Errorf("data model object %s.%s has no field %q", res.Model.Name, obj.Name, name)
+		}
+		return name, nil
+	}
+	return "", fmt.Errorf("unknown data model object %q in field %q", prefix, field)
+}
@@ -0,0 +1,167 @@
+package spl
+
+import (
+	"strings"
+	"testing"
+)
+
+const testEndpointModel = `{
+  "modelName": "Endpoint",
+  "objects": [
+    {
+      "objectName": "Processes",
+      "parentName": "BaseEvent",
+      "fields": [{"fieldName": "dest"}, {"fieldName": "user"}, {"fieldName": "process_name"}, {"fieldName": "process"}],
+      "calculations": [{"outputFields": [{"fieldName": "process_exec"}]}],
+      "constraints": [{"search": "tag=process tag=report"}]
+    },
+    {
+      "objectName": "Services",
+      "parentName": "BaseEvent",
+      "fields": [{"fieldName": "service_name"}],
+      "constraints": [{"search": "tag=service tag=report"}]
+    }
+  ]
+}`
+
+const testAuthenticationModel = `{
+  "modelName": "Authentication",
+  "objects": [
+    {
+      "objectName": "Authentication",
+      "parentName": "BaseEvent",
+      "fields": [{"fieldName": "action"}, {"fieldName": "src"}, {"fieldName": "user"}],
+      "constraints": [{"search": "tag=authentication NOT (action=success user=*$)"}]
+    },
+    {
+      "objectName": "Failed_Authentication",
+      "parentName": "Authentication",
+      "constraints": [{"search": "action=\"failure\""}]
+    }
+  ]
+}`
+
+func loadTestDataModels(t *testing.T) *DataModelCatalog {
+	t.Helper()
+	c, err := LoadDataModels(strings.NewReader(testEndpointModel), strings.NewReader(testAuthenticationModel))
+	if err != nil {
+		t.Fatalf("LoadDataModels error: %v", err)
+	}
+	return c
+}
+
+func TestDataModel_Objects(t *testing.T) {
+	c := loadTestDataModels(t)
+
+	failed := c.Models["Authentication"].Object("Failed_Authentication")
+	if failed == nil {
+		t.Fatal("Expected Failed_Authentication object")
+	}
+	if got := strings.Join(failed.Lineage(), "."); got != "Authentication.Failed_Authentication" {
+		t.Errorf("Lineage = %s", got)
+	}
+	if !failed.HasField("src") || !failed.HasField("_time") {
+		t.Errorf("Expected inherited fields, got %v", failed.AllFields())
+	}
+	want := `tag=authentication NOT (action=success user=*$) action="failure"`
+	if got := failed.ConstraintSearch(); got != want {
+		t.Errorf("ConstraintSearch = %q, want %q", got, want)
+	}
+	if !c.Models["Endpoint"].Object("Processes").HasField("process_exec") {
+		t.Error("Expected calculated output field process_exec")
+	}
+}
+
+func TestDataModel_ResolveTstats(t *testing.T) {
+	c := loadTestDataModels(t)
+	query := `| tstats count values(Processes.process) from datamodel=Endpoint.Processes where Processes.process_name="cmd.exe" by Processes.dest Processes.user _time span=1h`
+	result := ExtractConditions(query)
+	if len(result.DataModels) != 1 {
+		t.Fatalf("Expected 1 datamodel reference, got %+v", result.DataModels)
+	}
+
+	res := c.Resolve(result)[0]
+	if len(res.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", res.Errors)
+	}
+	wantFields := map[string]string{
+		"Processes.process":      "process",
+		"Processes.process_name": "process_name",
+		"Processes.dest":         "dest",
+		"Processes.user":         "user",
+		"_time":                  "_time",
+	}
+	for field, want := range wantFields {
+		if got := res.Fields[field]; got != want {
+			t.Errorf("Fields[%s] = %q, want %q", field, got, want)
+		}
+	}
+	if res.Constraint != "tag=process tag=report" {
+		t.Errorf("Constraint = %q", res.Constraint)
+	}
+	var tags []string
+	for _, cond := range res.ImpliedConditions {
+		if cond.Field != "tag" || cond.PipeStage != 0 {
+			t.Errorf("Unexpected implied condition %+v", cond)
+		}
+		tags = append(tags, cond.Value)
+	}
+	if strings.Join(tags, ",") != "process,report" {
+		t.Errorf("Implied tags = %v, want [process report]", tags)
+	}
+}
+
+func TestDataModel_ResolveNodename(t *testing.T) {
+	c := loadTestDataModels(t)
+	result := ExtractConditions(`| tstats count from datamodel=Authentication where nodename=Authentication.Failed_Authentication by Authentication.src`)
+	res := c.Resolve(result)[0]
+	if len(res.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", res.Errors)
+	}
+	if res.Object == nil || res.Object.Name != "Failed_Authentication" {
+		t.Fatalf("Object = %+v, want Failed_Authentication", res.Object)
+	}
+	if !strings.HasSuffix(res.Constraint, `action="failure"`) {
+		t.Errorf("Constraint = %q", res.Constraint)
+	}
+	if res.Fields["Authentication.src"] != "src" {
+		t.Errorf("Fields = %v", res.Fields)
+	}
+}
+
+func TestDataModel_ResolveErrors(t *testing.T) {
+	c := loadTestDataModels(t)
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`| tstats count from datamodel=Network_Traffic by All_Traffic.src`, `unknown data model "Network_Traffic"`},
+		{`| tstats count from datamodel=Endpoint.Registry by Registry.dest`, `data model Endpoint has no object "Registry"`},
+		{`| tstats count from datamodel=Endpoint.Processes by Processes.parent_guid`, `has no field "parent_guid"`},
+		{`| tstats count from datamodel=Endpoint.Processes by Filesystem.dest`, `unknown data model object "Filesystem"`},
+		{`| tstats count from datamodel=Endpoint.Processes by dest`, `not prefixed`},
+	}
+	for _, tt := range tests {
+		res := c.Resolve(ExtractConditions(tt.query))
+		if len(res) != 1 || len(res[0].Errors) == 0 {
+			t.Errorf("%s: expected an error, got %+v", tt.query, res)
+			continue
+		}
+		if !strings.Contains(res[0].Errors[0], tt.want) {
+			t.Errorf("%s: error %q, want it to contain %q", tt.query, res[0].Errors[0], tt.want)
+		}
+	}
+}
+
+func TestParseDataModel_Errors(t *testing.T) {
+	tests := []string{
+		`not json`,
+		`{"objects": []}`,
+		`{"modelName": "X", "objects": [{"objectName": "Child", "parentName": "Missing"}]}`,
+	}
+	for _, def := range tests {
+		if _, err := ParseDataModel(strings.NewReader(def)); err == nil {
+			t.Errorf("Expected error for %s", def)
+		}
+	}
+}
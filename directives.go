@@ -0,0 +1,59 @@
+package spl
+
+import (
+	"regexp"
+	"strings"
+)
+
+// majorBreakers are the characters Splunk splits events on when indexing
+// terms. TERM() matches a run of characters between them.
+const majorBreakers = " \t\r\n[]<>(){}|!;,'\"&?+"
+
+// splitDirective removes TERM() or CASE() from a directive token
+func splitDirective(text string) (arg string, term, caseSensitive bool) {
+	switch {
+	case strings.HasPrefix(text, "TERM(") && strings.HasSuffix(text, ")"):
+		return text[len("TERM(") : len(text)-1], true, false
+	case strings.HasPrefix(text, "CASE(") && strings.HasSuffix(text, ")"):
+		return text[len("CASE(") : len(text)-1], false, true
+	}
+	return text, false, false
+}
+
+// valueDirective returns the text of a value with any TERM() or CASE()
+// removed, and which directive it had
+func valueDirective(ctx IValueContext) (text string, term, caseSensitive bool) {
+	if ctx.TERM_DIRECTIVE() != nil || ctx.CASE_DIRECTIVE() != nil {
+		return splitDirective(ctx.GetText())
+	}
+	return ctx.GetText(), false, false
+}
+
+// bareWordDirective is valueDirective for a search term
+func bareWordDirective(ctx IBareWordContext) (text string, term, caseSensitive bool) {
+	if ctx.TERM_DIRECTIVE() != nil || ctx.CASE_DIRECTIVE() != nil {
+		return splitDirective(ctx.GetText())
+	}
+	return ctx.GetText(), false, false
+}
+
+// TermRegexp converts the argument of TERM() into an unanchored Go regex
+// that matches it as a whole term: bounded by major breakers or the ends of
+// the text, case-insensitively. A * matches within the term only.
+func TermRegexp(term string) string {
+	breakers := regexp.QuoteMeta(majorBreakers)
+	parts := strings.Split(term, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	return `(?i)(?:^|[` + breakers + `])` + strings.Join(parts, `[^`+breakers+`]*`) + `(?:$|[` + breakers + `])`
+}
+
+// matchTerm reports whether s contains term as a whole term
+func matchTerm(term, s string) bool {
+	re, err := cachedRegexp(TermRegexp(term))
+	if err != nil {
+		return false
+	}
+	return re.MatchString(s)
+}
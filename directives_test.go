@@ -0,0 +1,136 @@
+package spl
+
+import "testing"
+
+func TestSearchDirectives(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  Condition
+	}{
+		{
+			name:  "TERM",
+			query: `index=fw TERM(10.0.0.1)`,
+			want:  Condition{Field: "_raw", Operator: "contains", Value: "10.0.0.1", Term: true},
+		},
+		{
+			name:  "CASE",
+			query: `index=auth CASE(Admin)`,
+			want:  Condition{Field: "_raw", Operator: "contains", Value: "Admin", CaseSensitive: true},
+		},
+		{
+			name:  "negated TERM",
+			query: `index=dns NOT TERM(bad.example.com)`,
+			want:  Condition{Field: "_raw", Operator: "contains", Value: "bad.example.com", Term: true, Negated: true},
+		},
+		{
+			name:  "field value in CASE",
+			query: `index=auth user=CASE(Admin)`,
+			want:  Condition{Field: "user", Operator: "=", Value: "Admin", CaseSensitive: true},
+		},
+		{
+			name:  "field value in quoted TERM",
+			query: `index=auth user=TERM("admin@example.com")`,
+			want:  Condition{Field: "user", Operator: "=", Value: "admin@example.com", Term: true},
+		},
+		{
+			name:  "indexed field",
+			query: `index=web host::web01`,
+			want:  Condition{Field: "host", Operator: "=", Value: "web01", IndexedField: true},
+		},
+		{
+			name:  "indexed field wildcard",
+			query: `index=web sourcetype=access host::web*`,
+			want:  Condition{Field: "host", Operator: "=", Value: "web*", IndexedField: true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ExtractConditions(tc.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("unexpected errors: %v", result.Errors)
+			}
+			var got []Condition
+			for _, c := range result.Conditions {
+				if c.Field == tc.want.Field {
+					got = append(got, c)
+				}
+			}
+			if len(got) != 1 {
+				t.Fatalf("conditions on %s = %+v, want 1", tc.want.Field, got)
+			}
+			c := got[0]
+			if c.Operator != tc.want.Operator || c.Value != tc.want.Value || c.Negated != tc.want.Negated ||
+				c.Term != tc.want.Term || c.CaseSensitive != tc.want.CaseSensitive || c.IndexedField != tc.want.IndexedField {
+				t.Errorf("condition = %+v\nwant %+v", c, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchDirectives_EvalCaseIsAFunction(t *testing.T) {
+	result := ExtractConditions(`index=main | eval action=CASE(EventCode=4624, "Success", EventCode=4625, "Failure") | where action="Success"`)
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	for _, c := range result.Conditions {
+		if c.Field == "EventCode" || c.CaseSensitive {
+			t.Errorf("eval case() produced a directive condition: %+v", c)
+		}
+	}
+}
+
+func TestSearchDirectives_Executor(t *testing.T) {
+	events := []Event{
+		{"_raw": "login from 10.0.0.1 by Admin", "host": "web01", "user": "Admin"},
+		{"_raw": "login from 10.0.0.10 by admin", "host": "WEB01", "user": "admin"},
+		{"_raw": "src=10.0.0.1,user=root", "host": "db01", "user": "root"},
+	}
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{`TERM(10.0.0.1)`, 1}, // = is a minor breaker, so src=10.0.0.1 is one term
+		{`10.0.0.1`, 3},
+		{`TERM(10.0.0.*)`, 2},
+		{`NOT TERM(10.0.0.1)`, 2},
+		{`CASE(Admin)`, 1},
+		{`Admin`, 2},
+		{`user=CASE(Admin)`, 1},
+		{`user=Admin`, 2},
+		{`host::web01`, 2},
+		{`host::web*`, 2},
+	}
+	for _, tc := range tests {
+		rs, err := NewExecutor().Run(tc.query, events)
+		if err != nil {
+			t.Fatalf("Run(%s): %v", tc.query, err)
+		}
+		if len(rs.Rows) != tc.want {
+			t.Errorf("Run(%s) = %d rows, want %d", tc.query, len(rs.Rows), tc.want)
+		}
+	}
+}
+
+func TestTermRegexp(t *testing.T) {
+	tests := []struct {
+		term  string
+		input string
+		want  bool
+	}{
+		{"10.0.0.1", "from 10.0.0.1 to", true},
+		{"10.0.0.1", "from 10.0.0.10 to", false},
+		{"10.0.0.1", "[10.0.0.1]", true},
+		{"10.0.0.1", "10.0.0.1", true},
+		{"10.0.0.1", "10x0x0x1", false},
+		{"user@example.com", "to: USER@example.com,", true},
+		{"10.0.*", "ip 10.0.3.4 seen", true},
+		{"10.0.*", "ip 110.0.3.4 seen", false},
+	}
+	for _, tc := range tests {
+		if got := matchTerm(tc.term, tc.input); got != tc.want {
+			t.Errorf("TERM(%s) in %q = %v, want %v (%s)", tc.term, tc.input, got, tc.want, TermRegexp(tc.term))
+		}
+	}
+}
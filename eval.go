@@ -0,0 +1,795 @@
+package spl
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Event is a single search result: field name -> value. Values may be strings,
+// numbers, booleans, nil or slices (multivalue fields), as produced by encoding/json.
+type Event map[string]any
+
+// Clone returns a shallow copy of the event
+func (ev Event) Clone() Event {
+	out := make(Event, len(ev))
+	for k, v := range ev {
+		out[k] = v
+	}
+	return out
+}
+
+// EvalKind identifies the runtime type of an EvalValue
+type EvalKind int
+
+const (
+	EvalNull   EvalKind = iota // Missing field or null()
+	EvalString                 // String value
+	EvalNumber                 // Numeric value (SPL numbers are float64)
+	EvalBool                   // Result of a comparison or boolean function
+	EvalMulti                  // Multivalue field
+)
+
+// EvalValue is the result of evaluating an eval expression
+type EvalValue struct {
+	Kind  EvalKind    `json:"kind"`
+	Str   string      `json:"str,omitempty"`
+	Num   float64     `json:"num,omitempty"`
+	Bool  bool        `json:"bool,omitempty"`
+	Multi []EvalValue `json:"multi,omitempty"`
+}
+
+func nullVal() EvalValue         { return EvalValue{Kind: EvalNull} }
+func strVal(s string) EvalValue  { return EvalValue{Kind: EvalString, Str: s} }
+func numVal(f float64) EvalValue { return EvalValue{Kind: EvalNumber, Num: f} }
+func boolVal(b bool) EvalValue   { return EvalValue{Kind: EvalBool, Bool: b} }
+func mvVal(vals []EvalValue) EvalValue {
+	switch len(vals) {
+	case 0:
+		return nullVal()
+	case 1:
+		return vals[0]
+	}
+	return EvalValue{Kind: EvalMulti, Multi: vals}
+}
+
+// EvalValueOf converts a Go value (as stored in an Event) into an EvalValue.
+// Slices become multivalue fields; empty slices are null.
+func EvalValueOf(v any) EvalValue {
+	switch x := v.(type) {
+	case nil:
+		return nullVal()
+	case EvalValue:
+		return x
+	case string:
+		return strVal(x)
+	case float64:
+		return numVal(x)
+	case float32:
+		return numVal(float64(x))
+	case int:
+		return numVal(float64(x))
+	case int64:
+		return numVal(float64(x))
+	case int32:
+		return numVal(float64(x))
+	case uint:
+		return numVal(float64(x))
+	case uint64:
+		return numVal(float64(x))
+	case json.Number:
+		if f, err := x.Float64(); err == nil {
+			return numVal(f)
+		}
+		return strVal(x.String())
+	case bool:
+		return boolVal(x)
+	case []string:
+		vals := make([]EvalValue, 0, len(x))
+		for _, s := range x {
+			vals = append(vals, strVal(s))
+		}
+		return mvVal(vals)
+	case []any:
+		vals := make([]EvalValue, 0, len(x))
+		for _, item := range x {
+			if ev := EvalValueOf(item); !ev.IsNull() {
+				vals = append(vals, ev.Values()...)
+			}
+		}
+		return mvVal(vals)
+	case map[string]any:
+		b, err := json.Marshal(x)
+		if err != nil {
+			return strVal(fmt.Sprint(x))
+		}
+		return strVal(string(b))
+	}
+	return strVal(fmt.Sprint(v))
+}
+
+// IsNull reports whether the value is null
+func (v EvalValue) IsNull() bool {
+	return v.Kind == EvalNull
+}
+
+// Values returns the individual values: none for null, the elements of a
+// multivalue field, or the value itself.
+func (v EvalValue) Values() []EvalValue {
+	switch v.Kind {
+	case EvalNull:
+		return nil
+	case EvalMulti:
+		return v.Multi
+	}
+	return []EvalValue{v}
+}
+
+// scalar returns the first value of a multivalue field, or v itself
+func (v EvalValue) scalar() EvalValue {
+	if v.Kind == EvalMulti && len(v.Multi) > 0 {
+		return v.Multi[0]
+	}
+	return v
+}
+
+// String returns the SPL string form of the value. Multivalue fields are
+// joined with newlines, the way Splunk renders them.
+func (v EvalValue) String() string {
+	switch v.Kind {
+	case EvalString:
+		return v.Str
+	case EvalNumber:
+		return formatNumber(v.Num)
+	case EvalBool:
+		return strconv.FormatBool(v.Bool)
+	case EvalMulti:
+		parts := make([]string, len(v.Multi))
+		for i, m := range v.Multi {
+			parts[i] = m.String()
+		}
+		return strings.Join(parts, "\n")
+	}
+	return ""
+}
+
+// Number returns the numeric value, coercing numeric strings the way SPL
+// does. The second result is false if the value is not numeric.
+func (v EvalValue) Number() (float64, bool) {
+	switch v.Kind {
+	case EvalNumber:
+		return v.Num, true
+	case EvalString:
+		return parseNumber(v.Str)
+	case EvalMulti:
+		return v.scalar().Number()
+	}
+	return 0, false
+}
+
+// Truthy reports whether the value counts as true in a boolean context
+func (v EvalValue) Truthy() bool {
+	switch v.Kind {
+	case EvalBool:
+		return v.Bool
+	case EvalNumber:
+		return v.Num != 0
+	case EvalString:
+		return v.Str != ""
+	case EvalMulti:
+		return len(v.Multi) > 0
+	}
+	return false
+}
+
+// Interface converts the value back into a plain Go value for storing in an Event
+func (v EvalValue) Interface() any {
+	switch v.Kind {
+	case EvalString:
+		return v.Str
+	case EvalNumber:
+		return v.Num
+	case EvalBool:
+		return v.Bool
+	case EvalMulti:
+		out := make([]any, len(v.Multi))
+		for i, m := range v.Multi {
+			out[i] = m.Interface()
+		}
+		return out
+	}
+	return nil
+}
+
+// parseNumber parses s as a decimal number, rejecting the hex, Inf and NaN
+// forms strconv accepts but SPL does not.
+func parseNumber(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, false
+	}
+	for _, c := range s {
+		if !(c >= '0' && c <= '9') && c != '.' && c != '-' && c != '+' && c != 'e' && c != 'E' {
+			return 0, false
+		}
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, false
+	}
+	return f, true
+}
+
+// formatNumber renders a number without trailing zeros (3, 2.5, -0.125)
+func formatNumber(f float64) string {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Evaluator evaluates eval and where expressions against events using SPL
+// semantics: missing fields are null, nulls propagate through operators,
+// numeric strings compare and add as numbers, and "." concatenates.
+type Evaluator struct {
+	// Now is the clock used by now(), time() and relative_time(). Inject a
+	// fixed clock for deterministic tests. Defaults to time.Now.
+	Now func() time.Time
+	// Location is the time zone for strftime, strptime and time snapping.
+	// Defaults to UTC.
+	Location *time.Location
+}
+
+// NewEvaluator creates an Evaluator using the wall clock and UTC
+func NewEvaluator() *Evaluator {
+	return &Evaluator{Now: time.Now, Location: time.UTC}
+}
+
+func (e *Evaluator) now() time.Time {
+	if e.Now == nil {
+		return time.Now().In(e.location())
+	}
+	return e.Now().In(e.location())
+}
+
+func (e *Evaluator) location() *time.Location {
+	if e.Location == nil {
+		return time.UTC
+	}
+	return e.Location
+}
+
+// Evaluate parses a single eval expression and evaluates it against event.
+// e.g. Evaluate(`if(status>=500, "error", "ok")`, event)
+func (e *Evaluator) Evaluate(expr string, event Event) (EvalValue, error) {
+	tree, err := parseSPLRule(expr, func(p *SPLParser) antlr.ParserRuleContext { return p.Expression() })
+	if err != nil {
+		return nullVal(), err
+	}
+	return e.evalExpression(tree.(IExpressionContext), event)
+}
+
+// Eval applies the comma-separated assignments of an eval command (without
+// the "eval" keyword) to a copy of event and returns it. Assignments run left
+// to right, so later ones see earlier results. Null results remove the field.
+func (e *Evaluator) Eval(assignments string, event Event) (Event, error) {
+	tree, err := parseSPLRule("eval "+assignments, func(p *SPLParser) antlr.ParserRuleContext { return p.EvalCommand() })
+	if err != nil {
+		return nil, err
+	}
+	return e.applyEvalCommand(tree.(IEvalCommandContext), event)
+}
+
+// Where evaluates a where clause expression and reports whether event passes it
+func (e *Evaluator) Where(expr string, event Event) (bool, error) {
+	v, err := e.Evaluate(expr, event)
+	if err != nil {
+		return false, err
+	}
+	return v.Truthy(), nil
+}
+
+// parseSPLRule parses text with the given entry rule and fails on syntax
+// errors or trailing input.
+func parseSPLRule(text string, rule func(*SPLParser) antlr.ParserRuleContext) (tree antlr.ParserRuleContext, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			tree, err = nil, fmt.Errorf("parser panic: %v", r)
+		}
+	}()
+
+	input := antlr.NewInputStream(text)
+	lexer := NewSPLLexer(input)
+	lexer.RemoveErrorListeners()
+	lexerErrors := &errorListener{}
+	lexer.AddErrorListener(lexerErrors)
+
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := NewSPLParser(stream)
+	parser.RemoveErrorListeners()
+	parserErrors := &errorListener{}
+	parser.AddErrorListener(parserErrors)
+
+	tree = rule(parser)
+
+	if errs := append(lexerErrors.errors, parserErrors.errors...); len(errs) > 0 {
+		return nil, fmt.Errorf("syntax error in %q: %s", text, strings.Join(errs, "; "))
+	}
+	if stream.LA(1) != antlr.TokenEOF {
+		return nil, fmt.Errorf("syntax error in %q: unexpected %q", text, stream.LT(1).GetText())
+	}
+	return tree, nil
+}
+
+// applyEvalCommand runs every assignment of an eval command against a copy of event
+func (e *Evaluator) applyEvalCommand(ctx IEvalCommandContext, event Event) (Event, error) {
+	out := event.Clone()
+	for _, assign := range ctx.AllEvalAssignment() {
+		name := ""
+		if assign.FieldName() != nil {
+			name = fieldNameText(assign.FieldName())
+		} else if assign.QUOTED_STRING() != nil {
+			name = unquoteSPLString(assign.QUOTED_STRING().GetText())
+		}
+		v, err := e.evalExpression(assign.Expression(), out)
+		if err != nil {
+			return nil, fmt.Errorf("eval %s: %w", name, err)
+		}
+		if v.IsNull() {
+			delete(out, name)
+		} else {
+			out[name] = v.Interface()
+		}
+	}
+	return out, nil
+}
+
+func (e *Evaluator) evalExpression(ctx IExpressionContext, ev Event) (EvalValue, error) {
+	if ctx == nil || ctx.OrExpression() == nil {
+		return nullVal(), fmt.Errorf("empty expression")
+	}
+	return e.evalOr(ctx.OrExpression(), ev)
+}
+
+func (e *Evaluator) evalOr(ctx IOrExpressionContext, ev Event) (EvalValue, error) {
+	ands := ctx.AllAndExpression()
+	if len(ands) == 1 {
+		return e.evalAnd(ands[0], ev)
+	}
+	for _, and := range ands {
+		v, err := e.evalAnd(and, ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		if v.Truthy() {
+			return boolVal(true), nil
+		}
+	}
+	return boolVal(false), nil
+}
+
+func (e *Evaluator) evalAnd(ctx IAndExpressionContext, ev Event) (EvalValue, error) {
+	nots := ctx.AllNotExpression()
+	if len(nots) == 1 {
+		return e.evalNot(nots[0], ev)
+	}
+	for _, not := range nots {
+		v, err := e.evalNot(not, ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		if !v.Truthy() {
+			return boolVal(false), nil
+		}
+	}
+	return boolVal(true), nil
+}
+
+func (e *Evaluator) evalNot(ctx INotExpressionContext, ev Event) (EvalValue, error) {
+	if ctx.NOT() != nil {
+		v, err := e.evalNot(ctx.NotExpression(), ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		return boolVal(!v.Truthy()), nil
+	}
+	return e.evalComparison(ctx.ComparisonExpression(), ev)
+}
+
+func (e *Evaluator) evalComparison(ctx IComparisonExpressionContext, ev Event) (EvalValue, error) {
+	if ctx.Condition() != nil {
+		return e.evalCondition(ctx.Condition(), ev)
+	}
+	operands := ctx.AllAdditiveExpression()
+	left, err := e.evalAdditive(operands[0], ev)
+	if err != nil || ctx.ComparisonOp() == nil {
+		return left, err
+	}
+	var right EvalValue
+	if ctx.ProductExpression() != nil {
+		right, err = e.evalProduct(ctx.ProductExpression(), ev)
+	} else {
+		right, err = e.evalAdditive(operands[1], ev)
+	}
+	if err != nil {
+		return nullVal(), err
+	}
+	return boolVal(compareValues(left, right, ctx.ComparisonOp().GetText())), nil
+}
+
+// evalCondition handles the field-centric forms shared with the search
+// grammar: field op value, field IN (...), and bare function calls.
+func (e *Evaluator) evalCondition(ctx IConditionContext, ev Event) (EvalValue, error) {
+	if ctx.FunctionCall() != nil {
+		return e.evalFunctionCall(ctx.FunctionCall(), ev)
+	}
+	left, err := e.evalFieldName(ctx.FieldName(), ev)
+	if err != nil {
+		return nullVal(), err
+	}
+
+	switch {
+	case ctx.ComparisonOp() != nil:
+		op := ctx.ComparisonOp().GetText()
+		if wc := ctx.Value().WildcardValue(); wc != nil && (op == "=" || op == "==" || op == "!=") {
+			if left.IsNull() {
+				return boolVal(false), nil
+			}
+			matched := false
+			for _, v := range left.Values() {
+				if matchWildcard(wc.GetText(), v.String()) {
+					matched = true
+					break
+				}
+			}
+			return boolVal(matched == (op != "!=")), nil
+		}
+		right, err := e.evalValue(ctx.Value(), ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		return boolVal(compareValues(left, right, op)), nil
+	case ctx.DOUBLE_COLON() != nil:
+		right, err := e.evalValue(ctx.Value(), ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		return boolVal(compareValues(left, right, "=")), nil
+	case ctx.ValueList() != nil:
+		for _, vc := range ctx.ValueList().AllValue() {
+			right, err := e.evalValue(vc, ev)
+			if err != nil {
+				return nullVal(), err
+			}
+			if compareValues(left, right, "=") {
+				return boolVal(true), nil
+			}
+		}
+		return boolVal(false), nil
+	}
+	return nullVal(), fmt.Errorf("subsearch conditions cannot be evaluated: %s", ctx.GetText())
+}
+
+// evalValue evaluates the right-hand side of a condition. Bare identifiers
+// and single-quoted names are field references, as in eval.
+func (e *Evaluator) evalValue(ctx IValueContext, ev Event) (EvalValue, error) {
+	switch {
+	case ctx.QUOTED_STRING() != nil:
+		return strVal(unquoteSPLString(ctx.QUOTED_STRING().GetText())), nil
+	case ctx.SINGLE_QUOTED() != nil:
+		return e.lookupField(unquoteSPLString(ctx.SINGLE_QUOTED().GetText()), ev), nil
+	case ctx.NUMBER() != nil:
+		f, _ := strconv.ParseFloat(ctx.NUMBER().GetText(), 64)
+		return numVal(f), nil
+	case ctx.IDENTIFIER() != nil:
+		return e.lookupField(ctx.IDENTIFIER().GetText(), ev), nil
+	case ctx.KeywordAsValue() != nil:
+		return e.lookupField(ctx.KeywordAsValue().GetText(), ev), nil
+	case ctx.TERM_DIRECTIVE() != nil || ctx.CASE_DIRECTIVE() != nil:
+		text, _, _ := valueDirective(ctx)
+		return strVal(UnescapeValue(text)), nil
+	}
+	return strVal(ctx.GetText()), nil
+}
+
+func (e *Evaluator) evalAdditive(ctx IAdditiveExpressionContext, ev Event) (EvalValue, error) {
+	children := ctx.GetChildren()
+	acc, err := e.evalMultiplicative(children[0].(IMultiplicativeExpressionContext), ev)
+	if err != nil {
+		return nullVal(), err
+	}
+	for i := 1; i+1 < len(children); i += 2 {
+		op := children[i].(antlr.TerminalNode).GetSymbol().GetTokenType()
+		rhs, err := e.evalMultiplicative(children[i+1].(IMultiplicativeExpressionContext), ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		acc = arithmetic(acc, rhs, op)
+	}
+	return acc, nil
+}
+
+func (e *Evaluator) evalMultiplicative(ctx IMultiplicativeExpressionContext, ev Event) (EvalValue, error) {
+	children := ctx.GetChildren()
+	acc, err := e.evalUnary(children[0].(IUnaryExpressionContext), ev)
+	if err != nil {
+		return nullVal(), err
+	}
+	for i := 1; i+1 < len(children); i += 2 {
+		op := children[i].(antlr.TerminalNode).GetSymbol().GetTokenType()
+		rhs, err := e.evalUnary(children[i+1].(IUnaryExpressionContext), ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		acc = arithmetic(acc, rhs, op)
+	}
+	return acc, nil
+}
+
+// evalProduct evaluates a productExpression: a run of unary operands joined
+// by * / %, then whole multiplicative terms joined by + - and .
+func (e *Evaluator) evalProduct(ctx IProductExpressionContext, ev Event) (EvalValue, error) {
+	children := ctx.GetChildren()
+	acc, err := e.evalUnary(children[0].(IUnaryExpressionContext), ev)
+	if err != nil {
+		return nullVal(), err
+	}
+	for i := 1; i+1 < len(children); i += 2 {
+		op := children[i].(antlr.TerminalNode).GetSymbol().GetTokenType()
+		var rhs EvalValue
+		switch operand := children[i+1].(type) {
+		case IUnaryExpressionContext:
+			rhs, err = e.evalUnary(operand, ev)
+		case IMultiplicativeExpressionContext:
+			rhs, err = e.evalMultiplicative(operand, ev)
+		}
+		if err != nil {
+			return nullVal(), err
+		}
+		acc = arithmetic(acc, rhs, op)
+	}
+	return acc, nil
+}
+
+func (e *Evaluator) evalUnary(ctx IUnaryExpressionContext, ev Event) (EvalValue, error) {
+	if ctx.MINUS() != nil {
+		v, err := e.evalUnary(ctx.UnaryExpression(), ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		if n, ok := v.Number(); ok {
+			return numVal(-n), nil
+		}
+		return nullVal(), nil
+	}
+	return e.evalPrimary(ctx.PrimaryExpression(), ev)
+}
+
+func (e *Evaluator) evalPrimary(ctx IPrimaryExpressionContext, ev Event) (EvalValue, error) {
+	switch {
+	case ctx.Expression() != nil:
+		return e.evalExpression(ctx.Expression(), ev)
+	case ctx.FunctionCall() != nil:
+		return e.evalFunctionCall(ctx.FunctionCall(), ev)
+	case ctx.QUOTED_STRING() != nil:
+		return strVal(unquoteSPLString(ctx.QUOTED_STRING().GetText())), nil
+	case ctx.NUMBER() != nil:
+		f, _ := strconv.ParseFloat(ctx.NUMBER().GetText(), 64)
+		return numVal(f), nil
+	case ctx.FieldName() != nil:
+		return e.evalFieldName(ctx.FieldName(), ev)
+	case ctx.Subsearch() != nil:
+		return nullVal(), fmt.Errorf("subsearches cannot be evaluated: %s", ctx.GetText())
+	}
+	// TIME_SPAN and colon values have no meaning in eval; keep their text
+	return strVal(ctx.GetText()), nil
+}
+
+// evalFieldName resolves a field reference. The grammar folds a-b into one
+// hyphenated field name; unless the event has such a field, SPL reads it as
+// subtraction.
+func (e *Evaluator) evalFieldName(ctx IFieldNameContext, ev Event) (EvalValue, error) {
+	if ctx.NUMBER() != nil {
+		f, _ := strconv.ParseFloat(ctx.NUMBER().GetText(), 64)
+		return numVal(f), nil
+	}
+	if ctx.TEMPLATE_VAR() != nil {
+		return nullVal(), fmt.Errorf("unexpanded template field %s", ctx.GetText())
+	}
+	if ctx.SINGLE_QUOTED() != nil {
+		return e.lookupField(fieldNameText(ctx), ev), nil
+	}
+	name := ctx.GetText()
+	if _, ok := ev[name]; ok {
+		return EvalValueOf(ev[name]), nil
+	}
+	if bases := ctx.AllFieldNameBase(); len(bases) == 1 && len(ctx.AllFieldNameSuffix()) == 0 {
+		if parts := bases[0].AllIDENTIFIER(); len(parts) > 1 {
+			acc := e.lookupField(parts[0].GetText(), ev)
+			for _, p := range parts[1:] {
+				acc = arithmetic(acc, e.lookupField(p.GetText(), ev), SPLParserMINUS)
+			}
+			return acc, nil
+		}
+	}
+	return e.lookupField(name, ev), nil
+}
+
+// lookupField returns the named field. A dotted name missing from the event
+// is read as "." concatenation of its parts, which is how SPL parses a.b.
+func (e *Evaluator) lookupField(name string, ev Event) EvalValue {
+	if v, ok := ev[name]; ok {
+		return EvalValueOf(v)
+	}
+	if strings.Contains(name, ".") {
+		parts := strings.Split(name, ".")
+		acc := e.lookupField(parts[0], ev)
+		for _, p := range parts[1:] {
+			if p == "" {
+				return nullVal()
+			}
+			acc = arithmetic(acc, e.lookupField(p, ev), SPLParserDOT)
+		}
+		return acc
+	}
+	return nullVal()
+}
+
+// unquoteSPLString strips the surrounding quotes and resolves \" and \\.
+// Other backslash sequences are kept so regex escapes like \d survive.
+func unquoteSPLString(text string) string {
+	if len(text) < 2 {
+		return text
+	}
+	quote := text[0]
+	if (quote != '"' && quote != '\'') || text[len(text)-1] != quote {
+		return text
+	}
+	inner := text[1 : len(text)-1]
+	if !strings.Contains(inner, `\`) {
+		return inner
+	}
+	var b strings.Builder
+	for i := 0; i < len(inner); i++ {
+		if inner[i] == '\\' && i+1 < len(inner) && (inner[i+1] == quote || inner[i+1] == '\\') {
+			b.WriteByte(inner[i+1])
+			i++
+			continue
+		}
+		b.WriteByte(inner[i])
+	}
+	return b.String()
+}
+
+// arithmetic applies a binary operator token. Any null operand yields null;
+// "+" adds numbers and concatenates anything else; "." always concatenates.
+func arithmetic(a, b EvalValue, op int) EvalValue {
+	if a.IsNull() || b.IsNull() {
+		return nullVal()
+	}
+	a, b = a.scalar(), b.scalar()
+	x, xok := a.Number()
+	y, yok := b.Number()
+	switch op {
+	case SPLParserDOT:
+		return strVal(a.String() + b.String())
+	case SPLParserPLUS:
+		if xok && yok {
+			return numVal(x + y)
+		}
+		return strVal(a.String() + b.String())
+	}
+	if !xok || !yok {
+		return nullVal()
+	}
+	switch op {
+	case SPLParserMINUS:
+		return numVal(x - y)
+	case SPLParserWILDCARD:
+		return numVal(x * y)
+	case SPLParserSLASH:
+		if y == 0 {
+			return nullVal()
+		}
+		return numVal(x / y)
+	case SPLParserPERCENT:
+		if y == 0 {
+			return nullVal()
+		}
+		return numVal(math.Mod(x, y))
+	}
+	return nullVal()
+}
+
+// compareValues applies a comparison operator. Comparisons with null are
+// false; a multivalue operand matches if any of its values does.
+func compareValues(a, b EvalValue, op string) bool {
+	if a.IsNull() || b.IsNull() {
+		return false
+	}
+	for _, x := range a.Values() {
+		for _, y := range b.Values() {
+			c := compareScalars(x, y)
+			var ok bool
+			switch op {
+			case "=", "==":
+				ok = c == 0
+			case "!=":
+				ok = c != 0
+			case "<":
+				ok = c < 0
+			case "<=":
+				ok = c <= 0
+			case ">":
+				ok = c > 0
+			case ">=":
+				ok = c >= 0
+			}
+			if ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// compareScalars orders two values numerically when both are numeric and
+// lexicographically otherwise.
+func compareScalars(a, b EvalValue) int {
+	if x, ok := a.Number(); ok {
+		if y, ok := b.Number(); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(a.String(), b.String())
+}
+
+// regexCache holds compiled regular expressions keyed by pattern
+var regexCache sync.Map
+
+// cachedRegexp compiles pattern once and reuses it across events
+func cachedRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexCache.Store(pattern, re)
+	return re, nil
+}
+
+// matchWildcard reports whether s matches a search-style wildcard pattern
+// (* matches any run of characters), case-insensitively.
+func matchWildcard(pattern, s string) bool {
+	return matchWildcardCase(pattern, s, false)
+}
+
+// matchWildcardCase is matchWildcard with a choice of case sensitivity
+func matchWildcardCase(pattern, s string, caseSensitive bool) bool {
+	if !strings.Contains(pattern, "*") {
+		if caseSensitive {
+			return pattern == s
+		}
+		return strings.EqualFold(pattern, s)
+	}
+	re, err := cachedRegexp(WildcardRegexp(pattern, caseSensitive))
+	if err != nil {
+		return false
+	}
+	return re.MatchString(s)
+}
@@ -0,0 +1,823 @@
+package spl
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// evalFunction describes one eval function. Most functions are eager and
+// receive evaluated arguments; lazy ones (if, case, coalesce, mvfilter, ...)
+// receive the argument expressions and decide what to evaluate.
+type evalFunction struct {
+	minArgs int
+	maxArgs int // -1 for variadic
+	eager   func(e *Evaluator, args []EvalValue) (EvalValue, error)
+	lazy    func(e *Evaluator, args []IExpressionContext, ev Event) (EvalValue, error)
+}
+
+// evalFunctions is the SPL eval function library, keyed by lowercase name.
+// Populated in init because lazy functions call back into the evaluator.
+var evalFunctions map[string]evalFunction
+
+func init() {
+	evalFunctions = map[string]evalFunction{
+		// Conditional and comparison functions
+		"if":        {minArgs: 3, maxArgs: 3, lazy: fnIf},
+		"case":      {minArgs: 2, maxArgs: -1, lazy: fnCase},
+		"validate":  {minArgs: 2, maxArgs: -1, lazy: fnValidate},
+		"coalesce":  {minArgs: 1, maxArgs: -1, lazy: fnCoalesce},
+		"eval":      {minArgs: 1, maxArgs: 1, lazy: fnEval},
+		"null":      {minArgs: 0, maxArgs: 0, eager: func(*Evaluator, []EvalValue) (EvalValue, error) { return nullVal(), nil }},
+		"true":      {minArgs: 0, maxArgs: 0, eager: func(*Evaluator, []EvalValue) (EvalValue, error) { return boolVal(true), nil }},
+		"false":     {minArgs: 0, maxArgs: 0, eager: func(*Evaluator, []EvalValue) (EvalValue, error) { return boolVal(false), nil }},
+		"nullif":    {minArgs: 2, maxArgs: 2, eager: fnNullif},
+		"isnull":    {minArgs: 1, maxArgs: 1, eager: typeCheck(func(v EvalValue) bool { return v.IsNull() })},
+		"isnotnull": {minArgs: 1, maxArgs: 1, eager: typeCheck(func(v EvalValue) bool { return !v.IsNull() })},
+		"isnum":     {minArgs: 1, maxArgs: 1, eager: typeCheck(isNumericValue)},
+		"isint":     {minArgs: 1, maxArgs: 1, eager: typeCheck(isIntValue)},
+		"isstr":     {minArgs: 1, maxArgs: 1, eager: typeCheck(func(v EvalValue) bool { return v.Kind == EvalString && !isNumericValue(v) })},
+		"isbool":    {minArgs: 1, maxArgs: 1, eager: typeCheck(func(v EvalValue) bool { return v.Kind == EvalBool })},
+		"typeof":    {minArgs: 1, maxArgs: 1, eager: fnTypeof},
+		"like":      {minArgs: 2, maxArgs: 2, eager: fnLike},
+		"match":     {minArgs: 2, maxArgs: 2, eager: fnMatch},
+		"cidrmatch": {minArgs: 2, maxArgs: 2, eager: fnCidrmatch},
+
+		// Conversion functions
+		"tonumber": {minArgs: 1, maxArgs: 2, eager: fnTonumber},
+		"tostring": {minArgs: 1, maxArgs: 2, eager: fnTostring},
+
+		// Mathematical functions
+		"abs":     {minArgs: 1, maxArgs: 1, eager: mathFunc(math.Abs)},
+		"ceil":    {minArgs: 1, maxArgs: 1, eager: mathFunc(math.Ceil)},
+		"ceiling": {minArgs: 1, maxArgs: 1, eager: mathFunc(math.Ceil)},
+		"floor":   {minArgs: 1, maxArgs: 1, eager: mathFunc(math.Floor)},
+		"sqrt":    {minArgs: 1, maxArgs: 1, eager: mathFunc(math.Sqrt)},
+		"exp":     {minArgs: 1, maxArgs: 1, eager: mathFunc(math.Exp)},
+		"ln":      {minArgs: 1, maxArgs: 1, eager: mathFunc(math.Log)},
+		"exact":   {minArgs: 1, maxArgs: 1, eager: mathFunc(func(f float64) float64 { return f })},
+		"round":   {minArgs: 1, maxArgs: 2, eager: fnRound},
+		"pow":     {minArgs: 2, maxArgs: 2, eager: fnPow},
+		"log":     {minArgs: 1, maxArgs: 2, eager: fnLog},
+		"pi":      {minArgs: 0, maxArgs: 0, eager: func(*Evaluator, []EvalValue) (EvalValue, error) { return numVal(math.Pi), nil }},
+		"random":  {minArgs: 0, maxArgs: 0, eager: func(*Evaluator, []EvalValue) (EvalValue, error) { return numVal(float64(rand.Int31())), nil }},
+		"min":     {minArgs: 1, maxArgs: -1, eager: extremum(-1)},
+		"max":     {minArgs: 1, maxArgs: -1, eager: extremum(1)},
+
+		// Multivalue functions
+		"mvindex":  {minArgs: 2, maxArgs: 3, eager: fnMvindex},
+		"mvcount":  {minArgs: 1, maxArgs: 1, eager: fnMvcount},
+		"mvappend": {minArgs: 1, maxArgs: -1, eager: fnMvappend},
+		"mvfilter": {minArgs: 1, maxArgs: 1, lazy: fnMvfilter},
+		"mvjoin":   {minArgs: 2, maxArgs: 2, eager: fnMvjoin},
+		"mvdedup":  {minArgs: 1, maxArgs: 1, eager: fnMvdedup},
+		"mvsort":   {minArgs: 1, maxArgs: 1, eager: fnMvsort},
+		"mvfind":   {minArgs: 2, maxArgs: 2, eager: fnMvfind},
+		"mvrange":  {minArgs: 2, maxArgs: 3, eager: fnMvrange},
+		"mvzip":    {minArgs: 2, maxArgs: 3, eager: fnMvzip},
+		"split":    {minArgs: 2, maxArgs: 2, eager: fnSplit},
+
+		// Text functions
+		"len":       {minArgs: 1, maxArgs: 1, eager: fnLen},
+		"lower":     {minArgs: 1, maxArgs: 1, eager: stringFunc(strings.ToLower)},
+		"upper":     {minArgs: 1, maxArgs: 1, eager: stringFunc(strings.ToUpper)},
+		"urldecode": {minArgs: 1, maxArgs: 1, eager: stringFunc(urlDecode)},
+		"md5":       {minArgs: 1, maxArgs: 1, eager: stringFunc(func(s string) string { h := md5.Sum([]byte(s)); return hex.EncodeToString(h[:]) })},
+		"sha1":      {minArgs: 1, maxArgs: 1, eager: stringFunc(func(s string) string { h := sha1.Sum([]byte(s)); return hex.EncodeToString(h[:]) })},
+		"sha256":    {minArgs: 1, maxArgs: 1, eager: stringFunc(func(s string) string { h := sha256.Sum256([]byte(s)); return hex.EncodeToString(h[:]) })},
+		"sha512":    {minArgs: 1, maxArgs: 1, eager: stringFunc(func(s string) string { h := sha512.Sum512([]byte(s)); return hex.EncodeToString(h[:]) })},
+		"substr":    {minArgs: 2, maxArgs: 3, eager: fnSubstr},
+		"trim":      {minArgs: 1, maxArgs: 2, eager: trimFunc(strings.Trim)},
+		"ltrim":     {minArgs: 1, maxArgs: 2, eager: trimFunc(strings.TrimLeft)},
+		"rtrim":     {minArgs: 1, maxArgs: 2, eager: trimFunc(strings.TrimRight)},
+		"replace":   {minArgs: 3, maxArgs: 3, eager: fnReplace},
+
+		// Date and time functions
+		"now":           {minArgs: 0, maxArgs: 0, eager: fnNow},
+		"time":          {minArgs: 0, maxArgs: 0, eager: fnTime},
+		"relative_time": {minArgs: 2, maxArgs: 2, eager: fnRelativeTime},
+		"strftime":      {minArgs: 2, maxArgs: 2, eager: fnStrftime},
+		"strptime":      {minArgs: 2, maxArgs: 2, eager: fnStrptime},
+	}
+}
+
+// evalFunctionCall dispatches a function call to the function library
+func (e *Evaluator) evalFunctionCall(ctx IFunctionCallContext, ev Event) (EvalValue, error) {
+	name := strings.ToLower(ctx.GetChild(0).(antlr.TerminalNode).GetText())
+	fn, ok := evalFunctions[name]
+	if !ok {
+		return nullVal(), fmt.Errorf("unsupported eval function %s()", name)
+	}
+
+	var args []IExpressionContext
+	if ctx.ArgumentList() != nil {
+		args = ctx.ArgumentList().AllExpression()
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nullVal(), fmt.Errorf("%s(): wrong number of arguments (%d)", name, len(args))
+	}
+
+	if fn.lazy != nil {
+		v, err := fn.lazy(e, args, ev)
+		if err != nil {
+			return nullVal(), fmt.Errorf("%s(): %w", name, err)
+		}
+		return v, nil
+	}
+
+	values := make([]EvalValue, len(args))
+	for i, arg := range args {
+		v, err := e.evalExpression(arg, ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		values[i] = v
+	}
+	v, err := fn.eager(e, values)
+	if err != nil {
+		return nullVal(), fmt.Errorf("%s(): %w", name, err)
+	}
+	return v, nil
+}
+
+func fnIf(e *Evaluator, args []IExpressionContext, ev Event) (EvalValue, error) {
+	cond, err := e.evalExpression(args[0], ev)
+	if err != nil {
+		return nullVal(), err
+	}
+	if cond.Truthy() {
+		return e.evalExpression(args[1], ev)
+	}
+	return e.evalExpression(args[2], ev)
+}
+
+// fnCase returns the value paired with the first true condition, or null
+func fnCase(e *Evaluator, args []IExpressionContext, ev Event) (EvalValue, error) {
+	if len(args)%2 != 0 {
+		return nullVal(), fmt.Errorf("arguments must be condition/value pairs")
+	}
+	for i := 0; i < len(args); i += 2 {
+		cond, err := e.evalExpression(args[i], ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		if cond.Truthy() {
+			return e.evalExpression(args[i+1], ev)
+		}
+	}
+	return nullVal(), nil
+}
+
+// fnValidate returns the value paired with the first false condition, or null
+func fnValidate(e *Evaluator, args []IExpressionContext, ev Event) (EvalValue, error) {
+	if len(args)%2 != 0 {
+		return nullVal(), fmt.Errorf("arguments must be condition/value pairs")
+	}
+	for i := 0; i < len(args); i += 2 {
+		cond, err := e.evalExpression(args[i], ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		if !cond.Truthy() {
+			return e.evalExpression(args[i+1], ev)
+		}
+	}
+	return nullVal(), nil
+}
+
+func fnCoalesce(e *Evaluator, args []IExpressionContext, ev Event) (EvalValue, error) {
+	for _, arg := range args {
+		v, err := e.evalExpression(arg, ev)
+		if err != nil {
+			return nullVal(), err
+		}
+		if !v.IsNull() {
+			return v, nil
+		}
+	}
+	return nullVal(), nil
+}
+
+// fnEval evaluates its argument; it appears as count(eval(...)) in stats
+func fnEval(e *Evaluator, args []IExpressionContext, ev Event) (EvalValue, error) {
+	return e.evalExpression(args[0], ev)
+}
+
+func fnNullif(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if compareValues(args[0], args[1], "=") {
+		return nullVal(), nil
+	}
+	return args[0], nil
+}
+
+func typeCheck(pred func(EvalValue) bool) func(*Evaluator, []EvalValue) (EvalValue, error) {
+	return func(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+		return boolVal(pred(args[0])), nil
+	}
+}
+
+func isNumericValue(v EvalValue) bool {
+	if v.Kind == EvalBool || v.IsNull() {
+		return false
+	}
+	_, ok := v.Number()
+	return ok
+}
+
+func isIntValue(v EvalValue) bool {
+	if !isNumericValue(v) {
+		return false
+	}
+	n, _ := v.Number()
+	return n == math.Trunc(n)
+}
+
+func fnTypeof(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	switch v := args[0]; {
+	case v.IsNull():
+		return strVal("Invalid"), nil
+	case v.Kind == EvalBool:
+		return strVal("Boolean"), nil
+	case v.Kind == EvalMulti:
+		return strVal("Multivalue"), nil
+	case isNumericValue(v):
+		return strVal("Number"), nil
+	}
+	return strVal("String"), nil
+}
+
+// fnLike implements SQL-style LIKE: % matches any run, _ matches one character
+func fnLike(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() || args[1].IsNull() {
+		return boolVal(false), nil
+	}
+	re, err := cachedRegexp(LikeRegexp(args[1].String()))
+	if err != nil {
+		return nullVal(), err
+	}
+	return boolVal(anyValue(args[0], func(v EvalValue) bool { return re.MatchString(v.String()) })), nil
+}
+
+func fnMatch(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() || args[1].IsNull() {
+		return boolVal(false), nil
+	}
+	re, err := cachedRegexp(args[1].String())
+	if err != nil {
+		return nullVal(), fmt.Errorf("invalid regex: %w", err)
+	}
+	return boolVal(anyValue(args[0], func(v EvalValue) bool { return re.MatchString(v.String()) })), nil
+}
+
+// fnCidrmatch checks whether an IP (or any value of a multivalue field) is in a subnet
+func fnCidrmatch(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() || args[1].IsNull() {
+		return boolVal(false), nil
+	}
+	cidr := args[0].String()
+	if !strings.Contains(cidr, "/") {
+		cidr += "/32"
+		if strings.Contains(args[0].String(), ":") {
+			cidr = args[0].String() + "/128"
+		}
+	}
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nullVal(), fmt.Errorf("invalid CIDR %q", args[0].String())
+	}
+	return boolVal(anyValue(args[1], func(v EvalValue) bool {
+		ip := net.ParseIP(strings.TrimSpace(v.String()))
+		return ip != nil && network.Contains(ip)
+	})), nil
+}
+
+func anyValue(v EvalValue, pred func(EvalValue) bool) bool {
+	for _, item := range v.Values() {
+		if pred(item) {
+			return true
+		}
+	}
+	return false
+}
+
+func fnTonumber(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	v := args[0].scalar()
+	if v.IsNull() {
+		return nullVal(), nil
+	}
+	base := 10
+	if len(args) > 1 {
+		b, ok := args[1].Number()
+		if !ok || b < 2 || b > 36 {
+			return nullVal(), fmt.Errorf("invalid base %q", args[1].String())
+		}
+		base = int(b)
+	}
+	if base == 10 {
+		if f, ok := v.Number(); ok {
+			return numVal(f), nil
+		}
+		return nullVal(), nil
+	}
+	s := strings.TrimSpace(v.String())
+	if base == 16 {
+		s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	}
+	n, err := strconv.ParseInt(s, base, 64)
+	if err != nil {
+		return nullVal(), nil
+	}
+	return numVal(float64(n)), nil
+}
+
+// fnTostring converts a value to a string, optionally formatted as "hex",
+// "commas" or "duration".
+func fnTostring(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	v := args[0]
+	if v.IsNull() {
+		return nullVal(), nil
+	}
+	if len(args) == 1 {
+		return strVal(v.String()), nil
+	}
+	n, ok := v.Number()
+	if !ok {
+		return strVal(v.String()), nil
+	}
+	switch strings.ToLower(args[1].String()) {
+	case "hex":
+		return strVal(fmt.Sprintf("0x%X", int64(n))), nil
+	case "commas":
+		return strVal(formatCommas(n)), nil
+	case "duration":
+		return strVal(formatDuration(n)), nil
+	}
+	return strVal(v.String()), nil
+}
+
+// formatCommas renders n with thousands separators, rounding to two decimals
+func formatCommas(n float64) string {
+	s := strconv.FormatFloat(math.Abs(n), 'f', -1, 64)
+	if n != math.Trunc(n) {
+		s = strconv.FormatFloat(math.Abs(n), 'f', 2, 64)
+	}
+	intPart, frac, _ := strings.Cut(s, ".")
+	var b strings.Builder
+	if n < 0 {
+		b.WriteByte('-')
+	}
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+	if frac != "" {
+		b.WriteByte('.')
+		b.WriteString(frac)
+	}
+	return b.String()
+}
+
+// formatDuration renders seconds as [D+]HH:MM:SS
+func formatDuration(secs float64) string {
+	total := int64(secs)
+	sign := ""
+	if total < 0 {
+		sign = "-"
+		total = -total
+	}
+	days := total / 86400
+	h, m, s := (total%86400)/3600, (total%3600)/60, total%60
+	if days > 0 {
+		return fmt.Sprintf("%s%d+%02d:%02d:%02d", sign, days, h, m, s)
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, h, m, s)
+}
+
+func mathFunc(fn func(float64) float64) func(*Evaluator, []EvalValue) (EvalValue, error) {
+	return func(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+		n, ok := args[0].Number()
+		if !ok {
+			return nullVal(), nil
+		}
+		r := fn(n)
+		if math.IsNaN(r) || math.IsInf(r, 0) {
+			return nullVal(), nil
+		}
+		return numVal(r), nil
+	}
+}
+
+func fnRound(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	n, ok := args[0].Number()
+	if !ok {
+		return nullVal(), nil
+	}
+	digits := 0.0
+	if len(args) > 1 {
+		if d, ok := args[1].Number(); ok {
+			digits = d
+		}
+	}
+	scale := math.Pow(10, digits)
+	return numVal(math.Round(n*scale) / scale), nil
+}
+
+func fnPow(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	x, ok1 := args[0].Number()
+	y, ok2 := args[1].Number()
+	if !ok1 || !ok2 {
+		return nullVal(), nil
+	}
+	return numVal(math.Pow(x, y)), nil
+}
+
+func fnLog(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	x, ok := args[0].Number()
+	if !ok || x <= 0 {
+		return nullVal(), nil
+	}
+	base := 10.0
+	if len(args) > 1 {
+		if base, ok = args[1].Number(); !ok || base <= 0 || base == 1 {
+			return nullVal(), nil
+		}
+	}
+	switch base {
+	case 10:
+		return numVal(math.Log10(x)), nil
+	case 2:
+		return numVal(math.Log2(x)), nil
+	}
+	return numVal(math.Log(x) / math.Log(base)), nil
+}
+
+// extremum implements min/max over all argument values. Numbers sort before
+// strings, as in Splunk.
+func extremum(sign int) func(*Evaluator, []EvalValue) (EvalValue, error) {
+	return func(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+		var best EvalValue
+		found := false
+		for _, arg := range args {
+			for _, v := range arg.Values() {
+				if !found || sign*orderValues(v, best) > 0 {
+					best, found = v, true
+				}
+			}
+		}
+		if !found {
+			return nullVal(), nil
+		}
+		return best, nil
+	}
+}
+
+// orderValues is a total order over scalars: numbers (numerically) before strings
+func orderValues(a, b EvalValue) int {
+	_, an := a.Number()
+	_, bn := b.Number()
+	switch {
+	case an && !bn:
+		return -1
+	case !an && bn:
+		return 1
+	}
+	return compareScalars(a, b)
+}
+
+// fnMvindex returns the values from start to end (inclusive, zero-based;
+// negative indexes count from the end).
+func fnMvindex(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	vals := args[0].Values()
+	start, ok := args[1].Number()
+	if !ok || len(vals) == 0 {
+		return nullVal(), nil
+	}
+	end := start
+	if len(args) > 2 {
+		if end, ok = args[2].Number(); !ok {
+			return nullVal(), nil
+		}
+	}
+	s, en := int(start), int(end)
+	if s < 0 {
+		s += len(vals)
+	}
+	if en < 0 {
+		en += len(vals)
+	}
+	if s < 0 || s >= len(vals) || en < s {
+		return nullVal(), nil
+	}
+	if en >= len(vals) {
+		en = len(vals) - 1
+	}
+	return mvVal(vals[s : en+1]), nil
+}
+
+func fnMvcount(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() {
+		return nullVal(), nil
+	}
+	return numVal(float64(len(args[0].Values()))), nil
+}
+
+func fnMvappend(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	var out []EvalValue
+	for _, arg := range args {
+		out = append(out, arg.Values()...)
+	}
+	return mvVal(out), nil
+}
+
+// fnMvfilter keeps the values of a multivalue field for which the predicate
+// holds. The predicate must reference exactly one field present in the event.
+func fnMvfilter(e *Evaluator, args []IExpressionContext, ev Event) (EvalValue, error) {
+	var field string
+	for _, name := range referencedFields(args[0]) {
+		if _, ok := ev[name]; ok {
+			if field != "" && field != name {
+				return nullVal(), fmt.Errorf("predicate references more than one field (%s, %s)", field, name)
+			}
+			field = name
+		}
+	}
+	if field == "" {
+		return nullVal(), nil
+	}
+
+	var kept []EvalValue
+	scratch := ev.Clone()
+	for _, v := range EvalValueOf(ev[field]).Values() {
+		scratch[field] = v.Interface()
+		ok, err := e.evalExpression(args[0], scratch)
+		if err != nil {
+			return nullVal(), err
+		}
+		if ok.Truthy() {
+			kept = append(kept, v)
+		}
+	}
+	return mvVal(kept), nil
+}
+
+// referencedFields collects the field names an expression reads: field
+// names, single-quoted references and bare identifiers in conditions.
+func referencedFields(tree antlr.Tree) []string {
+	var names []string
+	var walk func(antlr.Tree)
+	walk = func(t antlr.Tree) {
+		switch n := t.(type) {
+		case *FieldNameContext:
+			if n.NUMBER() == nil {
+				names = append(names, n.GetText())
+			}
+			return
+		case *ValueContext:
+			if n.IDENTIFIER() != nil {
+				names = append(names, n.GetText())
+			}
+		case antlr.TerminalNode:
+			if n.GetSymbol().GetTokenType() == SPLParserQUOTED_STRING && strings.HasPrefix(n.GetText(), "'") {
+				names = append(names, unquoteSPLString(n.GetText()))
+			}
+		}
+		for i := 0; i < t.GetChildCount(); i++ {
+			walk(t.GetChild(i))
+		}
+	}
+	walk(tree)
+	return names
+}
+
+func fnMvjoin(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	vals := args[0].Values()
+	if len(vals) == 0 {
+		return nullVal(), nil
+	}
+	parts := make([]string, len(vals))
+	for i, v := range vals {
+		parts[i] = v.String()
+	}
+	return strVal(strings.Join(parts, args[1].String())), nil
+}
+
+func fnMvdedup(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	seen := make(map[string]bool)
+	var out []EvalValue
+	for _, v := range args[0].Values() {
+		if !seen[v.String()] {
+			seen[v.String()] = true
+			out = append(out, v)
+		}
+	}
+	return mvVal(out), nil
+}
+
+// fnMvsort sorts values lexicographically, as Splunk does (so "10" < "9")
+func fnMvsort(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	vals := append([]EvalValue(nil), args[0].Values()...)
+	sort.SliceStable(vals, func(i, j int) bool { return vals[i].String() < vals[j].String() })
+	return mvVal(vals), nil
+}
+
+func fnMvfind(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	re, err := cachedRegexp(args[1].String())
+	if err != nil {
+		return nullVal(), fmt.Errorf("invalid regex: %w", err)
+	}
+	for i, v := range args[0].Values() {
+		if re.MatchString(v.String()) {
+			return numVal(float64(i)), nil
+		}
+	}
+	return nullVal(), nil
+}
+
+// fnMvrange returns numbers from start up to (excluding) end
+func fnMvrange(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	start, ok1 := args[0].Number()
+	end, ok2 := args[1].Number()
+	step := 1.0
+	if len(args) > 2 {
+		var ok bool
+		if step, ok = args[2].Number(); !ok {
+			return nullVal(), nil
+		}
+	}
+	if !ok1 || !ok2 || step <= 0 {
+		return nullVal(), nil
+	}
+	var out []EvalValue
+	for n := start; n < end && len(out) < 10000; n += step {
+		out = append(out, numVal(n))
+	}
+	return mvVal(out), nil
+}
+
+func fnMvzip(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	a, b := args[0].Values(), args[1].Values()
+	delim := ","
+	if len(args) > 2 {
+		delim = args[2].String()
+	}
+	n := min(len(a), len(b))
+	out := make([]EvalValue, n)
+	for i := 0; i < n; i++ {
+		out[i] = strVal(a[i].String() + delim + b[i].String())
+	}
+	return mvVal(out), nil
+}
+
+func fnSplit(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() {
+		return nullVal(), nil
+	}
+	parts := strings.Split(args[0].scalar().String(), args[1].String())
+	out := make([]EvalValue, len(parts))
+	for i, p := range parts {
+		out[i] = strVal(p)
+	}
+	return mvVal(out), nil
+}
+
+func stringFunc(fn func(string) string) func(*Evaluator, []EvalValue) (EvalValue, error) {
+	return func(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+		if args[0].IsNull() {
+			return nullVal(), nil
+		}
+		return strVal(fn(args[0].scalar().String())), nil
+	}
+}
+
+func urlDecode(s string) string {
+	if d, err := url.QueryUnescape(s); err == nil {
+		return d
+	}
+	return s
+}
+
+func fnLen(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() {
+		return nullVal(), nil
+	}
+	return numVal(float64(utf8.RuneCountInString(args[0].scalar().String()))), nil
+}
+
+// fnSubstr takes a 1-based start (negative counts from the end) and an optional length
+func fnSubstr(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() {
+		return nullVal(), nil
+	}
+	runes := []rune(args[0].scalar().String())
+	start, ok := args[1].Number()
+	if !ok {
+		return nullVal(), nil
+	}
+	s := int(start)
+	switch {
+	case s < 0:
+		s = max(len(runes)+s, 0)
+	case s > 0:
+		s--
+	}
+	if s > len(runes) {
+		return strVal(""), nil
+	}
+	end := len(runes)
+	if len(args) > 2 {
+		n, ok := args[2].Number()
+		if !ok || n < 0 {
+			return nullVal(), nil
+		}
+		end = min(s+int(n), len(runes))
+	}
+	return strVal(string(runes[s:end])), nil
+}
+
+func trimFunc(fn func(string, string) string) func(*Evaluator, []EvalValue) (EvalValue, error) {
+	return func(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+		if args[0].IsNull() {
+			return nullVal(), nil
+		}
+		cutset := " \t"
+		if len(args) > 1 {
+			cutset = args[1].String()
+		}
+		return strVal(fn(args[0].scalar().String(), cutset)), nil
+	}
+}
+
+// backrefPattern matches \1-style back references in replace() replacements
+var backrefPattern = regexp.MustCompile(`\\(\d)`)
+
+func fnReplace(_ *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() {
+		return nullVal(), nil
+	}
+	re, err := cachedRegexp(args[1].String())
+	if err != nil {
+		return nullVal(), fmt.Errorf("invalid regex: %w", err)
+	}
+	repl := strings.ReplaceAll(args[2].String(), "$", "$$")
+	repl = backrefPattern.ReplaceAllString(repl, "$${$1}")
+	out := make([]EvalValue, 0)
+	for _, v := range args[0].Values() {
+		out = append(out, strVal(re.ReplaceAllString(v.String(), repl)))
+	}
+	return mvVal(out), nil
+}
+
+func fnNow(e *Evaluator, _ []EvalValue) (EvalValue, error) {
+	return numVal(float64(e.now().Unix())), nil
+}
+
+func fnTime(e *Evaluator, _ []EvalValue) (EvalValue, error) {
+	return numVal(math.Round(timeToEpoch(e.now())*1e6) / 1e6), nil
+}
+
+func fnRelativeTime(e *Evaluator, args []EvalValue) (EvalValue, error) {
+	secs, ok := args[0].Number()
+	if !ok {
+		return nullVal(), nil
+	}
+	t, err := applyTimeModifier(epochToTime(secs, e.location()), args[1].String())
+	if err != nil {
+		return nullVal(), err
+	}
+	return numVal(timeToEpoch(t)), nil
+}
+
+func fnStrftime(e *Evaluator, args []EvalValue) (EvalValue, error) {
+	secs, ok := args[0].Number()
+	if !ok {
+		return nullVal(), nil
+	}
+	return strVal(strftimeFormat(epochToTime(secs, e.location()), args[1].String())), nil
+}
+
+func fnStrptime(e *Evaluator, args []EvalValue) (EvalValue, error) {
+	if args[0].IsNull() {
+		return nullVal(), nil
+	}
+	t, err := strptimeParse(args[0].scalar().String(), args[1].String(), e.location())
+	if err != nil {
+		return nullVal(), nil
+	}
+	return numVal(timeToEpoch(t)), nil
+}
@@ -0,0 +1,214 @@
+package spl
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestEvaluator() *Evaluator {
+	ev := NewEvaluator()
+	fixed := time.Date(2024, time.March, 14, 15, 9, 26, 0, time.UTC) // a Thursday
+	ev.Now = func() time.Time { return fixed }
+	return ev
+}
+
+func TestEvaluate_Expressions(t *testing.T) {
+	event := Event{
+		"status":       "404",
+		"bytes":        1500.0,
+		"user":         "alice",
+		"domain":       "corp",
+		"src_ip":       "10.1.2.3",
+		"tags":         []any{"a", "b", "c"},
+		"empty":        "",
+		"the.name":     "dotted",
+		"uri":          "/admin/login.php?x=%2Fetc",
+		"Account Name": "alice",
+	}
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		// Arithmetic and coercion
+		{`bytes * 2`, "3000"},
+		{`bytes / 1000`, "1.5"},
+		{`status + 1`, "405"},
+		{`bytes % 7`, "2"},
+		{`-bytes`, "-1500"},
+		{`bytes / 0`, ""},
+		{`user + "@" + domain`, "alice@corp"},
+		{`user . "-" . status`, "alice-404"},
+		{`missing . "x"`, ""},
+		{`'the.name'`, "dotted"},
+		{`'Account Name' . "!"`, "alice!"},
+		{`user = 'Account Name'`, "true"},
+		{`user = "Account Name"`, "false"},
+		{`"literal"`, "literal"},
+		{`"say \"hi\""`, `say "hi"`},
+
+		// Comparisons
+		{`status == 404`, "true"},
+		{`status = "404"`, "true"},
+		{`status > 99`, "true"},
+		{`user < "bob"`, "true"},
+		{`missing != 1`, "false"},
+		{`tags = "b"`, "true"},
+		{`user IN ("bob", "alice")`, "true"},
+		{`NOT status=200 AND bytes>1000`, "true"},
+		{`status=200 OR user="alice"`, "true"},
+
+		// Conditional functions
+		{`if(status>=400, "error", "ok")`, "error"},
+		{`case(status=200, "ok", status=404, "not found", true(), "other")`, "not found"},
+		{`case(status=200, "ok")`, ""},
+		{`coalesce(missing, empty, user)`, ""},
+		{`coalesce(missing, user)`, "alice"},
+		{`nullif(user, "alice")`, ""},
+		{`validate(isnum(status), "bad status", bytes>0, "bad bytes")`, ""},
+		{`isnull(missing)`, "true"},
+		{`isnotnull(user)`, "true"},
+		{`isnum(status)`, "true"},
+		{`isstr(user)`, "true"},
+		{`typeof(bytes)`, "Number"},
+		{`typeof(missing)`, "Invalid"},
+
+		// Matching
+		{`like(user, "al%")`, "true"},
+		{`like(user, "a_ice")`, "true"},
+		{`like(user, "AL%")`, "false"},
+		{`match(uri, "^/admin/.*\.php")`, "true"},
+		{`cidrmatch("10.0.0.0/8", src_ip)`, "true"},
+		{`cidrmatch("192.168.0.0/16", src_ip)`, "false"},
+
+		// Multivalue
+		{`mvcount(tags)`, "3"},
+		{`mvcount(missing)`, ""},
+		{`mvindex(tags, 1)`, "b"},
+		{`mvindex(tags, -1)`, "c"},
+		{`mvjoin(mvindex(tags, 0, 1), ";")`, "a;b"},
+		{`mvjoin(mvappend(tags, "d", missing), ",")`, "a,b,c,d"},
+		{`mvjoin(mvfilter(tags!="b"), ",")`, "a,c"},
+		{`mvjoin(split("x|y|z", "|"), ",")`, "x,y,z"},
+		{`mvfind(tags, "^c$")`, "2"},
+		{`mvjoin(mvrange(1, 4), ",")`, "1,2,3"},
+		{`mvjoin(mvdedup(mvappend(tags, "a")), ",")`, "a,b,c"},
+		{`mvjoin(mvzip(tags, tags, "="), ",")`, "a=a,b=b,c=c"},
+
+		// Text
+		{`len(user)`, "5"},
+		{`upper(user)`, "ALICE"},
+		{`substr(user, 2, 3)`, "lic"},
+		{`substr(user, -3)`, "ice"},
+		{`trim("  x  ")`, "x"},
+		{`replace(user, "(a)(l)", "\2\1")`, "laice"},
+		{`urldecode(uri)`, "/admin/login.php?x=/etc"},
+		{`md5("abc")`, "900150983cd24fb0d6963f7d28e17f72"},
+
+		// Conversion and math
+		{`tonumber("0x1F", 16)`, "31"},
+		{`tonumber("abc")`, ""},
+		{`tostring(1234567, "commas")`, "1,234,567"},
+		{`tostring(3725, "duration")`, "01:02:05"},
+		{`tostring(255, "hex")`, "0xFF"},
+		{`round(3.14159, 2)`, "3.14"},
+		{`floor(2.7) + ceil(2.1)`, "5"},
+		{`max(3, 10, 2)`, "10"},
+		{`min(3, "abc", 2)`, "2"},
+		{`pow(2, 10)`, "1024"},
+		{`log(1000)`, "3"},
+
+		// Time functions use the injected clock
+		{`now()`, "1710428966"},
+		{`relative_time(now(), "-1d@d")`, "1710288000"},
+		{`relative_time(now(), "@w1")`, "1710115200"},
+		{`strftime(now(), "%Y-%m-%d %H:%M:%S")`, "2024-03-14 15:09:26"},
+		{`strptime("2024-03-14T00:00:00", "%Y-%m-%dT%H:%M:%S")`, "1710374400"},
+		{`strftime(relative_time(now(), "-7d@d+6h"), "%F %T")`, "2024-03-07 06:00:00"},
+	}
+
+	e := newTestEvaluator()
+	for _, tt := range tests {
+		got, err := e.Evaluate(tt.expr, event)
+		if err != nil {
+			t.Errorf("Evaluate(%s) error: %v", tt.expr, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("Evaluate(%s) = %q (kind %d), want %q", tt.expr, got.String(), got.Kind, tt.want)
+		}
+	}
+}
+
+func TestEvaluate_NullPropagation(t *testing.T) {
+	e := newTestEvaluator()
+	for _, expr := range []string{`missing + 1`, `missing * 2`, `"a" . missing`, `lower(missing)`, `mvindex(missing, 0)`} {
+		got, err := e.Evaluate(expr, Event{})
+		if err != nil {
+			t.Errorf("Evaluate(%s) error: %v", expr, err)
+			continue
+		}
+		if !got.IsNull() {
+			t.Errorf("Evaluate(%s) = %q, want null", expr, got.String())
+		}
+	}
+}
+
+func TestEval_Assignments(t *testing.T) {
+	e := newTestEvaluator()
+	in := Event{"bytes": "2048", "drop": "x"}
+
+	out, err := e.Eval(`kb = bytes / 1024, label = "size:" . kb, drop = null()`, in)
+	if err != nil {
+		t.Fatalf("Eval error: %v", err)
+	}
+	if out["kb"] != 2.0 {
+		t.Errorf("Expected kb=2, got %v", out["kb"])
+	}
+	if out["label"] != "size:2" {
+		t.Errorf("Expected label=size:2 (later assignments see earlier ones), got %v", out["label"])
+	}
+	if _, ok := out["drop"]; ok {
+		t.Errorf("Expected null assignment to remove the field")
+	}
+	if in["drop"] != "x" {
+		t.Errorf("Eval must not modify its input event")
+	}
+}
+
+func TestWhere_Semantics(t *testing.T) {
+	e := newTestEvaluator()
+	event := Event{"count": "12", "user": "admin", "host": "web-01"}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`count > 10`, true},
+		{`count > 10 AND user="root"`, false},
+		{`count*2 > 20`, true},
+		{`like(host, "web-%")`, true},
+		{`isnull(src)`, true},
+		{`src="x"`, false},
+		{`NOT src="x"`, true},
+	}
+	for _, tt := range tests {
+		got, err := e.Where(tt.expr, event)
+		if err != nil {
+			t.Errorf("Where(%s) error: %v", tt.expr, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Where(%s) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	e := newTestEvaluator()
+	for _, expr := range []string{`nosuchfunc(1)`, `if(a, b)`, `match(x, "(")`, `1 +`} {
+		if _, err := e.Evaluate(expr, Event{"x": "y"}); err == nil {
+			t.Errorf("Evaluate(%s): expected error", expr)
+		}
+	}
+}
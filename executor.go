@@ -0,0 +1,1088 @@
+package spl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// defaultSortLimit is the number of results sort keeps when no count is given
+const defaultSortLimit = 10000
+
+// Executor runs SPL pipelines over in-memory events, so detections can be
+// unit-tested end to end without a Splunk instance. It supports the commands
+// the grammar models: search, where, eval, rex, rename, table, fields, dedup,
+// sort, head, tail, top, rare, stats, eventstats, streamstats, bin, fillnull,
+// makemv, mvexpand, transaction, lookup, inputlookup, format, return,
+// foreach and map.
+// Subsearches in searches run over the same input events.
+type Executor struct {
+	// Evaluator evaluates eval/where expressions; inject its clock for
+	// deterministic time functions and earliest/latest filtering.
+	Evaluator *Evaluator
+	// Lookups holds lookup tables by name (e.g. "users.csv" or "users").
+	Lookups map[string][]Event
+	// LookupDir is searched for <name> and <name>.csv when a table is not in Lookups.
+	LookupDir string
+
+	input       []Event                                        // Events of the running query, for subsearches
+	subsearches map[ISubsearchContext]ISearchExpressionContext // Expanded subsearches of the running query
+}
+
+// ResultSet is the output of a pipeline: the rows and their column order
+type ResultSet struct {
+	Fields []string `json:"fields"`
+	Rows   []Event  `json:"rows"`
+}
+
+// NewExecutor creates an Executor with a default Evaluator and no lookups
+func NewExecutor() *Executor {
+	return &Executor{
+		Evaluator: NewEvaluator(),
+		Lookups:   make(map[string][]Event),
+	}
+}
+
+// AddLookupCSV registers a lookup table read from CSV under name
+func (x *Executor) AddLookupCSV(name string, r io.Reader) error {
+	rows, err := ReadCSVEvents(r)
+	if err != nil {
+		return fmt.Errorf("lookup %s: %w", name, err)
+	}
+	if x.Lookups == nil {
+		x.Lookups = make(map[string][]Event)
+	}
+	x.Lookups[name] = rows
+	return nil
+}
+
+// Run parses query and executes it over events. The input events are not modified.
+// Search-scope fields (index, sourcetype, source) only filter events that carry
+// them, so test events need not repeat the scope of the search.
+func (x *Executor) Run(query string, events []Event) (*ResultSet, error) {
+	tree, err := parseSPLRule(query, func(p *SPLParser) antlr.ParserRuleContext { return p.Query() })
+	if err != nil {
+		return nil, err
+	}
+	if x.Evaluator == nil {
+		x.Evaluator = NewEvaluator()
+	}
+
+	run := *x
+	run.input = events
+	run.subsearches = make(map[ISubsearchContext]ISearchExpressionContext)
+	return run.runQuery(tree.(IQueryContext), events)
+}
+
+// runQuery executes the stages of a parsed query over a copy of events
+func (x *Executor) runQuery(query IQueryContext, events []Event) (*ResultSet, error) {
+	rs := &ResultSet{Rows: make([]Event, len(events))}
+	for i, ev := range events {
+		rs.Rows[i] = ev.Clone()
+	}
+	for i, stage := range query.AllPipelineStage() {
+		if err := x.runStage(stage, rs); err != nil {
+			return nil, fmt.Errorf("stage %d (%s): %w", i, classifyStage(stage), err)
+		}
+	}
+	if rs.Fields == nil {
+		rs.Fields = collectFields(rs.Rows)
+	}
+	return rs, nil
+}
+
+// runStage executes a single pipeline stage, replacing rs.Rows
+func (x *Executor) runStage(stage IPipelineStageContext, rs *ResultSet) error {
+	switch cmd := stage.GetChild(0).(type) {
+	case *SearchCommandContext:
+		return rs.filter(func(ev Event) (bool, error) { return x.matchSearch(cmd.SearchExpression(), ev) })
+	case *WhereCommandContext:
+		return rs.filter(func(ev Event) (bool, error) {
+			v, err := x.Evaluator.evalExpression(cmd.Expression(), ev)
+			return v.Truthy(), err
+		})
+	case *EvalCommandContext:
+		return x.runEval(cmd, rs)
+	case *RexCommandContext:
+		return x.runRex(cmd, rs)
+	case *RegexCommandContext:
+		return runRegex(cmd, rs)
+	case *RenameCommandContext:
+		return x.runRename(cmd, rs)
+	case *TableCommandContext:
+		return x.runTable(cmd, rs)
+	case *FieldsCommandContext:
+		return x.runFields(cmd, rs)
+	case *DedupCommandContext:
+		return x.runDedup(cmd, rs)
+	case *SortCommandContext:
+		return x.runSort(cmd, rs)
+	case *HeadCommandContext:
+		n := countArg(cmd.NUMBER(), 10)
+		if n < len(rs.Rows) {
+			rs.Rows = rs.Rows[:n]
+		}
+		return nil
+	case *TailCommandContext:
+		n := min(countArg(cmd.NUMBER(), 10), len(rs.Rows))
+		tail := make([]Event, 0, n)
+		for i := len(rs.Rows) - 1; i >= len(rs.Rows)-n; i-- {
+			tail = append(tail, rs.Rows[i]) // tail returns results in reverse order
+		}
+		rs.Rows = tail
+		return nil
+	case *TopCommandContext:
+		return x.runTopRare("top", cmd.AllFieldList(), cmd.NUMBER(), commandOptions(cmd.AllTopOption()), rs)
+	case *RareCommandContext:
+		return x.runTopRare("rare", cmd.AllFieldList(), cmd.NUMBER(), commandOptions(cmd.AllTopOption()), rs)
+	case *StatsCommandContext:
+		return x.runStats(cmd.AllStatsFunction(), cmd.FieldList(), rs)
+	case *EventstatsCommandContext:
+		return x.runEventstats(cmd, rs)
+	case *StreamstatsCommandContext:
+		return x.runStreamstats(cmd, rs)
+	case *BucketCommandContext:
+		return x.runBin(cmd, rs)
+	case *FillnullCommandContext:
+		return x.runFillnull(cmd, rs)
+	case *MakemvCommandContext:
+		return x.runMakemv(cmd, rs)
+	case *MvexpandCommandContext:
+		return x.runMvexpand(cmd, rs)
+	case *SpathCommandContext:
+		return x.runSpath(cmd, rs)
+	case *TransactionCommandContext:
+		return x.runTransaction(cmd, rs)
+	case *LookupCommandContext:
+		return x.runLookup(cmd, rs)
+	case *InputlookupCommandContext:
+		return x.runInputlookup(cmd, rs)
+	case *FormatCommandContext:
+		format, err := newFormatCommandFormat(cmd)
+		if err != nil {
+			return err
+		}
+		rs.replaceWithSearch(format.Render(rs.Rows))
+		return nil
+	case *ReturnCommandContext:
+		rs.replaceWithSearch(newReturnCommandFormat(cmd).Render(rs.Rows))
+		return nil
+	case *ForeachCommandContext:
+		return x.runForeach(cmd, rs)
+	case *MapCommandContext:
+		return x.runMap(cmd, rs)
+	}
+	return fmt.Errorf("command not supported by the executor: %s", stage.GetText())
+}
+
+// replaceWithSearch replaces the results with the single search row that
+// format and return emit
+func (rs *ResultSet) replaceWithSearch(search string) {
+	rs.Rows = []Event{{"search": search}}
+	rs.Fields = []string{"search"}
+}
+
+// filter keeps the rows for which keep returns true
+func (rs *ResultSet) filter(keep func(Event) (bool, error)) error {
+	out := rs.Rows[:0:0]
+	for _, ev := range rs.Rows {
+		ok, err := keep(ev)
+		if err != nil {
+			return err
+		}
+		if ok {
+			out = append(out, ev)
+		}
+	}
+	rs.Rows = out
+	return nil
+}
+
+// addField appends name to the column order when one has been fixed
+func (rs *ResultSet) addField(name string) {
+	if rs.Fields == nil {
+		return
+	}
+	for _, f := range rs.Fields {
+		if f == name {
+			return
+		}
+	}
+	rs.Fields = append(rs.Fields, name)
+}
+
+// collectFields returns every field name across rows: _time and _raw first,
+// then the rest alphabetically.
+func collectFields(rows []Event) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, ev := range rows {
+		for k := range ev {
+			if !seen[k] {
+				seen[k] = true
+				names = append(names, k)
+			}
+		}
+	}
+	rank := func(name string) int {
+		switch name {
+		case "_time":
+			return 0
+		case "_raw":
+			return 1
+		}
+		return 2
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if ri, rj := rank(names[i]), rank(names[j]); ri != rj {
+			return ri < rj
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// fieldListNames returns the field names of a fieldList, unquoting quoted names
+func fieldListNames(ctx IFieldListContext) []string {
+	if ctx == nil {
+		return nil
+	}
+	return fieldOrQuotedNames(ctx.AllFieldOrQuoted())
+}
+
+// fieldNameText returns a field name, unquoting a single-quoted 'field name'
+func fieldNameText(ctx IFieldNameContext) string {
+	if ctx.SINGLE_QUOTED() != nil {
+		return unquoteSPLString(ctx.SINGLE_QUOTED().GetText())
+	}
+	return ctx.GetText()
+}
+
+// fieldOrQuotedNames returns the names of field or quoted-field contexts
+func fieldOrQuotedNames(fields []IFieldOrQuotedContext) []string {
+	var names []string
+	for _, foq := range fields {
+		if foq.FieldName() != nil {
+			names = append(names, fieldNameText(foq.FieldName()))
+		} else if foq.QUOTED_STRING() != nil {
+			names = append(names, unquoteSPLString(foq.QUOTED_STRING().GetText()))
+		} else if foq.WildcardValue() != nil {
+			names = append(names, foq.WildcardValue().GetText())
+		}
+	}
+	return names
+}
+
+// commandOptions reads key=value option contexts into a map keyed by
+// lowercase option name, with quotes removed from the values.
+func commandOptions[T antlr.ParserRuleContext](opts []T) map[string]string {
+	out := make(map[string]string, len(opts))
+	for _, opt := range opts {
+		if opt.GetChildCount() < 3 {
+			continue
+		}
+		key := strings.ToLower(opt.GetChild(0).(antlr.ParseTree).GetText())
+		var val strings.Builder
+		for i := 2; i < opt.GetChildCount(); i++ {
+			val.WriteString(opt.GetChild(i).(antlr.ParseTree).GetText())
+		}
+		out[key] = unquoteSPLString(val.String())
+	}
+	return out
+}
+
+// isTrueOption interprets Splunk boolean option values (t, true, 1, yes)
+func isTrueOption(v string) bool {
+	switch strings.ToLower(v) {
+	case "t", "true", "1", "yes", "y":
+		return true
+	}
+	return false
+}
+
+// countArg reads an optional count argument, falling back to def
+func countArg(num antlr.TerminalNode, def int) int {
+	if num == nil {
+		return def
+	}
+	n, err := strconv.Atoi(num.GetText())
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+func (x *Executor) runEval(cmd *EvalCommandContext, rs *ResultSet) error {
+	for i, ev := range rs.Rows {
+		out, err := x.Evaluator.applyEvalCommand(cmd, ev)
+		if err != nil {
+			return err
+		}
+		rs.Rows[i] = out
+	}
+	for _, assign := range cmd.AllEvalAssignment() {
+		if assign.FieldName() != nil {
+			rs.addField(fieldNameText(assign.FieldName()))
+		} else if assign.QUOTED_STRING() != nil {
+			rs.addField(unquoteSPLString(assign.QUOTED_STRING().GetText()))
+		}
+	}
+	return nil
+}
+
+// runRex extracts named capture groups from a field (default _raw).
+// max_match=0 collects every match into multivalue fields.
+func (x *Executor) runRex(cmd *RexCommandContext, rs *ResultSet) error {
+	opts := commandOptions(cmd.AllRexOption())
+	if cmd.QUOTED_STRING() == nil {
+		return fmt.Errorf("rex requires a regular expression")
+	}
+	field := "_raw"
+	if f, ok := opts["field"]; ok {
+		field = f
+	}
+	if strings.EqualFold(opts["mode"], "sed") {
+		return runSed(cmd.QUOTED_STRING().GetText(), field, rs)
+	}
+	re, err := cachedRegexp(unquoteSPLString(cmd.QUOTED_STRING().GetText()))
+	if err != nil {
+		return fmt.Errorf("invalid rex pattern: %w", err)
+	}
+	limit := 1
+	if m, ok := opts["max_match"]; ok {
+		if n, err := strconv.Atoi(m); err == nil {
+			limit = n
+		}
+	}
+	if limit == 0 {
+		limit = -1
+	}
+
+	names := re.SubexpNames()
+	for i, ev := range rs.Rows {
+		src := EvalValueOf(ev[field])
+		if src.IsNull() {
+			continue
+		}
+		captured := make(map[string][]any)
+		for _, v := range src.Values() {
+			for _, m := range re.FindAllStringSubmatchIndex(v.String(), limit) {
+				for g, name := range names {
+					if name != "" && m[2*g] >= 0 {
+						captured[name] = append(captured[name], v.String()[m[2*g]:m[2*g+1]])
+					}
+				}
+			}
+		}
+		if len(captured) == 0 {
+			continue
+		}
+		out := ev.Clone()
+		for name, vals := range captured {
+			if len(vals) == 1 {
+				out[name] = vals[0]
+			} else {
+				out[name] = vals
+			}
+		}
+		rs.Rows[i] = out
+	}
+	for _, name := range names {
+		if name != "" {
+			rs.addField(name)
+		}
+	}
+	return nil
+}
+
+// runSed rewrites field in place with a rex mode=sed expression
+func runSed(expr, field string, rs *ResultSet) error {
+	op, err := ParseSedExpression(unquoteSPLString(expr))
+	if err != nil {
+		return err
+	}
+	for i, ev := range rs.Rows {
+		src := EvalValueOf(ev[field])
+		if src.IsNull() {
+			continue
+		}
+		vals := src.Values()
+		out := make([]any, len(vals))
+		for j, v := range vals {
+			if out[j], err = op.Apply(v.String()); err != nil {
+				return err
+			}
+		}
+		ev = ev.Clone()
+		if len(out) == 1 {
+			ev[field] = out[0]
+		} else {
+			ev[field] = out
+		}
+		rs.Rows[i] = ev
+	}
+	return nil
+}
+
+// runRegex keeps the events whose field matches (or, negated, does not match)
+// the pattern. Events without the field only pass a negated regex.
+func runRegex(cmd *RegexCommandContext, rs *ResultSet) error {
+	re, err := cachedRegexp(unquoteSPLString(cmd.QUOTED_STRING().GetText()))
+	if err != nil {
+		return fmt.Errorf("invalid regex pattern: %w", err)
+	}
+	field := "_raw"
+	if cmd.FieldName() != nil {
+		field = cmd.FieldName().GetText()
+	}
+	return rs.filter(func(ev Event) (bool, error) {
+		matched := false
+		for _, v := range EvalValueOf(ev[field]).Values() {
+			if re.MatchString(v.String()) {
+				matched = true
+				break
+			}
+		}
+		return matched != (cmd.NEQ() != nil), nil
+	})
+}
+
+func (x *Executor) runRename(cmd *RenameCommandContext, rs *ResultSet) error {
+	for _, spec := range cmd.AllRenameSpec() {
+		names := spec.AllFieldName()
+		from := names[0].GetText()
+		to := ""
+		if len(names) > 1 {
+			to = names[1].GetText()
+		} else if spec.QUOTED_STRING() != nil {
+			to = unquoteSPLString(spec.QUOTED_STRING().GetText())
+		}
+		for i, ev := range rs.Rows {
+			v, ok := ev[from]
+			if !ok {
+				continue
+			}
+			out := ev.Clone()
+			delete(out, from)
+			out[to] = v
+			rs.Rows[i] = out
+		}
+		for i, f := range rs.Fields {
+			if f == from {
+				rs.Fields[i] = to
+			}
+		}
+	}
+	return nil
+}
+
+func (x *Executor) runTable(cmd *TableCommandContext, rs *ResultSet) error {
+	names := fieldListNames(cmd.FieldList())
+	if err := noWildcardFields("table", names); err != nil {
+		return err
+	}
+	for i, ev := range rs.Rows {
+		out := make(Event, len(names))
+		for _, n := range names {
+			if v, ok := ev[n]; ok {
+				out[n] = v
+			}
+		}
+		rs.Rows[i] = out
+	}
+	rs.Fields = names
+	return nil
+}
+
+// noWildcardFields rejects wildcard field names, which the executor does
+// not expand
+func noWildcardFields(command string, names []string) error {
+	for _, n := range names {
+		if strings.Contains(n, "*") {
+			return fmt.Errorf("%s: wildcard field %s is not supported by the executor", command, n)
+		}
+	}
+	return nil
+}
+
+// runFields keeps (fields a b) or removes (fields - a b) fields. Keeping
+// retains the internal _time and _raw fields, as Splunk does.
+func (x *Executor) runFields(cmd *FieldsCommandContext, rs *ResultSet) error {
+	names := fieldListNames(cmd.FieldList())
+	if err := noWildcardFields("fields", names); err != nil {
+		return err
+	}
+	if cmd.MINUS() != nil {
+		for i, ev := range rs.Rows {
+			out := ev.Clone()
+			for _, n := range names {
+				delete(out, n)
+			}
+			rs.Rows[i] = out
+		}
+		if rs.Fields != nil {
+			var kept []string
+			for _, f := range rs.Fields {
+				if !containsString(names, f) {
+					kept = append(kept, f)
+				}
+			}
+			rs.Fields = kept
+		}
+		return nil
+	}
+
+	keep := append([]string{"_time", "_raw"}, names...)
+	for i, ev := range rs.Rows {
+		out := make(Event, len(keep))
+		for _, n := range keep {
+			if v, ok := ev[n]; ok {
+				out[n] = v
+			}
+		}
+		rs.Rows[i] = out
+	}
+	rs.Fields = names
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// runDedup keeps the first N (default 1) events for each combination of
+// field values. Events missing a field are dropped unless keepempty=true;
+// consecutive=true only removes adjacent duplicates.
+func (x *Executor) runDedup(cmd *DedupCommandContext, rs *ResultSet) error {
+	names := fieldListNames(cmd.FieldList())
+	keepN := countArg(cmd.NUMBER(), 1)
+	opts := commandOptions(cmd.AllDedupOption())
+	keepEmpty := isTrueOption(opts["keepempty"])
+	consecutive := isTrueOption(opts["consecutive"])
+
+	seen := make(map[string]int)
+	prevKey := ""
+	out := rs.Rows[:0:0]
+	for _, ev := range rs.Rows {
+		key, complete := groupKey(ev, names)
+		if !complete {
+			if keepEmpty {
+				out = append(out, ev)
+			}
+			continue
+		}
+		if consecutive {
+			if key == prevKey && len(out) > 0 {
+				continue
+			}
+			prevKey = key
+			out = append(out, ev)
+			continue
+		}
+		if seen[key] < keepN {
+			seen[key]++
+			out = append(out, ev)
+		}
+	}
+	rs.Rows = out
+	return nil
+}
+
+// groupKey builds a key from the string values of fields; complete is false
+// if any field is missing.
+func groupKey(ev Event, fields []string) (string, bool) {
+	parts := make([]string, len(fields))
+	for i, f := range fields {
+		v := EvalValueOf(ev[f])
+		if v.IsNull() {
+			return "", false
+		}
+		parts[i] = v.String()
+	}
+	return strings.Join(parts, "\x00"), true
+}
+
+// runSort orders rows by the sort fields. Numbers sort numerically before
+// strings and missing values sort last. Without a count, at most 10000
+// rows are kept; sort 0 keeps all.
+func (x *Executor) runSort(cmd *SortCommandContext, rs *ResultSet) error {
+	type key struct {
+		field string
+		desc  bool
+	}
+	var keys []key
+	for _, sf := range cmd.AllSortField() {
+		field := ""
+		if sf.FieldName() != nil {
+			field = fieldNameText(sf.FieldName())
+		} else {
+			field = unquoteSPLString(sf.QUOTED_STRING().GetText())
+		}
+		keys = append(keys, key{field: field, desc: sf.MINUS() != nil})
+	}
+
+	sort.SliceStable(rs.Rows, func(i, j int) bool {
+		for _, k := range keys {
+			a := EvalValueOf(rs.Rows[i][k.field]).scalar()
+			b := EvalValueOf(rs.Rows[j][k.field]).scalar()
+			switch {
+			case a.IsNull() && b.IsNull():
+				continue
+			case a.IsNull():
+				return false
+			case b.IsNull():
+				return true
+			}
+			c := orderValues(a, b)
+			if c == 0 {
+				continue
+			}
+			if k.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+
+	limit := countArg(cmd.NUMBER(), defaultSortLimit)
+	if limit > 0 && limit < len(rs.Rows) {
+		rs.Rows = rs.Rows[:limit]
+	}
+	return nil
+}
+
+// runBin buckets a numeric or _time field by span=. _time values snap to the
+// start of the span; other fields become "low-high" range strings. With AS
+// the bucket is written to the new field.
+func (x *Executor) runBin(cmd *BucketCommandContext, rs *ResultSet) error {
+	field, target := cmd.FieldName(0).GetText(), cmd.FieldName(0).GetText()
+	if cmd.AS() != nil {
+		target = cmd.FieldName(1).GetText()
+	}
+	opts := commandOptions(cmd.AllBucketOption())
+	spanText, ok := opts["span"]
+	if !ok {
+		return fmt.Errorf("bin requires span=")
+	}
+	amount, unit, err := parseSpan(spanText)
+	if err != nil {
+		return err
+	}
+	size, _ := parseNumber(spanText)
+	loc := x.Evaluator.location()
+
+	for i, ev := range rs.Rows {
+		n, ok := EvalValueOf(ev[field]).Number()
+		if !ok {
+			continue
+		}
+		out := ev.Clone()
+		if field == "_time" {
+			out[target] = timeToEpoch(binTime(epochToTime(n, loc), amount, unit))
+		} else {
+			if size == 0 {
+				size = float64(amount)
+			}
+			low := math.Floor(n/size) * size
+			out[target] = formatNumber(low) + "-" + formatNumber(low+size)
+		}
+		rs.Rows[i] = out
+	}
+	return nil
+}
+
+// binTime floors t to a multiple of amount units
+func binTime(t time.Time, amount int, unit string) time.Time {
+	if d, ok := spanDuration(amount, unit); ok && unit != "d" && unit != "w" {
+		return t.Truncate(d)
+	}
+	y, mo, day := t.Date()
+	loc := t.Location()
+	switch unit {
+	case "d":
+		start := time.Date(y, mo, day, 0, 0, 0, 0, loc)
+		days := int(start.Sub(time.Date(1970, 1, 1, 0, 0, 0, 0, loc)).Hours() / 24)
+		return start.AddDate(0, 0, -(days % amount))
+	case "w":
+		return snapWeekday(t, time.Sunday)
+	case "mon":
+		m := int(mo) - 1
+		return time.Date(y, time.Month(m-m%amount+1), 1, 0, 0, 0, 0, loc)
+	case "q":
+		m := int(mo) - 1
+		return time.Date(y, time.Month(m-m%(3*amount)+1), 1, 0, 0, 0, 0, loc)
+	case "y":
+		return time.Date(y-y%amount, time.January, 1, 0, 0, 0, 0, loc)
+	}
+	return t
+}
+
+// runFillnull sets missing or empty fields to value= (default 0). Without a
+// field list every field seen in the results is filled.
+func (x *Executor) runFillnull(cmd *FillnullCommandContext, rs *ResultSet) error {
+	value := "0"
+	if v, ok := commandOptions(cmd.AllFillnullOption())["value"]; ok {
+		value = v
+	}
+	names := fieldListNames(cmd.FieldList())
+	if len(names) == 0 {
+		names = rs.Fields
+		if names == nil {
+			names = collectFields(rs.Rows)
+		}
+	}
+	for i, ev := range rs.Rows {
+		out := ev.Clone()
+		for _, n := range names {
+			if v := EvalValueOf(out[n]); v.IsNull() || (v.Kind == EvalString && v.Str == "") {
+				out[n] = value
+			}
+		}
+		rs.Rows[i] = out
+	}
+	return nil
+}
+
+// runMakemv splits a field into a multivalue field on delim= (default a
+// space) or on the first capture group of tokenizer=.
+func (x *Executor) runMakemv(cmd *MakemvCommandContext, rs *ResultSet) error {
+	field := cmd.FieldName().GetText()
+	opts := commandOptions(cmd.AllMakemvOption())
+	delim, hasDelim := opts["delim"]
+	if !hasDelim {
+		delim = " "
+	}
+	var tokenizer *regexp.Regexp
+	if tok, ok := opts["tokenizer"]; ok && !hasDelim {
+		re, err := cachedRegexp(tok)
+		if err != nil {
+			return fmt.Errorf("invalid tokenizer: %w", err)
+		}
+		tokenizer = re
+	}
+	allowEmpty := isTrueOption(opts["allowempty"])
+
+	for i, ev := range rs.Rows {
+		v := EvalValueOf(ev[field])
+		if v.IsNull() || v.Kind == EvalMulti {
+			continue
+		}
+		var parts []string
+		if tokenizer != nil {
+			for _, m := range tokenizer.FindAllStringSubmatch(v.String(), -1) {
+				if len(m) > 1 {
+					parts = append(parts, m[1])
+				} else {
+					parts = append(parts, m[0])
+				}
+			}
+		} else {
+			parts = strings.Split(v.String(), delim)
+		}
+		var vals []any
+		for _, p := range parts {
+			if p != "" || allowEmpty {
+				vals = append(vals, p)
+			}
+		}
+		out := ev.Clone()
+		switch len(vals) {
+		case 0:
+			delete(out, field)
+		case 1:
+			out[field] = vals[0]
+		default:
+			out[field] = vals
+		}
+		rs.Rows[i] = out
+	}
+	return nil
+}
+
+// runMvexpand emits one row per value of a multivalue field
+func (x *Executor) runMvexpand(cmd *MvexpandCommandContext, rs *ResultSet) error {
+	field := cmd.FieldName().GetText()
+	var out []Event
+	for _, ev := range rs.Rows {
+		v := EvalValueOf(ev[field])
+		if v.IsNull() {
+			continue
+		}
+		for _, item := range v.Values() {
+			row := ev.Clone()
+			row[field] = item.Interface()
+			out = append(out, row)
+		}
+	}
+	rs.Rows = out
+	return nil
+}
+
+// runSpath extracts JSON from the input field: the values at the path into
+// the output field, or every leaf under its flattened name when there is no
+// path. Events whose input is not JSON are left unchanged.
+func (x *Executor) runSpath(cmd *SpathCommandContext, rs *ResultSet) error {
+	info, err := newSpathInfo(cmd)
+	if err != nil {
+		return err
+	}
+	for i, ev := range rs.Rows {
+		input := EvalValueOf(ev[info.Input])
+		if input.IsNull() {
+			continue
+		}
+		var doc any
+		if err := json.Unmarshal([]byte(input.scalar().String()), &doc); err != nil {
+			continue
+		}
+
+		out := ev.Clone()
+		if info.Path == "" {
+			obj, ok := doc.(map[string]any)
+			if !ok {
+				continue
+			}
+			leaves := make(Event)
+			for k, v := range obj {
+				flattenJSON(k, v, leaves)
+			}
+			for k, v := range leaves {
+				out[k] = v
+			}
+		} else {
+			switch vals := extractJSONPath(doc, info.Steps); len(vals) {
+			case 0:
+				delete(out, info.Field())
+			case 1:
+				out[info.Field()] = vals[0]
+			default:
+				out[info.Field()] = vals
+			}
+		}
+		rs.Rows[i] = out
+	}
+	if info.Path != "" {
+		rs.addField(info.Field())
+	}
+	return nil
+}
+
+// runForeach runs the foreach template, expanded once per matching field,
+// over the results
+func (x *Executor) runForeach(cmd *ForeachCommandContext, rs *ResultSet) error {
+	t := newForeachTemplate(cmd, subsearchQueryText(cmd.Subsearch()))
+	if mode := strings.ToLower(t.Options["mode"]); mode != "" && mode != "multifield" && mode != "auto" {
+		return fmt.Errorf("foreach mode=%s is not supported by the executor", mode)
+	}
+	fields := rs.Fields
+	if fields == nil {
+		fields = collectFields(rs.Rows)
+	}
+	for _, expansion := range t.expandFields(fields) {
+		out, err := x.runTemplate(expansion.Search, rs.Rows)
+		if err != nil {
+			return fmt.Errorf("foreach: %w", err)
+		}
+		rs.Rows = out.Rows
+		for _, f := range out.Fields {
+			rs.addField(f)
+		}
+	}
+	return nil
+}
+
+// runMap runs the map search once per result row, up to maxsearches rows,
+// over the input events and replaces the results with the combined output
+func (x *Executor) runMap(cmd *MapCommandContext, rs *ResultSet) error {
+	text := ""
+	if subsearches := cmd.AllSubsearch(); len(subsearches) > 0 {
+		text = subsearchQueryText(subsearches[0])
+	}
+	t := newMapTemplate(cmd, text)
+
+	var rows []Event
+	for i, row := range rs.Rows {
+		if i == t.MaxSearches {
+			break
+		}
+		out, err := x.runTemplate(t.Expand(row)[0].Search, x.input)
+		if err != nil {
+			return fmt.Errorf("map: %w", err)
+		}
+		rows = append(rows, out.Rows...)
+	}
+	rs.Rows, rs.Fields = rows, nil
+	return nil
+}
+
+// runTemplate parses and runs an expanded foreach or map search
+func (x *Executor) runTemplate(search string, events []Event) (*ResultSet, error) {
+	tree, err := parseSPLRule(search, func(p *SPLParser) antlr.ParserRuleContext { return p.Query() })
+	if err != nil {
+		return nil, err
+	}
+	return x.runQuery(tree.(IQueryContext), events)
+}
+
+// lookupTable resolves a lookup table by name from Lookups or LookupDir
+func (x *Executor) lookupTable(name string) ([]Event, error) {
+	candidates := []string{name, name + ".csv", strings.TrimSuffix(name, ".csv")}
+	for _, c := range candidates {
+		if rows, ok := x.Lookups[c]; ok {
+			return rows, nil
+		}
+	}
+	if x.LookupDir != "" {
+		for _, c := range candidates {
+			f, err := os.Open(filepath.Join(x.LookupDir, filepath.Base(c)))
+			if err != nil {
+				continue
+			}
+			rows, err := ReadCSVEvents(f)
+			f.Close()
+			if err != nil {
+				return nil, fmt.Errorf("lookup %s: %w", name, err)
+			}
+			return rows, nil
+		}
+	}
+	return nil, fmt.Errorf("lookup table %q not found", name)
+}
+
+// runLookup enriches rows from a lookup table. Input fields are matched
+// against the table; output fields are copied into the row (all other
+// columns when none are listed). OUTPUTNEW leaves existing values.
+func (x *Executor) runLookup(cmd *LookupCommandContext, rs *ResultSet) error {
+	info := newLookupInfo(cmd)
+	table, err := x.lookupTable(info.Table)
+	if err != nil {
+		return err
+	}
+
+	var tableFields, eventFields, tableOutputs, eventOutputs []string
+	for _, f := range info.Inputs {
+		tableFields = append(tableFields, f.TableField)
+		eventFields = append(eventFields, f.EventField)
+	}
+	for _, f := range info.Outputs {
+		tableOutputs = append(tableOutputs, f.TableField)
+		eventOutputs = append(eventOutputs, f.EventField)
+	}
+	return x.applyLookup(table, tableFields, eventFields, tableOutputs, eventOutputs, info.OutputNew, rs)
+}
+
+// applyLookup matches each row's eventFields against the table's
+// tableFields and copies tableOutputs into the row as eventOutputs.
+func (x *Executor) applyLookup(table []Event, tableFields, eventFields, tableOutputs, eventOutputs []string, outputNew bool, rs *ResultSet) error {
+	if len(tableFields) == 0 {
+		return fmt.Errorf("lookup requires at least one match field")
+	}
+	if len(tableOutputs) == 0 && len(table) > 0 {
+		for _, col := range collectFields(table) {
+			if !containsString(tableFields, col) {
+				tableOutputs = append(tableOutputs, col)
+			}
+		}
+		eventOutputs = tableOutputs
+	}
+
+	index := make(map[string][]Event)
+	for _, row := range table {
+		if key, ok := groupKey(row, tableFields); ok {
+			index[key] = append(index[key], row)
+		}
+	}
+
+	for i, ev := range rs.Rows {
+		key, ok := groupKey(ev, eventFields)
+		if !ok || len(index[key]) == 0 {
+			continue
+		}
+		out := ev.Clone()
+		for j, col := range tableOutputs {
+			dest := eventOutputs[j]
+			if _, exists := out[dest]; outputNew && exists {
+				continue
+			}
+			var vals []any
+			for _, match := range index[key] {
+				if v, ok := match[col]; ok {
+					vals = append(vals, v)
+				}
+			}
+			switch len(vals) {
+			case 0:
+				delete(out, dest)
+			case 1:
+				out[dest] = vals[0]
+			default:
+				out[dest] = vals
+			}
+		}
+		rs.Rows[i] = out
+	}
+	for _, dest := range eventOutputs {
+		rs.addField(dest)
+	}
+	return nil
+}
+
+// runInputlookup replaces the results with the rows of a lookup table,
+// optionally filtered by a where expression. append=true keeps existing rows.
+func (x *Executor) runInputlookup(cmd *InputlookupCommandContext, rs *ResultSet) error {
+	name := ""
+	if cmd.IDENTIFIER() != nil {
+		name = cmd.IDENTIFIER().GetText()
+	} else if cmd.QUOTED_STRING() != nil {
+		name = unquoteSPLString(cmd.QUOTED_STRING().GetText())
+	}
+	table, err := x.lookupTable(name)
+	if err != nil {
+		return err
+	}
+	opts := commandOptions(cmd.AllInputlookupOption())
+
+	var rows []Event
+	for _, row := range table {
+		if cmd.Expression() != nil {
+			v, err := x.Evaluator.evalExpression(cmd.Expression(), row)
+			if err != nil {
+				return err
+			}
+			if !v.Truthy() {
+				continue
+			}
+		}
+		rows = append(rows, row.Clone())
+	}
+	if start, err := strconv.Atoi(opts["start"]); err == nil && start > 0 {
+		rows = rows[min(start, len(rows)):]
+	}
+	if max, err := strconv.Atoi(opts["max"]); err == nil && max > 0 && max < len(rows) {
+		rows = rows[:max]
+	}
+
+	if isTrueOption(opts["append"]) {
+		rs.Rows = append(rs.Rows, rows...)
+		return nil
+	}
+	rs.Rows = rows
+	rs.Fields = nil
+	return nil
+}
@@ -0,0 +1,129 @@
+package spl
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ParseJSONEvents decodes events from a JSON array or from newline-delimited
+// JSON objects. Nested objects are flattened the way Splunk's JSON field
+// extraction names them (a.b, list{}, list{}.name), _raw is set to the
+// original object text unless present, and an RFC 3339 _time string is
+// converted to epoch seconds.
+func ParseJSONEvents(data []byte) ([]Event, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var objects []json.RawMessage
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := dec.Decode(&objects); err != nil {
+			return nil, fmt.Errorf("decoding JSON events: %w", err)
+		}
+	} else {
+		for {
+			var raw json.RawMessage
+			err := dec.Decode(&raw)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("decoding JSON events: %w", err)
+			}
+			objects = append(objects, raw)
+		}
+	}
+
+	events := make([]Event, 0, len(objects))
+	for i, raw := range objects {
+		var obj map[string]any
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, fmt.Errorf("event %d: %w", i, err)
+		}
+		ev := make(Event, len(obj)+1)
+		for k, v := range obj {
+			flattenJSON(k, v, ev)
+		}
+		if _, ok := ev["_raw"]; !ok {
+			ev["_raw"] = string(bytes.TrimSpace(raw))
+		}
+		if s, ok := ev["_time"].(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				ev["_time"] = timeToEpoch(t)
+			}
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// flattenJSON stores v under key, descending into objects (key.child) and
+// arrays (key{}); repeated keys become multivalue fields.
+func flattenJSON(key string, v any, out Event) {
+	switch x := v.(type) {
+	case map[string]any:
+		for k, child := range x {
+			flattenJSON(key+"."+k, child, out)
+		}
+	case []any:
+		for _, item := range x {
+			flattenJSON(key+"{}", item, out)
+		}
+	default:
+		appendFieldValue(out, key, x)
+	}
+}
+
+// appendFieldValue adds v to a field, turning it into a multivalue field if
+// it already has a value.
+func appendFieldValue(ev Event, key string, v any) {
+	existing, ok := ev[key]
+	if !ok {
+		ev[key] = v
+		return
+	}
+	if list, ok := existing.([]any); ok {
+		ev[key] = append(list, v)
+		return
+	}
+	ev[key] = []any{existing, v}
+}
+
+// ReadCSVEvents reads a CSV file with a header row (the lookup table format)
+// into events. Empty cells are omitted, so they read as null.
+func ReadCSVEvents(r io.Reader) ([]Event, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+	events := make([]Event, 0, len(records)-1)
+	for _, rec := range records[1:] {
+		ev := make(Event, len(header))
+		for i, cell := range rec {
+			if i < len(header) && cell != "" {
+				ev[header[i]] = cell
+			}
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// eventTime returns the event's _time as epoch seconds
+func eventTime(ev Event) (float64, bool) {
+	return EvalValueOf(ev["_time"]).Number()
+}
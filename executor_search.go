@@ -0,0 +1,227 @@
+package spl
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// matchSearch evaluates a search expression against an event using search
+// semantics: case-insensitive values with * wildcards, bare terms matched
+// against _raw, and OR binding tighter than AND (a b OR c is a AND (b OR c)).
+func (x *Executor) matchSearch(ctx ISearchExpressionContext, ev Event) (bool, error) {
+	var groups [][]ISearchTermContext
+	pendingOR := false
+	for _, child := range ctx.GetChildren() {
+		switch c := child.(type) {
+		case ILogicalOpContext:
+			pendingOR = c.OR() != nil
+		case ISearchTermContext:
+			if pendingOR && len(groups) > 0 {
+				groups[len(groups)-1] = append(groups[len(groups)-1], c)
+			} else {
+				groups = append(groups, []ISearchTermContext{c})
+			}
+			pendingOR = false
+		}
+	}
+
+	for _, group := range groups {
+		matched := false
+		for _, term := range group {
+			ok, err := x.matchSearchTerm(term, ev)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (x *Executor) matchSearchTerm(ctx ISearchTermContext, ev Event) (bool, error) {
+	switch {
+	case ctx.NOT() != nil:
+		ok, err := x.matchSearchTerm(ctx.SearchTerm(), ev)
+		return !ok, err
+	case ctx.SearchExpression() != nil:
+		return x.matchSearch(ctx.SearchExpression(), ev)
+	case ctx.Condition() != nil:
+		return x.matchSearchCondition(ctx.Condition(), ev)
+	case ctx.BareWord() != nil:
+		return matchBareWord(ctx.BareWord(), ev), nil
+	case ctx.MACRO() != nil:
+		return false, fmt.Errorf("macro %s must be expanded before execution", ctx.MACRO().GetText())
+	case ctx.Subsearch() != nil:
+		expr, err := x.expandSubsearch(ctx.Subsearch())
+		if err != nil || expr == nil {
+			return err == nil, err
+		}
+		return x.matchSearch(expr, ev)
+	}
+	return true, nil // An empty group ( ) matches every event
+}
+
+// expandSubsearch runs a subsearch over the input events and parses the
+// search string it emits: the search field of format and return, otherwise
+// the rows formatted with the format defaults. The result is nil for an
+// empty search string, which does not filter.
+func (x *Executor) expandSubsearch(ctx ISubsearchContext) (ISearchExpressionContext, error) {
+	if expr, ok := x.subsearches[ctx]; ok {
+		return expr, nil
+	}
+	rs, err := x.runQuery(ctx.Query(), x.input)
+	if err != nil {
+		return nil, fmt.Errorf("subsearch: %w", err)
+	}
+
+	search := DefaultSubsearchFormat().Render(rs.Rows)
+	if len(rs.Rows) == 1 && rs.Rows[0]["search"] != nil {
+		search = EvalValueOf(rs.Rows[0]["search"]).String()
+	}
+	var expr ISearchExpressionContext
+	if strings.TrimSpace(search) != "" {
+		tree, err := parseSPLRule(search, func(p *SPLParser) antlr.ParserRuleContext { return p.SearchExpression() })
+		if err != nil {
+			return nil, fmt.Errorf("subsearch: %w", err)
+		}
+		expr = tree.(ISearchExpressionContext)
+	}
+	if x.subsearches != nil {
+		x.subsearches[ctx] = expr
+	}
+	return expr, nil
+}
+
+// matchSearchCondition applies field=value style conditions. Search-scope
+// metadata only filters events that carry the field, and earliest/latest
+// filter on _time relative to the evaluator's clock.
+func (x *Executor) matchSearchCondition(ctx IConditionContext, ev Event) (bool, error) {
+	if ctx.FunctionCall() != nil {
+		v, err := x.Evaluator.evalFunctionCall(ctx.FunctionCall(), ev)
+		return v.Truthy(), err
+	}
+	if ctx.Subsearch() != nil {
+		return false, fmt.Errorf("subsearches are not supported by the executor: %s", ctx.GetText())
+	}
+
+	field := fieldNameText(ctx.FieldName())
+	switch strings.ToLower(field) {
+	case "earliest", "latest", "_index_earliest", "_index_latest":
+		return x.matchTimeBound(field, searchValueText(ctx.Value()), ev)
+	}
+	values := EvalValueOf(ev[field])
+	if values.IsNull() && IsSearchScopeMetadata(field) {
+		return true, nil
+	}
+	if values.IsNull() {
+		return false, nil
+	}
+
+	if ctx.ValueList() != nil {
+		for _, vc := range ctx.ValueList().AllValue() {
+			_, _, caseSensitive := valueDirective(vc)
+			if anyValue(values, func(v EvalValue) bool { return matchSearchValue(v, searchValueText(vc), caseSensitive) }) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	// field::value matches an indexed field like field=value. TERM() only
+	// narrows the index lookup, so a field value is compared as usual.
+	pattern := searchValueText(ctx.Value())
+	_, _, caseSensitive := valueDirective(ctx.Value())
+	op := "="
+	if ctx.ComparisonOp() != nil {
+		op = ctx.ComparisonOp().GetText()
+	}
+	switch op {
+	case "=", "==":
+		return anyValue(values, func(v EvalValue) bool { return matchSearchValue(v, pattern, caseSensitive) }), nil
+	case "!=":
+		return !anyValue(values, func(v EvalValue) bool { return matchSearchValue(v, pattern, caseSensitive) }), nil
+	default:
+		return compareValues(values, strVal(pattern), op), nil
+	}
+}
+
+// matchTimeBound filters on _time for earliest/latest. Events without a
+// _time pass.
+func (x *Executor) matchTimeBound(field, value string, ev Event) (bool, error) {
+	t, ok := eventTime(ev)
+	if !ok {
+		return true, nil
+	}
+	bound, err := resolveTimeBound(value, x.Evaluator.now())
+	if err != nil {
+		return false, err
+	}
+	if strings.HasSuffix(strings.ToLower(field), "earliest") {
+		return t >= timeToEpoch(bound), nil
+	}
+	return t < timeToEpoch(bound), nil
+}
+
+// searchValueText returns the literal text of a search value, unquoted
+// and unescaped, without TERM() or CASE()
+func searchValueText(ctx IValueContext) string {
+	text, _, _ := valueDirective(ctx)
+	return UnescapeValue(text)
+}
+
+// matchSearchValue compares an event value with a search value: numerically
+// when both are numbers, by network membership when the search value is a
+// CIDR network and the event value an IP address, otherwise as a wildcard
+// pattern, case-insensitively unless the value was wrapped in CASE().
+func matchSearchValue(v EvalValue, pattern string, caseSensitive bool) bool {
+	if !strings.Contains(pattern, "*") {
+		if a, ok := v.Number(); ok {
+			if b, ok := parseNumber(pattern); ok {
+				return a == b
+			}
+		}
+		if _, network, err := net.ParseCIDR(pattern); err == nil {
+			if ip := net.ParseIP(strings.TrimSpace(v.String())); ip != nil {
+				return network.Contains(ip)
+			}
+		}
+	}
+	return matchWildcardCase(pattern, v.String(), caseSensitive)
+}
+
+// matchBareWord matches a free-text term against _raw (or, without _raw,
+// against every field value), case-insensitively. TERM(x) must match a
+// whole term and CASE(x) matches case-sensitively.
+func matchBareWord(ctx IBareWordContext, ev Event) bool {
+	term, exact, caseSensitive := bareWordDirective(ctx)
+	if ctx.QUOTED_STRING() != nil || exact || caseSensitive {
+		term = UnescapeValue(term)
+	}
+	if strings.Trim(term, "*") == "" {
+		return true
+	}
+
+	var haystacks []string
+	if raw, ok := ev["_raw"]; ok {
+		haystacks = append(haystacks, EvalValueOf(raw).String())
+	} else {
+		for _, v := range ev {
+			haystacks = append(haystacks, EvalValueOf(v).String())
+		}
+	}
+	for _, h := range haystacks {
+		if exact && matchTerm(term, h) || !exact && matchWildcardCase("*"+term+"*", h, caseSensitive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,835 @@
+package spl
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// statsSpec is one aggregation of a stats-family command, e.g. dc(user) AS users
+type statsSpec struct {
+	fn   string             // lowercase function name
+	arg  IExpressionContext // nil for bare count
+	name string             // output field name
+}
+
+// statsAggregateFunctions are the aggregations the executor implements.
+// Percentiles (perc95, p99, exactperc50, upperperc90) are matched separately.
+var statsAggregateFunctions = map[string]bool{
+	"count": true, "c": true, "dc": true, "distinct_count": true, "estdc": true,
+	"sum": true, "sumsq": true, "avg": true, "mean": true, "min": true, "max": true,
+	"range": true, "median": true, "mode": true, "stdev": true, "stdevp": true,
+	"var": true, "varp": true, "values": true, "list": true, "first": true,
+	"last": true, "earliest": true, "latest": true, "earliest_time": true,
+	"latest_time": true,
+}
+
+// percentilePattern matches percentile function names and captures the percentile
+var percentilePattern = regexp.MustCompile(`^(?:perc|p|exactperc|upperperc)(\d+(?:\.\d+)?)$`)
+
+// maxListValues caps list(), as Splunk does
+const maxListValues = 100
+
+func parseStatsSpecs(fns []IStatsFunctionContext) ([]statsSpec, error) {
+	specs := make([]statsSpec, 0, len(fns))
+	for _, f := range fns {
+		spec := statsSpec{fn: strings.ToLower(f.IDENTIFIER().GetText()), arg: f.Expression()}
+		if !statsAggregateFunctions[spec.fn] && !percentilePattern.MatchString(spec.fn) {
+			return nil, fmt.Errorf("unsupported stats function %s", spec.fn)
+		}
+		if spec.arg == nil && spec.fn != "count" && spec.fn != "c" {
+			return nil, fmt.Errorf("%s requires a field", spec.fn)
+		}
+
+		switch {
+		case f.FieldName() != nil:
+			spec.name = f.FieldName().GetText()
+		case f.QUOTED_STRING() != nil:
+			spec.name = unquoteSPLString(f.QUOTED_STRING().GetText())
+		case spec.arg != nil:
+			spec.name = f.IDENTIFIER().GetText() + "(" + spec.arg.GetText() + ")"
+		default:
+			spec.name = f.IDENTIFIER().GetText()
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// aggValue is one input value to an aggregation, with its event time
+type aggValue struct {
+	v       EvalValue
+	time    float64
+	hasTime bool
+}
+
+// collectAggValues evaluates the aggregation argument for each event. Boolean
+// false (from eval(...) conditions) counts as null.
+func (x *Executor) collectAggValues(spec statsSpec, events []Event) ([]aggValue, error) {
+	var out []aggValue
+	for _, ev := range events {
+		v, err := x.Evaluator.evalExpression(spec.arg, ev)
+		if err != nil {
+			return nil, err
+		}
+		if v.Kind == EvalBool && !v.Bool {
+			continue
+		}
+		t, hasTime := eventTime(ev)
+		for _, item := range v.Values() {
+			out = append(out, aggValue{v: item, time: t, hasTime: hasTime})
+		}
+	}
+	return out, nil
+}
+
+// aggregate computes one statsSpec over a group of events
+func (x *Executor) aggregate(spec statsSpec, events []Event) (EvalValue, error) {
+	if spec.arg == nil {
+		return numVal(float64(len(events))), nil
+	}
+	vals, err := x.collectAggValues(spec, events)
+	if err != nil {
+		return nullVal(), err
+	}
+	return computeAggregate(spec.fn, vals), nil
+}
+
+func computeAggregate(fn string, vals []aggValue) EvalValue {
+	var nums []float64
+	for _, a := range vals {
+		if n, ok := a.v.Number(); ok {
+			nums = append(nums, n)
+		}
+	}
+
+	if m := percentilePattern.FindStringSubmatch(fn); m != nil {
+		p, _ := strconv.ParseFloat(m[1], 64)
+		return percentile(nums, p, strings.HasPrefix(fn, "upperperc"))
+	}
+
+	switch fn {
+	case "count", "c":
+		return numVal(float64(len(vals)))
+	case "dc", "distinct_count", "estdc":
+		seen := make(map[string]bool)
+		for _, a := range vals {
+			seen[a.v.String()] = true
+		}
+		return numVal(float64(len(seen)))
+	case "sum":
+		if len(nums) == 0 {
+			return nullVal()
+		}
+		return numVal(sumFloats(nums))
+	case "sumsq":
+		if len(nums) == 0 {
+			return nullVal()
+		}
+		total := 0.0
+		for _, n := range nums {
+			total += n * n
+		}
+		return numVal(total)
+	case "avg", "mean":
+		if len(nums) == 0 {
+			return nullVal()
+		}
+		return numVal(sumFloats(nums) / float64(len(nums)))
+	case "min", "max":
+		sign := 1
+		if fn == "min" {
+			sign = -1
+		}
+		var best EvalValue
+		found := false
+		for _, a := range vals {
+			if !found || sign*orderValues(a.v, best) > 0 {
+				best, found = a.v, true
+			}
+		}
+		if !found {
+			return nullVal()
+		}
+		return best
+	case "range":
+		if len(nums) == 0 {
+			return nullVal()
+		}
+		lo, hi := nums[0], nums[0]
+		for _, n := range nums {
+			lo, hi = math.Min(lo, n), math.Max(hi, n)
+		}
+		return numVal(hi - lo)
+	case "median":
+		return percentile(nums, 50, false)
+	case "mode":
+		counts := make(map[string]int)
+		var best EvalValue
+		bestCount := 0
+		for _, a := range vals {
+			counts[a.v.String()]++
+			if c := counts[a.v.String()]; c > bestCount {
+				best, bestCount = a.v, c
+			}
+		}
+		if bestCount == 0 {
+			return nullVal()
+		}
+		return best
+	case "stdev", "stdevp", "var", "varp":
+		sample := fn == "stdev" || fn == "var"
+		if len(nums) == 0 || (sample && len(nums) < 2) {
+			return nullVal()
+		}
+		mean := sumFloats(nums) / float64(len(nums))
+		ss := 0.0
+		for _, n := range nums {
+			ss += (n - mean) * (n - mean)
+		}
+		denom := float64(len(nums))
+		if sample {
+			denom--
+		}
+		variance := ss / denom
+		if strings.HasPrefix(fn, "stdev") {
+			return numVal(math.Sqrt(variance))
+		}
+		return numVal(variance)
+	case "values":
+		seen := make(map[string]bool)
+		var out []EvalValue
+		for _, a := range vals {
+			if !seen[a.v.String()] {
+				seen[a.v.String()] = true
+				out = append(out, a.v)
+			}
+		}
+		sort.SliceStable(out, func(i, j int) bool { return out[i].String() < out[j].String() })
+		return mvVal(out)
+	case "list":
+		var out []EvalValue
+		for _, a := range vals {
+			if len(out) == maxListValues {
+				break
+			}
+			out = append(out, a.v)
+		}
+		return mvVal(out)
+	case "first":
+		if len(vals) == 0 {
+			return nullVal()
+		}
+		return vals[0].v
+	case "last":
+		if len(vals) == 0 {
+			return nullVal()
+		}
+		return vals[len(vals)-1].v
+	case "earliest", "latest", "earliest_time", "latest_time":
+		idx := -1
+		for i, a := range vals {
+			if !a.hasTime {
+				continue
+			}
+			if idx < 0 || (strings.HasPrefix(fn, "earliest") && a.time < vals[idx].time) ||
+				(strings.HasPrefix(fn, "latest") && a.time > vals[idx].time) {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			return nullVal()
+		}
+		if strings.HasSuffix(fn, "_time") {
+			return numVal(vals[idx].time)
+		}
+		return vals[idx].v
+	}
+	return nullVal()
+}
+
+func sumFloats(nums []float64) float64 {
+	total := 0.0
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+// percentile interpolates linearly between the closest ranks, or returns the
+// nearest value at or above the rank when upper is set.
+func percentile(nums []float64, p float64, upper bool) EvalValue {
+	if len(nums) == 0 {
+		return nullVal()
+	}
+	sorted := append([]float64(nil), nums...)
+	sort.Float64s(sorted)
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if upper || lo == hi {
+		return numVal(sorted[hi])
+	}
+	return numVal(sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo)))
+}
+
+// eventGroup is a set of events sharing the same BY field values
+type eventGroup struct {
+	keys   []EvalValue
+	events []Event
+	rows   []int // indexes of the events in the input
+}
+
+// groupEvents partitions rows by the BY fields. Events with a null BY field
+// are skipped; multivalue BY fields put the event in one group per value.
+func groupEvents(rows []Event, by []string) []*eventGroup {
+	index := make(map[string]*eventGroup)
+	var groups []*eventGroup
+	for i, ev := range rows {
+		combos := [][]EvalValue{{}}
+		for _, f := range by {
+			vals := EvalValueOf(ev[f]).Values()
+			if len(vals) == 0 {
+				combos = nil
+				break
+			}
+			var next [][]EvalValue
+			for _, c := range combos {
+				for _, v := range vals {
+					next = append(next, append(append([]EvalValue(nil), c...), v))
+				}
+			}
+			combos = next
+		}
+		for _, keys := range combos {
+			parts := make([]string, len(keys))
+			for j, k := range keys {
+				parts[j] = k.String()
+			}
+			id := strings.Join(parts, "\x00")
+			g, ok := index[id]
+			if !ok {
+				g = &eventGroup{keys: keys}
+				index[id] = g
+				groups = append(groups, g)
+			}
+			g.events = append(g.events, ev)
+			g.rows = append(g.rows, i)
+		}
+	}
+	return groups
+}
+
+// sortGroups orders groups by their BY values, as stats output is
+func sortGroups(groups []*eventGroup) {
+	sort.SliceStable(groups, func(i, j int) bool {
+		for k := range groups[i].keys {
+			if c := orderValues(groups[i].keys[k], groups[j].keys[k]); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+}
+
+// runStats replaces the rows with one row per BY group holding the aggregates
+func (x *Executor) runStats(fns []IStatsFunctionContext, byList IFieldListContext, rs *ResultSet) error {
+	specs, err := parseStatsSpecs(fns)
+	if err != nil {
+		return err
+	}
+	by := fieldListNames(byList)
+
+	groups := groupEvents(rs.Rows, by)
+	if len(by) == 0 && len(groups) == 0 {
+		groups = []*eventGroup{{}} // stats without BY always returns one row
+	}
+	sortGroups(groups)
+
+	rows := make([]Event, 0, len(groups))
+	for _, g := range groups {
+		row := make(Event, len(by)+len(specs))
+		for i, f := range by {
+			row[f] = g.keys[i].Interface()
+		}
+		for _, spec := range specs {
+			v, err := x.aggregate(spec, g.events)
+			if err != nil {
+				return err
+			}
+			if !v.IsNull() {
+				row[spec.name] = v.Interface()
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	rs.Rows = rows
+	rs.Fields = append([]string(nil), by...)
+	for _, spec := range specs {
+		rs.Fields = append(rs.Fields, spec.name)
+	}
+	return nil
+}
+
+// runEventstats adds the aggregates of each event's group to the event
+func (x *Executor) runEventstats(cmd *EventstatsCommandContext, rs *ResultSet) error {
+	info, err := newStreamstatsInfo("eventstats", cmd.AllStatsOption(), cmd.AllStatsFunction(), cmd.FieldList())
+	if err != nil {
+		return err
+	}
+	specs, err := parseStatsSpecs(cmd.AllStatsFunction())
+	if err != nil {
+		return err
+	}
+	for _, g := range groupEvents(rs.Rows, info.GroupBy) {
+		results := make([]EvalValue, len(specs))
+		for i, spec := range specs {
+			if results[i], err = x.windowAggregate(spec, g.events, info.AllNum); err != nil {
+				return err
+			}
+		}
+		for _, idx := range g.rows {
+			out := rs.Rows[idx].Clone()
+			for i, spec := range specs {
+				if !results[i].IsNull() {
+					out[spec.name] = results[i].Interface()
+				}
+			}
+			rs.Rows[idx] = out
+		}
+	}
+	for _, spec := range specs {
+		rs.addField(spec.name)
+	}
+	return nil
+}
+
+// runStreamstats adds running aggregates: each event gets the aggregates of
+// the earlier events of its group, and itself unless current=f. window and
+// time_window limit how far back the aggregates reach (over all groups when
+// global=t); reset_before, reset_after and reset_on_change start over.
+func (x *Executor) runStreamstats(cmd *StreamstatsCommandContext, rs *ResultSet) error {
+	info, err := newStreamstatsInfo("streamstats", cmd.AllStatsOption(), cmd.AllStatsFunction(), cmd.FieldList())
+	if err != nil {
+		return err
+	}
+	specs, err := parseStatsSpecs(cmd.AllStatsFunction())
+	if err != nil {
+		return err
+	}
+	resetBefore, err := x.resetCondition(info.ResetBefore)
+	if err != nil {
+		return fmt.Errorf("streamstats reset_before: %w", err)
+	}
+	resetAfter, err := x.resetCondition(info.ResetAfter)
+	if err != nil {
+		return fmt.Errorf("streamstats reset_after: %w", err)
+	}
+	timeWindow, hasTimeWindow := info.TimeWindowDuration()
+
+	type seen struct {
+		key string
+		ev  Event
+	}
+	var history []seen // events since the last reset, oldest first
+	var lastKey string
+	hasLast := false
+	for idx, ev := range rs.Rows {
+		if reset, err := matchOptional(resetBefore, ev); err != nil {
+			return err
+		} else if reset {
+			history = nil
+		}
+		key, ok := groupKey(ev, info.GroupBy)
+		if !ok {
+			continue
+		}
+		if info.ResetOnChange && hasLast && key != lastKey {
+			history = nil
+		}
+		lastKey, hasLast = key, true
+
+		limit := info.Window
+		if limit > 0 && info.Current {
+			limit--
+		}
+		var window []Event
+		for i := len(history) - 1; i >= 0; i-- {
+			if info.Window > 0 && info.Global && len(history)-i > limit {
+				break
+			}
+			if info.Window > 0 && !info.Global && len(window) >= limit {
+				break
+			}
+			prev := history[i]
+			if prev.key != key {
+				continue
+			}
+			if hasTimeWindow && !withinTimeWindow(prev.ev, ev, timeWindow) {
+				continue
+			}
+			window = append(window, prev.ev)
+		}
+		for i, j := 0, len(window)-1; i < j; i, j = i+1, j-1 {
+			window[i], window[j] = window[j], window[i]
+		}
+		if info.Current {
+			window = append(window, ev)
+		}
+
+		out := ev.Clone()
+		for _, spec := range specs {
+			v, err := x.windowAggregate(spec, window, info.AllNum)
+			if err != nil {
+				return err
+			}
+			if !v.IsNull() {
+				out[spec.name] = v.Interface()
+			}
+		}
+		rs.Rows[idx] = out
+		history = append(history, seen{key: key, ev: ev})
+
+		if reset, err := matchOptional(resetAfter, out); err != nil {
+			return err
+		} else if reset {
+			history = nil
+		}
+	}
+	for _, spec := range specs {
+		rs.addField(spec.name)
+	}
+	return nil
+}
+
+// windowAggregate aggregates events; with allnum, a function over a field
+// gives no result unless every value of the field is numeric
+func (x *Executor) windowAggregate(spec statsSpec, events []Event, allNum bool) (EvalValue, error) {
+	if len(events) == 0 {
+		return nullVal(), nil
+	}
+	if allNum && spec.arg != nil {
+		vals, err := x.collectAggValues(spec, events)
+		if err != nil {
+			return nullVal(), err
+		}
+		for _, v := range vals {
+			if _, ok := v.v.Number(); !ok {
+				return nullVal(), nil
+			}
+		}
+	}
+	return x.aggregate(spec, events)
+}
+
+// resetCondition compiles a reset_before/reset_after eval expression
+func (x *Executor) resetCondition(text string) (func(Event) (bool, error), error) {
+	if text == "" {
+		return nil, nil
+	}
+	tree, err := parseSPLRule(text, func(p *SPLParser) antlr.ParserRuleContext { return p.Expression() })
+	if err != nil {
+		return nil, err
+	}
+	return func(ev Event) (bool, error) {
+		v, err := x.Evaluator.evalExpression(tree.(IExpressionContext), ev)
+		return v.Truthy(), err
+	}, nil
+}
+
+// withinTimeWindow reports whether prev is less than window away from ev.
+// Events without a _time are outside every time window.
+func withinTimeWindow(prev, ev Event, window time.Duration) bool {
+	pt, ok1 := eventTime(prev)
+	t, ok2 := eventTime(ev)
+	return ok1 && ok2 && math.Abs(t-pt) < window.Seconds()
+}
+
+// runTopRare counts value combinations of the fields (per BY group) and keeps
+// the most (top) or least (rare) common, with count and percent columns.
+// limit (0 keeps all), countfield, percentfield, showcount, showperc,
+// useother and otherstr work as in Splunk.
+func (x *Executor) runTopRare(command string, lists []IFieldListContext, num antlr.TerminalNode, opts map[string]string, rs *ResultSet) error {
+	top := command == "top"
+	fields := fieldListNames(lists[0])
+	var by []string
+	if len(lists) > 1 {
+		by = fieldListNames(lists[1])
+	}
+	limit := countArg(num, 10)
+	if v, ok := opts["limit"]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return fmt.Errorf("%s limit must be a non-negative integer, got %q", command, v)
+		}
+		limit = n
+	}
+	countField, percentField := "count", "percent"
+	if v, ok := opts["countfield"]; ok {
+		countField = v
+	}
+	if v, ok := opts["percentfield"]; ok {
+		percentField = v
+	}
+	showCount, showPercent := true, true
+	if v, ok := opts["showcount"]; ok {
+		showCount = isTrueOption(v)
+	}
+	if v, ok := opts["showperc"]; ok {
+		showPercent = isTrueOption(v)
+	}
+	otherStr := "OTHER"
+	if v, ok := opts["otherstr"]; ok {
+		otherStr = v
+	}
+	useOther := isTrueOption(opts["useother"])
+
+	groups := groupEvents(rs.Rows, by)
+	sortGroups(groups)
+
+	var rows []Event
+	for _, g := range groups {
+		type tally struct {
+			values []EvalValue
+			count  int
+		}
+		var order []*tally
+		index := make(map[string]*tally)
+		total := 0
+		for _, sub := range groupEvents(g.events, fields) {
+			id, _ := groupKey(sub.events[0], fields)
+			t, ok := index[id]
+			if !ok {
+				t = &tally{values: sub.keys}
+				index[id] = t
+				order = append(order, t)
+			}
+			t.count += len(sub.events)
+			total += len(sub.events)
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			if top {
+				return order[i].count > order[j].count
+			}
+			return order[i].count < order[j].count
+		})
+		if limit > 0 && len(order) > limit {
+			if useOther {
+				other := &tally{values: make([]EvalValue, len(fields))}
+				for i := range fields {
+					other.values[i] = strVal(otherStr)
+				}
+				for _, t := range order[limit:] {
+					other.count += t.count
+				}
+				order = append(order[:limit], other)
+			} else {
+				order = order[:limit]
+			}
+		}
+		for _, t := range order {
+			row := make(Event, len(by)+len(fields)+2)
+			for i, f := range by {
+				row[f] = g.keys[i].Interface()
+			}
+			for i, f := range fields {
+				row[f] = t.values[i].Interface()
+			}
+			if showCount {
+				row[countField] = float64(t.count)
+			}
+			if showPercent {
+				row[percentField] = math.Round(float64(t.count)/float64(total)*100*1e6) / 1e6
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	rs.Rows = rows
+	rs.Fields = append(append([]string(nil), by...), fields...)
+	if showCount {
+		rs.Fields = append(rs.Fields, countField)
+	}
+	if showPercent {
+		rs.Fields = append(rs.Fields, percentField)
+	}
+	return nil
+}
+
+// transaction groups events by field values into single merged events
+type transaction struct {
+	events []Event
+	start  float64
+	last   float64
+	closed bool
+}
+
+// runTransaction groups events that share the transaction fields, in time
+// order, honoring maxspan, maxpause, maxevents, startswith and endswith.
+// Each transaction becomes one event with multivalue fields, duration and
+// eventcount; results are returned most recent first, as Splunk does.
+func (x *Executor) runTransaction(cmd *TransactionCommandContext, rs *ResultSet) error {
+	info, err := newTransactionInfo(cmd)
+	if err != nil {
+		return err
+	}
+	fields := info.Fields
+	maxSpan, maxPause, maxEvents := info.MaxSpan.Seconds(), info.MaxPause.Seconds(), info.MaxEvents
+	startsWith, endsWith := x.boundaryMatcher(info.StartsWith), x.boundaryMatcher(info.EndsWith)
+	keepEvicted := info.KeepEvicted
+
+	events := append([]Event(nil), rs.Rows...)
+	sort.SliceStable(events, func(i, j int) bool {
+		ti, _ := eventTime(events[i])
+		tj, _ := eventTime(events[j])
+		return ti < tj
+	})
+
+	open := make(map[string]*transaction)
+	var done []*transaction
+	finish := func(key string, closed bool) {
+		txn := open[key]
+		txn.closed = closed
+		if closed || keepEvicted {
+			done = append(done, txn)
+		}
+		delete(open, key)
+	}
+
+	for _, ev := range events {
+		key, ok := groupKey(ev, fields)
+		if !ok {
+			continue
+		}
+		t, _ := eventTime(ev)
+		starts, err := matchOptional(startsWith, ev)
+		if err != nil {
+			return err
+		}
+		if txn := open[key]; txn != nil {
+			if (maxSpan > 0 && t-txn.start > maxSpan) || (maxPause > 0 && t-txn.last > maxPause) ||
+				len(txn.events) >= maxEvents || (startsWith != nil && starts) {
+				finish(key, endsWith == nil)
+			}
+		}
+		txn := open[key]
+		if txn == nil {
+			if startsWith != nil && !starts {
+				continue
+			}
+			txn = &transaction{start: t}
+			open[key] = txn
+		}
+		txn.events = append(txn.events, ev)
+		txn.last = t
+
+		if endsWith != nil {
+			ends, err := endsWith(ev)
+			if err != nil {
+				return err
+			}
+			if ends {
+				finish(key, true)
+			}
+		}
+	}
+	for key := range open {
+		finish(key, endsWith == nil)
+	}
+
+	rows := make([]Event, 0, len(done))
+	for _, txn := range done {
+		row := mergeTransaction(txn)
+		if keepEvicted {
+			row["closed_txn"] = boolToNumber(txn.closed)
+		}
+		rows = append(rows, row)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		ti, _ := eventTime(rows[i])
+		tj, _ := eventTime(rows[j])
+		return ti > tj
+	})
+	rs.Rows = rows
+	rs.addField("duration")
+	rs.addField("eventcount")
+	return nil
+}
+
+// boundaryMatcher returns a matcher for a startswith/endswith boundary, or
+// nil when there is none
+func (x *Executor) boundaryMatcher(b *TransactionBoundary) func(Event) (bool, error) {
+	switch {
+	case b == nil:
+		return nil
+	case b.eval != nil:
+		return func(ev Event) (bool, error) {
+			v, err := x.Evaluator.evalExpression(b.eval, ev)
+			return v.Truthy(), err
+		}
+	}
+	return func(ev Event) (bool, error) {
+		return x.matchSearch(b.search, ev)
+	}
+}
+
+func matchOptional(match func(Event) (bool, error), ev Event) (bool, error) {
+	if match == nil {
+		return false, nil
+	}
+	return match(ev)
+}
+
+// mergeTransaction combines the events of a transaction: each field holds
+// the distinct values in order, _raw the joined raw text, _time the start.
+func mergeTransaction(txn *transaction) Event {
+	merged := make(Event)
+	var raws []string
+	for _, ev := range txn.events {
+		for k, v := range ev {
+			if k == "_time" {
+				continue
+			}
+			if k == "_raw" {
+				raws = append(raws, EvalValueOf(v).String())
+				continue
+			}
+			for _, item := range EvalValueOf(v).Values() {
+				existing := EvalValueOf(merged[k]).Values()
+				dup := false
+				for _, e := range existing {
+					if e.String() == item.String() {
+						dup = true
+						break
+					}
+				}
+				if !dup {
+					merged[k] = mvVal(append(append([]EvalValue(nil), existing...), item)).Interface()
+				}
+			}
+		}
+	}
+	if len(raws) > 0 {
+		merged["_raw"] = strings.Join(raws, "\n")
+	}
+	if _, ok := eventTime(txn.events[0]); ok {
+		merged["_time"] = txn.start
+	}
+	merged["duration"] = txn.last - txn.start
+	merged["eventcount"] = float64(len(txn.events))
+	return merged
+}
+
+func boolToNumber(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,515 @@
+package spl
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// renderRows formats the named fields of each row as "a=1 b=x" for comparison;
+// multivalue fields are joined with "|" and missing fields render as "-".
+func renderRows(rows []Event, fields ...string) []string {
+	out := make([]string, len(rows))
+	for i, row := range rows {
+		parts := make([]string, len(fields))
+		for j, f := range fields {
+			v := EvalValueOf(row[f])
+			s := "-"
+			if !v.IsNull() {
+				var vals []string
+				for _, item := range v.Values() {
+					vals = append(vals, item.String())
+				}
+				s = strings.Join(vals, "|")
+			}
+			parts[j] = f + "=" + s
+		}
+		out[i] = strings.Join(parts, " ")
+	}
+	return out
+}
+
+func authEvents() []Event {
+	return []Event{
+		{"_time": 1000.0, "user": "alice", "action": "failure", "src": "10.0.0.1", "bytes": "100", "_raw": "login failure user=alice"},
+		{"_time": 1010.0, "user": "alice", "action": "failure", "src": "10.0.0.1", "bytes": "300", "_raw": "login failure user=alice"},
+		{"_time": 1020.0, "user": "bob", "action": "success", "src": "10.0.0.2", "bytes": "50", "_raw": "login success user=bob"},
+		{"_time": 1030.0, "user": "alice", "action": "success", "src": "10.0.0.3", "bytes": "200", "_raw": "login success user=alice"},
+		{"_time": 1040.0, "user": "carol", "action": "failure", "src": "10.0.0.4", "_raw": "login failure user=carol"},
+	}
+}
+
+func TestExecutor_Pipelines(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		fields []string
+		want   []string
+	}{
+		{
+			name:   "search field and bare word",
+			query:  `search action=fail* alice`,
+			fields: []string{"_time"},
+			want:   []string{"_time=1000", "_time=1010"},
+		},
+		{
+			name:   "search OR binds tighter than AND",
+			query:  `user=bob OR user=carol action=failure`,
+			fields: []string{"user"},
+			want:   []string{"user=carol"},
+		},
+		{
+			name:   "search NOT and IN",
+			query:  `NOT user IN (alice, bob)`,
+			fields: []string{"user"},
+			want:   []string{"user=carol"},
+		},
+		{
+			name:   "search scope metadata missing from events passes",
+			query:  `index=auth sourcetype=linux_secure user=bob`,
+			fields: []string{"user"},
+			want:   []string{"user=bob"},
+		},
+		{
+			name:   "search numeric comparison",
+			query:  `bytes>=200`,
+			fields: []string{"bytes"},
+			want:   []string{"bytes=300", "bytes=200"},
+		},
+		{
+			name:   "where and eval",
+			query:  `search user=alice | eval kb=bytes/100 | where kb > 1`,
+			fields: []string{"kb"},
+			want:   []string{"kb=3", "kb=2"},
+		},
+		{
+			name:   "search a CIDR network",
+			query:  `search src=10.0.0.0/31 OR src!=10.0.0.0/8 | stats count by src`,
+			fields: []string{"src", "count"},
+			want:   []string{"src=10.0.0.1 count=2"},
+		},
+		{
+			name:   "stats by",
+			query:  `stats count dc(src) AS sources sum(bytes) AS total by user`,
+			fields: []string{"user", "count", "sources", "total"},
+			want: []string{
+				"user=alice count=3 sources=2 total=600",
+				"user=bob count=1 sources=1 total=50",
+				"user=carol count=1 sources=1 total=-",
+			},
+		},
+		{
+			name:   "stats without by on no events",
+			query:  `search user=nobody | stats count`,
+			fields: []string{"count"},
+			want:   []string{"count=0"},
+		},
+		{
+			name:   "stats aggregates",
+			query:  `stats avg(bytes) AS avg min(bytes) AS min max(bytes) AS max median(bytes) AS med values(action) AS actions earliest(user) AS first_user latest(user) AS last_user`,
+			fields: []string{"avg", "min", "max", "med", "actions", "first_user", "last_user"},
+			want:   []string{"avg=162.5 min=50 max=300 med=150 actions=failure|success first_user=alice last_user=carol"},
+		},
+		{
+			name:   "stats count eval",
+			query:  `stats count(eval(action="failure")) AS failures`,
+			fields: []string{"failures"},
+			want:   []string{"failures=3"},
+		},
+		{
+			name:   "default aggregate names",
+			query:  `stats count(user) max(bytes)`,
+			fields: []string{"count(user)", "max(bytes)"},
+			want:   []string{"count(user)=5 max(bytes)=300"},
+		},
+		{
+			name:   "eventstats",
+			query:  `eventstats count AS n by user | where n > 1 | table user n`,
+			fields: []string{"user", "n"},
+			want:   []string{"user=alice n=3", "user=alice n=3", "user=alice n=3"},
+		},
+		{
+			name:   "streamstats",
+			query:  `streamstats count AS seq by user | search user=alice`,
+			fields: []string{"seq"},
+			want:   []string{"seq=1", "seq=2", "seq=3"},
+		},
+		{
+			name:   "streamstats window",
+			query:  `streamstats window=2 sum(bytes) AS s`,
+			fields: []string{"s"},
+			want:   []string{"s=100", "s=400", "s=350", "s=250", "s=200"},
+		},
+		{
+			name:   "streamstats current=f",
+			query:  `streamstats current=f count AS prior by user`,
+			fields: []string{"user", "prior"},
+			want:   []string{"user=alice prior=-", "user=alice prior=1", "user=bob prior=-", "user=alice prior=2", "user=carol prior=-"},
+		},
+		{
+			name:   "streamstats global window",
+			query:  `streamstats window=2 count AS n by user | search user=alice`,
+			fields: []string{"n"},
+			want:   []string{"n=1", "n=2", "n=1"},
+		},
+		{
+			name:   "streamstats per-group window",
+			query:  `streamstats window=2 global=f count AS n by user | search user=alice`,
+			fields: []string{"n"},
+			want:   []string{"n=1", "n=2", "n=2"},
+		},
+		{
+			name:   "streamstats time_window",
+			query:  `streamstats time_window=15s count AS n by user`,
+			fields: []string{"user", "n"},
+			want:   []string{"user=alice n=1", "user=alice n=2", "user=bob n=1", "user=alice n=1", "user=carol n=1"},
+		},
+		{
+			name:   "streamstats reset_on_change",
+			query:  `streamstats reset_on_change=t count AS n by action`,
+			fields: []string{"action", "n"},
+			want:   []string{"action=failure n=1", "action=failure n=2", "action=success n=1", "action=success n=2", "action=failure n=1"},
+		},
+		{
+			name:   "streamstats reset_after",
+			query:  `streamstats reset_after="(n>=2)" count AS n`,
+			fields: []string{"n"},
+			want:   []string{"n=1", "n=2", "n=1", "n=2", "n=1"},
+		},
+		{
+			name:   "streamstats reset_before",
+			query:  `streamstats reset_before="(action=\"success\")" count AS n`,
+			fields: []string{"n"},
+			want:   []string{"n=1", "n=2", "n=1", "n=1", "n=2"},
+		},
+		{
+			name:   "eventstats allnum",
+			query:  `eventstats allnum=t max(bytes) AS mb max(src) AS ms | head 1`,
+			fields: []string{"mb", "ms"},
+			want:   []string{"mb=300 ms=-"},
+		},
+		{
+			name:   "top",
+			query:  `top 1 user`,
+			fields: []string{"user", "count", "percent"},
+			want:   []string{"user=alice count=3 percent=60"},
+		},
+		{
+			name:   "rare limit",
+			query:  `rare limit=1 user`,
+			fields: []string{"user", "count", "percent"},
+			want:   []string{"user=bob count=1 percent=20"},
+		},
+		{
+			name:   "top options",
+			query:  `top limit=1 countfield=events showperc=f useother=t user`,
+			fields: []string{"user", "events", "percent"},
+			want:   []string{"user=alice events=3 percent=-", "user=OTHER events=2 percent=-"},
+		},
+		{
+			name:   "rare by",
+			query:  `rare user by action`,
+			fields: []string{"action", "user", "count"},
+			want: []string{
+				"action=failure user=carol count=1",
+				"action=failure user=alice count=2",
+				"action=success user=bob count=1",
+				"action=success user=alice count=1",
+			},
+		},
+		{
+			name:   "dedup and sort",
+			query:  `dedup user | sort - user`,
+			fields: []string{"user", "_time"},
+			want:   []string{"user=carol _time=1040", "user=bob _time=1020", "user=alice _time=1000"},
+		},
+		{
+			name:   "sort numeric with missing values last",
+			query:  `sort bytes`,
+			fields: []string{"bytes"},
+			want:   []string{"bytes=50", "bytes=100", "bytes=200", "bytes=300", "bytes=-"},
+		},
+		{
+			name:   "head and tail",
+			query:  `head 3 | tail 2`,
+			fields: []string{"_time"},
+			want:   []string{"_time=1020", "_time=1010"},
+		},
+		{
+			name:   "rename and fields",
+			query:  `rename user AS account | fields account | head 1`,
+			fields: []string{"account", "user", "_time"},
+			want:   []string{"account=alice user=- _time=1000"},
+		},
+		{
+			name:   "rename without commas and sort by a quoted field",
+			query:  `rename user AS account bytes AS "byte count" | sort - "byte count" | head 1`,
+			fields: []string{"account", "byte count"},
+			want:   []string{"account=alice byte count=300"},
+		},
+		{
+			name:   "sort by a single-quoted field",
+			query:  `rename bytes AS "byte count" | sort - 'byte count' | head 1`,
+			fields: []string{"user", "byte count"},
+			want:   []string{"user=alice byte count=300"},
+		},
+		{
+			name:   "rex",
+			query:  `rex "login (?<outcome>\w+)" | stats count by outcome`,
+			fields: []string{"outcome", "count"},
+			want:   []string{"outcome=failure count=3", "outcome=success count=2"},
+		},
+		{
+			name:   "rex mode=sed",
+			query:  `rex field=src mode=sed "s/\.\d+$/.x/" | rex field=user mode=sed "y/abc/ABC/" | dedup src | table user src`,
+			fields: []string{"user", "src"},
+			want:   []string{"user=AliCe src=10.0.0.x"},
+		},
+		{
+			name:   "regex command",
+			query:  `regex user!="^a" | regex "success|carol" | table user`,
+			fields: []string{"user"},
+			want:   []string{"user=bob", "user=carol"},
+		},
+		{
+			name:   "subsearch fields",
+			query:  `action=success [search action=failure | fields user] | table _time user`,
+			fields: []string{"_time", "user"},
+			want:   []string{"_time=1030 user=alice"},
+		},
+		{
+			name:   "subsearch return",
+			query:  `[search action=success | return 2 src] | table user`,
+			fields: []string{"user"},
+			want:   []string{"user=bob", "user=alice"},
+		},
+		{
+			name:   "subsearch without results",
+			query:  `NOT [search user=dave | fields user] | stats count`,
+			fields: []string{"count"},
+			want:   []string{"count=5"},
+		},
+		{
+			name:   "format",
+			query:  `action=failure | dedup user | table user src | format maxresults=1`,
+			fields: []string{"search"},
+			want:   []string{`search=( ( src="10.0.0.1" AND user="alice" ) )`},
+		},
+		{
+			name:   "foreach",
+			query:  `stats count by user | eval n=count | foreach count n [eval <<FIELD>>=<<FIELD>>*10] | table user count n`,
+			fields: []string{"user", "count", "n"},
+			want:   []string{"user=alice count=30 n=30", "user=bob count=10 n=10", "user=carol count=10 n=10"},
+		},
+		{
+			name:   "map",
+			query:  `dedup user | table user | map maxsearches=2 search="search user=$user$ action=success" | table _time user`,
+			fields: []string{"_time", "user"},
+			want:   []string{"_time=1030 user=alice", "_time=1020 user=bob"},
+		},
+		{
+			name:   "bin time",
+			query:  `bin _time span=30s | stats count by _time`,
+			fields: []string{"_time", "count"},
+			want:   []string{"_time=990 count=2", "_time=1020 count=3"},
+		},
+		{
+			name:   "bin options before the field and AS",
+			query:  `bin span=30s _time AS slot | stats count by slot`,
+			fields: []string{"slot", "count"},
+			want:   []string{"slot=990 count=2", "slot=1020 count=3"},
+		},
+		{
+			name:   "fillnull",
+			query:  `fillnull value=NA bytes | search user=carol`,
+			fields: []string{"bytes"},
+			want:   []string{"bytes=NA"},
+		},
+		{
+			name:   "makemv and mvexpand",
+			query:  `head 1 | eval hosts="a,b,c" | makemv delim="," hosts | mvexpand hosts`,
+			fields: []string{"hosts"},
+			want:   []string{"hosts=a", "hosts=b", "hosts=c"},
+		},
+		{
+			name:   "transaction",
+			query:  `transaction user maxpause=15s`,
+			fields: []string{"user", "eventcount", "duration", "action"},
+			want: []string{
+				"user=carol eventcount=1 duration=0 action=failure",
+				"user=alice eventcount=1 duration=0 action=success",
+				"user=bob eventcount=1 duration=0 action=success",
+				"user=alice eventcount=2 duration=10 action=failure",
+			},
+		},
+		{
+			name:   "transaction startswith restarts on each start event",
+			query:  `transaction user startswith="action=failure" endswith="action=success"`,
+			fields: []string{"user", "eventcount", "action"},
+			want:   []string{"user=alice eventcount=2 action=failure|success"},
+		},
+		{
+			name:   "transaction parenthesized and eval boundaries",
+			query:  `transaction user startswith=(action=failure src="10.0.0.1") endswith=eval(action == "success") maxspan=1m`,
+			fields: []string{"user", "eventcount", "action"},
+			want:   []string{"user=alice eventcount=2 action=failure|success"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := NewExecutor()
+			x.Evaluator = newTestEvaluator()
+			rs, err := x.Run(tt.query, authEvents())
+			if err != nil {
+				t.Fatalf("Run(%s) error: %v", tt.query, err)
+			}
+			got := renderRows(rs.Rows, tt.fields...)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Run(%s)\n got: %q\nwant: %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecutor_Lookups(t *testing.T) {
+	x := NewExecutor()
+	csv := "username,department,risk\nalice,finance,high\nbob,it,low\n"
+	if err := x.AddLookupCSV("users.csv", strings.NewReader(csv)); err != nil {
+		t.Fatalf("AddLookupCSV error: %v", err)
+	}
+
+	rs, err := x.Run(`eval username=user | lookup users username OUTPUT department | table user department`, authEvents())
+	if err != nil {
+		t.Fatalf("lookup error: %v", err)
+	}
+	got := renderRows(rs.Rows, "user", "department")
+	want := []string{
+		"user=alice department=finance", "user=alice department=finance", "user=bob department=it",
+		"user=alice department=finance", "user=carol department=-",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("lookup\n got: %q\nwant: %q", got, want)
+	}
+	if fmt.Sprint(rs.Fields) != "[user department]" {
+		t.Errorf("lookup fields = %v", rs.Fields)
+	}
+
+	rs, err = x.Run(`inputlookup users.csv where risk="high"`, nil)
+	if err != nil {
+		t.Fatalf("inputlookup error: %v", err)
+	}
+	if got := renderRows(rs.Rows, "username"); fmt.Sprint(got) != "[username=alice]" {
+		t.Errorf("inputlookup = %q", got)
+	}
+}
+
+func TestExecutor_LookupFieldMappings(t *testing.T) {
+	x := NewExecutor()
+	csv := "username,department,risk\nalice,finance,high\nbob,it,low\n"
+	if err := x.AddLookupCSV("users.csv", strings.NewReader(csv)); err != nil {
+		t.Fatalf("AddLookupCSV error: %v", err)
+	}
+
+	tests := []struct {
+		query  string
+		fields []string
+		want   []string
+	}{
+		{
+			query:  `lookup users username AS user OUTPUT department | table user department`,
+			fields: []string{"user", "department"},
+			want: []string{
+				"user=alice department=finance", "user=alice department=finance", "user=bob department=it",
+				"user=alice department=finance", "user=carol department=-",
+			},
+		},
+		{
+			query:  `dedup user | lookup users username AS user OUTPUT department AS dept, risk | table user dept risk`,
+			fields: []string{"user", "dept", "risk"},
+			want:   []string{"user=alice dept=finance risk=high", "user=bob dept=it risk=low", "user=carol dept=- risk=-"},
+		},
+		{
+			query:  `eval department="sales" | lookup users username AS user OUTPUTNEW department | dedup user | table user department`,
+			fields: []string{"user", "department"},
+			want:   []string{"user=alice department=sales", "user=bob department=sales", "user=carol department=sales"},
+		},
+	}
+	for _, tc := range tests {
+		rs, err := x.Run(tc.query, authEvents())
+		if err != nil {
+			t.Fatalf("Run(%s): %v", tc.query, err)
+		}
+		if got := renderRows(rs.Rows, tc.fields...); fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("Run(%s)\n got: %q\nwant: %q", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestExecutor_TimeBounds(t *testing.T) {
+	x := NewExecutor()
+	x.Evaluator = newTestEvaluator()
+	now := timeToEpoch(x.Evaluator.now())
+	events := []Event{
+		{"_time": now - 7200, "n": "old"},
+		{"_time": now - 600, "n": "recent"},
+	}
+	rs, err := x.Run(`search earliest=-1h latest=now`, events)
+	if err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+	if got := renderRows(rs.Rows, "n"); fmt.Sprint(got) != "[n=recent]" {
+		t.Errorf("earliest=-1h kept %q", got)
+	}
+}
+
+func TestExecutor_Errors(t *testing.T) {
+	tests := []string{
+		`search foo | stats bogus(x)`,
+		`search foo | stats sum`,
+		`search foo | join user [search bar]`,
+		`search * [search bar | format "(" "("]`,
+		`search foo | lookup missing_table user`,
+		`search foo | table user*`,
+		`search foo | top limit=x user`,
+		`search foo | rex field=user mode=sed "s/a/b/x"`,
+		`search foo | streamstats window=x count`,
+		`search foo | eventstats window=5 count`,
+		`search foo | transaction user maxspan=1mon`,
+		`search foo | transaction user startswith="action=(" `,
+	}
+	x := NewExecutor()
+	for _, query := range tests {
+		if _, err := x.Run(query, authEvents()); err == nil {
+			t.Errorf("Run(%s) expected error", query)
+		}
+	}
+}
+
+func TestParseJSONEvents(t *testing.T) {
+	data := []byte(`{"_time":"2024-03-14T15:09:26Z","user":{"name":"alice"},"tags":["a","b"],"n":3}
+{"user":{"name":"bob"},"items":[{"id":1},{"id":2}]}`)
+	events, err := ParseJSONEvents(data)
+	if err != nil {
+		t.Fatalf("ParseJSONEvents error: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	got := renderRows(events, "_time", "user.name", "tags{}", "items{}.id", "n")
+	want := []string{
+		"_time=1710428966 user.name=alice tags{}=a|b items{}.id=- n=3",
+		"_time=- user.name=bob tags{}=- items{}.id=1|2 n=-",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ParseJSONEvents\n got: %q\nwant: %q", got, want)
+	}
+	if raw, _ := events[1]["_raw"].(string); !strings.HasPrefix(raw, `{"user"`) {
+		t.Errorf("_raw = %q, want original JSON", raw)
+	}
+
+	arr, err := ParseJSONEvents([]byte(`[{"a":1},{"a":2}]`))
+	if err != nil || len(arr) != 2 {
+		t.Errorf("JSON array: %d events, err %v", len(arr), err)
+	}
+}
@@ -2,6 +2,8 @@ package spl
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,15 +16,27 @@ var MaxParseTime = 5 * time.Second
 
 // Condition represents a field condition extracted from an SPL query
 type Condition struct {
-	Field        string   `json:"field"`
-	Operator     string   `json:"operator"`
-	Value        string   `json:"value"`
-	Negated      bool     `json:"negated"`
-	PipeStage    int      `json:"pipe_stage"`
-	LogicalOp    string   `json:"logical_op"`              // "AND" or "OR" connecting to previous condition
-	Alternatives []string `json:"alternatives,omitempty"`  // For OR conditions on same field
-	IsComputed   bool     `json:"is_computed,omitempty"`   // True if field was created by eval/rex
-	SourceField  string   `json:"source_field,omitempty"`  // Original field before transformation (for computed fields)
+	Field           string           `json:"field"`
+	Operator        string           `json:"operator"`
+	Value           string           `json:"value"`                      // Quotes removed, escapes as written
+	RawValue        string           `json:"raw_value,omitempty"`        // Value token as written, with its quotes
+	Unescaped       string           `json:"unescaped,omitempty"`        // Value as matched: unquoted, escapes decoded (see UnescapeValue)
+	Quoted          bool             `json:"quoted,omitempty"`           // The value was quoted
+	ValueKind       ValueKind        `json:"value_kind,omitempty"`       // Type of the value; empty when compared with a field or expression
+	Term            bool             `json:"term,omitempty"`             // TERM(value): matches the exact indexed term, bounded by major breakers
+	CaseSensitive   bool             `json:"case_sensitive,omitempty"`   // CASE(value): matches case-sensitively
+	IndexedField    bool             `json:"indexed_field,omitempty"`    // field::value: matches an indexed field, not a search-time extraction
+	Negated         bool             `json:"negated"`
+	PipeStage       int              `json:"pipe_stage"`
+	LogicalOp       string           `json:"logical_op"`                 // "AND" or "OR" connecting to previous condition
+	Alternatives    []string         `json:"alternatives,omitempty"`     // For OR conditions on same field
+	IsComputed      bool             `json:"is_computed,omitempty"`      // True if field was created by eval/rex
+	SourceField     string           `json:"source_field,omitempty"`     // Original field before transformation (for computed fields)
+	Comparison      ComparisonKind   `json:"comparison,omitempty"`       // What Field is compared with; empty for a literal Value
+	ValueField      string           `json:"value_field,omitempty"`      // Right-hand field (where src_ip=dest_ip or user='other_field')
+	ValueExpression string           `json:"value_expression,omitempty"` // Right-hand expression (where bytes_out > bytes_in*10)
+	FieldExpression string           `json:"field_expression,omitempty"` // Left-hand expression, when not a field; Field is its first field
+	Subsearch       *SubsearchFilter `json:"subsearch,omitempty"`        // Set when the condition is generated by a subsearch (operator "subsearch")
 }
 
 // ParseResult contains all conditions extracted from the query
@@ -33,6 +47,19 @@ type ParseResult struct {
 	FieldAliases   map[string]string `json:"field_aliases,omitempty"`   // Map of new name -> original name (from rename)
 	Commands       []string          `json:"commands,omitempty"`         // List of commands used in the query (stats, eventstats, etc.)
 	Joins          []JoinInfo        `json:"joins,omitempty"`            // Extracted join/append info
+	Lookups        []LookupInfo      `json:"lookups,omitempty"`          // Extracted lookup command info
+	DataModels     []DataModelReference `json:"data_models,omitempty"`   // tstats datamodel references (see DataModelCatalog.Resolve)
+	SedOperations  []SedOperation    `json:"sed_operations,omitempty"`   // rex mode=sed substitutions and transliterations
+	Regexes        []RegexUsage      `json:"regexes,omitempty"`          // Regexes in rex, regex, match() and replace(), with analysis
+	Templates      []TemplateSearch  `json:"templates,omitempty"`        // foreach/map templated searches with their expansions
+	Streamstats    []StreamstatsInfo `json:"streamstats,omitempty"`      // streamstats/eventstats aggregates and window options
+	Aggregations   []Aggregation     `json:"aggregations,omitempty"`     // stats-family stages with their functions, BY fields and options
+	Thresholds     []Threshold       `json:"thresholds,omitempty"`       // Post-aggregation comparisons linked to their aggregates
+	Transactions   []TransactionInfo `json:"transactions,omitempty"`     // transaction commands with their boundaries and spans
+	Spaths         []SpathInfo       `json:"spaths,omitempty"`           // spath commands with their parsed paths
+	SideEffects    []SideEffect      `json:"side_effects,omitempty"`     // Stages that write, send, delete or execute
+	TimeRange      *TimeRange        `json:"time_range,omitempty"`       // Search window and time spans (nil if none)
+	FieldOrigins   map[string]FieldOrigin `json:"field_origins,omitempty"` // Search-time field origins from props.conf (see FieldKnowledge.Annotate)
 	Errors         []string          `json:"errors,omitempty"`
 }
 
@@ -53,8 +80,48 @@ type JoinInfo struct {
 	Options        map[string]string `json:"options,omitempty"`         // All joinOption key=value pairs
 	Subsearch      *ParseResult      `json:"subsearch"`                 // Recursively parsed subsearch
 	PipeStage      int               `json:"pipe_stage"`                // Pipeline stage where join appears
-	IsAppend       bool              `json:"is_append,omitempty"`       // True if this is an APPEND, not JOIN
+	IsAppend       bool              `json:"is_append,omitempty"`       // True for commands that add results (append, appendcols, appendpipe, union, multisearch), not JOIN
 	ExposedFields  []string          `json:"exposed_fields,omitempty"`  // Fields the subsearch makes available
+	Branches       []*ParseResult    `json:"branches,omitempty"`        // union/multisearch: one parsed search per [subsearch] dataset, Subsearch stays nil
+	Datasets       []string          `json:"datasets,omitempty"`        // union: named datasets (saved searches, data models, ...)
+}
+
+// SubsearchFilter describes the search a subsearch expands to in the outer
+// search. A subsearch ending in fields, table or format emits its
+// result rows as ((f1="v1" f2="v2") OR (...)), so the outer search filters on
+// the returned fields even though their values are only known at run time.
+type SubsearchFilter struct {
+	Subsearch *ParseResult    `json:"subsearch"`  // Recursively parsed subsearch
+	Fields    []string        `json:"fields"`     // Fields the generated search filters on (_raw for return $field)
+	Command   string          `json:"command"`    // Command that shapes the output: fields, table, return or format
+	Format    SubsearchFormat `json:"format"`     // Shape of the emitted search string (see SubsearchFormat.Render)
+	PipeStage int             `json:"pipe_stage"` // Outer pipeline stage containing the subsearch
+}
+
+// LookupField maps a lookup table column to the event field it is matched
+// against (inputs) or written to (outputs). Without AS both names are the same.
+type LookupField struct {
+	TableField string `json:"table_field"`
+	EventField string `json:"event_field"`
+}
+
+// LookupInfo captures the structured decomposition of a LOOKUP command
+type LookupInfo struct {
+	Table     string            `json:"table"`                // Lookup table or definition name
+	Inputs    []LookupField     `json:"inputs"`               // Match fields
+	Outputs   []LookupField     `json:"outputs,omitempty"`    // OUTPUT/OUTPUTNEW fields (empty means all table columns)
+	OutputNew bool              `json:"output_new,omitempty"` // True for OUTPUTNEW: existing event values are kept
+	Options   map[string]string `json:"options,omitempty"`    // local=, update=, etc.
+	PipeStage int               `json:"pipe_stage"`           // Pipeline stage where lookup appears
+}
+
+// DataModelReference is a tstats "from datamodel=<model>[.<object>]" clause
+// together with the node-prefixed fields the command uses
+type DataModelReference struct {
+	Model     string   `json:"model"`            // Data model name, e.g. Endpoint
+	Object    string   `json:"object,omitempty"` // Object (node) name, e.g. Processes; empty for the whole model
+	Fields    []string `json:"fields,omitempty"` // Fields used in functions, WHERE and BY, as written
+	PipeStage int      `json:"pipe_stage"`       // Pipeline stage of the tstats command
 }
 
 // SearchScopeMetadata are fields that define WHERE to search, not WHAT to match
@@ -85,7 +152,8 @@ var splCommandKeywords = map[string]bool{
 // Note: This excludes time-range modifiers but NOT index/sourcetype/source which provide
 // useful context for rules. For test data generation filtering, use IsSearchScopeMetadata.
 func isExcludedField(fieldLower string) bool {
-	return fieldLower == "earliest" || fieldLower == "latest" || fieldLower == "splunk_server" ||
+	return fieldLower == "earliest" || fieldLower == "latest" ||
+		fieldLower == "_index_earliest" || fieldLower == "_index_latest" || fieldLower == "splunk_server" ||
 		splCommandKeywords[fieldLower]
 }
 
@@ -110,10 +178,28 @@ type conditionExtractor struct {
 	fieldAliases    map[string]string // Rename mappings: new name -> original name
 	commands        []string          // Commands used in the query (stats, eventstats, etc.)
 	joins           []JoinInfo        // Extracted join info
+	lookups         []LookupInfo      // Extracted lookup info
+	dataModels      []DataModelReference // tstats datamodel references
+	sedOperations   []SedOperation       // rex mode=sed expressions
+	regexes         []RegexUsage         // Embedded regexes with their analysis
+	templates       []TemplateSearch     // foreach/map templated searches
+	streamstats     []StreamstatsInfo    // streamstats/eventstats commands
+	aggregations    []Aggregation        // stats-family stages
+	aggStages       []aggregationStage   // Top-level stages with aggregating commands
+	thresholds      []Threshold          // Post-aggregation comparisons
+	transactions    []TransactionInfo    // transaction commands
+	spaths          []SpathInfo          // spath commands
+	spathInput      string               // Input of the last spath without a path, whose leaves are all extracted
+	sideEffects     []SideEffect         // Commands with side effects
+	stageStarts     map[int]bool         // Token indexes of the parsed stages' first tokens
+	inCommandArgs   bool                 // Current stage is a command parsed as search terms (outputlookup, collect)
+	outerStage      int                  // Top-level stage holding the current subsearch
+	timeRange       *TimeRange        // earliest/latest bounds and time spans
 	currentStage    int
 	inSubsearch     int // depth of subsearch nesting
 	inFunctionCall  int // depth of function call nesting (eval, count, etc.)
 	inStatsFunction int // depth of stats function nesting (count(), sum(), etc.)
+	inBoundary      int // depth of transaction startswith/endswith expressions
 	negated         bool
 	lastLogicalOp   string
 	errors          []string
@@ -181,6 +267,10 @@ func extractConditionsInternal(query string) (result *ParseResult) {
 
 	// Parse the query
 	tree := parser.Query()
+	// query has no EOF, so input the parser could not continue with is left over
+	if stream.LA(1) != antlr.TokenEOF {
+		parserErrors.errors = append(parserErrors.errors, fmt.Sprintf("unexpected %q, the rest of the query was not parsed", stream.LT(1).GetText()))
+	}
 
 	// Walk the tree to extract conditions
 	extractor := &conditionExtractor{
@@ -194,6 +284,7 @@ func extractConditionsInternal(query string) (result *ParseResult) {
 		originalQuery:  query,
 	}
 	antlr.ParseTreeWalkerDefault.Walk(extractor, tree)
+	extractor.unparsedSideEffects()
 
 	// Combine errors
 	allErrors := append(lexerErrors.errors, parserErrors.errors...)
@@ -209,17 +300,59 @@ func extractConditionsInternal(query string) (result *ParseResult) {
 		FieldAliases:   extractor.fieldAliases,
 		Commands:       extractor.commands,
 		Joins:          extractor.joins,
+		Lookups:        extractor.lookups,
+		DataModels:     extractor.dataModels,
+		SedOperations:  extractor.sedOperations,
+		Regexes:        extractor.regexes,
+		Templates:      extractor.templates,
+		Streamstats:    extractor.streamstats,
+		Aggregations:   extractor.aggregations,
+		Thresholds:     extractor.thresholds,
+		Transactions:   extractor.transactions,
+		Spaths:         extractor.spaths,
+		SideEffects:    extractor.sideEffects,
+		TimeRange:      extractor.timeRange,
 		Errors:         allErrors,
 	}
 }
 
+// EnterPipelineStage records the aggregating stages that thresholds link to
+func (e *conditionExtractor) EnterPipelineStage(ctx *PipelineStageContext) {
+	if e.stageStarts == nil {
+		e.stageStarts = make(map[int]bool)
+	}
+	e.stageStarts[ctx.GetStart().GetTokenIndex()] = true
+	effect := e.commandStageEffect(ctx)
+	if effect != nil {
+		// The arguments of outputlookup, collect, sendemail... are not filters
+		e.commands = append(e.commands, effect.Command)
+		e.inCommandArgs = true
+	} else if ctx.RestCommand() != nil {
+		effect = e.restEffect(ctx.RestCommand().REST().GetSymbol())
+	}
+	if effect != nil {
+		e.recordSideEffect(*effect)
+	}
+
+	if e.inSubsearch > 0 {
+		return
+	}
+	if command := classifyStage(ctx); aggregationCommands[command] {
+		e.aggStages = append(e.aggStages, aggregationStage{command: command, stage: e.currentStage})
+	}
+}
+
 // ExitPipelineStage increments the stage counter after processing each stage
 func (e *conditionExtractor) ExitPipelineStage(ctx *PipelineStageContext) {
 	e.currentStage++
+	e.inCommandArgs = false
 }
 
 // EnterSubsearch tracks when we enter a subsearch
 func (e *conditionExtractor) EnterSubsearch(ctx *SubsearchContext) {
+	if e.inSubsearch == 0 {
+		e.outerStage = e.currentStage
+	}
 	e.inSubsearch++
 }
 
@@ -291,12 +424,8 @@ func (e *conditionExtractor) EnterJoinCommand(ctx *JoinCommandContext) {
 	}
 
 	// Recursively parse the subsearch
-	if ctx.Subsearch() != nil {
-		subText := e.extractSubsearchText(ctx.Subsearch().(*SubsearchContext))
-		if subText != "" {
-			info.Subsearch = ExtractConditions(subText)
-			info.ExposedFields = deriveExposedFields(info.Subsearch, info.JoinFields)
-		}
+	if info.Subsearch = e.parseSubsearch(ctx.Subsearch()); info.Subsearch != nil {
+		info.ExposedFields = deriveExposedFields(info.Subsearch, info.JoinFields)
 	}
 
 	e.joins = append(e.joins, info)
@@ -312,15 +441,154 @@ func (e *conditionExtractor) EnterAppendCommand(ctx *AppendCommandContext) {
 		PipeStage: e.currentStage,
 	}
 
-	if ctx.Subsearch() != nil {
-		subText := e.extractSubsearchText(ctx.Subsearch().(*SubsearchContext))
-		if subText != "" {
-			info.Subsearch = ExtractConditions(subText)
-			info.ExposedFields = deriveExposedFields(info.Subsearch, nil)
+	if info.Subsearch = e.parseSubsearch(ctx.Subsearch()); info.Subsearch != nil {
+		info.ExposedFields = deriveExposedFields(info.Subsearch, nil)
+	}
+
+	e.joins = append(e.joins, info)
+}
+
+// EnterAppendcolsCommand extracts appendcols subsearch info
+func (e *conditionExtractor) EnterAppendcolsCommand(ctx *AppendcolsCommandContext) {
+	e.appendSubsearchCommand("appendcols", commandOptions(ctx.AllJoinOption()), ctx.Subsearch())
+}
+
+// EnterAppendpipeCommand extracts appendpipe subpipeline info. The
+// subpipeline runs over the current results rather than a new search.
+func (e *conditionExtractor) EnterAppendpipeCommand(ctx *AppendpipeCommandContext) {
+	e.appendSubsearchCommand("appendpipe", commandOptions(ctx.AllJoinOption()), ctx.Subsearch())
+}
+
+// EnterUnionCommand extracts the datasets of a union command
+func (e *conditionExtractor) EnterUnionCommand(ctx *UnionCommandContext) {
+	info := e.appendSubsearchCommand("union", commandOptions(ctx.AllJoinOption()), ctx.AllSubsearch()...)
+	for _, v := range ctx.AllValue() {
+		info.Datasets = append(info.Datasets, extractValue(v))
+	}
+}
+
+// EnterMultisearchCommand extracts the searches of a multisearch command
+func (e *conditionExtractor) EnterMultisearchCommand(ctx *MultisearchCommandContext) {
+	e.appendSubsearchCommand("multisearch", nil, ctx.AllSubsearch()...)
+}
+
+// appendSubsearchCommand records a command that adds the results of its
+// subsearches. union and multisearch store theirs as Branches, even when
+// there is only one; appendcols and appendpipe store theirs as Subsearch.
+func (e *conditionExtractor) appendSubsearchCommand(command string, options map[string]string, subsearches ...ISubsearchContext) *JoinInfo {
+	e.commands = append(e.commands, command)
+
+	info := JoinInfo{
+		Type:      command,
+		Options:   options,
+		IsAppend:  true,
+		PipeStage: e.currentStage,
+	}
+	datasets := command == "union" || command == "multisearch"
+	seen := make(map[string]bool)
+	for _, sub := range subsearches {
+		branch := e.parseSubsearch(sub)
+		if branch == nil {
+			continue
+		}
+		if datasets {
+			info.Branches = append(info.Branches, branch)
+		} else {
+			info.Subsearch = branch
+		}
+		for _, f := range deriveExposedFields(branch, nil) {
+			if !seen[f] {
+				seen[f] = true
+				info.ExposedFields = append(info.ExposedFields, f)
+			}
 		}
 	}
 
 	e.joins = append(e.joins, info)
+	return &e.joins[len(e.joins)-1]
+}
+
+// parseSubsearch recursively parses the query inside a subsearch's brackets
+func (e *conditionExtractor) parseSubsearch(ctx ISubsearchContext) *ParseResult {
+	if ctx == nil {
+		return nil
+	}
+	if subText := e.extractSubsearchText(ctx.(*SubsearchContext)); subText != "" {
+		return ExtractConditions(subText)
+	}
+	return nil
+}
+
+// recordSubsearchFilter adds the conditions a subsearch generates in the
+// outer search, one per returned field. For "field IN [subsearch]" the
+// returned values are matched against field instead.
+func (e *conditionExtractor) recordSubsearchFilter(ctx ISubsearchContext, field string) {
+	if e.inSubsearch > 0 || e.inFunctionCall > 0 || ctx == nil || ctx.Query() == nil {
+		return
+	}
+	fields, command, format := subsearchOutput(ctx.Query())
+	if command == "" {
+		return
+	}
+
+	filter := &SubsearchFilter{Fields: fields, Command: command, Format: format, PipeStage: e.currentStage}
+	filter.Subsearch = e.parseSubsearch(ctx)
+	if field != "" {
+		fields = []string{field}
+	}
+
+	for _, f := range fields {
+		sourceField, isComputed := e.computedSource(f)
+		e.conditions = append(e.conditions, Condition{
+			Field:       f,
+			Operator:    "subsearch",
+			Negated:     e.negated,
+			PipeStage:   e.currentStage,
+			LogicalOp:   e.lastLogicalOp,
+			IsComputed:  isComputed,
+			SourceField: sourceField,
+			Subsearch:   filter,
+		})
+		e.lastLogicalOp = "AND"
+	}
+}
+
+// subsearchOutput returns the fields a subsearch emits its results as, the
+// command that shapes them and the resulting search string format. The
+// command is empty unless the subsearch ends in fields, table, return or
+// format; head, tail, dedup and sort only drop or reorder rows and may follow
+// the field selection.
+func subsearchOutput(query IQueryContext) ([]string, string, SubsearchFormat) {
+	command := ""
+	format := DefaultSubsearchFormat()
+	stages := query.AllPipelineStage()
+	for i := len(stages) - 1; i >= 0; i-- {
+		stage := stages[i]
+		kind := classifyStage(stage)
+		switch {
+		case kind == "head" || kind == "tail" || kind == "dedup" || kind == "sort":
+			continue
+		case kind == "format" && command == "":
+			command = kind
+			format, _ = newFormatCommandFormat(stage.FormatCommand())
+			continue
+		case kind == "return" && command == "":
+			format = newReturnCommandFormat(stage.ReturnCommand())
+			return format.SearchFields(), kind, format
+		}
+
+		if command == "" {
+			command = kind
+		}
+		switch {
+		case kind == "table":
+			return fieldListNames(stage.TableCommand().FieldList()), command, format
+		case kind == "fields" && stage.FieldsCommand().MINUS() == nil:
+			return fieldListNames(stage.FieldsCommand().FieldList()), command, format
+		}
+		return nil, "", format
+	}
+	return nil, "", format
 }
 
 // deriveExposedFields determines which fields a subsearch makes available
@@ -421,6 +689,11 @@ func ClassifyFieldProvenance(result *ParseResult, field string) FieldProvenance
 		return ProvenanceMain
 	}
 
+	// Search-time fields from props.conf exist on the main search's events
+	if _, ok := result.FieldOrigins[fieldLower]; ok {
+		return ProvenanceMain
+	}
+
 	return ProvenanceAmbiguous
 }
 
@@ -441,8 +714,8 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 			allArgs := args.AllExpression()
 			if len(allArgs) >= 2 {
 				// First arg is CIDR, second is field
-				cidr := strings.Trim(allArgs[0].GetText(), "\"'")
-				field := allArgs[1].GetText()
+				cidr := stripQuotes(allArgs[0].GetText())
+				field := unquoteSPLString(allArgs[1].GetText())
 				cond := Condition{
 					Field:     field,
 					Operator:  "cidrmatch",
@@ -451,6 +724,7 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 					PipeStage: e.currentStage,
 					LogicalOp: e.lastLogicalOp,
 				}
+				setValue(&cond, allArgs[0].GetText(), true)
 				e.conditions = append(e.conditions, cond)
 				e.lastLogicalOp = "AND"
 			}
@@ -458,6 +732,13 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 		return // Don't increment inFunctionCall for these
 	}
 
+	// replace(field, regex, replacement) only needs its regex checked
+	if ctx.IDENTIFIER() != nil && strings.EqualFold(ctx.IDENTIFIER().GetText(), "replace") && ctx.ArgumentList() != nil {
+		if args := ctx.ArgumentList().AllExpression(); len(args) >= 2 && isQuotedLiteral(args[1].GetText()) {
+			e.recordRegex("replace", unquoteSPLString(args[0].GetText()), unquoteSPLString(args[1].GetText()))
+		}
+	}
+
 	// Check for match(field, regex) - extracts a regex match condition
 	if ctx.MATCH() != nil {
 		args := ctx.ArgumentList()
@@ -465,8 +746,11 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 			allArgs := args.AllExpression()
 			if len(allArgs) >= 2 {
 				// First arg is field, second is regex
-				field := allArgs[0].GetText()
-				regex := strings.Trim(allArgs[1].GetText(), "\"'")
+				field := unquoteSPLString(allArgs[0].GetText())
+				regex := stripQuotes(allArgs[1].GetText())
+				if isQuotedLiteral(allArgs[1].GetText()) {
+					e.recordRegex("match", field, unquoteSPLString(allArgs[1].GetText()))
+				}
 				cond := Condition{
 					Field:     field,
 					Operator:  "matches",
@@ -475,6 +759,7 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 					PipeStage: e.currentStage,
 					LogicalOp: e.lastLogicalOp,
 				}
+				setValue(&cond, allArgs[1].GetText(), true)
 				e.conditions = append(e.conditions, cond)
 				e.lastLogicalOp = "AND"
 			}
@@ -489,11 +774,10 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 			allArgs := args.AllExpression()
 			if len(allArgs) >= 2 {
 				// First arg is field, second is pattern
-				field := allArgs[0].GetText()
-				pattern := strings.Trim(allArgs[1].GetText(), "\"'")
+				field := unquoteSPLString(allArgs[0].GetText())
+				pattern := stripQuotes(allArgs[1].GetText())
 				// Convert SQL LIKE pattern to wildcard
-				pattern = strings.ReplaceAll(pattern, "%", "*")
-				pattern = strings.ReplaceAll(pattern, "_", "?")
+				pattern = likeToWildcard.Replace(pattern)
 				cond := Condition{
 					Field:     field,
 					Operator:  "like",
@@ -502,6 +786,7 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 					PipeStage: e.currentStage,
 					LogicalOp: e.lastLogicalOp,
 				}
+				setValue(&cond, allArgs[1].GetText(), true)
 				e.conditions = append(e.conditions, cond)
 				e.lastLogicalOp = "AND"
 			}
@@ -515,7 +800,7 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 		if args != nil {
 			allArgs := args.AllExpression()
 			if len(allArgs) >= 1 {
-				field := allArgs[0].GetText()
+				field := unquoteSPLString(allArgs[0].GetText())
 				cond := Condition{
 					Field:     field,
 					Operator:  "isnotnull",
@@ -523,6 +808,7 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 					Negated:   e.negated,
 					PipeStage: e.currentStage,
 					LogicalOp: e.lastLogicalOp,
+					ValueKind: ValueNull,
 				}
 				e.conditions = append(e.conditions, cond)
 				e.lastLogicalOp = "AND"
@@ -537,7 +823,7 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 		if args != nil {
 			allArgs := args.AllExpression()
 			if len(allArgs) >= 1 {
-				field := allArgs[0].GetText()
+				field := unquoteSPLString(allArgs[0].GetText())
 				cond := Condition{
 					Field:     field,
 					Operator:  "isnull",
@@ -545,6 +831,7 @@ func (e *conditionExtractor) EnterFunctionCall(ctx *FunctionCallContext) {
 					Negated:   e.negated,
 					PipeStage: e.currentStage,
 					LogicalOp: e.lastLogicalOp,
+					ValueKind: ValueNull,
 				}
 				e.conditions = append(e.conditions, cond)
 				e.lastLogicalOp = "AND"
@@ -593,6 +880,11 @@ func (e *conditionExtractor) ExitStatsFunction(ctx *StatsFunctionContext) {
 // EnterTstatsCommand extracts group-by fields, datamodel reference, and commands from tstats
 func (e *conditionExtractor) EnterTstatsCommand(ctx *TstatsCommandContext) {
 	e.commands = append(e.commands, "tstats")
+	for _, opt := range ctx.AllTstatsPostOption() {
+		e.recordTimeSpan("tstats", "span", opt)
+	}
+	e.recordAggregation("tstats", ctx.AllStatsFunction(), fieldOrQuotedNames(ctx.AllFieldOrQuoted()),
+		commandOptions(ctx.AllTstatsPreOption()), commandOptions(ctx.AllTstatsPostOption()))
 
 	// Extract BY/GROUPBY fields from fieldOrQuoted elements
 	for _, foq := range ctx.AllFieldOrQuoted() {
@@ -617,18 +909,61 @@ func (e *conditionExtractor) EnterTstatsCommand(ctx *TstatsCommandContext) {
 		ids := dm.AllIDENTIFIER()
 		if dm.EQ() != nil && len(ids) >= 2 {
 			// "datamodel=Endpoint.Processes" — skip "datamodel" keyword
-			parts := make([]string, 0, len(ids)-1)
-			for _, id := range ids[1:] {
-				parts = append(parts, id.GetText())
+			ids = ids[1:]
+		}
+		parts := make([]string, 0, len(ids))
+		for _, id := range ids {
+			parts = append(parts, id.GetText())
+		}
+		e.computedFields["_datamodel"] = strings.Join(parts, ".")
+
+		// IDENTIFIER may itself contain dots, so split the joined name
+		if path := strings.Split(e.computedFields["_datamodel"], "."); e.inSubsearch == 0 && path[0] != "" {
+			ref := DataModelReference{Model: path[0], PipeStage: e.currentStage}
+			if len(path) > 1 {
+				ref.Object = path[len(path)-1]
 			}
-			e.computedFields["_datamodel"] = strings.Join(parts, ".")
-		} else {
-			// Plain "Endpoint.Processes"
-			parts := make([]string, 0, len(ids))
-			for _, id := range ids {
-				parts = append(parts, id.GetText())
+			for _, fn := range ctx.AllStatsFunction() {
+				if expr := fn.Expression(); expr != nil && tstatsFieldPattern.MatchString(expr.GetText()) {
+					ref.Fields = append(ref.Fields, expr.GetText())
+				}
 			}
-			e.computedFields["_datamodel"] = strings.Join(parts, ".")
+			e.dataModels = append(e.dataModels, ref)
+		}
+	}
+}
+
+// tstatsFieldPattern matches a plain (optionally node-prefixed) field argument
+// such as Processes.process_name in values(Processes.process_name)
+var tstatsFieldPattern = regexp.MustCompile(`^[A-Za-z_][\w.]*$`)
+
+// ExitTstatsCommand adds the WHERE and BY fields to the datamodel reference
+// once the WHERE conditions have been extracted
+func (e *conditionExtractor) ExitTstatsCommand(ctx *TstatsCommandContext) {
+	if ctx.TstatsDatamodel() == nil || e.inSubsearch > 0 || len(e.dataModels) == 0 {
+		return
+	}
+	ref := &e.dataModels[len(e.dataModels)-1]
+	for _, c := range e.conditions {
+		if c.PipeStage != ref.PipeStage {
+			continue
+		}
+		if strings.EqualFold(c.Field, "nodename") {
+			// where nodename=Authentication.Failed_Authentication selects a child object
+			if i := strings.LastIndex(c.Value, "."); i >= 0 {
+				ref.Object = c.Value[i+1:]
+			} else {
+				ref.Object = c.Value
+			}
+			continue
+		}
+		ref.Fields = append(ref.Fields, c.Field)
+	}
+	for _, foq := range ctx.AllFieldOrQuoted() {
+		if foq.FieldName() != nil {
+			ref.Fields = append(ref.Fields, foq.FieldName().GetText())
+		} else if foq.QUOTED_STRING() != nil {
+			ref.Fields = append(ref.Fields, strings.Trim(foq.QUOTED_STRING().GetText(), `"'`))
 		}
 	}
 }
@@ -636,6 +971,8 @@ func (e *conditionExtractor) EnterTstatsCommand(ctx *TstatsCommandContext) {
 // EnterMstatsCommand extracts group-by fields from mstats commands (metrics store)
 func (e *conditionExtractor) EnterMstatsCommand(ctx *MstatsCommandContext) {
 	e.commands = append(e.commands, "mstats")
+	e.recordAggregation("mstats", ctx.AllStatsFunction(), fieldOrQuotedNames(ctx.AllFieldOrQuoted()),
+		commandOptions(ctx.AllTstatsPreOption()), commandOptions(ctx.AllTstatsPostOption()))
 
 	// Extract BY/GROUPBY fields from fieldOrQuoted elements
 	for _, foq := range ctx.AllFieldOrQuoted() {
@@ -655,6 +992,79 @@ func (e *conditionExtractor) EnterMstatsCommand(ctx *MstatsCommandContext) {
 	}
 }
 
+// EnterLookupCommand records the lookup's table, field mappings and options.
+// Output fields are registered as computed fields whose source is the table
+// (e.g. "_lookup:users.csv"), so later conditions on them such as
+// | where dept="finance" are not mistaken for raw event fields.
+func (e *conditionExtractor) EnterLookupCommand(ctx *LookupCommandContext) {
+	e.commands = append(e.commands, "lookup")
+
+	info := newLookupInfo(ctx)
+	info.PipeStage = e.currentStage
+	for _, out := range info.Outputs {
+		e.computedFields[strings.ToLower(out.EventField)] = LookupSourceMarker(info.Table)
+	}
+	e.lookups = append(e.lookups, info)
+}
+
+// LookupSourceMarker is the computed-field source recorded for fields
+// written by a lookup of the given table
+func LookupSourceMarker(table string) string {
+	return "_lookup:" + table
+}
+
+// newLookupInfo decomposes a lookup command into a LookupInfo
+func newLookupInfo(ctx ILookupCommandContext) LookupInfo {
+	info := LookupInfo{
+		Options: make(map[string]string),
+		Inputs:  lookupFieldMappings(ctx.AllLookupFieldMapping()),
+	}
+	if ctx.IDENTIFIER() != nil {
+		info.Table = ctx.IDENTIFIER().GetText()
+	} else if ctx.QUOTED_STRING() != nil {
+		info.Table = strings.Trim(ctx.QUOTED_STRING().GetText(), "\"'")
+	}
+
+	for _, opt := range ctx.AllLookupOption() {
+		key := strings.ToLower(opt.IDENTIFIER().GetText())
+		if opt.QUOTED_STRING() != nil {
+			info.Options[key] = strings.Trim(opt.QUOTED_STRING().GetText(), "\"'")
+		} else if opt.FieldName() != nil {
+			info.Options[key] = opt.FieldName().GetText()
+		} else if opt.NUMBER() != nil {
+			info.Options[key] = opt.NUMBER().GetText()
+		}
+	}
+
+	if out := ctx.LookupOutput(); out != nil {
+		info.OutputNew = out.OUTPUTNEW() != nil
+		info.Outputs = lookupFieldMappings(out.AllLookupFieldMapping())
+	}
+	return info
+}
+
+// lookupFieldMappings converts "table_field [AS event_field]" mappings
+func lookupFieldMappings(mappings []ILookupFieldMappingContext) []LookupField {
+	fields := make([]LookupField, 0, len(mappings))
+	for _, m := range mappings {
+		names := m.AllLookupField()
+		field := LookupField{TableField: lookupFieldName(names[0])}
+		field.EventField = field.TableField
+		if len(names) > 1 {
+			field.EventField = lookupFieldName(names[1])
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func lookupFieldName(ctx ILookupFieldContext) string {
+	if ctx.QUOTED_STRING() != nil {
+		return strings.Trim(ctx.QUOTED_STRING().GetText(), "\"'")
+	}
+	return ctx.GetText()
+}
+
 // EnterInputlookupCommand extracts the command name for inputlookup
 func (e *conditionExtractor) EnterInputlookupCommand(ctx *InputlookupCommandContext) {
 	e.commands = append(e.commands, "inputlookup")
@@ -664,23 +1074,76 @@ func (e *conditionExtractor) EnterInputlookupCommand(ctx *InputlookupCommandCont
 func (e *conditionExtractor) EnterStatsCommand(ctx *StatsCommandContext) {
 	e.commands = append(e.commands, "stats")
 	e.extractByFields(ctx.FieldList())
+	e.recordAggregation("stats", ctx.AllStatsFunction(), fieldListNames(ctx.FieldList()))
 }
 
 // EnterEventstatsCommand extracts group-by fields from eventstats commands
 func (e *conditionExtractor) EnterEventstatsCommand(ctx *EventstatsCommandContext) {
 	e.commands = append(e.commands, "eventstats")
 	e.extractByFields(ctx.FieldList())
+	e.recordStreamstats("eventstats", ctx.AllStatsOption(), ctx.AllStatsFunction(), ctx.FieldList())
+	e.recordAggregation("eventstats", ctx.AllStatsFunction(), fieldListNames(ctx.FieldList()), commandOptions(ctx.AllStatsOption()))
 }
 
 // EnterStreamstatsCommand extracts group-by fields from streamstats commands
 func (e *conditionExtractor) EnterStreamstatsCommand(ctx *StreamstatsCommandContext) {
 	e.commands = append(e.commands, "streamstats")
 	e.extractByFields(ctx.FieldList())
+	e.recordStreamstats("streamstats", ctx.AllStatsOption(), ctx.AllStatsFunction(), ctx.FieldList())
+	e.recordAggregation("streamstats", ctx.AllStatsFunction(), fieldListNames(ctx.FieldList()), commandOptions(ctx.AllStatsOption()))
+	for _, opt := range ctx.AllStatsOption() {
+		e.recordTimeSpan("streamstats", "time_window", opt)
+	}
+}
+
+// recordStreamstats records the aggregates and window options of a
+// streamstats or eventstats command, reporting invalid options as errors
+func (e *conditionExtractor) recordStreamstats(command string, opts []IStatsOptionContext, fns []IStatsFunctionContext, by IFieldListContext) {
+	if e.inSubsearch > 0 {
+		return
+	}
+	info, err := newStreamstatsInfo(command, opts, fns, by)
+	if err != nil {
+		e.errors = append(e.errors, err.Error())
+	}
+	info.PipeStage = e.currentStage
+	e.streamstats = append(e.streamstats, info)
+}
+
+// recordAggregation records a stats-family stage. Options maps are merged
+// in order, so tstats options after BY override those before it.
+func (e *conditionExtractor) recordAggregation(command string, fns []IStatsFunctionContext, groupBy []string, options ...map[string]string) {
+	if e.inSubsearch > 0 {
+		return
+	}
+	agg := Aggregation{
+		Command:   command,
+		Functions: newAggregationFunctions(fns),
+		GroupBy:   groupBy,
+		PipeStage: e.currentStage,
+	}
+	for _, opts := range options {
+		for k, v := range opts {
+			if agg.Options == nil {
+				agg.Options = make(map[string]string)
+			}
+			agg.Options[k] = v
+		}
+	}
+	e.aggregations = append(e.aggregations, agg)
 }
 
 // EnterTimechartCommand extracts group-by fields from timechart commands
 func (e *conditionExtractor) EnterTimechartCommand(ctx *TimechartCommandContext) {
 	e.commands = append(e.commands, "timechart")
+	for _, opt := range ctx.AllTimechartOption() {
+		e.recordTimeSpan("timechart", "span", opt)
+	}
+	groupBy := []string{"_time"}
+	if ctx.FieldName() != nil {
+		groupBy = append(groupBy, ctx.FieldName().GetText())
+	}
+	e.recordAggregation("timechart", ctx.AllStatsFunction(), groupBy, commandOptions(ctx.AllTimechartOption()))
 	if ctx.FieldName() != nil {
 		field := ctx.FieldName().GetText()
 		if !isExcludedField(strings.ToLower(field)) {
@@ -693,6 +1156,12 @@ func (e *conditionExtractor) EnterTimechartCommand(ctx *TimechartCommandContext)
 func (e *conditionExtractor) EnterChartCommand(ctx *ChartCommandContext) {
 	e.commands = append(e.commands, "chart")
 	e.extractByFields(ctx.FieldList())
+	var groupBy []string
+	if ctx.FieldName() != nil {
+		groupBy = append(groupBy, ctx.FieldName().GetText())
+	}
+	groupBy = append(groupBy, fieldListNames(ctx.FieldList())...)
+	e.recordAggregation("chart", ctx.AllStatsFunction(), groupBy, commandOptions(ctx.AllStatsOption()))
 	// Also extract the OVER field if present
 	if ctx.FieldName() != nil {
 		field := ctx.FieldName().GetText()
@@ -746,6 +1215,114 @@ func (e *conditionExtractor) EnterTableCommand(ctx *TableCommandContext) {
 	e.extractByFields(ctx.FieldList())
 }
 
+// EnterFormatCommand reports invalid format separators and options
+func (e *conditionExtractor) EnterFormatCommand(ctx *FormatCommandContext) {
+	e.commands = append(e.commands, "format")
+	if e.inSubsearch > 0 {
+		return
+	}
+	if _, err := newFormatCommandFormat(ctx); err != nil {
+		e.errors = append(e.errors, err.Error())
+	}
+}
+
+// EnterReturnCommand tracks return commands
+func (e *conditionExtractor) EnterReturnCommand(ctx *ReturnCommandContext) {
+	e.commands = append(e.commands, "return")
+}
+
+// EnterForeachCommand expands the foreach template for the fields known at
+// this point of the pipeline and records the fields the expansions compute
+func (e *conditionExtractor) EnterForeachCommand(ctx *ForeachCommandContext) {
+	e.commands = append(e.commands, "foreach")
+	if e.inSubsearch > 0 {
+		return
+	}
+
+	t := newForeachTemplate(ctx, subsearchQueryText(ctx.Subsearch()))
+	t.PipeStage = e.currentStage
+	t.Expansions = t.expandFields(e.knownFields())
+	for _, x := range t.Expansions {
+		for field, source := range x.Result.ComputedFields {
+			// Keep the original source of a computed field rewritten in place
+			if _, ok := e.computedFields[field]; !ok || source != field {
+				e.computedFields[field] = source
+			}
+		}
+	}
+	e.templates = append(e.templates, t)
+}
+
+// EnterMapCommand records the map search. Its $field$ tokens are only known
+// per result row, so the template is analyzed with the tokens as values.
+func (e *conditionExtractor) EnterMapCommand(ctx *MapCommandContext) {
+	e.commands = append(e.commands, "map")
+	e.recordSideEffect(SideEffect{
+		Kind: SideEffectFanOut, Command: "map", Options: commandOptions(ctx.AllMapOption()),
+		Text: ruleText(ctx), Reason: "runs a search for each result",
+	})
+	if e.inSubsearch > 0 {
+		return
+	}
+
+	text := ""
+	if subsearches := ctx.AllSubsearch(); len(subsearches) > 0 {
+		text = subsearchQueryText(subsearches[0])
+	}
+	t := newMapTemplate(ctx, text)
+	t.PipeStage = e.currentStage
+	if t.Template != "" {
+		t.Expansions = []TemplateExpansion{{Search: t.Template, Result: ExtractConditions(t.Template)}}
+		if len(ctx.AllSubsearch()) == 0 {
+			// A bracketed search is walked as a subsearch; a quoted one is not
+			for _, effect := range t.Expansions[0].Result.SideEffects {
+				effect.PipeStage, effect.Nested = e.currentStage, true
+				e.sideEffects = append(e.sideEffects, effect)
+			}
+		}
+	}
+	e.templates = append(e.templates, t)
+}
+
+// recordSideEffect records a side effect at the current stage, or at the
+// top-level stage holding the subsearch it is nested in
+func (e *conditionExtractor) recordSideEffect(effect SideEffect) {
+	effect.PipeStage = e.currentStage
+	if e.inSubsearch > 0 {
+		effect.PipeStage, effect.Nested = e.outerStage, true
+	}
+	e.sideEffects = append(e.sideEffects, effect)
+}
+
+// knownFields lists the fields known at this point of the pipeline:
+// group-by fields, computed fields and condition fields. Internal fields
+// and source markers starting with _ are left out.
+func (e *conditionExtractor) knownFields() []string {
+	seen := make(map[string]bool)
+	var fields []string
+	add := func(f string) {
+		if !seen[strings.ToLower(f)] && !strings.HasPrefix(f, "_") {
+			seen[strings.ToLower(f)] = true
+			fields = append(fields, f)
+		}
+	}
+	for _, f := range e.groupByFields {
+		add(f)
+	}
+	computed := make([]string, 0, len(e.computedFields))
+	for f := range e.computedFields {
+		computed = append(computed, f)
+	}
+	sort.Strings(computed)
+	for _, f := range computed {
+		add(f)
+	}
+	for _, c := range e.conditions {
+		add(c.Field)
+	}
+	return fields
+}
+
 // EnterTopCommand extracts fields from top commands
 func (e *conditionExtractor) EnterTopCommand(ctx *TopCommandContext) {
 	for _, fieldList := range ctx.AllFieldList() {
@@ -802,6 +1379,159 @@ func (e *conditionExtractor) EnterTransactionCommand(ctx *TransactionCommandCont
 	if ctx.FieldList() != nil {
 		e.extractByFields(ctx.FieldList())
 	}
+	for _, opt := range ctx.AllTransactionOption() {
+		e.recordTimeSpan("transaction", "maxspan", opt)
+	}
+
+	if e.inSubsearch > 0 {
+		return
+	}
+	info, err := newTransactionInfo(ctx)
+	if err != nil {
+		e.errors = append(e.errors, err.Error())
+	}
+	info.PipeStage = e.currentStage
+	e.transactions = append(e.transactions, info)
+}
+
+// EnterTransactionOption skips startswith/endswith expressions: they mark
+// transaction boundaries rather than filter events, and are extracted into
+// TransactionInfo instead
+func (e *conditionExtractor) EnterTransactionOption(ctx *TransactionOptionContext) {
+	if ctx.SearchExpression() != nil || ctx.EVAL() != nil || ctx.Condition() != nil {
+		e.inBoundary++
+	}
+}
+
+// ExitTransactionOption leaves a startswith/endswith expression
+func (e *conditionExtractor) ExitTransactionOption(ctx *TransactionOptionContext) {
+	if ctx.SearchExpression() != nil || ctx.EVAL() != nil || ctx.Condition() != nil {
+		e.inBoundary--
+	}
+}
+
+// EnterSpathCommand registers the field spath writes as computed from its
+// input. Without a path every leaf is extracted, so later conditions on
+// fields not otherwise computed are attributed to the input (see computedSource).
+func (e *conditionExtractor) EnterSpathCommand(ctx *SpathCommandContext) {
+	e.commands = append(e.commands, "spath")
+	if e.inSubsearch > 0 {
+		return
+	}
+	info, err := newSpathInfo(ctx)
+	if err != nil {
+		e.errors = append(e.errors, err.Error())
+	}
+	info.PipeStage = e.currentStage
+	e.spaths = append(e.spaths, info)
+	if info.Path == "" {
+		e.spathInput = info.Input
+	} else {
+		e.computedFields[strings.ToLower(info.Field())] = info.Input
+	}
+}
+
+// computedSource returns the source of a computed field. After an spath
+// without a path, event fields (other than internal and search-scope
+// fields) are leaves extracted from the spath input.
+func (e *conditionExtractor) computedSource(field string) (string, bool) {
+	fieldLower := strings.ToLower(field)
+	if source, ok := e.computedFields[fieldLower]; ok {
+		return source, true
+	}
+	if e.spathInput == "" || strings.HasPrefix(fieldLower, "_") || IsSearchScopeMetadata(fieldLower) {
+		return "", false
+	}
+	e.computedFields[fieldLower] = e.spathInput
+	return e.spathInput, true
+}
+
+// EnterBucketCommand records the span of bin/bucket commands and registers
+// the new field of bin ... AS <field>. bin _time span=1m AS minute leaves
+// _time as it is.
+func (e *conditionExtractor) EnterBucketCommand(ctx *BucketCommandContext) {
+	command := "bin"
+	if ctx.BUCKET() != nil {
+		command = "bucket"
+	}
+	for _, opt := range ctx.AllBucketOption() {
+		e.recordTimeSpan(command, "span", opt)
+	}
+	if ctx.AS() != nil && e.inSubsearch == 0 {
+		e.computedFields[strings.ToLower(ctx.FieldName(1).GetText())] = ctx.FieldName(0).GetText()
+	}
+}
+
+// recordTimeBound stores an earliest/latest value of the main search.
+// A later value overrides an earlier one, as in Splunk. Bounds under NOT or
+// one side of an OR do not bound the whole search, so they are skipped.
+func (e *conditionExtractor) recordTimeBound(ctx antlr.Tree, fieldLower, value string) {
+	if e.inSubsearch > 0 || e.negated || underOr(ctx) {
+		return
+	}
+	tr := e.timeRange
+	if tr == nil {
+		tr = &TimeRange{}
+	}
+	switch fieldLower {
+	case "earliest":
+		tr.Earliest = ParseTimeBound(value)
+	case "latest":
+		tr.Latest = ParseTimeBound(value)
+	case "_index_earliest":
+		tr.IndexEarliest = ParseTimeBound(value)
+	case "_index_latest":
+		tr.IndexLatest = ParseTimeBound(value)
+	default:
+		return
+	}
+	e.timeRange = tr
+}
+
+// underOr reports whether a node is an operand of OR. In a search, OR joins
+// the terms on either side of it; in an expression, the branches of an
+// orExpression.
+func underOr(node antlr.Tree) bool {
+	for ; node != nil; node = node.GetParent() {
+		switch parent := node.GetParent().(type) {
+		case *SearchExpressionContext:
+			children := parent.GetChildren()
+			for i, child := range children {
+				if child != node {
+					continue
+				}
+				for _, j := range []int{i - 1, i + 1} {
+					if j >= 0 && j < len(children) {
+						if op, ok := children[j].(*LogicalOpContext); ok && op.OR() != nil {
+							return true
+						}
+					}
+				}
+			}
+		case *OrExpressionContext:
+			if len(parent.AllAndExpression()) > 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// recordTimeSpan records a command option such as span=1h when its name
+// matches option. Options have the shape IDENTIFIER EQ value.
+func (e *conditionExtractor) recordTimeSpan(command, option string, opt antlr.ParserRuleContext) {
+	if e.inSubsearch > 0 || opt.GetChildCount() != 3 || !strings.EqualFold(opt.GetChild(0).(antlr.ParseTree).GetText(), option) {
+		return
+	}
+	if e.timeRange == nil {
+		e.timeRange = &TimeRange{}
+	}
+	e.timeRange.Spans = append(e.timeRange.Spans, TimeSpan{
+		Command:   command,
+		Option:    option,
+		Value:     strings.Trim(opt.GetChild(2).(antlr.ParseTree).GetText(), "\"'"),
+		PipeStage: e.currentStage,
+	})
 }
 
 // EnterRexCommand tracks rex commands and extracts computed fields from named capture groups
@@ -828,9 +1558,16 @@ func (e *conditionExtractor) EnterRexCommand(ctx *RexCommandContext) {
 		}
 	}
 
+	// mode=sed rewrites the field in place instead of extracting fields
+	if strings.EqualFold(commandOptions(ctx.AllRexOption())["mode"], "sed") {
+		e.recordSedOperation(ctx, sourceField)
+		return
+	}
+
 	// Get the regex pattern and extract named capture groups
 	if ctx.QUOTED_STRING() != nil {
 		pattern := ctx.QUOTED_STRING().GetText()
+		e.recordRegex("rex", sourceField, unquoteSPLString(pattern))
 		captureGroups := extractNamedCaptureGroups(pattern)
 
 		// Map each captured field to the source field
@@ -840,6 +1577,69 @@ func (e *conditionExtractor) EnterRexCommand(ctx *RexCommandContext) {
 	}
 }
 
+// recordSedOperation parses a rex mode=sed expression. The modified field is
+// registered as computed from itself, so later conditions on it are known to
+// match the rewritten value rather than the raw one.
+func (e *conditionExtractor) recordSedOperation(ctx *RexCommandContext, field string) {
+	if ctx.QUOTED_STRING() == nil {
+		e.errors = append(e.errors, "rex mode=sed requires a sed expression")
+		return
+	}
+	op, err := ParseSedExpression(unquoteSPLString(ctx.QUOTED_STRING().GetText()))
+	if err != nil {
+		e.errors = append(e.errors, fmt.Sprintf("rex mode=sed: %v", err))
+		return
+	}
+	op.Field = field
+	op.PipeStage = e.currentStage
+	e.sedOperations = append(e.sedOperations, op)
+	if op.Kind == SedSubstitute {
+		e.recordRegex("rex", field, op.Pattern)
+	}
+	e.computedFields[strings.ToLower(field)] = field
+}
+
+// recordRegex analyzes a regex used by command on field. Patterns that
+// would fail in Splunk are reported as errors, since a broken regex silently
+// disables a detection.
+func (e *conditionExtractor) recordRegex(command, field, pattern string) {
+	usage := RegexUsage{RegexAnalysis: AnalyzeRegex(pattern), Command: command, Field: field, PipeStage: e.currentStage}
+	e.regexes = append(e.regexes, usage)
+	for _, issue := range usage.Issues {
+		if issue.IsError() {
+			e.errors = append(e.errors, fmt.Sprintf("invalid regex in %s at position %d: %s", command, issue.Position, issue.Message))
+		}
+	}
+}
+
+// EnterRegexCommand turns | regex field="pattern" into a "matches" condition,
+// negated for field!="pattern". Without a field the pattern applies to _raw.
+func (e *conditionExtractor) EnterRegexCommand(ctx *RegexCommandContext) {
+	e.commands = append(e.commands, "regex")
+	if e.inSubsearch > 0 {
+		return
+	}
+
+	field := "_raw"
+	if ctx.FieldName() != nil {
+		field = ctx.FieldName().GetText()
+	}
+	e.recordRegex("regex", field, unquoteSPLString(ctx.QUOTED_STRING().GetText()))
+	sourceField, isComputed := e.computedSource(field)
+	cond := Condition{
+		Field:       field,
+		Operator:    "matches",
+		Value:       stripQuotes(ctx.QUOTED_STRING().GetText()),
+		Negated:     ctx.NEQ() != nil,
+		PipeStage:   e.currentStage,
+		LogicalOp:   "AND",
+		IsComputed:  isComputed,
+		SourceField: sourceField,
+	}
+	setValue(&cond, ctx.QUOTED_STRING().GetText(), false)
+	e.conditions = append(e.conditions, cond)
+}
+
 // EnterRenameCommand tracks rename commands: | rename OldField AS NewField
 // Records field aliases so downstream consumers can resolve renamed fields.
 func (e *conditionExtractor) EnterRenameCommand(ctx *RenameCommandContext) {
@@ -871,36 +1671,15 @@ func (e *conditionExtractor) EnterRenameCommand(ctx *RenameCommandContext) {
 }
 
 // extractNamedCaptureGroups extracts named capture group names from a regex pattern
-// Pattern: (?<name>...) or (?P<name>...) returns ["name", ...]
+// Pattern: (?<name>...), (?P<name>...) or (?'name'...) returns ["name", ...];
+// lookbehinds such as (?<=...) are not groups.
 func extractNamedCaptureGroups(pattern string) []string {
-	var groups []string
-	// Look for (?<name> or (?P<name> patterns
-	i := 0
-	for i < len(pattern)-4 {
-		if pattern[i] == '(' && pattern[i+1] == '?' {
-			start := i + 2
-			// Check for (?<name> or (?P<name>
-			if start < len(pattern) && pattern[start] == '<' {
-				start++ // skip '<'
-			} else if start < len(pattern) && pattern[start] == 'P' && start+1 < len(pattern) && pattern[start+1] == '<' {
-				start += 2 // skip 'P<'
-			} else {
-				i++
-				continue
-			}
+	return AnalyzeRegex(pattern).CaptureGroups
+}
 
-			// Extract the group name until '>'
-			end := start
-			for end < len(pattern) && pattern[end] != '>' {
-				end++
-			}
-			if end > start && end < len(pattern) {
-				groups = append(groups, pattern[start:end])
-			}
-		}
-		i++
-	}
-	return groups
+// isQuotedLiteral reports whether an expression is a single quoted string
+func isQuotedLiteral(text string) bool {
+	return len(text) >= 2 && (text[0] == '"' || text[0] == '\'') && text[len(text)-1] == text[0]
 }
 
 // EnterEvalAssignment tracks computed fields from eval commands
@@ -912,7 +1691,7 @@ func (e *conditionExtractor) EnterEvalAssignment(ctx *EvalAssignmentContext) {
 
 	// Extract the field name being assigned to and try to find the source field
 	if ctx.FieldName() != nil {
-		computedField := ctx.FieldName().GetText()
+		computedField := fieldNameText(ctx.FieldName())
 		sourceField := ""
 
 		// Try to extract the source field from the expression
@@ -955,17 +1734,23 @@ func extractFieldNamesFromText(text string) []string {
 	var current strings.Builder
 	inQuote := false
 	quoteChar := rune(0)
+	quoteStart := 0
 
 	// Track if we're inside a function name (before the opening paren)
 	// We want to skip function names but include their arguments
 
 	for i, ch := range text {
-		// Handle quoted strings - skip their contents
+		// Handle quoted strings - skip string contents, but a single-quoted
+		// 'field name' is a field reference
 		if (ch == '"' || ch == '\'') && (i == 0 || text[i-1] != '\\') {
 			if !inQuote {
 				inQuote = true
 				quoteChar = ch
+				quoteStart = i
 			} else if ch == quoteChar {
+				if ch == '\'' && i > quoteStart+1 {
+					fields = append(fields, text[quoteStart+1:i])
+				}
 				inQuote = false
 				quoteChar = 0
 			}
@@ -1050,26 +1835,26 @@ func startsWithDigit(s string) bool {
 
 // EnterBareWord extracts bare search terms (quoted strings used as fulltext search)
 func (e *conditionExtractor) EnterBareWord(ctx *BareWordContext) {
-	// Skip inside subsearches
-	if e.inSubsearch > 0 {
+	// Skip inside subsearches, transaction boundaries and command arguments
+	if e.inSubsearch > 0 || e.inBoundary > 0 || e.inCommandArgs {
 		return
 	}
 
-	// Only extract quoted strings as keyword conditions
-	if ctx.QUOTED_STRING() != nil {
-		value := ctx.QUOTED_STRING().GetText()
-		// Remove quotes
-		value = strings.Trim(value, "\"'")
+	// Only extract quoted strings and TERM()/CASE() as keyword conditions
+	if ctx.QUOTED_STRING() != nil || ctx.TERM_DIRECTIVE() != nil || ctx.CASE_DIRECTIVE() != nil {
+		raw, term, caseSensitive := bareWordDirective(ctx)
 
 		// Create a keyword condition (field="_raw" or "_keyword")
 		cond := Condition{
 			Field:     "_raw",
 			Operator:  "contains",
-			Value:     value,
+			Value:     stripQuotes(raw),
 			Negated:   e.negated,
 			PipeStage: e.currentStage,
 			LogicalOp: e.lastLogicalOp,
 		}
+		setValue(&cond, raw, false)
+		cond.Term, cond.CaseSensitive = term, caseSensitive
 		e.conditions = append(e.conditions, cond)
 		e.lastLogicalOp = "AND"
 	}
@@ -1094,21 +1879,63 @@ func (e *conditionExtractor) EnterCondition(ctx *ConditionContext) {
 		return
 	}
 
-	// Check for field comparison: field op value
-	if ctx.FieldName() != nil && ctx.ComparisonOp() != nil && ctx.Value() != nil {
-		field := ctx.FieldName().GetText()
+	// Skip transaction startswith/endswith expressions and the key=value
+	// arguments of commands parsed as search terms
+	if e.inBoundary > 0 || e.inCommandArgs {
+		return
+	}
+
+	// Check for field comparison: field op value, or field::value
+	if ctx.FieldName() != nil && (ctx.ComparisonOp() != nil || ctx.DOUBLE_COLON() != nil) && ctx.Value() != nil {
+		field := fieldNameText(ctx.FieldName())
 		fieldLower := strings.ToLower(field)
 
+		op := "="
+		if ctx.ComparisonOp() != nil {
+			op = ctx.ComparisonOp().GetText()
+		}
+		if op == "==" {
+			op = "=" // eval/where equality is the same comparison as search's =
+		}
+		raw, term, caseSensitive := valueDirective(ctx.Value())
+		value, valueField := extractValue(ctx.Value()), ""
+		_, inExpression := ctx.GetParent().(*ComparisonExpressionContext)
+		if inExpression {
+			// In eval/where, bare words and 'name' are fields, not strings.
+			// The search command keeps them as literals.
+			if ctx.Value().IDENTIFIER() != nil || ctx.Value().KeywordAsValue() != nil || ctx.Value().SINGLE_QUOTED() != nil {
+				value, valueField = "", unquoteSPLString(ctx.Value().GetText())
+			}
+			if ctx.FieldName().NUMBER() != nil {
+				// 10 < count compares count with 10
+				if valueField == "" {
+					return
+				}
+				field, fieldLower, value, valueField = valueField, strings.ToLower(valueField), ctx.FieldName().GetText(), ""
+				raw = value
+				op = flippedOperators[op]
+			}
+		}
+
+		if valueField == "" && ctx.ComparisonOp() != nil && ctx.ComparisonOp().GetText() == "=" {
+			e.recordTimeBound(ctx, fieldLower, value)
+		}
+		// Aggregate outputs such as count are keywords, so thresholds are
+		// recorded before the keyword check. A field compared with another
+		// field is recorded with the other field as the value.
+		if valueField != "" {
+			e.recordThreshold(field, op, valueField)
+		} else {
+			e.recordThreshold(field, op, value)
+		}
+
 		// Skip SPL keywords (metadata fields like index, sourcetype, etc.)
 		if isExcludedField(fieldLower) {
 			return
 		}
 
-		op := ctx.ComparisonOp().GetText()
-		value := extractValue(ctx.Value())
-
 		// Check if this is a computed field and get its source field
-		sourceField, isComputed := e.computedFields[fieldLower]
+		sourceField, isComputed := e.computedSource(field)
 
 		cond := Condition{
 			Field:       field,
@@ -1120,6 +1947,12 @@ func (e *conditionExtractor) EnterCondition(ctx *ConditionContext) {
 			IsComputed:  isComputed,
 			SourceField: sourceField,
 		}
+		if valueField != "" {
+			cond.Comparison, cond.ValueField = CompareField, valueField
+		} else {
+			setValue(&cond, raw, inExpression)
+		}
+		cond.Term, cond.CaseSensitive, cond.IndexedField = term, caseSensitive, ctx.DOUBLE_COLON() != nil
 		e.conditions = append(e.conditions, cond)
 		e.lastLogicalOp = "AND" // reset to default
 	}
@@ -1129,7 +1962,7 @@ func (e *conditionExtractor) EnterCondition(ctx *ConditionContext) {
 	// This preserves correct semantics for NOT field IN (...) which requires AND logic (not match ANY),
 	// unlike expanded form which would be OR logic.
 	if ctx.FieldName() != nil && ctx.IN() != nil && ctx.ValueList() != nil {
-		field := ctx.FieldName().GetText()
+		field := fieldNameText(ctx.FieldName())
 		fieldLower := strings.ToLower(field)
 
 		// Skip SPL keywords
@@ -1138,7 +1971,7 @@ func (e *conditionExtractor) EnterCondition(ctx *ConditionContext) {
 		}
 
 		// Check if this is a computed field and get its source field
-		sourceField, isComputed := e.computedFields[fieldLower]
+		sourceField, isComputed := e.computedSource(field)
 
 		values := extractValueList(ctx.ValueList())
 
@@ -1154,9 +1987,39 @@ func (e *conditionExtractor) EnterCondition(ctx *ConditionContext) {
 			IsComputed:   isComputed,
 			SourceField:  sourceField,
 		}
+		setValue(&cond, ctx.ValueList().Value(0).GetText(), inWhereCommand(ctx))
 		e.conditions = append(e.conditions, cond)
 		e.lastLogicalOp = "AND"
 	}
+
+	// field IN [subsearch]: the subsearch values are matched against field
+	if ctx.FieldName() != nil && ctx.Subsearch() != nil && !isExcludedField(strings.ToLower(fieldNameText(ctx.FieldName()))) {
+		e.recordSubsearchFilter(ctx.Subsearch(), fieldNameText(ctx.FieldName()))
+	}
+}
+
+// recordThreshold records a comparison on a field produced by an earlier
+// aggregation, following renames back to the aggregate's name
+func (e *conditionExtractor) recordThreshold(field, op, value string) {
+	if e.inSubsearch > 0 || !thresholdOperators[op] {
+		return
+	}
+	name := field
+	for i := 0; i < len(e.fieldAliases); i++ {
+		orig, ok := e.fieldAliases[strings.ToLower(name)]
+		if !ok || strings.EqualFold(orig, name) {
+			break
+		}
+		name = orig
+	}
+	th, ok := linkThreshold(e.aggStages, e.aggregations, name, e.currentStage)
+	if !ok {
+		return
+	}
+	th.Field, th.Operator, th.Value = field, op, value
+	th.Negated = e.negated
+	th.PipeStage = e.currentStage
+	e.thresholds = append(e.thresholds, th)
 }
 
 // EnterNotExpression tracks negation
@@ -1173,11 +2036,21 @@ func (e *conditionExtractor) ExitNotExpression(ctx *NotExpressionContext) {
 	}
 }
 
-// EnterSearchTerm handles NOT in search terms
+// EnterSearchTerm handles NOT in search terms and subsearch filters
 func (e *conditionExtractor) EnterSearchTerm(ctx *SearchTermContext) {
 	if ctx.NOT() != nil {
 		e.negated = !e.negated
 	}
+	if ctx.Subsearch() != nil {
+		e.recordSubsearchFilter(ctx.Subsearch(), "")
+	}
+}
+
+// EnterPrimaryExpression handles subsearch filters in where clauses
+func (e *conditionExtractor) EnterPrimaryExpression(ctx *PrimaryExpressionContext) {
+	if ctx.Subsearch() != nil {
+		e.recordSubsearchFilter(ctx.Subsearch(), "")
+	}
 }
 
 // ExitSearchTerm resets negation for search terms
@@ -1189,6 +2062,11 @@ func (e *conditionExtractor) ExitSearchTerm(ctx *SearchTermContext) {
 
 // EnterLogicalOp tracks the logical operator
 func (e *conditionExtractor) EnterLogicalOp(ctx *LogicalOpContext) {
+	// Operators inside transaction startswith/endswith join conditions
+	// that are not extracted, and must not carry over to the next one
+	if e.inBoundary > 0 {
+		return
+	}
 	if ctx.OR() != nil {
 		e.lastLogicalOp = "OR"
 	} else {
@@ -1210,14 +2088,9 @@ func extractValue(ctx IValueContext) string {
 		return ""
 	}
 
-	text := ctx.GetText()
-
-	// Remove quotes if present
-	if ctx.QUOTED_STRING() != nil {
-		text = strings.Trim(text, "\"'")
-	}
-
-	return text
+	// Remove TERM()/CASE() and one pair of quotes; escapes are kept (see UnescapeValue)
+	text, _, _ := valueDirective(ctx)
+	return stripQuotes(text)
 }
 
 // extractValueList gets all values from a value list context
@@ -1245,7 +2118,7 @@ func groupORConditions(conditions []Condition) []Condition {
 		cond := conditions[i]
 
 		// Look ahead for OR conditions on the same field
-		if i+1 < len(conditions) && conditions[i+1].LogicalOp == "OR" {
+		if i+1 < len(conditions) && conditions[i+1].LogicalOp == "OR" && cond.Subsearch == nil {
 			fieldLower := strings.ToLower(cond.Field)
 			alternatives := []string{cond.Value}
 
@@ -1388,10 +2261,11 @@ func HasComplexWhereConditions(result *ParseResult) bool {
 
 // PipelineStageInfo describes a single stage in a SPL pipeline
 type PipelineStageInfo struct {
-	Index         int    `json:"index"`          // 0-based stage index
-	CommandType   string `json:"command_type"`   // e.g. "search", "where", "eval", "stats", "generic"
-	IsAggregation bool   `json:"is_aggregation"` // true for stats, eventstats, streamstats, chart, timechart, transaction, dedup, top, rare
-	OriginalText  string `json:"original_text"`  // Original text of this pipeline stage from the parsed query
+	Index         int      `json:"index"`              // 0-based stage index
+	CommandType   string   `json:"command_type"`       // e.g. "search", "where", "eval", "stats", "generic"
+	IsAggregation bool     `json:"is_aggregation"`     // true for stats, eventstats, streamstats, chart, timechart, transaction, dedup, top, rare
+	OriginalText  string   `json:"original_text"`      // Original text of this pipeline stage from the parsed query
+	Comments      []string `json:"comments,omitempty"` // ```...``` comments in or next to this stage, without delimiters
 }
 
 // aggregationCommands are commands that aggregate multiple events, making them
@@ -1438,6 +2312,7 @@ func classifyPipelineStagesInternal(query string) (result []PipelineStageInfo) {
 
 	stages := tree.AllPipelineStage()
 	infos := make([]PipelineStageInfo, len(stages))
+	comments := stageComments(stream, stages)
 
 	for i, stage := range stages {
 		cmdType := classifyStage(stage)
@@ -1460,6 +2335,7 @@ func classifyPipelineStagesInternal(query string) (result []PipelineStageInfo) {
 			CommandType:   cmdType,
 			IsAggregation: aggregationCommands[cmdType],
 			OriginalText:  originalText,
+			Comments:      comments[i],
 		}
 	}
 
@@ -1467,14 +2343,16 @@ func classifyPipelineStagesInternal(query string) (result []PipelineStageInfo) {
 }
 
 // FirstJoinOrSubsearchStage returns the pipeline stage index of the first
-// join or append command. Returns -1 if no such stage exists.
+// join or append-style command (append, appendcols, appendpipe, union,
+// multisearch). Returns -1 if no such stage exists.
 // This is useful for filtering out conditions that come from after a join,
 // since those fields originate from a different index and aren't available
 // in injected test data.
 func FirstJoinOrSubsearchStage(query string) int {
 	stages := ClassifyPipelineStages(query)
 	for _, s := range stages {
-		if s.CommandType == "join" || s.CommandType == "append" {
+		switch s.CommandType {
+		case "join", "append", "appendcols", "appendpipe", "union", "multisearch":
 			return s.Index
 		}
 	}
@@ -1508,6 +2386,9 @@ func classifyStage(stage IPipelineStageContext) string {
 	if stage.RexCommand() != nil {
 		return "rex"
 	}
+	if stage.RegexCommand() != nil {
+		return "regex"
+	}
 	if stage.DedupCommand() != nil {
 		return "dedup"
 	}
@@ -1535,6 +2416,18 @@ func classifyStage(stage IPipelineStageContext) string {
 	if stage.AppendCommand() != nil {
 		return "append"
 	}
+	if stage.AppendcolsCommand() != nil {
+		return "appendcols"
+	}
+	if stage.AppendpipeCommand() != nil {
+		return "appendpipe"
+	}
+	if stage.UnionCommand() != nil {
+		return "union"
+	}
+	if stage.MultisearchCommand() != nil {
+		return "multisearch"
+	}
 	if stage.TransactionCommand() != nil {
 		return "transaction"
 	}
@@ -1565,6 +2458,15 @@ func classifyStage(stage IPipelineStageContext) string {
 	if stage.FormatCommand() != nil {
 		return "format"
 	}
+	if stage.ReturnCommand() != nil {
+		return "return"
+	}
+	if stage.ForeachCommand() != nil {
+		return "foreach"
+	}
+	if stage.MapCommand() != nil {
+		return "map"
+	}
 	if stage.ConvertCommand() != nil {
 		return "convert"
 	}
@@ -1,6 +1,7 @@
 package spl
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -1345,6 +1346,132 @@ func TestInputlookupCommand_NoWhere(t *testing.T) {
 	// Just verify it parses without errors
 }
 
+// ===== Lookup command tests =====
+
+func TestLookupCommand_Mappings(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		table     string
+		inputs    []LookupField
+		outputs   []LookupField
+		outputNew bool
+		options   map[string]string
+	}{
+		{
+			name:    "AS on input and output",
+			query:   `index=auth | lookup users.csv user AS src_user OUTPUT department AS dept`,
+			table:   "users.csv",
+			inputs:  []LookupField{{TableField: "user", EventField: "src_user"}},
+			outputs: []LookupField{{TableField: "department", EventField: "dept"}},
+		},
+		{
+			name:  "multiple inputs and comma-separated outputs",
+			query: `index=fw | lookup geo_lookup ip as src_ip, zone OUTPUT country, city`,
+			table: "geo_lookup",
+			inputs: []LookupField{
+				{TableField: "ip", EventField: "src_ip"},
+				{TableField: "zone", EventField: "zone"},
+			},
+			outputs: []LookupField{
+				{TableField: "country", EventField: "country"},
+				{TableField: "city", EventField: "city"},
+			},
+		},
+		{
+			name:      "OUTPUTNEW with options",
+			query:     `index=main | lookup local=true update=1 allowlist process OUTPUTNEW approved`,
+			table:     "allowlist",
+			inputs:    []LookupField{{TableField: "process", EventField: "process"}},
+			outputs:   []LookupField{{TableField: "approved", EventField: "approved"}},
+			outputNew: true,
+			options:   map[string]string{"local": "true", "update": "1"},
+		},
+		{
+			name:   "no output clause",
+			query:  `index=main | lookup assets host`,
+			table:  "assets",
+			inputs: []LookupField{{TableField: "host", EventField: "host"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractConditions(tt.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("Unexpected errors: %v", result.Errors)
+			}
+			if len(result.Lookups) != 1 {
+				t.Fatalf("Expected 1 lookup, got %d", len(result.Lookups))
+			}
+			info := result.Lookups[0]
+			if info.Table != tt.table {
+				t.Errorf("Table = %q, want %q", info.Table, tt.table)
+			}
+			if fmt.Sprint(info.Inputs) != fmt.Sprint(tt.inputs) {
+				t.Errorf("Inputs = %v, want %v", info.Inputs, tt.inputs)
+			}
+			if fmt.Sprint(info.Outputs) != fmt.Sprint(tt.outputs) {
+				t.Errorf("Outputs = %v, want %v", info.Outputs, tt.outputs)
+			}
+			if info.OutputNew != tt.outputNew {
+				t.Errorf("OutputNew = %v, want %v", info.OutputNew, tt.outputNew)
+			}
+			for k, v := range tt.options {
+				if info.Options[k] != v {
+					t.Errorf("Options[%s] = %q, want %q", k, info.Options[k], v)
+				}
+			}
+			if info.PipeStage != 1 {
+				t.Errorf("PipeStage = %d, want 1", info.PipeStage)
+			}
+		})
+	}
+}
+
+func TestLookupCommand_OutputsAreComputed(t *testing.T) {
+	query := `index=auth action=success | lookup allowlist.csv user AS src_user OUTPUT approved AS is_approved | where is_approved="false"`
+	result := ExtractConditions(query)
+	if len(result.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", result.Errors)
+	}
+
+	if got := result.ComputedFields["is_approved"]; got != LookupSourceMarker("allowlist.csv") {
+		t.Errorf("ComputedFields[is_approved] = %q, want %q", got, LookupSourceMarker("allowlist.csv"))
+	}
+	if _, ok := result.ComputedFields["src_user"]; ok {
+		t.Error("Lookup input field src_user should not be computed")
+	}
+
+	found := false
+	for _, c := range result.Conditions {
+		if c.Field == "is_approved" {
+			found = true
+			if !c.IsComputed || c.SourceField != "_lookup:allowlist.csv" {
+				t.Errorf("is_approved condition should be computed from the lookup, got %+v", c)
+			}
+		}
+		if c.Field == "action" && c.IsComputed {
+			t.Errorf("action should be a raw field, got %+v", c)
+		}
+	}
+	if !found {
+		t.Error("Expected condition on is_approved")
+	}
+}
+
+func TestLookupCommand_OutputKeywordAsField(t *testing.T) {
+	// OUTPUT is a lookup keyword but must remain usable as a field name elsewhere
+	query := `index=main | eval output=1 | stats count by output`
+	result := ExtractConditions(query)
+	if len(result.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", result.Errors)
+	}
+	if _, ok := result.ComputedFields["output"]; !ok {
+		t.Error("Expected eval to compute field 'output'")
+	}
+}
+
 // ===== REST_PATH fix test =====
 
 func TestEvalDivision_NoRestPathConflict(t *testing.T) {
@@ -1398,8 +1525,98 @@ func TestClassifyPipelineStages_Inputlookup(t *testing.T) {
 	}
 }
 
+func TestClassifyPipelineStages_Comments(t *testing.T) {
+	query := "```rule: brute force``` index=auth ```tuning: exclude svc accounts``` user!=svc_* | " +
+		"```suppress: 2024-01``` stats count ```per user\nand host``` by user host ```stage 1 end``` | where count>10 ```unterminated"
+	stages := ClassifyPipelineStages(query)
+	if len(stages) != 3 {
+		t.Fatalf("Expected 3 stages, got %d", len(stages))
+	}
+	want := [][]string{
+		{"rule: brute force", "tuning: exclude svc accounts"},
+		{"suppress: 2024-01", "per user\nand host", "stage 1 end"},
+		{"unterminated"},
+	}
+	for i, stage := range stages {
+		if fmt.Sprintf("%q", stage.Comments) != fmt.Sprintf("%q", want[i]) {
+			t.Errorf("stage %d comments = %q, want %q", i, stage.Comments, want[i])
+		}
+	}
+	if stages[1].CommandType != "stats" {
+		t.Errorf("Expected stage 1 to be stats, got %q", stages[1].CommandType)
+	}
+
+	// Comments do not change what is extracted
+	result := ExtractConditions(query)
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	if len(result.Conditions) != 2 || len(result.Thresholds) != 1 {
+		t.Errorf("Expected 2 conditions and a threshold, got %+v %+v", result.Conditions, result.Thresholds)
+	}
+}
+
+func TestClassifyPipelineStages_CommentNextToMacro(t *testing.T) {
+	stages := ClassifyPipelineStages("`security_logs` ```excludes test hosts``` | table host")
+	if len(stages) != 2 || len(stages[0].Comments) != 1 || stages[0].Comments[0] != "excludes test hosts" {
+		t.Errorf("stages = %+v", stages)
+	}
+}
+
 // ===== Production readiness: hyphenated fields, curly braces, template vars =====
 
+func TestExtractConditions_SingleQuotedFields(t *testing.T) {
+	result := ExtractConditions(`index=wineventlog user='bob' | eval account=lower('Account Name') | where 'Account Name'="admin" AND user='other_field'`)
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	if len(result.Conditions) != 4 {
+		t.Fatalf("Expected 4 conditions, got %+v", result.Conditions)
+	}
+
+	// In the base search, single quotes are part of a literal value
+	if c := result.Conditions[1]; c.Field != "user" || c.Value != "bob" || c.ValueField != "" {
+		t.Errorf("search condition = %+v, want user=bob", c)
+	}
+	if c := result.Conditions[2]; c.Field != "Account Name" || c.Value != "admin" {
+		t.Errorf("where condition = %+v, want Account Name=admin", c)
+	}
+	if c := result.Conditions[3]; c.Field != "user" || c.Value != "" || c.ValueField != "other_field" {
+		t.Errorf("where condition = %+v, want user compared with field other_field", c)
+	}
+	if src := result.ComputedFields["account"]; src != "Account Name" {
+		t.Errorf("ComputedFields[account] = %q, want Account Name", src)
+	}
+}
+
+func TestExtractConditions_SingleQuotedFunctionArgs(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		field string
+	}{
+		{"match", `index=a | where match('cmd line', "enc")`, "cmd line"},
+		{"like", `index=a | where like('cmd line', "%enc%")`, "cmd line"},
+		{"cidrmatch", `index=a | where cidrmatch("10.0.0.0/8", 'src ip')`, "src ip"},
+		{"isnull", `index=a | where isnull('cmd line')`, "cmd line"},
+		{"isnotnull", `index=a | where isnotnull('cmd line')`, "cmd line"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractConditions(tt.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("unexpected errors: %v", result.Errors)
+			}
+			for _, c := range result.Conditions {
+				if c.Field == tt.field {
+					return
+				}
+			}
+			t.Errorf("no condition on %q in %+v", tt.field, result.Conditions)
+		})
+	}
+}
+
 func TestExtractConditions_HyphenatedFieldNames(t *testing.T) {
 	// CIM fields like c-uri, cs-user-agent, sc-status are common in proxy/IIS logs
 	query := `index=proxy c-uri="*/malware/*" cs-user-agent="*bot*" sc-status=403`
@@ -1546,3 +1763,220 @@ func TestExtractConditions_NumericFieldName(t *testing.T) {
 		t.Error("Expected to find 'Image' condition")
 	}
 }
+
+func TestRegexCommand_Conditions(t *testing.T) {
+	query := `index=web | regex user_agent="(?i)(nikto|sqlmap)" | regex uri!="^/health" | regex "Failed password"`
+	result := ExtractConditions(query)
+	if len(result.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", result.Errors)
+	}
+
+	want := []Condition{
+		{Field: "user_agent", Operator: "matches", Value: "(?i)(nikto|sqlmap)", PipeStage: 1},
+		{Field: "uri", Operator: "matches", Value: "^/health", Negated: true, PipeStage: 2},
+		{Field: "_raw", Operator: "matches", Value: "Failed password", PipeStage: 3},
+	}
+	for _, w := range want {
+		found := false
+		for _, c := range result.Conditions {
+			if c.Field == w.Field && c.Operator == w.Operator && c.Value == w.Value && c.Negated == w.Negated && c.PipeStage == w.PipeStage {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected condition %s %s %q (negated=%v, stage %d), got %+v", w.Field, w.Operator, w.Value, w.Negated, w.PipeStage, result.Conditions)
+		}
+	}
+
+	stages := ClassifyPipelineStages(query)
+	if len(stages) != 4 || stages[1].CommandType != "regex" {
+		t.Errorf("Expected stage 1 to be classified as regex, got %+v", stages)
+	}
+}
+
+func TestRexSedMode_ModifiesField(t *testing.T) {
+	query := `index=pci | rex field=ccnumber mode=sed "s/(\\d{4}-){3}/XXXX-XXXX-XXXX-/g" | rex mode=sed "y/abc/xyz/" | search ccnumber="XXXX*"`
+	result := ExtractConditions(query)
+	if len(result.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", result.Errors)
+	}
+	if len(result.SedOperations) != 2 {
+		t.Fatalf("Expected 2 sed operations, got %+v", result.SedOperations)
+	}
+
+	sub := result.SedOperations[0]
+	if sub.Kind != SedSubstitute || sub.Field != "ccnumber" || sub.Pattern != `(\d{4}-){3}` ||
+		sub.Replacement != "XXXX-XXXX-XXXX-" || !sub.Global || sub.PipeStage != 1 {
+		t.Errorf("Unexpected substitute operation %+v", sub)
+	}
+	if tr := result.SedOperations[1]; tr.Kind != SedTransliterate || tr.Field != "_raw" || tr.Pattern != "abc" || tr.Replacement != "xyz" {
+		t.Errorf("Unexpected transliterate operation %+v", tr)
+	}
+
+	for _, c := range result.Conditions {
+		if c.Field == "ccnumber" && (!c.IsComputed || c.SourceField != "ccnumber") {
+			t.Errorf("ccnumber is rewritten by sed and should be computed from itself, got %+v", c)
+		}
+	}
+	if _, ok := result.ComputedFields["xxxx"]; ok {
+		t.Error("sed expressions must not be treated as extraction regexes")
+	}
+}
+
+func TestRexSedMode_PCREOnlyPattern(t *testing.T) {
+	result := ExtractConditions(`index=main | rex field=user mode=sed "s/(?<=user=)\w+/redacted/"`)
+	if len(result.Errors) > 0 {
+		t.Errorf("A PCRE-only sed pattern is valid in Splunk, got errors %v", result.Errors)
+	}
+	if len(result.SedOperations) != 1 || !result.SedOperations[0].PCREOnly {
+		t.Errorf("Expected a PCREOnly sed operation, got %+v", result.SedOperations)
+	}
+}
+
+func TestRexSedMode_InvalidExpression(t *testing.T) {
+	result := ExtractConditions(`index=main | rex field=user mode=sed "y/abc/xy/"`)
+	if len(result.Errors) == 0 {
+		t.Error("Expected an error for a y expression with unequal lengths")
+	}
+}
+
+func TestSubsearchFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		fields  []string // Outer condition fields
+		command string
+		negated bool
+	}{
+		{"fields", `index=edr [| inputlookup bad_hashes.csv | fields hash] | stats count by host`, []string{"hash"}, "fields", false},
+		{"table with dedup", `index=proxy NOT [search index=allow | table src_ip, dest | dedup src_ip]`, []string{"src_ip", "dest"}, "table", true},
+		{"return alias", `index=auth [search index=hr status=terminated | return 100 user=employee_id]`, []string{"user"}, "return", false},
+		{"return value", `index=fw [| inputlookup iocs.csv | return 10 $ioc]`, []string{"_raw"}, "return", false},
+		{"format", `index=dns [| inputlookup domains.csv | fields query | format maxresults=500]`, []string{"query"}, "format", false},
+		{"field IN", `index=edr process_hash IN [| inputlookup bad_hashes.csv | fields hash]`, []string{"process_hash"}, "fields", false},
+		{"where", `index=web | where NOT [| inputlookup allowed.csv | fields uri]`, []string{"uri"}, "fields", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ExtractConditions(tt.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("Unexpected errors: %v", result.Errors)
+			}
+			var fields []string
+			var filter *SubsearchFilter
+			for _, c := range result.Conditions {
+				if c.Subsearch == nil {
+					continue
+				}
+				fields = append(fields, c.Field)
+				filter = c.Subsearch
+				if c.Operator != "subsearch" || c.Negated != tt.negated {
+					t.Errorf("Unexpected condition %+v", c)
+				}
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.fields, ",") {
+				t.Fatalf("Subsearch condition fields = %v, want %v", fields, tt.fields)
+			}
+			if filter.Command != tt.command || filter.Subsearch == nil {
+				t.Errorf("Unexpected filter %+v", filter)
+			}
+		})
+	}
+}
+
+func TestSubsearchFilter_ParsedSubsearch(t *testing.T) {
+	result := ExtractConditions(`index=auth action=success [search index=auth action=failure | stats count AS failures by user | where failures > 10 | fields user]`)
+	var filter *SubsearchFilter
+	for _, c := range result.Conditions {
+		if c.Subsearch != nil {
+			filter = c.Subsearch
+		} else if c.Field == "failures" {
+			t.Errorf("Subsearch condition leaked into the outer search: %+v", c)
+		}
+	}
+	if filter == nil {
+		t.Fatal("Expected a subsearch filter")
+	}
+	if filter.PipeStage != 0 || strings.Join(filter.Fields, ",") != "user" {
+		t.Errorf("Unexpected filter %+v", filter)
+	}
+	var inner []string
+	for _, c := range filter.Subsearch.Conditions {
+		inner = append(inner, c.Field+c.Operator+c.Value)
+	}
+	if strings.Join(inner, " ") != "index=auth action=failure failures>10" {
+		t.Errorf("Subsearch conditions = %v", inner)
+	}
+
+	// Subsearches without an output command keep their rows' fields unknown
+	result = ExtractConditions(`index=auth [search index=hr | stats count by user]`)
+	for _, c := range result.Conditions {
+		if c.Subsearch != nil {
+			t.Errorf("Expected no subsearch filter, got %+v", c)
+		}
+	}
+}
+
+func TestExtractConditions_UnparsedInput(t *testing.T) {
+	// Input the parser stops at is reported instead of silently dropped
+	result := ExtractConditions(`index=main user=admin ) | delete`)
+	if len(result.Errors) == 0 || !strings.Contains(result.Errors[0], `unexpected ")"`) {
+		t.Errorf("Errors = %v, want the unparsed input reported", result.Errors)
+	}
+
+	// Commands whose arguments used to end the query early parse to the end
+	for _, query := range []string{
+		`index=web | top limit=10 countfield=requests showperc=f uri by status | stats count`,
+		`index=a | bin _time span=1m as minute | stats count by minute`,
+		`index=a | bucket span=12h _time | stats count`,
+		`index=a | rename a as b c as d | table b d`,
+		`index=a | streamstats window=10 avg(value) as avg current=f by host | stats count`,
+		`index=a | accum revenue as total | autoregress value as prev p=1 | stats count`,
+		`index=a | transaction host startswith=EventCode=4800 endswith=EventCode=4801 | stats count`,
+		`| rest splunk_server=splunk-idx01 /services/admin/inputstatus/ModularInputs:modular%20input | table title search`,
+		`index=a | fields inputs* | convert ctime("12 hour blocks") | sort - "12 hour blocks"`,
+	} {
+		result := ExtractConditions(query)
+		if len(result.Errors) > 0 {
+			t.Errorf("%s: unexpected errors: %v", query, result.Errors)
+		}
+	}
+}
+
+func TestCommandKeywordsAsValues(t *testing.T) {
+	tests := []struct {
+		query string
+		field string
+		value string
+	}{
+		{`index=main action=output`, "action", "output"},
+		{`index=main mode=OUTPUTNEW user=x`, "mode", "OUTPUTNEW"},
+		{`index=main process=regex`, "process", "regex"},
+		{`index=main cmd=return user=x`, "cmd", "return"},
+		{`index=main x=union host=y`, "x", "union"},
+		{`index=main x=appendpipe`, "x", "appendpipe"},
+		{`index=main | eval union=1 | where union=1`, "union", "1"},
+		{`index=main multisearch=2 | stats count by multisearch`, "multisearch", "2"},
+		{`index=main type=map`, "type", "map"},
+		{`index=main loop=foreach user=x`, "loop", "foreach"},
+	}
+	for _, tt := range tests {
+		result := ExtractConditions(tt.query)
+		if len(result.Errors) > 0 {
+			t.Errorf("%s: unexpected errors: %v", tt.query, result.Errors)
+			continue
+		}
+		found := false
+		for _, c := range result.Conditions {
+			if c.Field == tt.field {
+				found = true
+				if c.Value != tt.value {
+					t.Errorf("%s: %s = %q, want %q", tt.query, tt.field, c.Value, tt.value)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s: no condition on %s in %+v", tt.query, tt.field, result.Conditions)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package spl
+
+import (
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// FieldOrigin describes how a field comes to exist on an event at search time
+type FieldOrigin string
+
+const (
+	FieldOriginRaw        FieldOrigin = "raw"        // Indexed or automatically extracted key=value field
+	FieldOriginAlias      FieldOrigin = "alias"      // FIELDALIAS-* in props.conf, or rename in the query
+	FieldOriginCalculated FieldOrigin = "calculated" // EVAL-* in props.conf
+	FieldOriginExtracted  FieldOrigin = "extracted"  // EXTRACT-*/REPORT-* regex extraction
+	FieldOriginLookup     FieldOrigin = "lookup"     // LOOKUP-* automatic lookup output
+	FieldOriginComputed   FieldOrigin = "computed"   // Created by the query itself (eval, rex, stats, lookup)
+)
+
+// FieldDefinition is a search-time field defined by props.conf/transforms.conf
+type FieldDefinition struct {
+	Field      string      `json:"field"`
+	Origin     FieldOrigin `json:"origin"`
+	Source     string      `json:"source"`               // Aliased field, field the value is derived from, or lookup marker
+	Class      string      `json:"class"`                // Defining props.conf setting, e.g. FIELDALIAS-user
+	Definition string      `json:"definition,omitempty"` // Expression, regex or lookup text
+}
+
+// FieldKnowledge holds the props.conf and transforms.conf settings that
+// create search-time fields, keyed by stanza name
+type FieldKnowledge struct {
+	Props      map[string]map[string]string
+	Transforms map[string]map[string]string
+}
+
+// LoadFieldKnowledge reads props.conf and (optionally, may be nil) transforms.conf
+func LoadFieldKnowledge(props, transforms io.Reader) (*FieldKnowledge, error) {
+	k := &FieldKnowledge{
+		Props:      make(map[string]map[string]string),
+		Transforms: make(map[string]map[string]string),
+	}
+	_, stanzas, err := readConf(props, "props.conf")
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stanzas {
+		k.Props[s.name] = s.settings
+	}
+	if transforms != nil {
+		_, stanzas, err := readConf(transforms, "transforms.conf")
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range stanzas {
+			k.Transforms[s.name] = s.settings
+		}
+	}
+	return k, nil
+}
+
+// searchTimeOrder is the order Splunk applies search-time operations in; a
+// field defined by several classes ends up with the last one's value.
+var searchTimeOrder = []string{"EXTRACT-", "REPORT-", "FIELDALIAS-", "EVAL-", "LOOKUP-"}
+
+// Fields returns the search-time fields defined for a sourcetype, keyed by
+// lowercase field name. Definitions that cannot be parsed are skipped.
+func (k *FieldKnowledge) Fields(sourcetype string) map[string]FieldDefinition {
+	defs := make(map[string]FieldDefinition)
+	settings := k.Props[sourcetype]
+	if settings == nil {
+		return defs
+	}
+
+	classes := make([]string, 0, len(settings))
+	for class := range settings {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes) // Splunk applies classes of the same type in lexical order
+
+	add := func(def FieldDefinition) {
+		defs[strings.ToLower(def.Field)] = def
+	}
+	for _, prefix := range searchTimeOrder {
+		for _, class := range classes {
+			if !strings.HasPrefix(strings.ToUpper(class), prefix) {
+				continue
+			}
+			value := settings[class]
+			switch prefix {
+			case "EXTRACT-":
+				for _, def := range extractionFields(class, value) {
+					add(def)
+				}
+			case "REPORT-":
+				for _, name := range strings.Split(value, ",") {
+					for _, def := range k.transformFields(class, strings.TrimSpace(name)) {
+						add(def)
+					}
+				}
+			case "FIELDALIAS-":
+				for _, def := range aliasFields(class, value) {
+					add(def)
+				}
+			case "EVAL-":
+				add(calculatedField(class, value))
+			case "LOOKUP-":
+				for _, def := range lookupFields(class, value) {
+					add(def)
+				}
+			}
+		}
+	}
+	return defs
+}
+
+// extractionFields reads EXTRACT-<class> = <regex> [in <field>]
+func extractionFields(class, value string) []FieldDefinition {
+	pattern, source := value, "_raw"
+	if m := extractInFieldPattern.FindStringSubmatch(value); m != nil {
+		pattern, source = m[1], m[2]
+	}
+	var defs []FieldDefinition
+	for _, name := range extractNamedCaptureGroups(pattern) {
+		defs = append(defs, FieldDefinition{Field: name, Origin: FieldOriginExtracted, Source: source, Class: class, Definition: pattern})
+	}
+	return defs
+}
+
+// extractInFieldPattern splits "<regex> in <field>" in EXTRACT- settings
+var extractInFieldPattern = regexp.MustCompile(`^(.*\S)\s+in\s+([A-Za-z_][\w.]*)$`)
+
+// formatFieldPattern matches field::$N pairs in a transforms.conf FORMAT
+var formatFieldPattern = regexp.MustCompile(`([A-Za-z_][\w.]*)::`)
+
+// transformFields reads the fields a REPORT- transform extracts: named groups
+// in REGEX, fields named in FORMAT, and DELIMS-based FIELDS
+func (k *FieldKnowledge) transformFields(class, name string) []FieldDefinition {
+	t := k.Transforms[name]
+	if t == nil {
+		return nil
+	}
+	source := t["SOURCE_KEY"]
+	if source == "" {
+		source = "_raw"
+	}
+	names := extractNamedCaptureGroups(t["REGEX"])
+	for _, m := range formatFieldPattern.FindAllStringSubmatch(t["FORMAT"], -1) {
+		names = append(names, m[1])
+	}
+	if fields := t["FIELDS"]; fields != "" {
+		for _, f := range strings.Split(fields, ",") {
+			names = append(names, strings.Trim(strings.TrimSpace(f), `"`))
+		}
+	}
+
+	var defs []FieldDefinition
+	for _, n := range names {
+		if n != "" {
+			defs = append(defs, FieldDefinition{Field: n, Origin: FieldOriginExtracted, Source: source, Class: class, Definition: name})
+		}
+	}
+	return defs
+}
+
+// aliasFields reads FIELDALIAS-<class> = <orig> AS <alias> [<orig> ASNEW <alias> ...]
+func aliasFields(class, value string) []FieldDefinition {
+	tokens := strings.Fields(value)
+	var defs []FieldDefinition
+	for i := 0; i+2 < len(tokens); i++ {
+		op := strings.ToUpper(tokens[i+1])
+		if op != "AS" && op != "ASNEW" {
+			continue
+		}
+		orig, alias := strings.Trim(tokens[i], `"`), strings.Trim(tokens[i+2], `"`)
+		defs = append(defs, FieldDefinition{Field: alias, Origin: FieldOriginAlias, Source: orig, Class: class, Definition: value})
+		i += 2
+	}
+	return defs
+}
+
+// calculatedField reads EVAL-<field> = <expression>
+func calculatedField(class, value string) FieldDefinition {
+	def := FieldDefinition{Field: class[len("EVAL-"):], Origin: FieldOriginCalculated, Class: class, Definition: value}
+	if tree, err := parseSPLRule(value, func(p *SPLParser) antlr.ParserRuleContext { return p.Expression() }); err == nil {
+		def.Source = extractFirstFieldFromExpression(tree.(IExpressionContext))
+	}
+	if def.Source == "" {
+		def.Source = "_calculated"
+	}
+	return def
+}
+
+// lookupFields reads LOOKUP-<class> = <lookup> <in> [AS <field>] OUTPUT[NEW] <out> [AS <field>]
+func lookupFields(class, value string) []FieldDefinition {
+	tree, err := parseSPLRule("lookup "+value, func(p *SPLParser) antlr.ParserRuleContext { return p.LookupCommand() })
+	if err != nil {
+		return nil
+	}
+	info := newLookupInfo(tree.(ILookupCommandContext))
+	var defs []FieldDefinition
+	for _, out := range info.Outputs {
+		defs = append(defs, FieldDefinition{
+			Field: out.EventField, Origin: FieldOriginLookup, Source: LookupSourceMarker(info.Table),
+			Class: class, Definition: value,
+		})
+	}
+	return defs
+}
+
+// Annotate marks conditions on search-time fields as computed, with the
+// field they derive from as SourceField, and records each field's origin in
+// result.FieldOrigins. The sourcetypes default to the query's sourcetype=
+// conditions. Fields the query computes itself keep their in-query source.
+func (k *FieldKnowledge) Annotate(result *ParseResult, sourcetypes ...string) {
+	if len(sourcetypes) == 0 {
+		sourcetypes = querySourcetypes(result)
+	}
+	defs := make(map[string]FieldDefinition)
+	for _, st := range sourcetypes {
+		for name, def := range k.Fields(st) {
+			defs[name] = def
+		}
+	}
+	if len(defs) == 0 {
+		return
+	}
+
+	if result.FieldOrigins == nil {
+		result.FieldOrigins = make(map[string]FieldOrigin)
+	}
+	for name, def := range defs {
+		result.FieldOrigins[name] = def.Origin
+	}
+	for i := range result.Conditions {
+		c := &result.Conditions[i]
+		if def, ok := defs[strings.ToLower(c.Field)]; ok && !c.IsComputed {
+			c.IsComputed = true
+			c.SourceField = def.Source
+		}
+	}
+}
+
+// querySourcetypes returns the values of non-negated sourcetype= conditions
+func querySourcetypes(result *ParseResult) []string {
+	var sourcetypes []string
+	for _, c := range result.Conditions {
+		if strings.EqualFold(c.Field, "sourcetype") && c.Operator == "=" && !c.Negated {
+			sourcetypes = append(sourcetypes, c.Value)
+			sourcetypes = append(sourcetypes, c.Alternatives...)
+		}
+	}
+	return sourcetypes
+}
+
+// ClassifyFieldOrigin reports how a field referenced by the query is
+// produced: by the query itself, by props.conf (once FieldKnowledge.Annotate
+// has run), or as a raw event field.
+func ClassifyFieldOrigin(result *ParseResult, field string) FieldOrigin {
+	if result == nil {
+		return FieldOriginRaw
+	}
+	fieldLower := strings.ToLower(field)
+	if _, ok := result.ComputedFields[fieldLower]; ok {
+		return FieldOriginComputed
+	}
+	for alias := range result.FieldAliases {
+		if strings.ToLower(alias) == fieldLower {
+			return FieldOriginAlias
+		}
+	}
+	if origin, ok := result.FieldOrigins[fieldLower]; ok {
+		return origin
+	}
+	return FieldOriginRaw
+}
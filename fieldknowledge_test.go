@@ -0,0 +1,133 @@
+package spl
+
+import (
+	"strings"
+	"testing"
+)
+
+const testPropsConf = `[WinEventLog:Security]
+FIELDALIAS-user = Account_Name AS user TargetUserName ASNEW user
+FIELDALIAS-src = Source_Network_Address AS src
+EVAL-action = case(EventCode=4624, "success", EventCode=4625, "failure")
+EVAL-vendor_product = "Microsoft Windows"
+EXTRACT-logon = Logon Type:\s+(?<Logon_Type>\d+)
+EXTRACT-proc = (?<process_name>[^\\]+)$ in New_Process_Name
+REPORT-kv = win_kv, win_dest
+LOOKUP-assets = asset_lookup ip AS src OUTPUTNEW owner AS src_owner priority
+`
+
+const testTransformsConf = `[win_kv]
+REGEX = (\w+)=(\S+)
+FORMAT = $1::$2
+
+[win_dest]
+SOURCE_KEY = Message
+REGEX = Workstation Name:\s+(\S+)
+FORMAT = dest::$1
+
+[asset_lookup]
+filename = assets.csv
+`
+
+func loadTestKnowledge(t *testing.T) *FieldKnowledge {
+	t.Helper()
+	k, err := LoadFieldKnowledge(strings.NewReader(testPropsConf), strings.NewReader(testTransformsConf))
+	if err != nil {
+		t.Fatalf("LoadFieldKnowledge error: %v", err)
+	}
+	return k
+}
+
+func TestFieldKnowledge_Fields(t *testing.T) {
+	fields := loadTestKnowledge(t).Fields("WinEventLog:Security")
+
+	tests := []struct {
+		field  string
+		origin FieldOrigin
+		source string
+	}{
+		{"user", FieldOriginAlias, "TargetUserName"},
+		{"src", FieldOriginAlias, "Source_Network_Address"},
+		{"action", FieldOriginCalculated, "EventCode"},
+		{"vendor_product", FieldOriginCalculated, "_calculated"},
+		{"Logon_Type", FieldOriginExtracted, "_raw"},
+		{"process_name", FieldOriginExtracted, "New_Process_Name"},
+		{"dest", FieldOriginExtracted, "Message"},
+		{"src_owner", FieldOriginLookup, "_lookup:asset_lookup"},
+		{"priority", FieldOriginLookup, "_lookup:asset_lookup"},
+	}
+	for _, tt := range tests {
+		def, ok := fields[strings.ToLower(tt.field)]
+		if !ok {
+			t.Errorf("Expected a definition for %s", tt.field)
+			continue
+		}
+		if def.Origin != tt.origin || def.Source != tt.source {
+			t.Errorf("%s = %s from %q, want %s from %q", tt.field, def.Origin, def.Source, tt.origin, tt.source)
+		}
+	}
+	if _, ok := fields["eventcode"]; ok {
+		t.Error("EventCode is a raw field and should have no definition")
+	}
+	if len(loadTestKnowledge(t).Fields("linux_secure")) != 0 {
+		t.Error("Expected no definitions for an unknown sourcetype")
+	}
+}
+
+func TestFieldKnowledge_Annotate(t *testing.T) {
+	k := loadTestKnowledge(t)
+	query := `index=wineventlog sourcetype="WinEventLog:Security" EventCode=4625 user=admin* action=failure | eval tag="x" | where tag="x"`
+	result := ExtractConditions(query)
+	k.Annotate(result)
+
+	for _, c := range result.Conditions {
+		switch c.Field {
+		case "user":
+			if !c.IsComputed || c.SourceField != "TargetUserName" {
+				t.Errorf("user should be an alias of TargetUserName, got %+v", c)
+			}
+		case "action":
+			if !c.IsComputed || c.SourceField != "EventCode" {
+				t.Errorf("action should be calculated from EventCode, got %+v", c)
+			}
+		case "EventCode":
+			if c.IsComputed {
+				t.Errorf("EventCode should stay raw, got %+v", c)
+			}
+		case "tag":
+			if !c.IsComputed || c.SourceField == "_calculated" {
+				t.Errorf("tag should keep its in-query eval source, got %+v", c)
+			}
+		}
+	}
+
+	origins := map[string]FieldOrigin{
+		"user":      FieldOriginAlias,
+		"action":    FieldOriginCalculated,
+		"dest":      FieldOriginExtracted,
+		"src_owner": FieldOriginLookup,
+		"tag":       FieldOriginComputed,
+		"EventCode": FieldOriginRaw,
+	}
+	for field, want := range origins {
+		if got := ClassifyFieldOrigin(result, field); got != want {
+			t.Errorf("ClassifyFieldOrigin(%s) = %s, want %s", field, got, want)
+		}
+	}
+}
+
+func TestFieldKnowledge_AnnotateExplicitSourcetypeAndJoins(t *testing.T) {
+	k := loadTestKnowledge(t)
+	result := ExtractConditions(`index=wineventlog src="10.*" | join type=left user [search index=hr | table user, manager]`)
+	k.Annotate(result, "WinEventLog:Security")
+
+	if got := ClassifyFieldProvenance(result, "src"); got != ProvenanceMain {
+		t.Errorf("ClassifyFieldProvenance(src) = %s, want %s", got, ProvenanceMain)
+	}
+	if got := ClassifyFieldProvenance(result, "dest"); got != ProvenanceMain {
+		t.Errorf("ClassifyFieldProvenance(dest) = %s, want %s (search-time field of the main search)", got, ProvenanceMain)
+	}
+	if got := ClassifyFieldProvenance(result, "manager"); got != ProvenanceJoined {
+		t.Errorf("ClassifyFieldProvenance(manager) = %s, want %s", got, ProvenanceJoined)
+	}
+}
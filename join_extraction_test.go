@@ -117,6 +117,52 @@ var joinTestCases = []JoinTestCase{
 			},
 		}},
 	},
+	{
+		Name:  "appendcols with override",
+		Query: `index=web | stats count by host | appendcols override=true [search index=web status=500 | stats count AS errors]`,
+		ExpectedJoins: []ExpectedJoin{{
+			Type:     "appendcols",
+			IsAppend: true,
+			SubsearchConditions: []ExpectedCondition{
+				{Field: "status", Operator: "=", Value: "500"},
+			},
+		}},
+	},
+	{
+		Name:  "appendpipe subpipeline",
+		Query: `index=web | stats count by host | appendpipe [stats sum(count) AS count | eval host="TOTAL"]`,
+		ExpectedJoins: []ExpectedJoin{{
+			Type:     "appendpipe",
+			IsAppend: true,
+		}},
+	},
+	{
+		Name:  "union of subsearches and a named dataset",
+		Query: `| union maxout=100 [search index=fw action=blocked] [search index=proxy action=denied] saved_threats`,
+		ExpectedJoins: []ExpectedJoin{{
+			Type:     "union",
+			IsAppend: true,
+			BranchConditions: [][]ExpectedCondition{
+				{{Field: "action", Operator: "=", Value: "blocked"}},
+				{{Field: "action", Operator: "=", Value: "denied"}},
+			},
+		}},
+	},
+	{
+		Name:  "multisearch brute force success after failures",
+		Query: `| multisearch [search index=auth action=failure | eval stage="fail"] [search index=auth action=success | eval stage="ok"] | stats dc(stage) AS stages by user | where stages=2`,
+		ExpectedJoins: []ExpectedJoin{{
+			Type:     "multisearch",
+			IsAppend: true,
+			BranchConditions: [][]ExpectedCondition{
+				{{Field: "action", Operator: "=", Value: "failure"}},
+				{{Field: "action", Operator: "=", Value: "success"}},
+			},
+		}},
+		Provenance: map[string]FieldProvenance{
+			"stage": ProvenanceJoined,
+		},
+	},
 }
 
 func TestJoinExtraction_TableDriven(t *testing.T) {
@@ -171,6 +217,17 @@ func TestJoinExtraction_TableDriven(t *testing.T) {
 				} else if len(expJoin.SubsearchConditions) > 0 {
 					t.Errorf("Join[%d]: expected subsearch conditions but Subsearch is nil", i)
 				}
+
+				// Verify branch conditions
+				if len(j.Branches) != len(expJoin.BranchConditions) {
+					t.Errorf("Join[%d]: expected %d branches, got %d", i, len(expJoin.BranchConditions), len(j.Branches))
+				} else {
+					for k, expConds := range expJoin.BranchConditions {
+						for _, expCond := range expConds {
+							assertHasCondition(t, j.Branches[k].Conditions, expCond)
+						}
+					}
+				}
 			}
 
 			// Verify provenance
@@ -222,3 +279,27 @@ func TestJoinExtraction_ExistingTestStillPasses(t *testing.T) {
 		}
 	}
 }
+
+func TestJoinExtraction_AppendVariants(t *testing.T) {
+	result := ExtractConditions(`index=web | stats count by host | appendcols override=true [search index=web status=500 | stats count AS errors by host]`)
+	if len(result.Joins) != 1 || result.Joins[0].Options["override"] != "true" {
+		t.Fatalf("Expected appendcols options, got %+v", result.Joins)
+	}
+	if ClassifyFieldProvenance(result, "errors") != ProvenanceJoined {
+		t.Errorf("Expected errors to come from appendcols, exposed %v", result.Joins[0].ExposedFields)
+	}
+
+	result = ExtractConditions(`| union [search index=fw] saved_threats "datamodel:Network_Traffic"`)
+	if len(result.Joins) != 1 || strings.Join(result.Joins[0].Datasets, ",") != "saved_threats,datamodel:Network_Traffic" {
+		t.Fatalf("Expected named union datasets, got %+v", result.Joins)
+	}
+	if j := result.Joins[0]; j.Subsearch != nil || len(j.Branches) != 1 {
+		t.Errorf("A single subsearch dataset should be the only branch, got %+v", j)
+	} else {
+		assertHasCondition(t, j.Branches[0].Conditions, ExpectedCondition{Field: "index", Value: "fw"})
+	}
+
+	if got := FirstJoinOrSubsearchStage(`index=web | appendpipe [stats count] | table count`); got != 1 {
+		t.Errorf("FirstJoinOrSubsearchStage = %d, want 1", got)
+	}
+}
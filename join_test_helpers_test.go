@@ -17,6 +17,7 @@ type ExpectedJoin struct {
 	Type                string
 	JoinFields          []string
 	SubsearchConditions []ExpectedCondition
+	BranchConditions    [][]ExpectedCondition // union/multisearch: conditions per branch
 	IsAppend            bool
 }
 
@@ -0,0 +1,503 @@
+package spl
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+)
+
+// RegexIssueKind classifies a problem found in an embedded regular expression
+type RegexIssueKind string
+
+const (
+	RegexInvalid          RegexIssueKind = "invalid"                   // Pattern does not compile; the search would fail or match nothing
+	RegexInvalidGroupName RegexIssueKind = "invalid_group_name"        // Capture group name is not a valid field name
+	RegexBacktracking     RegexIssueKind = "catastrophic_backtracking" // Nested unbounded quantifiers such as (a+)+
+	RegexUnescapedDot     RegexIssueKind = "unescaped_dot"             // evil.com matches evilXcom too
+	RegexPCREOnly         RegexIssueKind = "pcre_only"                 // Lookaround, backreferences, etc. have no RE2 equivalent
+)
+
+// RegexIssue is one finding of AnalyzeRegex. Position is the byte offset in
+// the pattern, or -1 when unknown.
+type RegexIssue struct {
+	Kind     RegexIssueKind `json:"kind"`
+	Message  string         `json:"message"`
+	Position int            `json:"position"`
+}
+
+// IsError reports whether the issue stops the regex from working in Splunk
+func (i RegexIssue) IsError() bool {
+	return i.Kind == RegexInvalid || i.Kind == RegexInvalidGroupName
+}
+
+// RegexAnalysis is the result of AnalyzeRegex
+type RegexAnalysis struct {
+	Pattern       string       `json:"pattern"`
+	GoPattern     string       `json:"go_pattern,omitempty"`     // Equivalent RE2 pattern, empty if PCRE-only features are used or it is invalid
+	CaptureGroups []string     `json:"capture_groups,omitempty"` // Named groups, including those inside lookarounds
+	Issues        []RegexIssue `json:"issues,omitempty"`
+}
+
+// RegexUsage is a regular expression embedded in a query
+type RegexUsage struct {
+	RegexAnalysis
+	Command   string `json:"command"` // rex, regex, match or replace
+	Field     string `json:"field"`   // Field the regex is applied to
+	PipeStage int    `json:"pipe_stage"`
+}
+
+// splunkFieldNamePattern is what PCRE and Splunk accept as a group name
+var splunkFieldNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// regexGroup is an open group while scanning
+type regexGroup struct {
+	start      int
+	lookbehind bool
+	unbounded  bool // Contains an unbounded quantifier
+}
+
+// AnalyzeRegex checks a Splunk (PCRE) regular expression. PCRE syntax is
+// translated to RE2 where Go has an equivalent; lookarounds, atomic groups
+// and the like are checked as plain groups and reported as pcre_only.
+func AnalyzeRegex(pattern string) RegexAnalysis {
+	s := &regexScanner{pattern: pattern}
+	s.scan()
+	a := RegexAnalysis{Pattern: pattern, CaptureGroups: s.names, Issues: s.issues}
+
+	if !s.invalid {
+		if _, err := syntax.Parse(s.out.String(), syntax.Perl); err != nil {
+			a.Issues = append(a.Issues, RegexIssue{Kind: RegexInvalid, Message: err.Error(), Position: errorPosition(pattern, err)})
+			s.invalid = true
+		}
+	}
+	if !s.invalid && !s.badName && !s.pcreOnly {
+		a.GoPattern = s.out.String()
+	}
+	return a
+}
+
+// errorPosition locates the fragment a syntax error names in the pattern
+func errorPosition(pattern string, err error) int {
+	var serr *syntax.Error
+	if errors.As(err, &serr) && serr.Expr != "" {
+		return strings.Index(pattern, serr.Expr)
+	}
+	return -1
+}
+
+// regexScanner walks a PCRE pattern, writing a checkable RE2 form to out
+type regexScanner struct {
+	pattern  string
+	out      strings.Builder
+	groups   []regexGroup
+	names    []string
+	issues   []RegexIssue
+	pcreOnly bool
+	invalid  bool
+	badName  bool // A group name Splunk rejects; RE2 still checks the rest
+
+	// The previous atom, for quantifiers and the unescaped dot check
+	atomStart   int
+	atomGroup   *regexGroup
+	atomLiteral byte // Plain literal character, 0 otherwise
+	atomQuantOK bool // Whether a quantifier may follow
+}
+
+func (s *regexScanner) issue(kind RegexIssueKind, pos int, format string, args ...any) {
+	s.issues = append(s.issues, RegexIssue{Kind: kind, Message: fmt.Sprintf(format, args...), Position: pos})
+	switch kind {
+	case RegexInvalid:
+		s.invalid = true
+	case RegexInvalidGroupName:
+		s.badName = true
+	}
+}
+
+// pcre records a feature RE2 lacks
+func (s *regexScanner) pcre(pos int, feature string) {
+	s.pcreOnly = true
+	s.issue(RegexPCREOnly, pos, "%s is not supported by RE2; checked as a plain group", feature)
+}
+
+// atom records the atom that starts at pos
+func (s *regexScanner) atom(pos int, literal byte, group *regexGroup) {
+	s.atomStart, s.atomLiteral, s.atomGroup, s.atomQuantOK = pos, literal, group, true
+}
+
+func (s *regexScanner) scan() {
+	p := s.pattern
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch c {
+		case '\\':
+			i = s.escape(i)
+		case '[':
+			i = s.class(i)
+		case '(':
+			i = s.openGroup(i)
+		case ')':
+			if len(s.groups) == 0 {
+				s.issue(RegexInvalid, i, "unmatched )")
+				break
+			}
+			g := s.groups[len(s.groups)-1]
+			s.groups = s.groups[:len(s.groups)-1]
+			if g.unbounded && len(s.groups) > 0 {
+				s.groups[len(s.groups)-1].unbounded = true
+			}
+			s.out.WriteByte(')')
+			s.atom(g.start, 0, &g)
+		case '*', '+', '?':
+			i = s.quantifier(i, i+1)
+		case '{':
+			if m := repeatPattern.FindString(p[i:]); m != "" {
+				i = s.quantifier(i, i+len(m))
+			} else {
+				s.out.WriteString(`\{`)
+				s.atom(i, c, nil)
+			}
+		case '.':
+			if i > 0 && isAlnum(s.atomLiteral) && i+1 < len(p) && isLetter(p[i+1]) {
+				s.issue(RegexUnescapedDot, i, "unescaped . in %q matches any character; use \\.", literalAround(p, i))
+			}
+			s.out.WriteByte('.')
+			s.atom(i, 0, nil)
+		case '|', '^', '$':
+			s.out.WriteByte(c)
+			s.atomQuantOK, s.atomLiteral = false, 0
+		default:
+			s.out.WriteByte(c)
+			s.atom(i, c, nil)
+		}
+		if s.invalid {
+			// Field extraction still needs the names of later groups
+			s.names = append(s.names, groupNames(p[i+1:])...)
+			return
+		}
+	}
+	if len(s.groups) > 0 {
+		s.issue(RegexInvalid, s.groups[len(s.groups)-1].start, "missing closing )")
+	}
+}
+
+// repeatPattern matches a {n}, {n,} or {n,m} quantifier
+var repeatPattern = regexp.MustCompile(`^\{\d+(,\d*)?\}`)
+
+// quantifier handles the quantifier p[i:end] and any lazy/possessive suffix
+func (s *regexScanner) quantifier(i, end int) int {
+	p := s.pattern
+	if !s.atomQuantOK {
+		s.issue(RegexInvalid, i, "quantifier %s does not follow anything repeatable", p[i:end])
+		return end - 1
+	}
+	q := p[i:end]
+	unbounded := q == "*" || q == "+" || strings.HasSuffix(q, ",}")
+	variable := unbounded || q == "?" || strings.Contains(q, ",")
+	s.out.WriteString(q)
+
+	if end < len(p) && p[end] == '?' {
+		s.out.WriteByte('?')
+		end++
+	} else if end < len(p) && p[end] == '+' {
+		s.pcre(i, "possessive quantifier "+q+"+")
+		end++
+	}
+
+	if unbounded && s.atomGroup != nil && s.atomGroup.unbounded {
+		s.issue(RegexBacktracking, s.atomStart, "nested unbounded quantifiers in %q can backtrack catastrophically", p[s.atomStart:end])
+	}
+	if len(s.groups) > 0 {
+		g := &s.groups[len(s.groups)-1]
+		g.unbounded = g.unbounded || unbounded
+		for _, open := range s.groups {
+			if open.lookbehind && variable {
+				s.issue(RegexInvalid, i, "lookbehind must have a fixed length")
+				break
+			}
+		}
+	}
+	s.atomQuantOK, s.atomLiteral = false, 0
+	return end - 1
+}
+
+// escape handles the escape sequence at p[i] and returns its last index
+func (s *regexScanner) escape(i int) int {
+	p := s.pattern
+	if i+1 >= len(p) {
+		s.issue(RegexInvalid, i, "trailing backslash")
+		return i
+	}
+	c := p[i+1]
+	if text, last, ok := s.charEscape(i, false); ok {
+		s.out.WriteString(text)
+		s.atom(i, 0, nil)
+		return last
+	}
+	switch {
+	case c >= '1' && c <= '9':
+		s.pcre(i, "backreference \\"+string(c))
+		s.out.WriteString("(?:)")
+	case c == 'k' || c == 'g':
+		s.pcre(i, "backreference \\"+string(c))
+		s.out.WriteString("(?:)")
+		if end := strings.IndexAny(p[i+2:], ">}'"); end >= 0 && i+2 < len(p) && strings.ContainsRune("<{'", rune(p[i+2])) {
+			return i + 2 + end
+		}
+	case c == 'G' || c == 'K' || c == 'X' || c == 'C':
+		s.pcre(i, "\\"+string(c))
+		s.out.WriteString("(?:)")
+		s.atomQuantOK = false
+		return i + 1
+	case c == 'H':
+		s.out.WriteString(`[^\t \x{A0}]`)
+	case c == 'R':
+		s.out.WriteString(`(?:\r\n|\n|\r)`)
+	case c == 'Z':
+		s.out.WriteString(`(?:\n?\z)`)
+	case c == 'Q':
+		end := strings.Index(p[i:], `\E`)
+		if end < 0 {
+			end = len(p) - i
+		} else {
+			end += 2
+		}
+		s.out.WriteString(p[i : i+end])
+		s.atom(i, 0, nil)
+		return i + end - 1
+	default:
+		s.out.WriteString(p[i : i+2])
+	}
+	s.atom(i, 0, nil)
+	return i + 1
+}
+
+// charEscape translates the escapes that stand for characters, which are
+// valid both inside and outside a class: \x{263a}, \p{L}, \P{Lu}, \o{12},
+// \cM, \e and \h. It returns the RE2 text and the escape's last index, or
+// false for other escapes.
+func (s *regexScanner) charEscape(i int, inClass bool) (string, int, bool) {
+	p := s.pattern
+	switch c := p[i+1]; c {
+	case 'x', 'p', 'P', 'o':
+		if i+2 >= len(p) || p[i+2] != '{' {
+			break
+		}
+		end := strings.IndexByte(p[i+2:], '}')
+		if end < 0 {
+			break
+		}
+		last := i + 2 + end
+		if c != 'o' {
+			return p[i : last+1], last, true
+		}
+		n, err := strconv.ParseUint(p[i+3:last], 8, 32)
+		if err != nil {
+			s.issue(RegexInvalid, i, "invalid octal escape %s", p[i:last+1])
+			return "", last, true
+		}
+		return fmt.Sprintf(`\x{%X}`, n), last, true
+	case 'c':
+		if i+2 < len(p) {
+			b := p[i+2]
+			if b >= 'a' && b <= 'z' {
+				b -= 'a' - 'A'
+			}
+			return fmt.Sprintf(`\x{%02X}`, b^0x40), i + 2, true
+		}
+	case 'e':
+		return `\x1B`, i + 1, true
+	case 'h':
+		if inClass {
+			return `\t \x{A0}`, i + 1, true
+		}
+		return `[\t \x{A0}]`, i + 1, true
+	}
+	return "", i + 1, false
+}
+
+// class copies the character class starting at p[i], translating escapes,
+// and returns its last index
+func (s *regexScanner) class(i int) int {
+	p := s.pattern
+	j := i + 1
+	if j < len(p) && p[j] == '^' {
+		j++
+	}
+	if j < len(p) && p[j] == ']' {
+		j++ // A leading ] is literal
+	}
+	var out strings.Builder
+	out.WriteString(p[i:j])
+	for ; j < len(p); j++ {
+		switch {
+		case p[j] == '\\' && j+1 < len(p):
+			if text, last, ok := s.charEscape(j, true); ok {
+				out.WriteString(text)
+				j = last
+				continue
+			}
+			out.WriteString(p[j : j+2])
+			j++
+		case p[j] == '[' && j+1 < len(p) && p[j+1] == ':':
+			end := strings.Index(p[j:], ":]")
+			if end < 0 {
+				out.WriteByte('[')
+				continue
+			}
+			out.WriteString(p[j : j+end+2])
+			j += end + 1
+		case p[j] == ']':
+			out.WriteByte(']')
+			s.out.WriteString(out.String())
+			s.atom(i, 0, nil)
+			return j
+		default:
+			out.WriteByte(p[j])
+		}
+	}
+	s.issue(RegexInvalid, i, "missing closing ]")
+	return len(p) - 1
+}
+
+// openGroup handles the group opening at p[i] and returns the index of its last
+// syntax character
+func (s *regexScanner) openGroup(i int) int {
+	p := s.pattern
+	rest := p[i:]
+	push := func(out string, lookbehind bool) {
+		s.groups = append(s.groups, regexGroup{start: i, lookbehind: lookbehind})
+		s.out.WriteString(out)
+		s.atomQuantOK, s.atomLiteral = false, 0
+	}
+
+	switch {
+	case strings.HasPrefix(rest, "(?#"):
+		end := strings.IndexByte(rest, ')')
+		if end < 0 {
+			s.issue(RegexInvalid, i, "unterminated comment")
+			return len(p) - 1
+		}
+		return i + end
+	case strings.HasPrefix(rest, "(?<=") || strings.HasPrefix(rest, "(?<!"):
+		s.pcre(i, "lookbehind "+rest[:4])
+		push("(?:", true)
+		return i + 3
+	case strings.HasPrefix(rest, "(?=") || strings.HasPrefix(rest, "(?!"):
+		s.pcre(i, "lookahead "+rest[:3])
+		push("(?:", false)
+		return i + 2
+	case strings.HasPrefix(rest, "(?>") || strings.HasPrefix(rest, "(?|"):
+		s.pcre(i, "group "+rest[:3])
+		push("(?:", false)
+		return i + 2
+	case strings.HasPrefix(rest, "(?<") || strings.HasPrefix(rest, "(?P<") || strings.HasPrefix(rest, "(?'"):
+		open := strings.IndexAny(rest, "<'") + 1
+		closer := byte('>')
+		if rest[open-1] == '\'' {
+			closer = '\''
+		}
+		end := strings.IndexByte(rest[open:], closer)
+		if end < 0 {
+			s.issue(RegexInvalid, i, "unterminated group name")
+			return len(p) - 1
+		}
+		name := rest[open : open+end]
+		s.names = append(s.names, name)
+		if splunkFieldNamePattern.MatchString(name) {
+			push("(?P<"+name+">", false)
+		} else {
+			s.issue(RegexInvalidGroupName, i, "group name %q is not a valid field name", name)
+			push("(", false)
+		}
+		return i + open + end
+	case strings.HasPrefix(rest, "(?P=") || strings.HasPrefix(rest, "(?P>") || strings.HasPrefix(rest, "(?&") ||
+		strings.HasPrefix(rest, "(?R") || strings.HasPrefix(rest, "(?(") || (len(rest) > 2 && rest[1] == '?' && (isDigit(rest[2]) || rest[2] == '+' || rest[2] == '-' && len(rest) > 3 && isDigit(rest[3]))):
+		s.pcre(i, "backreference or recursion "+rest[:min(len(rest), 4)])
+		end := strings.IndexByte(rest, ')')
+		if end < 0 || strings.HasPrefix(rest, "(?(") {
+			s.invalid = true // Conditionals cannot be checked by RE2
+			return len(p) - 1
+		}
+		s.out.WriteString("(?:)")
+		s.atom(i, 0, nil)
+		return i + end
+	case strings.HasPrefix(rest, "(?"):
+		// Inline flags (?i) or (?i:...)
+		end := strings.IndexAny(rest, ":)")
+		if end < 0 {
+			s.issue(RegexInvalid, i, "unterminated group")
+			return len(p) - 1
+		}
+		flags := rest[2:end]
+		if strings.ContainsAny(flags, "xXJ") {
+			s.pcre(i, "flag (?"+flags+")")
+			flags = strings.Map(func(r rune) rune {
+				if strings.ContainsRune("xXJ", r) {
+					return -1
+				}
+				return r
+			}, flags)
+		}
+		if rest[end] == ')' {
+			if flags != "" && flags != "-" {
+				s.out.WriteString("(?" + flags + ")")
+			}
+			return i + end
+		}
+		if flags == "" || flags == "-" {
+			push("(?:", false)
+		} else {
+			push("(?"+flags+":", false)
+		}
+		return i + end
+	}
+	push("(", false)
+	return i
+}
+
+// groupNames lists the named groups in p without checking it, for the
+// rest of a pattern after an error
+func groupNames(p string) []string {
+	var names []string
+	for i := 0; i < len(p); i++ {
+		switch {
+		case p[i] == '\\':
+			i++
+		case p[i] == '[':
+			if end := strings.IndexByte(p[i+1:], ']'); end >= 0 {
+				i += end + 1
+			}
+		case strings.HasPrefix(p[i:], "(?<=") || strings.HasPrefix(p[i:], "(?<!"):
+		case strings.HasPrefix(p[i:], "(?<") || strings.HasPrefix(p[i:], "(?P<") || strings.HasPrefix(p[i:], "(?'"):
+			open := i + strings.IndexAny(p[i:], "<'") + 1
+			closer := byte('>')
+			if p[open-1] == '\'' {
+				closer = '\''
+			}
+			if end := strings.IndexByte(p[open:], closer); end >= 0 {
+				names = append(names, p[open:open+end])
+				i = open + end
+			}
+		}
+	}
+	return names
+}
+
+// literalAround returns the run of literal characters around p[i] for messages
+func literalAround(p string, i int) string {
+	start, end := i, i+1
+	for start > 0 && (isAlnum(p[start-1]) || p[start-1] == '-') {
+		start--
+	}
+	for end < len(p) && (isAlnum(p[end]) || p[end] == '.' || p[end] == '-') {
+		end++
+	}
+	return p[start:end]
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isAlnum(c byte) bool  { return isLetter(c) || isDigit(c) }
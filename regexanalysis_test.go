@@ -0,0 +1,169 @@
+package spl
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeRegex(t *testing.T) {
+	tests := []struct {
+		name      string
+		pattern   string
+		goPattern string // "" when no RE2 equivalent is expected
+		groups    []string
+		issues    []RegexIssueKind
+		position  int // Position of the first issue
+	}{
+		{
+			name:      "named groups translate to RE2",
+			pattern:   `(?<user>\w+)@(?P<domain>[^\s]+)`,
+			goPattern: `(?P<user>\w+)@(?P<domain>[^\s]+)`,
+			groups:    []string{"user", "domain"},
+		},
+		{
+			name:      "PCRE escapes with RE2 equivalents",
+			pattern:   `a\hb\Z`,
+			goPattern: `a[\t \x{A0}]b(?:\n?\z)`,
+		},
+		{
+			name:      "unicode properties and braced hex",
+			pattern:   `\p{L}+\P{Lu}\x{263a}[\p{Greek}\x{41}-\x{5A}]`,
+			goPattern: `\p{L}+\P{Lu}\x{263a}[\p{Greek}\x{41}-\x{5A}]`,
+		},
+		{
+			name:      "character escapes inside classes",
+			pattern:   `[\h]+-enc[^\h\e]\cM\o{12}[\cj]`,
+			goPattern: `[\t \x{A0}]+-enc[^\t \x{A0}\x1B]\x{0D}\x{A}[\x{0A}]`,
+		},
+		{
+			name:     "lookbehind is not a named group",
+			pattern:  `(?<=user=)(?<user>\w+)(?<!admin)`,
+			groups:   []string{"user"},
+			issues:   []RegexIssueKind{RegexPCREOnly, RegexPCREOnly},
+			position: 0,
+		},
+		{
+			name:     "named group inside lookahead",
+			pattern:  `^(?=.*(?<digit>\d))\w+$`,
+			groups:   []string{"digit"},
+			issues:   []RegexIssueKind{RegexPCREOnly},
+			position: 1,
+		},
+		{
+			name:     "variable length lookbehind",
+			pattern:  `(?<=\w+)x`,
+			issues:   []RegexIssueKind{RegexPCREOnly, RegexInvalid},
+			position: 0,
+		},
+		{
+			name:     "unbalanced group",
+			pattern:  `(?<user>\w+`,
+			groups:   []string{"user"},
+			issues:   []RegexIssueKind{RegexInvalid},
+			position: 0,
+		},
+		{
+			name:     "unmatched closing paren",
+			pattern:  `abc)`,
+			issues:   []RegexIssueKind{RegexInvalid},
+			position: 3,
+		},
+		{
+			name:     "groups after an error",
+			pattern:  `(?<a>\d+))(?<b>\w)[(?<c>)]`,
+			groups:   []string{"a", "b"},
+			issues:   []RegexIssueKind{RegexInvalid},
+			position: 9,
+		},
+		{
+			name:     "unterminated class",
+			pattern:  `x[a-z`,
+			issues:   []RegexIssueKind{RegexInvalid},
+			position: 1,
+		},
+		{
+			name:     "invalid repetition",
+			pattern:  `a**`,
+			issues:   []RegexIssueKind{RegexInvalid},
+			position: 2,
+		},
+		{
+			name:     "invalid group name",
+			pattern:  `(?<src-ip>\S+)`,
+			groups:   []string{"src-ip"},
+			issues:   []RegexIssueKind{RegexInvalidGroupName},
+			position: 0,
+		},
+		{
+			name:      "nested unbounded quantifiers",
+			pattern:   `^(\w+\s?)+$`,
+			goPattern: `^(\w+\s?)+$`,
+			issues:    []RegexIssueKind{RegexBacktracking},
+			position:  1,
+		},
+		{
+			name:      "unescaped dot in domain",
+			pattern:   `(?i)evil.com|good\.com`,
+			goPattern: `(?i)evil.com|good\.com`,
+			issues:    []RegexIssueKind{RegexUnescapedDot},
+			position:  8,
+		},
+		{
+			name:      "bounded nesting and wildcard dots are fine",
+			pattern:   `(\d{1,3}\.){3}\d+ .*x`,
+			goPattern: `(\d{1,3}\.){3}\d+ .*x`,
+		},
+		{
+			name:     "possessive quantifier and backreference",
+			pattern:  `(a)\w++\1`,
+			issues:   []RegexIssueKind{RegexPCREOnly, RegexPCREOnly},
+			position: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AnalyzeRegex(tt.pattern)
+			if a.GoPattern != tt.goPattern {
+				t.Errorf("GoPattern = %q, want %q", a.GoPattern, tt.goPattern)
+			}
+			if fmt.Sprint(a.CaptureGroups) != fmt.Sprint(tt.groups) {
+				t.Errorf("CaptureGroups = %v, want %v", a.CaptureGroups, tt.groups)
+			}
+			var kinds []RegexIssueKind
+			for _, issue := range a.Issues {
+				kinds = append(kinds, issue.Kind)
+			}
+			if fmt.Sprint(kinds) != fmt.Sprint(tt.issues) {
+				t.Fatalf("Issues = %+v, want kinds %v", a.Issues, tt.issues)
+			}
+			if len(a.Issues) > 0 && a.Issues[0].Position != tt.position {
+				t.Errorf("Position = %d, want %d (%s)", a.Issues[0].Position, tt.position, a.Issues[0].Message)
+			}
+		})
+	}
+}
+
+func TestExtractConditions_RegexUsages(t *testing.T) {
+	query := `index=web | rex field=uri "(?<=/api/)(?<endpoint>\w+)" | regex host="evil.com" | eval d=replace(dest, "(", "") | where match(user, "^adm")`
+	result := ExtractConditions(query)
+
+	want := []string{"rex uri", "regex host", "replace dest", "match user"}
+	var got []string
+	for _, r := range result.Regexes {
+		got = append(got, r.Command+" "+r.Field)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("Regexes = %v, want %v", got, want)
+	}
+
+	if _, ok := result.ComputedFields["endpoint"]; !ok || len(result.ComputedFields) != 2 {
+		t.Errorf("Expected endpoint (not the lookbehind) as rex output, got %v", result.ComputedFields)
+	}
+	if len(result.Regexes[1].Issues) != 1 || result.Regexes[1].Issues[0].Kind != RegexUnescapedDot {
+		t.Errorf("Expected an unescaped dot warning for regex, got %+v", result.Regexes[1].Issues)
+	}
+	if len(result.Errors) != 1 || !strings.Contains(result.Errors[0], "invalid regex in replace at position 0") {
+		t.Errorf("Expected the replace() regex to be reported, got %v", result.Errors)
+	}
+}
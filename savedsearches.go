@@ -0,0 +1,112 @@
+package spl
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SavedSearch is one stanza of a Splunk savedsearches.conf file
+type SavedSearch struct {
+	Name         string            `json:"name"`                    // Stanza name
+	Search       string            `json:"search"`                  // The SPL, with line continuations joined
+	CronSchedule string            `json:"cron_schedule,omitempty"` // cron_schedule
+	EarliestTime string            `json:"earliest_time,omitempty"` // dispatch.earliest_time
+	LatestTime   string            `json:"latest_time,omitempty"`   // dispatch.latest_time
+	Disabled     bool              `json:"disabled"`                // disabled = 1
+	Actions      map[string]string `json:"actions,omitempty"`       // action.* settings without the prefix (email, email.to, ...)
+	Alert        map[string]string `json:"alert,omitempty"`         // alert.* settings without the prefix (severity, suppress, ...)
+	Settings     map[string]string `json:"settings"`                // Every setting, including inherited [default] values
+	Line         int               `json:"line"`                    // Line of the stanza header
+
+	Result    *ParseResult       `json:"result"`               // ExtractConditions of Search
+	TimeRange *TimeRange         `json:"time_range,omitempty"` // Effective window: inline earliest/latest override dispatch times
+	Window    *ResolvedTimeRange `json:"window,omitempty"`     // TimeRange resolved at parse time (nil if unresolvable)
+}
+
+// EnabledActions returns the alert actions switched on with action.<name> = 1
+func (s *SavedSearch) EnabledActions() []string {
+	var names []string
+	for k, v := range s.Actions {
+		if !strings.Contains(k, ".") && isTrueOption(v) {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseSavedSearches reads a savedsearches.conf file. Settings in [default]
+// (or before the first stanza) are inherited by every stanza; a trailing
+// backslash continues a value on the next line. Each search is run through
+// ExtractConditions and its time range is resolved against now.
+func ParseSavedSearches(r io.Reader, now time.Time) ([]*SavedSearch, error) {
+	defaults, stanzas, err := readConf(r, "savedsearches.conf")
+	if err != nil {
+		return nil, err
+	}
+
+	searches := make([]*SavedSearch, 0, len(stanzas))
+	for _, stanza := range stanzas {
+		s := &SavedSearch{Name: stanza.name, Line: stanza.line, Settings: stanza.settings}
+		for k, v := range defaults {
+			if _, ok := s.Settings[k]; !ok {
+				s.Settings[k] = v
+			}
+		}
+		s.populate(now)
+		searches = append(searches, s)
+	}
+	return searches, nil
+}
+
+// populate fills the typed fields from Settings and analyzes the search
+func (s *SavedSearch) populate(now time.Time) {
+	s.Search = strings.TrimSpace(s.Settings["search"])
+	s.CronSchedule = s.Settings["cron_schedule"]
+	s.EarliestTime = s.Settings["dispatch.earliest_time"]
+	s.LatestTime = s.Settings["dispatch.latest_time"]
+	s.Disabled = isTrueOption(s.Settings["disabled"])
+	for k, v := range s.Settings {
+		if name, ok := strings.CutPrefix(k, "action."); ok {
+			if s.Actions == nil {
+				s.Actions = make(map[string]string)
+			}
+			s.Actions[name] = v
+		}
+		if name, ok := strings.CutPrefix(k, "alert."); ok {
+			if s.Alert == nil {
+				s.Alert = make(map[string]string)
+			}
+			s.Alert[name] = v
+		}
+	}
+
+	s.Result = ExtractConditions(s.Search)
+	s.TimeRange = s.effectiveTimeRange()
+	if s.TimeRange != nil {
+		if window, err := s.TimeRange.Resolve(now); err == nil {
+			s.Window = &window
+		}
+	}
+}
+
+// effectiveTimeRange merges the dispatch window with the search's own
+// earliest/latest, which take precedence as they do when Splunk runs it.
+func (s *SavedSearch) effectiveTimeRange() *TimeRange {
+	if s.EarliestTime == "" && s.LatestTime == "" && s.Result.TimeRange == nil {
+		return nil
+	}
+	tr := &TimeRange{}
+	if s.Result.TimeRange != nil {
+		*tr = *s.Result.TimeRange
+	}
+	if tr.Earliest == nil && s.EarliestTime != "" {
+		tr.Earliest = ParseTimeBound(s.EarliestTime)
+	}
+	if tr.Latest == nil && s.LatestTime != "" {
+		tr.Latest = ParseTimeBound(s.LatestTime)
+	}
+	return tr
+}
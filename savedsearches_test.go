@@ -0,0 +1,97 @@
+package spl
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSavedSearchesConf = `# Detections shipped with the app
+[default]
+dispatch.earliest_time = -24h
+dispatch.latest_time = now
+alert.severity = 3
+
+[Brute Force - Excessive Failed Logins]
+search = index=auth action=failure \
+| stats count by user, src \
+| where count > 10
+cron_schedule = */15 * * * *
+dispatch.earliest_time = -15m@m
+action.email = 1
+action.email.to = soc@example.com
+action.notable = 0
+alert.severity = 5
+alert.suppress = 1
+
+[Suspicious PowerShell]
+search = index=windows EventCode=4104 earliest=-7d@d ScriptBlockText="*-enc*"
+cron_schedule = 0 * * * *
+disabled = 1
+`
+
+func TestParseSavedSearches(t *testing.T) {
+	now := time.Date(2024, time.March, 14, 15, 9, 26, 0, time.UTC)
+	searches, err := ParseSavedSearches(strings.NewReader(testSavedSearchesConf), now)
+	if err != nil {
+		t.Fatalf("ParseSavedSearches error: %v", err)
+	}
+	if len(searches) != 2 {
+		t.Fatalf("Expected 2 saved searches, got %d", len(searches))
+	}
+
+	brute := searches[0]
+	if brute.Name != "Brute Force - Excessive Failed Logins" || brute.Line != 7 {
+		t.Errorf("Name/Line = %q/%d", brute.Name, brute.Line)
+	}
+	wantSearch := "index=auth action=failure \n| stats count by user, src \n| where count > 10"
+	if brute.Search != wantSearch {
+		t.Errorf("Search = %q, want %q", brute.Search, wantSearch)
+	}
+	if brute.CronSchedule != "*/15 * * * *" || brute.Disabled {
+		t.Errorf("CronSchedule/Disabled = %q/%v", brute.CronSchedule, brute.Disabled)
+	}
+	if brute.Alert["severity"] != "5" || brute.Alert["suppress"] != "1" {
+		t.Errorf("Alert = %v", brute.Alert)
+	}
+	if brute.Actions["email.to"] != "soc@example.com" {
+		t.Errorf("Actions = %v", brute.Actions)
+	}
+	if got := brute.EnabledActions(); fmt.Sprint(got) != "[email]" {
+		t.Errorf("EnabledActions = %v, want [email]", got)
+	}
+	if len(brute.Result.Errors) > 0 || len(brute.Result.Conditions) == 0 {
+		t.Errorf("Search analysis: conditions %v errors %v", brute.Result.Conditions, brute.Result.Errors)
+	}
+	if brute.Window == nil {
+		t.Fatal("Expected a resolved window")
+	}
+	if want := time.Date(2024, 3, 14, 14, 54, 0, 0, time.UTC); !brute.Window.Earliest.Equal(want) || !brute.Window.Latest.Equal(now) {
+		t.Errorf("Window = %+v, want [%v, %v]", brute.Window, want, now)
+	}
+
+	ps := searches[1]
+	if !ps.Disabled {
+		t.Error("Expected disabled search")
+	}
+	if ps.Alert["severity"] != "3" || ps.EarliestTime != "-24h" {
+		t.Errorf("Expected [default] settings to be inherited, got alert %v earliest %q", ps.Alert, ps.EarliestTime)
+	}
+	// Inline earliest in the search overrides dispatch.earliest_time
+	if want := time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC); ps.Window == nil || !ps.Window.Earliest.Equal(want) {
+		t.Errorf("Window = %+v, want earliest %v", ps.Window, want)
+	}
+}
+
+func TestParseSavedSearches_Errors(t *testing.T) {
+	tests := []string{
+		"[unterminated\nsearch = index=main",
+		"[ok]\nthis line has no equals sign",
+	}
+	for _, conf := range tests {
+		if _, err := ParseSavedSearches(strings.NewReader(conf), time.Now()); err == nil {
+			t.Errorf("Expected error for %q", conf)
+		}
+	}
+}
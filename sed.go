@@ -0,0 +1,133 @@
+package spl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SedKind is the operation of a rex mode=sed expression
+type SedKind string
+
+const (
+	SedSubstitute    SedKind = "substitute"    // s/<regex>/<replacement>/<flags>
+	SedTransliterate SedKind = "transliterate" // y/<characters>/<replacements>/
+)
+
+// SedOperation is a parsed rex mode=sed expression. The field is rewritten in
+// place, so later conditions on it see the modified value.
+type SedOperation struct {
+	Kind        SedKind `json:"kind"`
+	Field       string  `json:"field"`                // Field modified (default _raw)
+	Pattern     string  `json:"pattern"`              // Regex for s, source characters for y
+	Replacement string  `json:"replacement"`          // Replacement with \N backreferences for s, target characters for y
+	Global      bool    `json:"global,omitempty"`     // g flag: replace every match
+	Occurrence  int     `json:"occurrence,omitempty"` // N flag: replace only the Nth match
+	GoPattern   string  `json:"go_pattern,omitempty"` // RE2 form of an s pattern (see AnalyzeRegex)
+	PCREOnly    bool    `json:"pcre_only,omitempty"`  // s pattern uses PCRE features RE2 lacks, so Apply cannot run it
+	PipeStage   int     `json:"pipe_stage"`
+}
+
+// ParseSedExpression parses a sed expression such as s/foo/bar/g or
+// y/abc/xyz/. Any character may be the delimiter; a backslash escapes it.
+// An s pattern is checked as the PCRE regex Splunk runs: a pattern that is
+// valid but uses lookarounds or the like is marked PCREOnly, not rejected.
+func ParseSedExpression(expr string) (SedOperation, error) {
+	runes := []rune(expr)
+	if len(runes) < 2 || (runes[0] != 's' && runes[0] != 'y') {
+		return SedOperation{}, fmt.Errorf("sed expression %q must start with s or y and a delimiter", expr)
+	}
+	delim := runes[1]
+
+	var parts []string
+	var cur strings.Builder
+	for i := 2; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && i+1 < len(runes) && runes[i+1] == delim:
+			cur.WriteRune(delim)
+			i++
+		case runes[i] == delim:
+			parts = append(parts, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(runes[i])
+		}
+	}
+	parts = append(parts, cur.String())
+	if len(parts) != 3 {
+		return SedOperation{}, fmt.Errorf("sed expression %q needs three %c-delimited parts", expr, delim)
+	}
+
+	op := SedOperation{Pattern: parts[0], Replacement: parts[1]}
+	if runes[0] == 'y' {
+		op.Kind = SedTransliterate
+		if parts[2] != "" {
+			return SedOperation{}, fmt.Errorf("sed expression %q: y takes no flags", expr)
+		}
+		if len([]rune(op.Pattern)) != len([]rune(op.Replacement)) {
+			return SedOperation{}, fmt.Errorf("sed expression %q: y needs strings of equal length", expr)
+		}
+		return op, nil
+	}
+
+	op.Kind = SedSubstitute
+	switch flags := parts[2]; {
+	case flags == "":
+	case flags == "g":
+		op.Global = true
+	default:
+		n, err := strconv.Atoi(flags)
+		if err != nil || n < 1 {
+			return SedOperation{}, fmt.Errorf("sed expression %q: unknown flags %q", expr, flags)
+		}
+		op.Occurrence = n
+	}
+	analysis := AnalyzeRegex(op.Pattern)
+	for _, issue := range analysis.Issues {
+		if issue.IsError() {
+			return SedOperation{}, fmt.Errorf("sed expression %q: %s", expr, issue.Message)
+		}
+	}
+	op.GoPattern = analysis.GoPattern
+	op.PCREOnly = analysis.GoPattern == ""
+	return op, nil
+}
+
+// sedBackreference matches \N in a substitute replacement
+var sedBackreference = regexp.MustCompile(`\\(\d)`)
+
+// Apply runs the operation on s
+func (op SedOperation) Apply(s string) (string, error) {
+	if op.Kind == SedTransliterate {
+		from, to := []rune(op.Pattern), []rune(op.Replacement)
+		return strings.Map(func(r rune) rune {
+			for i, f := range from {
+				if f == r {
+					return to[i]
+				}
+			}
+			return r
+		}, s), nil
+	}
+
+	if op.PCREOnly {
+		return "", fmt.Errorf("sed pattern %q uses PCRE features Go does not support", op.Pattern)
+	}
+	re, err := cachedRegexp(op.GoPattern)
+	if err != nil {
+		return "", err
+	}
+	repl := sedBackreference.ReplaceAllString(strings.ReplaceAll(op.Replacement, "$", "$$"), "$${$1}")
+	var out []byte
+	last := 0
+	for i, m := range re.FindAllStringSubmatchIndex(s, -1) {
+		if !op.Global && i+1 != max(op.Occurrence, 1) {
+			continue
+		}
+		out = append(out, s[last:m[0]]...)
+		out = re.ExpandString(out, repl, s, m)
+		last = m[1]
+	}
+	return string(append(out, s[last:]...)), nil
+}
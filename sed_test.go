@@ -0,0 +1,63 @@
+package spl
+
+import "testing"
+
+func TestParseSedExpression(t *testing.T) {
+	tests := []struct {
+		expr  string
+		input string
+		want  string
+	}{
+		{`s/foo/bar/`, "foo foo", "bar foo"},
+		{`s/foo/bar/g`, "foo foo", "bar bar"},
+		{`s/o/0/2`, "foo boo", "fo0 boo"},
+		{`s/(\w+)@(\w+)/\2 at \1/`, "user@corp", "corp at user"},
+		{`s/\/tmp\//\/var\//g`, "/tmp/a /tmp/b", "/var/a /var/b"},
+		{`s#/tmp/#/var/#`, "/tmp/a", "/var/a"},
+		{`s/cost/$5/`, "cost", "$5"},
+		{`y/abc/xyz/`, "aabbcc-d", "xxyyzz-d"},
+		{`s/\h+/ /g`, "a \t b", "a b"},
+	}
+	for _, tt := range tests {
+		op, err := ParseSedExpression(tt.expr)
+		if err != nil {
+			t.Errorf("ParseSedExpression(%s) error: %v", tt.expr, err)
+			continue
+		}
+		got, err := op.Apply(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("%s applied to %q = %q (%v), want %q", tt.expr, tt.input, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseSedExpression_PCREOnly(t *testing.T) {
+	op, err := ParseSedExpression(`s/(?<=user=)\w+/x/`)
+	if err != nil {
+		t.Fatalf("ParseSedExpression error: %v", err)
+	}
+	if !op.PCREOnly || op.GoPattern != "" {
+		t.Errorf("lookbehind should be PCREOnly without a GoPattern, got %+v", op)
+	}
+	if _, err := op.Apply("user=bob"); err == nil {
+		t.Error("Apply of a PCREOnly pattern should fail")
+	}
+}
+
+func TestParseSedExpression_Errors(t *testing.T) {
+	tests := []string{
+		``,
+		`x/a/b/`,
+		`s/a/b`,
+		`s/a/b/c/d`,
+		`s/a/b/q`,
+		`s/(/b/`,
+		`y/abc/xy/`,
+		`y/a/b/g`,
+	}
+	for _, expr := range tests {
+		if _, err := ParseSedExpression(expr); err == nil {
+			t.Errorf("ParseSedExpression(%q) expected error", expr)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package spl
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// SideEffectKind classifies what a command does beyond reading events
+type SideEffectKind string
+
+const (
+	SideEffectWrite       SideEffectKind = "write"       // Writes a lookup, index or file (outputlookup, collect)
+	SideEffectNetwork     SideEffectKind = "network"     // Sends mail or alerts (sendemail, sendalert)
+	SideEffectDestructive SideEffectKind = "destructive" // Deletes indexed data (delete)
+	SideEffectExecute     SideEffectKind = "execute"     // Runs an external script (script, run)
+	SideEffectREST        SideEffectKind = "rest"        // Calls a REST endpoint that changes state
+	SideEffectFanOut      SideEffectKind = "fan_out"     // Runs a search per result row (map)
+)
+
+// SideEffect is a pipeline stage that writes, sends, deletes or executes
+// something, or otherwise needs approval before an ad-hoc search is run
+type SideEffect struct {
+	Kind      SideEffectKind    `json:"kind"`
+	Command   string            `json:"command"`
+	Arguments []string          `json:"arguments,omitempty"` // Positional arguments (lookup, alert, script, endpoint)
+	Options   map[string]string `json:"options,omitempty"`   // key=value arguments, unquoted
+	Text      string            `json:"text"`                // Stage as written
+	Reason    string            `json:"reason"`
+	PipeStage int               `json:"pipe_stage"`       // Top-level stage, or the stage holding the subsearch/map
+	Nested    bool              `json:"nested,omitempty"` // Inside a subsearch or map search
+}
+
+// sideEffectCommands lists the commands that the grammar has no rule for.
+// After a pipe they parse as search terms led by the command name.
+var sideEffectCommands = map[string]struct {
+	kind   SideEffectKind
+	reason string
+}{
+	"outputlookup":  {SideEffectWrite, "writes a lookup"},
+	"outputcsv":     {SideEffectWrite, "writes a CSV file on the search head"},
+	"collect":       {SideEffectWrite, "writes events to an index"},
+	"mcollect":      {SideEffectWrite, "writes metrics to an index"},
+	"meventcollect": {SideEffectWrite, "writes metrics to an index"},
+	"tscollect":     {SideEffectWrite, "writes tsidx files"},
+	"sendemail":     {SideEffectNetwork, "sends email"},
+	"sendalert":     {SideEffectNetwork, "triggers an alert action"},
+	"delete":        {SideEffectDestructive, "deletes indexed events"},
+	"script":        {SideEffectExecute, "runs an external script"},
+	"run":           {SideEffectExecute, "runs an external script"},
+}
+
+// restActions are REST path segments that change state when requested,
+// such as saved/searches/<name>/dispatch or server/control/restart
+var restActions = map[string]bool{
+	"dispatch": true, "control": true, "restart": true, "reload": true, "_reload": true,
+	"enable": true, "disable": true, "delete": true, "remove": true, "acl": true,
+	"move": true, "acknowledge": true, "suppress": true, "create": true,
+}
+
+// HasSideEffects reports whether the query contains any side effect
+func (r *ParseResult) HasSideEffects() bool {
+	return len(r.SideEffects) > 0
+}
+
+// commandStageEffect recognizes a side-effect command from the first
+// token of a stage, whichever rule it parsed as (usually search terms, or
+// an error node for arguments such as --force). Only a stage after a pipe
+// is a command: a leading "delete" is a search for the word.
+func (e *conditionExtractor) commandStageEffect(ctx IPipelineStageContext) *SideEffect {
+	name := ctx.GetStart()
+	if name == nil || !e.afterPipe(name) {
+		return nil
+	}
+	command := strings.ToLower(name.GetText())
+	spec, ok := sideEffectCommands[command]
+	if !ok {
+		return nil
+	}
+	effect := e.stageArguments(command, name)
+	effect.Kind, effect.Reason = spec.kind, spec.reason
+	return effect
+}
+
+// restEffect flags a rest call to an action endpoint or with a method
+// other than GET. name is the rest token.
+func (e *conditionExtractor) restEffect(name antlr.Token) *SideEffect {
+	effect := e.stageArguments("rest", name)
+	if method := strings.ToLower(effect.Options["method"]); method != "" && method != "get" {
+		effect.Kind, effect.Reason = SideEffectREST, "requests "+strings.ToUpper(method)
+		return effect
+	}
+	for _, path := range effect.Arguments {
+		for _, segment := range strings.Split(strings.ToLower(path), "/") {
+			if restActions[segment] {
+				effect.Kind, effect.Reason = SideEffectREST, "calls the "+segment+" endpoint"
+				return effect
+			}
+		}
+	}
+	return nil
+}
+
+// unparsedSideEffects fails closed on stages missing from the parse tree.
+// When the parser stops early or skips tokens while recovering from a
+// syntax error, side-effect commands after any pipe in the token stream
+// are still recorded.
+func (e *conditionExtractor) unparsedSideEffects() {
+	e.tokenStream.Fill()
+	stage, outer, depth := 0, 0, 0
+	var prev antlr.Token
+	for i := 0; i < e.tokenStream.Size(); i++ {
+		tok := e.tokenStream.Get(i)
+		if tok.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		switch tok.GetTokenType() {
+		case SPLLexerLBRACKET:
+			if depth == 0 {
+				outer = stage
+			}
+			depth++
+		case SPLLexerRBRACKET:
+			if depth > 0 {
+				depth--
+			}
+		case SPLLexerPIPE:
+			if depth == 0 && prev != nil {
+				stage++
+			}
+		default:
+			if prev == nil || prev.GetTokenType() != SPLLexerPIPE || e.stageStarts[tok.GetTokenIndex()] {
+				break
+			}
+			var effect *SideEffect
+			command := strings.ToLower(tok.GetText())
+			if spec, ok := sideEffectCommands[command]; ok {
+				effect = e.stageArguments(command, tok)
+				effect.Kind, effect.Reason = spec.kind, spec.reason
+			} else if tok.GetTokenType() == SPLLexerREST {
+				effect = e.restEffect(tok)
+			}
+			if effect == nil {
+				break
+			}
+			effect.PipeStage = stage
+			if depth > 0 {
+				effect.PipeStage, effect.Nested = outer, true
+			}
+			e.sideEffects = append(e.sideEffects, *effect)
+		}
+		prev = tok
+	}
+}
+
+// afterPipe reports whether the token before start is a pipe
+func (e *conditionExtractor) afterPipe(start antlr.Token) bool {
+	for i := start.GetTokenIndex() - 1; i >= 0; i-- {
+		if tok := e.tokenStream.Get(i); tok.GetChannel() == antlr.TokenDefaultChannel {
+			return tok.GetTokenType() == SPLLexerPIPE
+		}
+	}
+	return false
+}
+
+// stageArguments reads the arguments following a command's name token up
+// to the end of the stage. The token stream is used rather than the parse
+// tree because the search rule can stop before the end of such a stage
+// (outputlookup append=true stops at the append keyword).
+func (e *conditionExtractor) stageArguments(command string, name antlr.Token) *SideEffect {
+	e.tokenStream.Fill()
+	stop, depth := name, 0
+scan:
+	for i := name.GetTokenIndex() + 1; i < e.tokenStream.Size(); i++ {
+		tok := e.tokenStream.Get(i)
+		if tok.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		switch tok.GetTokenType() {
+		case antlr.TokenEOF:
+			break scan
+		case SPLLexerLBRACKET:
+			depth++
+		case SPLLexerRBRACKET:
+			if depth == 0 {
+				break scan // End of the enclosing subsearch
+			}
+			depth--
+		case SPLLexerPIPE:
+			if depth == 0 {
+				break scan
+			}
+		}
+		stop = tok
+	}
+
+	effect := &SideEffect{Command: command, Text: e.originalQuery[name.GetStart() : stop.GetStop()+1]}
+	for _, arg := range splitArguments(e.originalQuery[name.GetStop()+1 : stop.GetStop()+1]) {
+		if key, value, ok := strings.Cut(arg, "="); ok && key != "" && !strings.ContainsAny(key, `"'`) {
+			if effect.Options == nil {
+				effect.Options = make(map[string]string)
+			}
+			effect.Options[strings.ToLower(key)] = unquoteSPLString(value)
+			continue
+		}
+		effect.Arguments = append(effect.Arguments, unquoteSPLString(arg))
+	}
+	return effect
+}
+
+// splitArguments splits command arguments on whitespace outside quotes
+func splitArguments(text string) []string {
+	var args []string
+	var cur strings.Builder
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			cur.WriteByte(c)
+			if c == '\\' && i+1 < len(text) {
+				i++
+				cur.WriteByte(text[i])
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			cur.WriteByte(c)
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if cur.Len() > 0 {
+				args = append(args, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	if cur.Len() > 0 {
+		args = append(args, cur.String())
+	}
+	return args
+}
@@ -0,0 +1,169 @@
+package spl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSideEffects(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []SideEffect
+	}{
+		{
+			name:  "outputlookup",
+			query: `index=threat | stats count by ip | outputlookup append=true threats.csv`,
+			want: []SideEffect{{
+				Kind: SideEffectWrite, Command: "outputlookup", Arguments: []string{"threats.csv"},
+				Options: map[string]string{"append": "true"}, Text: "outputlookup append=true threats.csv",
+				Reason: "writes a lookup", PipeStage: 2,
+			}},
+		},
+		{
+			name:  "collect",
+			query: `index=auth action=failure | collect index=summary source="failed logins"`,
+			want: []SideEffect{{
+				Kind: SideEffectWrite, Command: "collect", Options: map[string]string{"index": "summary", "source": "failed logins"},
+				Text: `collect index=summary source="failed logins"`, Reason: "writes events to an index", PipeStage: 1,
+			}},
+		},
+		{
+			name:  "sendemail",
+			query: `| makeresults | sendemail to="soc@example.com" subject="Report"`,
+			want: []SideEffect{{
+				Kind: SideEffectNetwork, Command: "sendemail", Options: map[string]string{"to": "soc@example.com", "subject": "Report"},
+				Text: `sendemail to="soc@example.com" subject="Report"`, Reason: "sends email", PipeStage: 1,
+			}},
+		},
+		{
+			name:  "delete",
+			query: `index=main sourcetype=test | delete`,
+			want: []SideEffect{{
+				Kind: SideEffectDestructive, Command: "delete", Text: "delete", Reason: "deletes indexed events", PipeStage: 1,
+			}},
+		},
+		{
+			name:  "script",
+			query: `| script python cleanup.py --force`,
+			want: []SideEffect{{
+				Kind: SideEffectExecute, Command: "script", Arguments: []string{"python", "cleanup.py", "--force"},
+				Text: "script python cleanup.py --force", Reason: "runs an external script", PipeStage: 0,
+			}},
+		},
+		{
+			name:  "rest dispatch",
+			query: `| rest /servicesNS/admin/search/saved/searches/cleanup/dispatch`,
+			want: []SideEffect{{
+				Kind: SideEffectREST, Command: "rest", Arguments: []string{"/servicesNS/admin/search/saved/searches/cleanup/dispatch"},
+				Text: "rest /servicesNS/admin/search/saved/searches/cleanup/dispatch", Reason: "calls the dispatch endpoint", PipeStage: 0,
+			}},
+		},
+		{
+			name:  "rest read",
+			query: `| rest /services/authentication/users splunk_server=local | table title roles`,
+			want:  nil,
+		},
+		{
+			name:  "rest reload on a named server",
+			query: `| rest splunk_server=splunk-idx01 /services/data/inputs/script/%2Fopt%2Fcheck.sh/_reload`,
+			want: []SideEffect{{
+				Kind: SideEffectREST, Command: "rest", Arguments: []string{"/services/data/inputs/script/%2Fopt%2Fcheck.sh/_reload"},
+				Options: map[string]string{"splunk_server": "splunk-idx01"},
+				Text:    "rest splunk_server=splunk-idx01 /services/data/inputs/script/%2Fopt%2Fcheck.sh/_reload", Reason: "calls the _reload endpoint", PipeStage: 0,
+			}},
+		},
+		{
+			name:  "map with a writing template",
+			query: `| inputlookup hosts.csv | map maxsearches=50 search="search index=main host=$host$ | outputlookup append=t seen.csv"`,
+			want: []SideEffect{
+				{
+					Kind: SideEffectFanOut, Command: "map",
+					Options: map[string]string{"maxsearches": "50", "search": "search index=main host=$host$ | outputlookup append=t seen.csv"},
+					Text:    `map maxsearches=50 search="search index=main host=$host$ | outputlookup append=t seen.csv"`,
+					Reason:  "runs a search for each result", PipeStage: 1,
+				},
+				{
+					Kind: SideEffectWrite, Command: "outputlookup", Arguments: []string{"seen.csv"}, Options: map[string]string{"append": "t"},
+					Text: "outputlookup append=t seen.csv", Reason: "writes a lookup", PipeStage: 1, Nested: true,
+				},
+			},
+		},
+		{
+			name:  "inside a subsearch",
+			query: `index=web [| inputlookup bad.csv | outputlookup copy.csv | fields ip] | stats count by ip`,
+			want: []SideEffect{{
+				Kind: SideEffectWrite, Command: "outputlookup", Arguments: []string{"copy.csv"},
+				Text: "outputlookup copy.csv", Reason: "writes a lookup", PipeStage: 0, Nested: true,
+			}},
+		},
+		{
+			name:  "after implicit AND in where",
+			query: `index=a | where a=1 b=2 | outputlookup evil.csv`,
+			want: []SideEffect{{
+				Kind: SideEffectWrite, Command: "outputlookup", Arguments: []string{"evil.csv"},
+				Text: "outputlookup evil.csv", Reason: "writes a lookup", PipeStage: 2,
+			}},
+		},
+		{
+			name:  "after bin AS",
+			query: `index=a | bin _time span=1m as minute | delete`,
+			want: []SideEffect{{
+				Kind: SideEffectDestructive, Command: "delete", Text: "delete", Reason: "deletes indexed events", PipeStage: 2,
+			}},
+		},
+		{
+			name:  "after a function and a comparison in where",
+			query: `index=a | where isnotnull(x) x>5 | sendemail to="soc@example.com"`,
+			want: []SideEffect{{
+				Kind: SideEffectNetwork, Command: "sendemail", Options: map[string]string{"to": "soc@example.com"},
+				Text: `sendemail to="soc@example.com"`, Reason: "sends email", PipeStage: 2,
+			}},
+		},
+		{
+			name:  "after input the parser stopped at",
+			query: `index=a user=x ) | stats count | outputlookup evil.csv`,
+			want: []SideEffect{{
+				Kind: SideEffectWrite, Command: "outputlookup", Arguments: []string{"evil.csv"},
+				Text: "outputlookup evil.csv", Reason: "writes a lookup", PipeStage: 2,
+			}},
+		},
+		{
+			name:  "rest after input the parser stopped at",
+			query: `index=a ) | rest /services/server/control/restart`,
+			want: []SideEffect{{
+				Kind: SideEffectREST, Command: "rest", Arguments: []string{"/services/server/control/restart"},
+				Text: "rest /services/server/control/restart", Reason: "calls the control endpoint", PipeStage: 1,
+			}},
+		},
+		{
+			name:  "search terms are not commands",
+			query: `index=main delete OR script`,
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ExtractConditions(tc.query)
+			if !reflect.DeepEqual(result.SideEffects, tc.want) {
+				t.Errorf("SideEffects =\n%+v\nwant\n%+v", result.SideEffects, tc.want)
+			}
+			if result.HasSideEffects() != (len(tc.want) > 0) {
+				t.Errorf("HasSideEffects() = %v", result.HasSideEffects())
+			}
+		})
+	}
+}
+
+func TestSideEffects_ArgumentsAreNotConditions(t *testing.T) {
+	result := ExtractConditions(`index=auth action=failure | collect index=summary | sendemail to="soc@example.com"`)
+	for _, c := range result.Conditions {
+		if c.PipeStage > 0 {
+			t.Errorf("command argument extracted as a condition: %+v", c)
+		}
+	}
+	if len(result.Conditions) != 2 {
+		t.Errorf("Conditions = %+v, want index and action", result.Conditions)
+	}
+}
@@ -0,0 +1,241 @@
+package spl
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SpathInfo describes an spath command. Without a path, spath extracts
+// every leaf of the JSON under Splunk's names (a.b, list{}, list{}.name).
+type SpathInfo struct {
+	Input     string         `json:"input"`            // Field holding the JSON (default _raw)
+	Output    string         `json:"output,omitempty"` // Field written (default the path)
+	Path      string         `json:"path,omitempty"`   // Path as written; empty extracts every leaf
+	Steps     []JSONPathStep `json:"steps,omitempty"`  // Parsed path
+	PipeStage int            `json:"pipe_stage"`
+}
+
+// JSONPathStep is one step of an spath path or curly-brace field name: an
+// object member (Key) or an array step ({} for every element, {N} for one)
+type JSONPathStep struct {
+	Key   string `json:"key,omitempty"`   // Object member
+	Array bool   `json:"array,omitempty"` // Array step
+	Index int    `json:"index,omitempty"` // Array step: element N, or -1 for every element
+}
+
+// Field returns the field the command writes: the output, else the path
+func (s SpathInfo) Field() string {
+	if s.Output != "" {
+		return s.Output
+	}
+	return s.Path
+}
+
+// newSpathInfo reads an spath command's options and positional path
+func newSpathInfo(ctx ISpathCommandContext) (SpathInfo, error) {
+	opts := commandOptions(ctx.AllSpathOption())
+	info := SpathInfo{Input: opts["input"], Output: opts["output"], Path: opts["path"]}
+	if info.Input == "" {
+		info.Input = "_raw"
+	}
+	for _, p := range ctx.AllSpathPath() {
+		if info.Path == "" {
+			info.Path = unquoteSPLString(p.GetText())
+		}
+	}
+	if info.Path == "" {
+		return info, nil
+	}
+	steps, err := ParseJSONPath(info.Path)
+	if err != nil {
+		return info, fmt.Errorf("spath path %q: %w", info.Path, err)
+	}
+	info.Steps = steps
+	return info, nil
+}
+
+// ParseJSONPath parses an spath path or a curly-brace field name such as
+// ModifiedProperties{}.NewValue, a.b{0}.c or targetResources[*].displayName
+func ParseJSONPath(path string) ([]JSONPathStep, error) {
+	var steps []JSONPathStep
+	var key strings.Builder
+	flush := func(required bool) error {
+		if key.Len() == 0 {
+			if required {
+				return fmt.Errorf("empty path segment")
+			}
+			return nil
+		}
+		steps = append(steps, JSONPathStep{Key: key.String()})
+		key.Reset()
+		return nil
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; c {
+		case '.':
+			// A dot after an array step separates it from the next key
+			if err := flush(len(steps) == 0 || !steps[len(steps)-1].Array || key.Len() > 0); err != nil {
+				return nil, err
+			}
+		case '{', '[':
+			closer := byte('}')
+			if c == '[' {
+				closer = ']'
+			}
+			end := strings.IndexByte(path[i:], closer)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated %c", c)
+			}
+			if err := flush(false); err != nil {
+				return nil, err
+			}
+			step := JSONPathStep{Array: true, Index: -1}
+			if inner := path[i+1 : i+end]; inner != "" && inner != "*" {
+				n, err := strconv.Atoi(inner)
+				if err != nil || n < 0 {
+					return nil, fmt.Errorf("invalid array index %q", inner)
+				}
+				step.Index = n
+			}
+			steps = append(steps, step)
+			i += end
+		default:
+			key.WriteByte(c)
+		}
+	}
+	if err := flush(len(steps) == 0 || !steps[len(steps)-1].Array); err != nil {
+		return nil, err
+	}
+	return steps, nil
+}
+
+// extractJSONPath returns the values at a path of a decoded JSON document.
+// Objects and arrays found at the path are returned as JSON text.
+func extractJSONPath(doc any, steps []JSONPathStep) []any {
+	nodes := []any{doc}
+	for _, step := range steps {
+		var next []any
+		for _, node := range nodes {
+			switch {
+			case step.Array:
+				items, ok := node.([]any)
+				if !ok {
+					continue
+				}
+				if step.Index < 0 {
+					next = append(next, items...)
+				} else if step.Index < len(items) {
+					next = append(next, items[step.Index])
+				}
+			default:
+				if obj, ok := node.(map[string]any); ok {
+					if v, ok := obj[step.Key]; ok {
+						next = append(next, v)
+					}
+				}
+			}
+		}
+		nodes = next
+	}
+	for i, node := range nodes {
+		switch node.(type) {
+		case map[string]any, []any:
+			b, _ := json.Marshal(node) // Decoded JSON always re-encodes
+			nodes[i] = string(b)
+		}
+	}
+	return nodes
+}
+
+// NestJSONFields builds the JSON document whose spath extraction yields
+// the given fields, so that events can be generated for field names such
+// as ModifiedProperties{}.NewValue. Multivalue ([]any) values under a {}
+// step become one array element per value. Conflicting paths (a=1 and
+// a.b=2) are an error.
+func NestJSONFields(fields map[string]any) (map[string]any, error) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	doc := make(map[string]any)
+	for _, name := range names {
+		steps, err := ParseJSONPath(name)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", name, err)
+		}
+		if _, err := setJSONPath(doc, steps, fields[name]); err != nil {
+			return nil, fmt.Errorf("field %q: %w", name, err)
+		}
+	}
+	return doc, nil
+}
+
+// setJSONPath stores value at the path under node, creating objects and
+// arrays as needed, and returns the updated node
+func setJSONPath(node any, steps []JSONPathStep, value any) (any, error) {
+	if len(steps) == 0 {
+		if node != nil {
+			return nil, fmt.Errorf("path is already set")
+		}
+		return value, nil
+	}
+	step, rest := steps[0], steps[1:]
+
+	if !step.Array {
+		if node == nil {
+			node = make(map[string]any)
+		}
+		obj, ok := node.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s is not an object", step.Key)
+		}
+		child, err := setJSONPath(obj[step.Key], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		obj[step.Key] = child
+		return obj, nil
+	}
+
+	if node == nil {
+		node = []any{}
+	}
+	items, ok := node.([]any)
+	if !ok {
+		return nil, fmt.Errorf("not an array")
+	}
+	if step.Index >= 0 {
+		for len(items) <= step.Index {
+			items = append(items, nil)
+		}
+		child, err := setJSONPath(items[step.Index], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		items[step.Index] = child
+		return items, nil
+	}
+
+	// {}: one element per value, merged with elements set by other fields
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+	for i, v := range values {
+		if i == len(items) {
+			items = append(items, nil)
+		}
+		child, err := setJSONPath(items[i], rest, v)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = child
+	}
+	return items, nil
+}
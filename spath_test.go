@@ -0,0 +1,151 @@
+package spl
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []JSONPathStep
+		wantErr bool
+	}{
+		{path: "Operation", want: []JSONPathStep{{Key: "Operation"}}},
+		{path: "a.b.c", want: []JSONPathStep{{Key: "a"}, {Key: "b"}, {Key: "c"}}},
+		{path: "ModifiedProperties{}.NewValue", want: []JSONPathStep{{Key: "ModifiedProperties"}, {Array: true, Index: -1}, {Key: "NewValue"}}},
+		{path: "a.b{0}.c", want: []JSONPathStep{{Key: "a"}, {Key: "b"}, {Array: true, Index: 0}, {Key: "c"}}},
+		{path: "targetResources[*].displayName", want: []JSONPathStep{{Key: "targetResources"}, {Array: true, Index: -1}, {Key: "displayName"}}},
+		{path: "items[2]", want: []JSONPathStep{{Key: "items"}, {Array: true, Index: 2}}},
+		{path: "{}", want: []JSONPathStep{{Array: true, Index: -1}}},
+		{path: "a..b", wantErr: true},
+		{path: "a{x}", wantErr: true},
+		{path: "a{0", wantErr: true},
+	}
+	for _, tc := range tests {
+		got, err := ParseJSONPath(tc.path)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseJSONPath(%q) = %+v, want error", tc.path, got)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ParseJSONPath(%q) = %+v, %v; want %+v", tc.path, got, err, tc.want)
+		}
+	}
+}
+
+func TestNestJSONFields(t *testing.T) {
+	doc, err := NestJSONFields(map[string]any{
+		"Operation":                     "Update user.",
+		"Target{}.ID":                   []any{"u1", "u2"},
+		"ModifiedProperties{}.Name":     []any{"AccountEnabled", "StrongAuthentication"},
+		"ModifiedProperties{}.NewValue": []any{"false", "[]"},
+		"Actor{0}.Type":                 "User",
+	})
+	if err != nil {
+		t.Fatalf("NestJSONFields: %v", err)
+	}
+	got, _ := json.Marshal(doc)
+	want := `{"Actor":[{"Type":"User"}],"ModifiedProperties":[{"Name":"AccountEnabled","NewValue":"false"},{"Name":"StrongAuthentication","NewValue":"[]"}],"Operation":"Update user.","Target":[{"ID":"u1"},{"ID":"u2"}]}`
+	if string(got) != want {
+		t.Errorf("NestJSONFields =\n%s\nwant\n%s", got, want)
+	}
+
+	// Spath extraction of the generated document gives back the fields
+	events, err := ParseJSONEvents(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := fmt.Sprint(events[0]["ModifiedProperties{}.NewValue"]); v != "[false []]" {
+		t.Errorf("round trip ModifiedProperties{}.NewValue = %s", v)
+	}
+
+	if _, err := NestJSONFields(map[string]any{"a": "1", "a.b": "2"}); err == nil {
+		t.Error("expected an error for conflicting paths")
+	}
+}
+
+func TestSpathExtraction(t *testing.T) {
+	result := ExtractConditions(`sourcetype=o365 | spath input=AuditData output=new_value path=ModifiedProperties{}.NewValue | spath Workload | search new_value=false Workload=AzureActiveDirectory`)
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	want := []SpathInfo{
+		{Input: "AuditData", Output: "new_value", Path: "ModifiedProperties{}.NewValue", PipeStage: 1,
+			Steps: []JSONPathStep{{Key: "ModifiedProperties"}, {Array: true, Index: -1}, {Key: "NewValue"}}},
+		{Input: "_raw", Path: "Workload", PipeStage: 2, Steps: []JSONPathStep{{Key: "Workload"}}},
+	}
+	if !reflect.DeepEqual(result.Spaths, want) {
+		t.Errorf("Spaths = %+v\nwant %+v", result.Spaths, want)
+	}
+	for _, c := range result.Conditions {
+		switch c.Field {
+		case "new_value":
+			if !c.IsComputed || c.SourceField != "AuditData" {
+				t.Errorf("new_value condition = %+v, want computed from AuditData", c)
+			}
+		case "Workload":
+			if !c.IsComputed || c.SourceField != "_raw" {
+				t.Errorf("Workload condition = %+v, want computed from _raw", c)
+			}
+		}
+	}
+}
+
+func TestSpathExtraction_AutoLeaves(t *testing.T) {
+	result := ExtractConditions(`index=azure sourcetype=azure:aad | spath | search properties.riskLevel=high initiatedBy.user.userPrincipalName="*@example.com" ModifiedProperties{}.NewValue=*`)
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	if len(result.Spaths) != 1 || result.Spaths[0].Path != "" {
+		t.Fatalf("Spaths = %+v, want one without a path", result.Spaths)
+	}
+	for _, c := range result.Conditions {
+		if c.PipeStage == 0 {
+			if c.IsComputed {
+				t.Errorf("condition before spath marked computed: %+v", c)
+			}
+			continue
+		}
+		if !c.IsComputed || c.SourceField != "_raw" {
+			t.Errorf("condition after spath = %+v, want computed from _raw", c)
+		}
+	}
+	if result.ComputedFields["properties.risklevel"] != "_raw" {
+		t.Errorf("ComputedFields = %v", result.ComputedFields)
+	}
+}
+
+func TestExecutor_Spath(t *testing.T) {
+	events := []Event{
+		{"_raw": `{"Operation":"Update user.","ModifiedProperties":[{"Name":"AccountEnabled","NewValue":"false"},{"Name":"Email","NewValue":"x@example.com"}],"Actor":{"ID":"a1"}}`},
+		{"_raw": `not json`},
+	}
+	tests := []struct {
+		query  string
+		fields []string
+		want   []string
+	}{
+		{`spath path=ModifiedProperties{}.NewValue output=nv`, []string{"nv"}, []string{"nv=false|x@example.com", "nv=-"}},
+		{`spath "ModifiedProperties{1}.Name"`, []string{"ModifiedProperties{1}.Name"}, []string{"ModifiedProperties{1}.Name=Email", "ModifiedProperties{1}.Name=-"}},
+		{`spath output=actor path=Actor | spath input=actor path=ID output=id`, []string{"actor", "id"}, []string{`actor={"ID":"a1"} id=a1`, "actor=- id=-"}},
+		{`spath | search ModifiedProperties{}.Name=AccountEnabled`, []string{"Operation", "Actor.ID"}, []string{"Operation=Update user. Actor.ID=a1"}},
+	}
+	for _, tc := range tests {
+		rs, err := NewExecutor().Run(tc.query, events)
+		if err != nil {
+			t.Fatalf("Run(%s): %v", tc.query, err)
+		}
+		if got := renderRows(rs.Rows, tc.fields...); fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("Run(%s)\n got: %q\nwant: %q", tc.query, got, tc.want)
+		}
+	}
+
+	if _, err := NewExecutor().Run(`spath path=a{x}`, events); err == nil {
+		t.Error("expected an error for an invalid path")
+	}
+}
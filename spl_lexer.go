@@ -45,110 +45,164 @@ func spllexerLexerInit() {
 	staticData.LiteralNames = []string{
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "'='", "'!='", "'<'", "'>'",
-		"'<='", "'>='", "", "", "", "", "", "'|'", "'('", "')'", "'['", "']'",
-		"'{'", "'}'", "','", "':'", "'\"'", "'+'", "'-'", "'/'", "'%'", "",
-		"", "", "'*'", "'$'", "", "", "'.'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "'='", "'=='", "'!='", "'<'", "'>'", "'<='", "'>='", "",
+		"", "", "", "", "'|'", "'('", "')'", "'['", "']'", "'{'", "'}'", "','",
+		"'::'", "':'", "'\"'", "'+'", "'-'", "'/'", "'%'", "", "", "", "", "",
+		"", "", "", "'*'", "'$'", "", "", "", "'.'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "AND", "OR", "NOT", "BY", "AS", "IN", "WHERE", "SEARCH", "EVAL",
-		"STATS", "TABLE", "FIELDS", "RENAME", "REX", "DEDUP", "SORT", "HEAD",
-		"TAIL", "TOP", "RARE", "LOOKUP", "JOIN", "APPEND", "TRANSACTION", "SPATH",
-		"EVENTSTATS", "STREAMSTATS", "TIMECHART", "CHART", "FILLNULL", "MAKEMV",
-		"MVEXPAND", "FORMAT", "CONVERT", "BUCKET", "BIN", "OVER", "REST", "TSTATS",
-		"FROM", "GROUPBY", "MSTATS", "INPUTLOOKUP", "EQ", "NEQ", "LT", "GT",
-		"LTE", "GTE", "LIKE", "MATCH", "CIDRMATCH", "ISNOTNULL", "ISNULL", "PIPE",
-		"LPAREN", "RPAREN", "LBRACKET", "RBRACKET", "LBRACE", "RBRACE", "COMMA",
+		"STATS", "TABLE", "FIELDS", "RENAME", "REGEX", "REX", "DEDUP", "SORT",
+		"HEAD", "TAIL", "TOP", "RARE", "LOOKUP", "JOIN", "APPEND", "APPENDCOLS",
+		"APPENDPIPE", "UNION", "MULTISEARCH", "TRANSACTION", "SPATH", "EVENTSTATS",
+		"STREAMSTATS", "TIMECHART", "CHART", "FILLNULL", "MAKEMV", "MVEXPAND",
+		"FORMAT", "RETURN", "FOREACH", "MAP", "CONVERT", "BUCKET", "BIN", "OVER",
+		"REST", "TSTATS", "FROM", "GROUPBY", "MSTATS", "INPUTLOOKUP", "OUTPUT",
+		"OUTPUTNEW", "EQ", "EQEQ", "NEQ", "LT", "GT", "LTE", "GTE", "LIKE",
+		"MATCH", "CIDRMATCH", "ISNOTNULL", "ISNULL", "PIPE", "LPAREN", "RPAREN",
+		"LBRACKET", "RBRACKET", "LBRACE", "RBRACE", "COMMA", "DOUBLE_COLON",
 		"COLON", "DQUOTE", "PLUS", "MINUS", "SLASH", "PERCENT", "QUOTED_STRING",
-		"TIME_SPAN", "NUMBER", "WILDCARD", "DOLLAR", "TEMPLATE_VAR", "IDENTIFIER",
-		"DOT", "REST_PATH", "MACRO", "TIME_MODIFIER", "WS", "LINE_COMMENT",
+		"SINGLE_QUOTED", "TERM_DIRECTIVE", "CASE_DIRECTIVE", "TIME_SPAN", "TIME_ABSOLUTE",
+		"IP_ADDRESS", "NUMBER", "WILDCARD", "DOLLAR", "TOKEN_VAR", "TEMPLATE_VAR",
+		"IDENTIFIER", "DOT", "REST_PATH", "MACRO", "TIME_MODIFIER", "WS", "BLOCK_COMMENT",
+		"LINE_COMMENT",
 	}
 	staticData.RuleNames = []string{
 		"AND", "OR", "NOT", "BY", "AS", "IN", "WHERE", "SEARCH", "EVAL", "STATS",
-		"TABLE", "FIELDS", "RENAME", "REX", "DEDUP", "SORT", "HEAD", "TAIL",
-		"TOP", "RARE", "LOOKUP", "JOIN", "APPEND", "TRANSACTION", "SPATH", "EVENTSTATS",
-		"STREAMSTATS", "TIMECHART", "CHART", "FILLNULL", "MAKEMV", "MVEXPAND",
-		"FORMAT", "CONVERT", "BUCKET", "BIN", "OVER", "REST", "TSTATS", "FROM",
-		"GROUPBY", "MSTATS", "INPUTLOOKUP", "EQ", "NEQ", "LT", "GT", "LTE",
-		"GTE", "LIKE", "MATCH", "CIDRMATCH", "ISNOTNULL", "ISNULL", "PIPE",
-		"LPAREN", "RPAREN", "LBRACKET", "RBRACKET", "LBRACE", "RBRACE", "COMMA",
-		"COLON", "DQUOTE", "PLUS", "MINUS", "SLASH", "PERCENT", "QUOTED_STRING",
-		"TIME_SPAN", "NUMBER", "DIGIT", "WILDCARD", "DOLLAR", "TEMPLATE_VAR",
-		"IDENTIFIER", "DOT", "REST_PATH", "MACRO", "TIME_MODIFIER", "WS", "LINE_COMMENT",
+		"TABLE", "FIELDS", "RENAME", "REGEX", "REX", "DEDUP", "SORT", "HEAD",
+		"TAIL", "TOP", "RARE", "LOOKUP", "JOIN", "APPEND", "APPENDCOLS", "APPENDPIPE",
+		"UNION", "MULTISEARCH", "TRANSACTION", "SPATH", "EVENTSTATS", "STREAMSTATS",
+		"TIMECHART", "CHART", "FILLNULL", "MAKEMV", "MVEXPAND", "FORMAT", "RETURN",
+		"FOREACH", "MAP", "CONVERT", "BUCKET", "BIN", "OVER", "REST", "TSTATS",
+		"FROM", "GROUPBY", "MSTATS", "INPUTLOOKUP", "OUTPUT", "OUTPUTNEW", "EQ",
+		"EQEQ", "NEQ", "LT", "GT", "LTE", "GTE", "LIKE", "MATCH", "CIDRMATCH",
+		"ISNOTNULL", "ISNULL", "PIPE", "LPAREN", "RPAREN", "LBRACKET", "RBRACKET",
+		"LBRACE", "RBRACE", "COMMA", "DOUBLE_COLON", "COLON", "DQUOTE", "PLUS",
+		"MINUS", "SLASH", "PERCENT", "QUOTED_STRING", "SINGLE_QUOTED", "TERM_DIRECTIVE",
+		"CASE_DIRECTIVE", "DIRECTIVE_ARG", "TIME_SPAN", "TIME_UNIT", "TIME_ABSOLUTE",
+		"IP_ADDRESS", "NUMBER", "DIGIT", "EXPONENT", "WILDCARD", "DOLLAR", "TOKEN_VAR",
+		"TEMPLATE_VAR", "IDENTIFIER", "DOT", "REST_PATH", "MACRO", "TIME_MODIFIER",
+		"WS", "BLOCK_COMMENT", "LINE_COMMENT",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 81, 696, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
-		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
-		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
-		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
-		20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25,
-		2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
-		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36,
-		7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7,
-		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
-		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
-		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
-		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
-		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
-		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
-		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78,
-		7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 1, 0, 1, 0, 1, 0, 1, 0,
-		1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4,
-		1, 4, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7,
-		1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9,
-		1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11,
-		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1,
-		12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14,
-		1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1,
-		16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18,
-		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1,
-		20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22,
-		1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1,
-		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24,
-		1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1,
-		25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26,
-		1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1,
-		27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29,
-		1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1,
-		30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31,
-		1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34,
-		1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1,
-		36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1,
-		40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41,
-		1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1,
-		42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46,
-		1, 46, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1,
-		49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51,
-		1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53,
-		1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 55, 1, 55, 1, 56, 1, 56, 1, 57, 1,
-		57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 62, 1, 62,
-		1, 63, 1, 63, 1, 64, 1, 64, 1, 65, 1, 65, 1, 66, 1, 66, 1, 67, 1, 67, 1,
-		68, 1, 68, 1, 68, 1, 68, 5, 68, 525, 8, 68, 10, 68, 12, 68, 528, 9, 68,
-		1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 5, 68, 535, 8, 68, 10, 68, 12, 68, 538,
-		9, 68, 1, 68, 3, 68, 541, 8, 68, 1, 69, 3, 69, 544, 8, 69, 1, 69, 4, 69,
-		547, 8, 69, 11, 69, 12, 69, 548, 1, 69, 1, 69, 3, 69, 553, 8, 69, 1, 69,
-		4, 69, 556, 8, 69, 11, 69, 12, 69, 557, 1, 69, 1, 69, 1, 69, 4, 69, 563,
-		8, 69, 11, 69, 12, 69, 564, 3, 69, 567, 8, 69, 1, 70, 4, 70, 570, 8, 70,
-		11, 70, 12, 70, 571, 1, 70, 1, 70, 4, 70, 576, 8, 70, 11, 70, 12, 70, 577,
-		3, 70, 580, 8, 70, 1, 70, 1, 70, 4, 70, 584, 8, 70, 11, 70, 12, 70, 585,
-		3, 70, 588, 8, 70, 1, 71, 1, 71, 1, 72, 1, 72, 1, 73, 1, 73, 1, 74, 1,
-		74, 1, 74, 1, 74, 1, 74, 5, 74, 601, 8, 74, 10, 74, 12, 74, 604, 9, 74,
-		1, 74, 1, 74, 1, 74, 1, 75, 1, 75, 5, 75, 611, 8, 75, 10, 75, 12, 75, 614,
-		9, 75, 1, 75, 1, 75, 5, 75, 618, 8, 75, 10, 75, 12, 75, 621, 9, 75, 1,
-		75, 1, 75, 1, 75, 5, 75, 626, 8, 75, 10, 75, 12, 75, 629, 9, 75, 4, 75,
-		631, 8, 75, 11, 75, 12, 75, 632, 3, 75, 635, 8, 75, 1, 76, 1, 76, 1, 77,
-		1, 77, 1, 77, 5, 77, 642, 8, 77, 10, 77, 12, 77, 645, 9, 77, 1, 77, 1,
-		77, 4, 77, 649, 8, 77, 11, 77, 12, 77, 650, 1, 77, 1, 77, 4, 77, 655, 8,
-		77, 11, 77, 12, 77, 656, 5, 77, 659, 8, 77, 10, 77, 12, 77, 662, 9, 77,
-		1, 78, 1, 78, 4, 78, 666, 8, 78, 11, 78, 12, 78, 667, 1, 78, 1, 78, 1,
-		79, 1, 79, 4, 79, 674, 8, 79, 11, 79, 12, 79, 675, 1, 80, 4, 80, 679, 8,
-		80, 11, 80, 12, 80, 680, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81,
-		5, 81, 690, 8, 81, 10, 81, 12, 81, 693, 9, 81, 1, 81, 1, 81, 0, 0, 82,
-		1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11,
+		4, 0, 100, 1120, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
+		2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9,
+		2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2,
+		15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20,
+		7, 20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7,
+		25, 2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30,
+		2, 31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2,
+		36, 7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41,
+		7, 41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7,
+		46, 2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51,
+		2, 52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2,
+		57, 7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62,
+		7, 62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7,
+		67, 2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72,
+		2, 73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2,
+		78, 7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83,
+		7, 83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7,
+		88, 2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93,
+		2, 94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2,
+		99, 7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103,
+		1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3,
+		1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6,
+		1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8,
+		1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1,
+		10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12,
+		1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1,
+		13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15,
+		1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1,
+		17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20,
+		1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1,
+		21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
+		1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1,
+		24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
+		1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1,
+		27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27,
+		1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1,
+		28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30,
+		1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1,
+		31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32,
+		1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1,
+		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34,
+		1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1,
+		36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37,
+		1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
+		38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40,
+		1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1,
+		41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43,
+		1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1,
+		45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47,
+		1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1,
+		49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50,
+		1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1,
+		51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52,
+		1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 55, 1,
+		55, 1, 55, 1, 56, 1, 56, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59,
+		1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1,
+		61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62,
+		1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 66,
+		1, 66, 1, 67, 1, 67, 1, 68, 1, 68, 1, 69, 1, 69, 1, 70, 1, 70, 1, 71, 1,
+		71, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 75, 1, 75, 1, 76,
+		1, 76, 1, 77, 1, 77, 1, 78, 1, 78, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1,
+		80, 5, 80, 657, 8, 80, 10, 80, 12, 80, 660, 9, 80, 1, 80, 1, 80, 1, 81,
+		1, 81, 1, 81, 1, 81, 5, 81, 668, 8, 81, 10, 81, 12, 81, 671, 9, 81, 1,
+		81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82,
+		1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 84, 1,
+		84, 1, 84, 1, 84, 1, 84, 5, 84, 698, 8, 84, 10, 84, 12, 84, 701, 9, 84,
+		1, 84, 4, 84, 704, 8, 84, 11, 84, 12, 84, 705, 1, 85, 3, 85, 709, 8, 85,
+		1, 85, 4, 85, 712, 8, 85, 11, 85, 12, 85, 713, 1, 85, 1, 85, 1, 85, 4,
+		85, 719, 8, 85, 11, 85, 12, 85, 720, 1, 85, 3, 85, 724, 8, 85, 3, 85, 726,
+		8, 85, 1, 85, 1, 85, 4, 85, 730, 8, 85, 11, 85, 12, 85, 731, 1, 85, 5,
+		85, 735, 8, 85, 10, 85, 12, 85, 738, 9, 85, 1, 86, 1, 86, 1, 86, 1, 86,
+		1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1,
+		86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86,
+		1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1,
+		86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86,
+		1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1,
+		86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86,
+		1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1,
+		86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86,
+		1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1,
+		86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86,
+		1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1,
+		86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 3, 86, 867, 8, 86,
+		1, 87, 1, 87, 3, 87, 871, 8, 87, 1, 87, 1, 87, 1, 87, 3, 87, 876, 8, 87,
+		1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1,
+		87, 1, 87, 1, 87, 1, 87, 3, 87, 892, 8, 87, 1, 88, 4, 88, 895, 8, 88, 11,
+		88, 12, 88, 896, 1, 88, 1, 88, 4, 88, 901, 8, 88, 11, 88, 12, 88, 902,
+		1, 88, 1, 88, 4, 88, 907, 8, 88, 11, 88, 12, 88, 908, 1, 88, 1, 88, 4,
+		88, 913, 8, 88, 11, 88, 12, 88, 914, 1, 88, 1, 88, 4, 88, 919, 8, 88, 11,
+		88, 12, 88, 920, 3, 88, 923, 8, 88, 1, 89, 4, 89, 926, 8, 89, 11, 89, 12,
+		89, 927, 1, 89, 1, 89, 4, 89, 932, 8, 89, 11, 89, 12, 89, 933, 3, 89, 936,
+		8, 89, 1, 89, 3, 89, 939, 8, 89, 1, 89, 1, 89, 4, 89, 943, 8, 89, 11, 89,
+		12, 89, 944, 1, 89, 3, 89, 948, 8, 89, 3, 89, 950, 8, 89, 1, 90, 1, 90,
+		1, 91, 1, 91, 3, 91, 956, 8, 91, 1, 91, 4, 91, 959, 8, 91, 11, 91, 12,
+		91, 960, 1, 92, 1, 92, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 5, 94, 970, 8,
+		94, 10, 94, 12, 94, 973, 9, 94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 1, 95,
+		1, 95, 5, 95, 982, 8, 95, 10, 95, 12, 95, 985, 9, 95, 1, 95, 1, 95, 1,
+		95, 1, 96, 1, 96, 5, 96, 992, 8, 96, 10, 96, 12, 96, 995, 9, 96, 1, 96,
+		1, 96, 5, 96, 999, 8, 96, 10, 96, 12, 96, 1002, 9, 96, 1, 96, 1, 96, 1,
+		96, 5, 96, 1007, 8, 96, 10, 96, 12, 96, 1010, 9, 96, 4, 96, 1012, 8, 96,
+		11, 96, 12, 96, 1013, 3, 96, 1016, 8, 96, 1, 97, 1, 97, 1, 98, 1, 98, 1,
+		98, 5, 98, 1023, 8, 98, 10, 98, 12, 98, 1026, 9, 98, 1, 98, 1, 98, 4, 98,
+		1030, 8, 98, 11, 98, 12, 98, 1031, 1, 98, 1, 98, 4, 98, 1036, 8, 98, 11,
+		98, 12, 98, 1037, 5, 98, 1040, 8, 98, 10, 98, 12, 98, 1043, 9, 98, 1, 99,
+		1, 99, 4, 99, 1047, 8, 99, 11, 99, 12, 99, 1048, 1, 99, 1, 99, 1, 100,
+		1, 100, 4, 100, 1055, 8, 100, 11, 100, 12, 100, 1056, 1, 100, 3, 100, 1060,
+		8, 100, 1, 100, 1, 100, 4, 100, 1064, 8, 100, 11, 100, 12, 100, 1065, 1,
+		100, 5, 100, 1069, 8, 100, 10, 100, 12, 100, 1072, 9, 100, 1, 101, 4, 101,
+		1075, 8, 101, 11, 101, 12, 101, 1076, 1, 101, 1, 101, 1, 102, 1, 102, 1,
+		102, 1, 102, 1, 102, 5, 102, 1086, 8, 102, 10, 102, 12, 102, 1089, 9, 102,
+		1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 1, 103, 1, 103, 1, 103,
+		1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 5, 103,
+		1108, 8, 103, 10, 103, 12, 103, 1111, 9, 103, 1, 103, 3, 103, 1114, 8,
+		103, 1, 103, 3, 103, 1117, 8, 103, 1, 103, 1, 103, 1, 1087, 0, 104, 1,
+		1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11,
 		23, 12, 25, 13, 27, 14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20,
 		41, 21, 43, 22, 45, 23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29,
 		59, 30, 61, 31, 63, 32, 65, 33, 67, 34, 69, 35, 71, 36, 73, 37, 75, 38,
@@ -156,242 +210,403 @@ func spllexerLexerInit() {
 		95, 48, 97, 49, 99, 50, 101, 51, 103, 52, 105, 53, 107, 54, 109, 55, 111,
 		56, 113, 57, 115, 58, 117, 59, 119, 60, 121, 61, 123, 62, 125, 63, 127,
 		64, 129, 65, 131, 66, 133, 67, 135, 68, 137, 69, 139, 70, 141, 71, 143,
-		0, 145, 72, 147, 73, 149, 74, 151, 75, 153, 76, 155, 77, 157, 78, 159,
-		79, 161, 80, 163, 81, 1, 0, 36, 2, 0, 65, 65, 97, 97, 2, 0, 78, 78, 110,
-		110, 2, 0, 68, 68, 100, 100, 2, 0, 79, 79, 111, 111, 2, 0, 82, 82, 114,
-		114, 2, 0, 84, 84, 116, 116, 2, 0, 66, 66, 98, 98, 2, 0, 89, 89, 121, 121,
-		2, 0, 83, 83, 115, 115, 2, 0, 73, 73, 105, 105, 2, 0, 87, 87, 119, 119,
-		2, 0, 72, 72, 104, 104, 2, 0, 69, 69, 101, 101, 2, 0, 67, 67, 99, 99, 2,
-		0, 86, 86, 118, 118, 2, 0, 76, 76, 108, 108, 2, 0, 70, 70, 102, 102, 2,
-		0, 77, 77, 109, 109, 2, 0, 88, 88, 120, 120, 2, 0, 85, 85, 117, 117, 2,
+		72, 145, 73, 147, 74, 149, 75, 151, 76, 153, 77, 155, 78, 157, 79, 159,
+		80, 161, 81, 163, 82, 165, 83, 167, 84, 169, 0, 171, 85, 173, 0, 175, 86,
+		177, 87, 179, 88, 181, 0, 183, 0, 185, 89, 187, 90, 189, 91, 191, 92, 193,
+		93, 195, 94, 197, 95, 199, 96, 201, 97, 203, 98, 205, 99, 207, 100, 1,
+		0, 39, 2, 0, 65, 65, 97, 97, 2, 0, 78, 78, 110, 110, 2, 0, 68, 68, 100,
+		100, 2, 0, 79, 79, 111, 111, 2, 0, 82, 82, 114, 114, 2, 0, 84, 84, 116,
+		116, 2, 0, 66, 66, 98, 98, 2, 0, 89, 89, 121, 121, 2, 0, 83, 83, 115, 115,
+		2, 0, 73, 73, 105, 105, 2, 0, 87, 87, 119, 119, 2, 0, 72, 72, 104, 104,
+		2, 0, 69, 69, 101, 101, 2, 0, 67, 67, 99, 99, 2, 0, 86, 86, 118, 118, 2,
+		0, 76, 76, 108, 108, 2, 0, 70, 70, 102, 102, 2, 0, 77, 77, 109, 109, 2,
+		0, 71, 71, 103, 103, 2, 0, 88, 88, 120, 120, 2, 0, 85, 85, 117, 117, 2,
 		0, 80, 80, 112, 112, 2, 0, 75, 75, 107, 107, 2, 0, 74, 74, 106, 106, 2,
-		0, 71, 71, 103, 103, 2, 0, 34, 34, 92, 92, 2, 0, 39, 39, 92, 92, 1, 0,
-		48, 57, 7, 0, 77, 77, 100, 100, 104, 104, 109, 109, 115, 115, 119, 119,
-		121, 121, 2, 0, 65, 90, 97, 122, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0, 48,
-		57, 65, 90, 95, 95, 97, 122, 5, 0, 45, 45, 48, 57, 65, 90, 95, 95, 97,
-		122, 6, 0, 42, 42, 45, 45, 48, 57, 65, 90, 95, 95, 97, 122, 1, 0, 96, 96,
-		3, 0, 9, 10, 13, 13, 32, 32, 2, 0, 10, 10, 13, 13, 724, 0, 1, 1, 0, 0,
-		0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0,
-		0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0,
-		0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1,
-		0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33,
-		1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0,
-		41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0,
-		0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0,
-		0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0,
-		0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1,
-		0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79,
-		1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0,
-		87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0,
-		0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0,
-		0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109,
-		1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0,
-		0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1,
-		0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0,
-		131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0,
-		0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147,
-		1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0,
-		0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1,
-		0, 0, 0, 0, 163, 1, 0, 0, 0, 1, 165, 1, 0, 0, 0, 3, 169, 1, 0, 0, 0, 5,
-		172, 1, 0, 0, 0, 7, 176, 1, 0, 0, 0, 9, 179, 1, 0, 0, 0, 11, 182, 1, 0,
-		0, 0, 13, 185, 1, 0, 0, 0, 15, 191, 1, 0, 0, 0, 17, 198, 1, 0, 0, 0, 19,
-		203, 1, 0, 0, 0, 21, 209, 1, 0, 0, 0, 23, 215, 1, 0, 0, 0, 25, 222, 1,
-		0, 0, 0, 27, 229, 1, 0, 0, 0, 29, 233, 1, 0, 0, 0, 31, 239, 1, 0, 0, 0,
-		33, 244, 1, 0, 0, 0, 35, 249, 1, 0, 0, 0, 37, 254, 1, 0, 0, 0, 39, 258,
-		1, 0, 0, 0, 41, 263, 1, 0, 0, 0, 43, 270, 1, 0, 0, 0, 45, 275, 1, 0, 0,
-		0, 47, 282, 1, 0, 0, 0, 49, 294, 1, 0, 0, 0, 51, 300, 1, 0, 0, 0, 53, 311,
-		1, 0, 0, 0, 55, 323, 1, 0, 0, 0, 57, 333, 1, 0, 0, 0, 59, 339, 1, 0, 0,
-		0, 61, 348, 1, 0, 0, 0, 63, 355, 1, 0, 0, 0, 65, 364, 1, 0, 0, 0, 67, 371,
-		1, 0, 0, 0, 69, 379, 1, 0, 0, 0, 71, 386, 1, 0, 0, 0, 73, 390, 1, 0, 0,
-		0, 75, 395, 1, 0, 0, 0, 77, 400, 1, 0, 0, 0, 79, 407, 1, 0, 0, 0, 81, 412,
-		1, 0, 0, 0, 83, 420, 1, 0, 0, 0, 85, 427, 1, 0, 0, 0, 87, 439, 1, 0, 0,
-		0, 89, 441, 1, 0, 0, 0, 91, 444, 1, 0, 0, 0, 93, 446, 1, 0, 0, 0, 95, 448,
-		1, 0, 0, 0, 97, 451, 1, 0, 0, 0, 99, 454, 1, 0, 0, 0, 101, 459, 1, 0, 0,
-		0, 103, 465, 1, 0, 0, 0, 105, 475, 1, 0, 0, 0, 107, 485, 1, 0, 0, 0, 109,
-		492, 1, 0, 0, 0, 111, 494, 1, 0, 0, 0, 113, 496, 1, 0, 0, 0, 115, 498,
-		1, 0, 0, 0, 117, 500, 1, 0, 0, 0, 119, 502, 1, 0, 0, 0, 121, 504, 1, 0,
-		0, 0, 123, 506, 1, 0, 0, 0, 125, 508, 1, 0, 0, 0, 127, 510, 1, 0, 0, 0,
-		129, 512, 1, 0, 0, 0, 131, 514, 1, 0, 0, 0, 133, 516, 1, 0, 0, 0, 135,
-		518, 1, 0, 0, 0, 137, 540, 1, 0, 0, 0, 139, 566, 1, 0, 0, 0, 141, 587,
-		1, 0, 0, 0, 143, 589, 1, 0, 0, 0, 145, 591, 1, 0, 0, 0, 147, 593, 1, 0,
-		0, 0, 149, 595, 1, 0, 0, 0, 151, 634, 1, 0, 0, 0, 153, 636, 1, 0, 0, 0,
-		155, 638, 1, 0, 0, 0, 157, 663, 1, 0, 0, 0, 159, 671, 1, 0, 0, 0, 161,
-		678, 1, 0, 0, 0, 163, 684, 1, 0, 0, 0, 165, 166, 7, 0, 0, 0, 166, 167,
-		7, 1, 0, 0, 167, 168, 7, 2, 0, 0, 168, 2, 1, 0, 0, 0, 169, 170, 7, 3, 0,
-		0, 170, 171, 7, 4, 0, 0, 171, 4, 1, 0, 0, 0, 172, 173, 7, 1, 0, 0, 173,
-		174, 7, 3, 0, 0, 174, 175, 7, 5, 0, 0, 175, 6, 1, 0, 0, 0, 176, 177, 7,
-		6, 0, 0, 177, 178, 7, 7, 0, 0, 178, 8, 1, 0, 0, 0, 179, 180, 7, 0, 0, 0,
-		180, 181, 7, 8, 0, 0, 181, 10, 1, 0, 0, 0, 182, 183, 7, 9, 0, 0, 183, 184,
-		7, 1, 0, 0, 184, 12, 1, 0, 0, 0, 185, 186, 7, 10, 0, 0, 186, 187, 7, 11,
-		0, 0, 187, 188, 7, 12, 0, 0, 188, 189, 7, 4, 0, 0, 189, 190, 7, 12, 0,
-		0, 190, 14, 1, 0, 0, 0, 191, 192, 7, 8, 0, 0, 192, 193, 7, 12, 0, 0, 193,
-		194, 7, 0, 0, 0, 194, 195, 7, 4, 0, 0, 195, 196, 7, 13, 0, 0, 196, 197,
-		7, 11, 0, 0, 197, 16, 1, 0, 0, 0, 198, 199, 7, 12, 0, 0, 199, 200, 7, 14,
-		0, 0, 200, 201, 7, 0, 0, 0, 201, 202, 7, 15, 0, 0, 202, 18, 1, 0, 0, 0,
-		203, 204, 7, 8, 0, 0, 204, 205, 7, 5, 0, 0, 205, 206, 7, 0, 0, 0, 206,
-		207, 7, 5, 0, 0, 207, 208, 7, 8, 0, 0, 208, 20, 1, 0, 0, 0, 209, 210, 7,
-		5, 0, 0, 210, 211, 7, 0, 0, 0, 211, 212, 7, 6, 0, 0, 212, 213, 7, 15, 0,
-		0, 213, 214, 7, 12, 0, 0, 214, 22, 1, 0, 0, 0, 215, 216, 7, 16, 0, 0, 216,
-		217, 7, 9, 0, 0, 217, 218, 7, 12, 0, 0, 218, 219, 7, 15, 0, 0, 219, 220,
-		7, 2, 0, 0, 220, 221, 7, 8, 0, 0, 221, 24, 1, 0, 0, 0, 222, 223, 7, 4,
-		0, 0, 223, 224, 7, 12, 0, 0, 224, 225, 7, 1, 0, 0, 225, 226, 7, 0, 0, 0,
-		226, 227, 7, 17, 0, 0, 227, 228, 7, 12, 0, 0, 228, 26, 1, 0, 0, 0, 229,
-		230, 7, 4, 0, 0, 230, 231, 7, 12, 0, 0, 231, 232, 7, 18, 0, 0, 232, 28,
-		1, 0, 0, 0, 233, 234, 7, 2, 0, 0, 234, 235, 7, 12, 0, 0, 235, 236, 7, 2,
-		0, 0, 236, 237, 7, 19, 0, 0, 237, 238, 7, 20, 0, 0, 238, 30, 1, 0, 0, 0,
-		239, 240, 7, 8, 0, 0, 240, 241, 7, 3, 0, 0, 241, 242, 7, 4, 0, 0, 242,
-		243, 7, 5, 0, 0, 243, 32, 1, 0, 0, 0, 244, 245, 7, 11, 0, 0, 245, 246,
-		7, 12, 0, 0, 246, 247, 7, 0, 0, 0, 247, 248, 7, 2, 0, 0, 248, 34, 1, 0,
-		0, 0, 249, 250, 7, 5, 0, 0, 250, 251, 7, 0, 0, 0, 251, 252, 7, 9, 0, 0,
-		252, 253, 7, 15, 0, 0, 253, 36, 1, 0, 0, 0, 254, 255, 7, 5, 0, 0, 255,
-		256, 7, 3, 0, 0, 256, 257, 7, 20, 0, 0, 257, 38, 1, 0, 0, 0, 258, 259,
-		7, 4, 0, 0, 259, 260, 7, 0, 0, 0, 260, 261, 7, 4, 0, 0, 261, 262, 7, 12,
-		0, 0, 262, 40, 1, 0, 0, 0, 263, 264, 7, 15, 0, 0, 264, 265, 7, 3, 0, 0,
-		265, 266, 7, 3, 0, 0, 266, 267, 7, 21, 0, 0, 267, 268, 7, 19, 0, 0, 268,
-		269, 7, 20, 0, 0, 269, 42, 1, 0, 0, 0, 270, 271, 7, 22, 0, 0, 271, 272,
-		7, 3, 0, 0, 272, 273, 7, 9, 0, 0, 273, 274, 7, 1, 0, 0, 274, 44, 1, 0,
-		0, 0, 275, 276, 7, 0, 0, 0, 276, 277, 7, 20, 0, 0, 277, 278, 7, 20, 0,
-		0, 278, 279, 7, 12, 0, 0, 279, 280, 7, 1, 0, 0, 280, 281, 7, 2, 0, 0, 281,
-		46, 1, 0, 0, 0, 282, 283, 7, 5, 0, 0, 283, 284, 7, 4, 0, 0, 284, 285, 7,
-		0, 0, 0, 285, 286, 7, 1, 0, 0, 286, 287, 7, 8, 0, 0, 287, 288, 7, 0, 0,
-		0, 288, 289, 7, 13, 0, 0, 289, 290, 7, 5, 0, 0, 290, 291, 7, 9, 0, 0, 291,
-		292, 7, 3, 0, 0, 292, 293, 7, 1, 0, 0, 293, 48, 1, 0, 0, 0, 294, 295, 7,
-		8, 0, 0, 295, 296, 7, 20, 0, 0, 296, 297, 7, 0, 0, 0, 297, 298, 7, 5, 0,
-		0, 298, 299, 7, 11, 0, 0, 299, 50, 1, 0, 0, 0, 300, 301, 7, 12, 0, 0, 301,
-		302, 7, 14, 0, 0, 302, 303, 7, 12, 0, 0, 303, 304, 7, 1, 0, 0, 304, 305,
-		7, 5, 0, 0, 305, 306, 7, 8, 0, 0, 306, 307, 7, 5, 0, 0, 307, 308, 7, 0,
-		0, 0, 308, 309, 7, 5, 0, 0, 309, 310, 7, 8, 0, 0, 310, 52, 1, 0, 0, 0,
-		311, 312, 7, 8, 0, 0, 312, 313, 7, 5, 0, 0, 313, 314, 7, 4, 0, 0, 314,
-		315, 7, 12, 0, 0, 315, 316, 7, 0, 0, 0, 316, 317, 7, 17, 0, 0, 317, 318,
-		7, 8, 0, 0, 318, 319, 7, 5, 0, 0, 319, 320, 7, 0, 0, 0, 320, 321, 7, 5,
-		0, 0, 321, 322, 7, 8, 0, 0, 322, 54, 1, 0, 0, 0, 323, 324, 7, 5, 0, 0,
-		324, 325, 7, 9, 0, 0, 325, 326, 7, 17, 0, 0, 326, 327, 7, 12, 0, 0, 327,
-		328, 7, 13, 0, 0, 328, 329, 7, 11, 0, 0, 329, 330, 7, 0, 0, 0, 330, 331,
-		7, 4, 0, 0, 331, 332, 7, 5, 0, 0, 332, 56, 1, 0, 0, 0, 333, 334, 7, 13,
-		0, 0, 334, 335, 7, 11, 0, 0, 335, 336, 7, 0, 0, 0, 336, 337, 7, 4, 0, 0,
-		337, 338, 7, 5, 0, 0, 338, 58, 1, 0, 0, 0, 339, 340, 7, 16, 0, 0, 340,
-		341, 7, 9, 0, 0, 341, 342, 7, 15, 0, 0, 342, 343, 7, 15, 0, 0, 343, 344,
-		7, 1, 0, 0, 344, 345, 7, 19, 0, 0, 345, 346, 7, 15, 0, 0, 346, 347, 7,
-		15, 0, 0, 347, 60, 1, 0, 0, 0, 348, 349, 7, 17, 0, 0, 349, 350, 7, 0, 0,
-		0, 350, 351, 7, 21, 0, 0, 351, 352, 7, 12, 0, 0, 352, 353, 7, 17, 0, 0,
-		353, 354, 7, 14, 0, 0, 354, 62, 1, 0, 0, 0, 355, 356, 7, 17, 0, 0, 356,
-		357, 7, 14, 0, 0, 357, 358, 7, 12, 0, 0, 358, 359, 7, 18, 0, 0, 359, 360,
-		7, 20, 0, 0, 360, 361, 7, 0, 0, 0, 361, 362, 7, 1, 0, 0, 362, 363, 7, 2,
-		0, 0, 363, 64, 1, 0, 0, 0, 364, 365, 7, 16, 0, 0, 365, 366, 7, 3, 0, 0,
-		366, 367, 7, 4, 0, 0, 367, 368, 7, 17, 0, 0, 368, 369, 7, 0, 0, 0, 369,
-		370, 7, 5, 0, 0, 370, 66, 1, 0, 0, 0, 371, 372, 7, 13, 0, 0, 372, 373,
-		7, 3, 0, 0, 373, 374, 7, 1, 0, 0, 374, 375, 7, 14, 0, 0, 375, 376, 7, 12,
-		0, 0, 376, 377, 7, 4, 0, 0, 377, 378, 7, 5, 0, 0, 378, 68, 1, 0, 0, 0,
-		379, 380, 7, 6, 0, 0, 380, 381, 7, 19, 0, 0, 381, 382, 7, 13, 0, 0, 382,
-		383, 7, 21, 0, 0, 383, 384, 7, 12, 0, 0, 384, 385, 7, 5, 0, 0, 385, 70,
-		1, 0, 0, 0, 386, 387, 7, 6, 0, 0, 387, 388, 7, 9, 0, 0, 388, 389, 7, 1,
-		0, 0, 389, 72, 1, 0, 0, 0, 390, 391, 7, 3, 0, 0, 391, 392, 7, 14, 0, 0,
-		392, 393, 7, 12, 0, 0, 393, 394, 7, 4, 0, 0, 394, 74, 1, 0, 0, 0, 395,
-		396, 7, 4, 0, 0, 396, 397, 7, 12, 0, 0, 397, 398, 7, 8, 0, 0, 398, 399,
-		7, 5, 0, 0, 399, 76, 1, 0, 0, 0, 400, 401, 7, 5, 0, 0, 401, 402, 7, 8,
-		0, 0, 402, 403, 7, 5, 0, 0, 403, 404, 7, 0, 0, 0, 404, 405, 7, 5, 0, 0,
-		405, 406, 7, 8, 0, 0, 406, 78, 1, 0, 0, 0, 407, 408, 7, 16, 0, 0, 408,
-		409, 7, 4, 0, 0, 409, 410, 7, 3, 0, 0, 410, 411, 7, 17, 0, 0, 411, 80,
-		1, 0, 0, 0, 412, 413, 7, 23, 0, 0, 413, 414, 7, 4, 0, 0, 414, 415, 7, 3,
-		0, 0, 415, 416, 7, 19, 0, 0, 416, 417, 7, 20, 0, 0, 417, 418, 7, 6, 0,
-		0, 418, 419, 7, 7, 0, 0, 419, 82, 1, 0, 0, 0, 420, 421, 7, 17, 0, 0, 421,
-		422, 7, 8, 0, 0, 422, 423, 7, 5, 0, 0, 423, 424, 7, 0, 0, 0, 424, 425,
-		7, 5, 0, 0, 425, 426, 7, 8, 0, 0, 426, 84, 1, 0, 0, 0, 427, 428, 7, 9,
-		0, 0, 428, 429, 7, 1, 0, 0, 429, 430, 7, 20, 0, 0, 430, 431, 7, 19, 0,
-		0, 431, 432, 7, 5, 0, 0, 432, 433, 7, 15, 0, 0, 433, 434, 7, 3, 0, 0, 434,
-		435, 7, 3, 0, 0, 435, 436, 7, 21, 0, 0, 436, 437, 7, 19, 0, 0, 437, 438,
-		7, 20, 0, 0, 438, 86, 1, 0, 0, 0, 439, 440, 5, 61, 0, 0, 440, 88, 1, 0,
-		0, 0, 441, 442, 5, 33, 0, 0, 442, 443, 5, 61, 0, 0, 443, 90, 1, 0, 0, 0,
-		444, 445, 5, 60, 0, 0, 445, 92, 1, 0, 0, 0, 446, 447, 5, 62, 0, 0, 447,
-		94, 1, 0, 0, 0, 448, 449, 5, 60, 0, 0, 449, 450, 5, 61, 0, 0, 450, 96,
-		1, 0, 0, 0, 451, 452, 5, 62, 0, 0, 452, 453, 5, 61, 0, 0, 453, 98, 1, 0,
-		0, 0, 454, 455, 7, 15, 0, 0, 455, 456, 7, 9, 0, 0, 456, 457, 7, 21, 0,
-		0, 457, 458, 7, 12, 0, 0, 458, 100, 1, 0, 0, 0, 459, 460, 7, 17, 0, 0,
-		460, 461, 7, 0, 0, 0, 461, 462, 7, 5, 0, 0, 462, 463, 7, 13, 0, 0, 463,
-		464, 7, 11, 0, 0, 464, 102, 1, 0, 0, 0, 465, 466, 7, 13, 0, 0, 466, 467,
-		7, 9, 0, 0, 467, 468, 7, 2, 0, 0, 468, 469, 7, 4, 0, 0, 469, 470, 7, 17,
-		0, 0, 470, 471, 7, 0, 0, 0, 471, 472, 7, 5, 0, 0, 472, 473, 7, 13, 0, 0,
-		473, 474, 7, 11, 0, 0, 474, 104, 1, 0, 0, 0, 475, 476, 7, 9, 0, 0, 476,
-		477, 7, 8, 0, 0, 477, 478, 7, 1, 0, 0, 478, 479, 7, 3, 0, 0, 479, 480,
-		7, 5, 0, 0, 480, 481, 7, 1, 0, 0, 481, 482, 7, 19, 0, 0, 482, 483, 7, 15,
-		0, 0, 483, 484, 7, 15, 0, 0, 484, 106, 1, 0, 0, 0, 485, 486, 7, 9, 0, 0,
-		486, 487, 7, 8, 0, 0, 487, 488, 7, 1, 0, 0, 488, 489, 7, 19, 0, 0, 489,
-		490, 7, 15, 0, 0, 490, 491, 7, 15, 0, 0, 491, 108, 1, 0, 0, 0, 492, 493,
-		5, 124, 0, 0, 493, 110, 1, 0, 0, 0, 494, 495, 5, 40, 0, 0, 495, 112, 1,
-		0, 0, 0, 496, 497, 5, 41, 0, 0, 497, 114, 1, 0, 0, 0, 498, 499, 5, 91,
-		0, 0, 499, 116, 1, 0, 0, 0, 500, 501, 5, 93, 0, 0, 501, 118, 1, 0, 0, 0,
-		502, 503, 5, 123, 0, 0, 503, 120, 1, 0, 0, 0, 504, 505, 5, 125, 0, 0, 505,
-		122, 1, 0, 0, 0, 506, 507, 5, 44, 0, 0, 507, 124, 1, 0, 0, 0, 508, 509,
-		5, 58, 0, 0, 509, 126, 1, 0, 0, 0, 510, 511, 5, 34, 0, 0, 511, 128, 1,
-		0, 0, 0, 512, 513, 5, 43, 0, 0, 513, 130, 1, 0, 0, 0, 514, 515, 5, 45,
-		0, 0, 515, 132, 1, 0, 0, 0, 516, 517, 5, 47, 0, 0, 517, 134, 1, 0, 0, 0,
-		518, 519, 5, 37, 0, 0, 519, 136, 1, 0, 0, 0, 520, 526, 5, 34, 0, 0, 521,
-		525, 8, 24, 0, 0, 522, 523, 5, 92, 0, 0, 523, 525, 9, 0, 0, 0, 524, 521,
-		1, 0, 0, 0, 524, 522, 1, 0, 0, 0, 525, 528, 1, 0, 0, 0, 526, 524, 1, 0,
-		0, 0, 526, 527, 1, 0, 0, 0, 527, 529, 1, 0, 0, 0, 528, 526, 1, 0, 0, 0,
-		529, 541, 5, 34, 0, 0, 530, 536, 5, 39, 0, 0, 531, 535, 8, 25, 0, 0, 532,
-		533, 5, 92, 0, 0, 533, 535, 9, 0, 0, 0, 534, 531, 1, 0, 0, 0, 534, 532,
-		1, 0, 0, 0, 535, 538, 1, 0, 0, 0, 536, 534, 1, 0, 0, 0, 536, 537, 1, 0,
-		0, 0, 537, 539, 1, 0, 0, 0, 538, 536, 1, 0, 0, 0, 539, 541, 5, 39, 0, 0,
-		540, 520, 1, 0, 0, 0, 540, 530, 1, 0, 0, 0, 541, 138, 1, 0, 0, 0, 542,
-		544, 5, 45, 0, 0, 543, 542, 1, 0, 0, 0, 543, 544, 1, 0, 0, 0, 544, 546,
-		1, 0, 0, 0, 545, 547, 7, 26, 0, 0, 546, 545, 1, 0, 0, 0, 547, 548, 1, 0,
-		0, 0, 548, 546, 1, 0, 0, 0, 548, 549, 1, 0, 0, 0, 549, 550, 1, 0, 0, 0,
-		550, 567, 7, 27, 0, 0, 551, 553, 5, 45, 0, 0, 552, 551, 1, 0, 0, 0, 552,
-		553, 1, 0, 0, 0, 553, 555, 1, 0, 0, 0, 554, 556, 7, 26, 0, 0, 555, 554,
-		1, 0, 0, 0, 556, 557, 1, 0, 0, 0, 557, 555, 1, 0, 0, 0, 557, 558, 1, 0,
-		0, 0, 558, 559, 1, 0, 0, 0, 559, 560, 7, 27, 0, 0, 560, 562, 5, 64, 0,
-		0, 561, 563, 7, 28, 0, 0, 562, 561, 1, 0, 0, 0, 563, 564, 1, 0, 0, 0, 564,
-		562, 1, 0, 0, 0, 564, 565, 1, 0, 0, 0, 565, 567, 1, 0, 0, 0, 566, 543,
-		1, 0, 0, 0, 566, 552, 1, 0, 0, 0, 567, 140, 1, 0, 0, 0, 568, 570, 3, 143,
-		71, 0, 569, 568, 1, 0, 0, 0, 570, 571, 1, 0, 0, 0, 571, 569, 1, 0, 0, 0,
-		571, 572, 1, 0, 0, 0, 572, 579, 1, 0, 0, 0, 573, 575, 5, 46, 0, 0, 574,
-		576, 3, 143, 71, 0, 575, 574, 1, 0, 0, 0, 576, 577, 1, 0, 0, 0, 577, 575,
-		1, 0, 0, 0, 577, 578, 1, 0, 0, 0, 578, 580, 1, 0, 0, 0, 579, 573, 1, 0,
-		0, 0, 579, 580, 1, 0, 0, 0, 580, 588, 1, 0, 0, 0, 581, 583, 5, 46, 0, 0,
-		582, 584, 3, 143, 71, 0, 583, 582, 1, 0, 0, 0, 584, 585, 1, 0, 0, 0, 585,
-		583, 1, 0, 0, 0, 585, 586, 1, 0, 0, 0, 586, 588, 1, 0, 0, 0, 587, 569,
-		1, 0, 0, 0, 587, 581, 1, 0, 0, 0, 588, 142, 1, 0, 0, 0, 589, 590, 7, 26,
-		0, 0, 590, 144, 1, 0, 0, 0, 591, 592, 5, 42, 0, 0, 592, 146, 1, 0, 0, 0,
-		593, 594, 5, 36, 0, 0, 594, 148, 1, 0, 0, 0, 595, 596, 5, 60, 0, 0, 596,
-		597, 5, 60, 0, 0, 597, 598, 1, 0, 0, 0, 598, 602, 7, 29, 0, 0, 599, 601,
-		7, 30, 0, 0, 600, 599, 1, 0, 0, 0, 601, 604, 1, 0, 0, 0, 602, 600, 1, 0,
-		0, 0, 602, 603, 1, 0, 0, 0, 603, 605, 1, 0, 0, 0, 604, 602, 1, 0, 0, 0,
-		605, 606, 5, 62, 0, 0, 606, 607, 5, 62, 0, 0, 607, 150, 1, 0, 0, 0, 608,
-		612, 7, 29, 0, 0, 609, 611, 7, 30, 0, 0, 610, 609, 1, 0, 0, 0, 611, 614,
-		1, 0, 0, 0, 612, 610, 1, 0, 0, 0, 612, 613, 1, 0, 0, 0, 613, 635, 1, 0,
-		0, 0, 614, 612, 1, 0, 0, 0, 615, 619, 7, 29, 0, 0, 616, 618, 7, 30, 0,
-		0, 617, 616, 1, 0, 0, 0, 618, 621, 1, 0, 0, 0, 619, 617, 1, 0, 0, 0, 619,
-		620, 1, 0, 0, 0, 620, 630, 1, 0, 0, 0, 621, 619, 1, 0, 0, 0, 622, 623,
-		5, 46, 0, 0, 623, 627, 7, 29, 0, 0, 624, 626, 7, 30, 0, 0, 625, 624, 1,
-		0, 0, 0, 626, 629, 1, 0, 0, 0, 627, 625, 1, 0, 0, 0, 627, 628, 1, 0, 0,
-		0, 628, 631, 1, 0, 0, 0, 629, 627, 1, 0, 0, 0, 630, 622, 1, 0, 0, 0, 631,
-		632, 1, 0, 0, 0, 632, 630, 1, 0, 0, 0, 632, 633, 1, 0, 0, 0, 633, 635,
-		1, 0, 0, 0, 634, 608, 1, 0, 0, 0, 634, 615, 1, 0, 0, 0, 635, 152, 1, 0,
-		0, 0, 636, 637, 5, 46, 0, 0, 637, 154, 1, 0, 0, 0, 638, 639, 5, 47, 0,
-		0, 639, 643, 7, 28, 0, 0, 640, 642, 7, 31, 0, 0, 641, 640, 1, 0, 0, 0,
-		642, 645, 1, 0, 0, 0, 643, 641, 1, 0, 0, 0, 643, 644, 1, 0, 0, 0, 644,
-		646, 1, 0, 0, 0, 645, 643, 1, 0, 0, 0, 646, 648, 5, 47, 0, 0, 647, 649,
-		7, 32, 0, 0, 648, 647, 1, 0, 0, 0, 649, 650, 1, 0, 0, 0, 650, 648, 1, 0,
-		0, 0, 650, 651, 1, 0, 0, 0, 651, 660, 1, 0, 0, 0, 652, 654, 5, 47, 0, 0,
-		653, 655, 7, 32, 0, 0, 654, 653, 1, 0, 0, 0, 655, 656, 1, 0, 0, 0, 656,
-		654, 1, 0, 0, 0, 656, 657, 1, 0, 0, 0, 657, 659, 1, 0, 0, 0, 658, 652,
-		1, 0, 0, 0, 659, 662, 1, 0, 0, 0, 660, 658, 1, 0, 0, 0, 660, 661, 1, 0,
-		0, 0, 661, 156, 1, 0, 0, 0, 662, 660, 1, 0, 0, 0, 663, 665, 5, 96, 0, 0,
-		664, 666, 8, 33, 0, 0, 665, 664, 1, 0, 0, 0, 666, 667, 1, 0, 0, 0, 667,
-		665, 1, 0, 0, 0, 667, 668, 1, 0, 0, 0, 668, 669, 1, 0, 0, 0, 669, 670,
-		5, 96, 0, 0, 670, 158, 1, 0, 0, 0, 671, 673, 5, 64, 0, 0, 672, 674, 7,
-		28, 0, 0, 673, 672, 1, 0, 0, 0, 674, 675, 1, 0, 0, 0, 675, 673, 1, 0, 0,
-		0, 675, 676, 1, 0, 0, 0, 676, 160, 1, 0, 0, 0, 677, 679, 7, 34, 0, 0, 678,
-		677, 1, 0, 0, 0, 679, 680, 1, 0, 0, 0, 680, 678, 1, 0, 0, 0, 680, 681,
-		1, 0, 0, 0, 681, 682, 1, 0, 0, 0, 682, 683, 6, 80, 0, 0, 683, 162, 1, 0,
-		0, 0, 684, 685, 5, 96, 0, 0, 685, 686, 5, 96, 0, 0, 686, 687, 5, 96, 0,
-		0, 687, 691, 1, 0, 0, 0, 688, 690, 8, 35, 0, 0, 689, 688, 1, 0, 0, 0, 690,
-		693, 1, 0, 0, 0, 691, 689, 1, 0, 0, 0, 691, 692, 1, 0, 0, 0, 692, 694,
-		1, 0, 0, 0, 693, 691, 1, 0, 0, 0, 694, 695, 6, 81, 0, 0, 695, 164, 1, 0,
-		0, 0, 31, 0, 524, 526, 534, 536, 540, 543, 548, 552, 557, 564, 566, 571,
-		577, 579, 585, 587, 602, 612, 619, 627, 632, 634, 643, 650, 656, 660, 667,
-		675, 680, 691, 1, 6, 0, 0,
+		0, 34, 34, 92, 92, 2, 0, 39, 39, 92, 92, 10, 0, 9, 10, 13, 13, 32, 32,
+		34, 34, 39, 41, 44, 44, 61, 61, 91, 91, 93, 93, 124, 124, 2, 0, 43, 43,
+		45, 45, 1, 0, 48, 57, 2, 0, 65, 90, 97, 122, 2, 0, 77, 77, 113, 113, 3,
+		0, 65, 90, 95, 95, 97, 122, 5, 0, 46, 46, 48, 57, 65, 90, 95, 95, 97, 122,
+		4, 0, 48, 57, 65, 90, 95, 95, 97, 122, 5, 0, 45, 45, 48, 57, 65, 90, 95,
+		95, 97, 122, 7, 0, 37, 37, 42, 42, 45, 46, 48, 58, 65, 90, 95, 95, 97,
+		122, 1, 0, 96, 96, 3, 0, 9, 10, 13, 13, 32, 32, 3, 0, 10, 10, 13, 13, 96,
+		96, 1204, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1,
+		0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15,
+		1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0,
+		23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0,
+		0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0,
+		0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0,
+		0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1,
+		0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61,
+		1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0,
+		69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0,
+		0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0,
+		0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0,
+		0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1,
+		0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0,
+		107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 1, 0,
+		0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121,
+		1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0,
+		0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1,
+		0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 0,
+		143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149, 1, 0,
+		0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0, 0, 157,
+		1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0,
+		0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 175, 1,
+		0, 0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 0, 185, 1, 0, 0, 0, 0,
+		187, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191, 1, 0, 0, 0, 0, 193, 1, 0,
+		0, 0, 0, 195, 1, 0, 0, 0, 0, 197, 1, 0, 0, 0, 0, 199, 1, 0, 0, 0, 0, 201,
+		1, 0, 0, 0, 0, 203, 1, 0, 0, 0, 0, 205, 1, 0, 0, 0, 0, 207, 1, 0, 0, 0,
+		1, 209, 1, 0, 0, 0, 3, 213, 1, 0, 0, 0, 5, 216, 1, 0, 0, 0, 7, 220, 1,
+		0, 0, 0, 9, 223, 1, 0, 0, 0, 11, 226, 1, 0, 0, 0, 13, 229, 1, 0, 0, 0,
+		15, 235, 1, 0, 0, 0, 17, 242, 1, 0, 0, 0, 19, 247, 1, 0, 0, 0, 21, 253,
+		1, 0, 0, 0, 23, 259, 1, 0, 0, 0, 25, 266, 1, 0, 0, 0, 27, 273, 1, 0, 0,
+		0, 29, 279, 1, 0, 0, 0, 31, 283, 1, 0, 0, 0, 33, 289, 1, 0, 0, 0, 35, 294,
+		1, 0, 0, 0, 37, 299, 1, 0, 0, 0, 39, 304, 1, 0, 0, 0, 41, 308, 1, 0, 0,
+		0, 43, 313, 1, 0, 0, 0, 45, 320, 1, 0, 0, 0, 47, 325, 1, 0, 0, 0, 49, 332,
+		1, 0, 0, 0, 51, 343, 1, 0, 0, 0, 53, 354, 1, 0, 0, 0, 55, 360, 1, 0, 0,
+		0, 57, 372, 1, 0, 0, 0, 59, 384, 1, 0, 0, 0, 61, 390, 1, 0, 0, 0, 63, 401,
+		1, 0, 0, 0, 65, 413, 1, 0, 0, 0, 67, 423, 1, 0, 0, 0, 69, 429, 1, 0, 0,
+		0, 71, 438, 1, 0, 0, 0, 73, 445, 1, 0, 0, 0, 75, 454, 1, 0, 0, 0, 77, 461,
+		1, 0, 0, 0, 79, 468, 1, 0, 0, 0, 81, 476, 1, 0, 0, 0, 83, 480, 1, 0, 0,
+		0, 85, 488, 1, 0, 0, 0, 87, 495, 1, 0, 0, 0, 89, 499, 1, 0, 0, 0, 91, 504,
+		1, 0, 0, 0, 93, 509, 1, 0, 0, 0, 95, 516, 1, 0, 0, 0, 97, 521, 1, 0, 0,
+		0, 99, 529, 1, 0, 0, 0, 101, 536, 1, 0, 0, 0, 103, 548, 1, 0, 0, 0, 105,
+		555, 1, 0, 0, 0, 107, 565, 1, 0, 0, 0, 109, 567, 1, 0, 0, 0, 111, 570,
+		1, 0, 0, 0, 113, 573, 1, 0, 0, 0, 115, 575, 1, 0, 0, 0, 117, 577, 1, 0,
+		0, 0, 119, 580, 1, 0, 0, 0, 121, 583, 1, 0, 0, 0, 123, 588, 1, 0, 0, 0,
+		125, 594, 1, 0, 0, 0, 127, 604, 1, 0, 0, 0, 129, 614, 1, 0, 0, 0, 131,
+		621, 1, 0, 0, 0, 133, 623, 1, 0, 0, 0, 135, 625, 1, 0, 0, 0, 137, 627,
+		1, 0, 0, 0, 139, 629, 1, 0, 0, 0, 141, 631, 1, 0, 0, 0, 143, 633, 1, 0,
+		0, 0, 145, 635, 1, 0, 0, 0, 147, 637, 1, 0, 0, 0, 149, 640, 1, 0, 0, 0,
+		151, 642, 1, 0, 0, 0, 153, 644, 1, 0, 0, 0, 155, 646, 1, 0, 0, 0, 157,
+		648, 1, 0, 0, 0, 159, 650, 1, 0, 0, 0, 161, 652, 1, 0, 0, 0, 163, 663,
+		1, 0, 0, 0, 165, 674, 1, 0, 0, 0, 167, 683, 1, 0, 0, 0, 169, 703, 1, 0,
+		0, 0, 171, 708, 1, 0, 0, 0, 173, 866, 1, 0, 0, 0, 175, 868, 1, 0, 0, 0,
+		177, 894, 1, 0, 0, 0, 179, 949, 1, 0, 0, 0, 181, 951, 1, 0, 0, 0, 183,
+		953, 1, 0, 0, 0, 185, 962, 1, 0, 0, 0, 187, 964, 1, 0, 0, 0, 189, 966,
+		1, 0, 0, 0, 191, 976, 1, 0, 0, 0, 193, 1015, 1, 0, 0, 0, 195, 1017, 1,
+		0, 0, 0, 197, 1019, 1, 0, 0, 0, 199, 1044, 1, 0, 0, 0, 201, 1052, 1, 0,
+		0, 0, 203, 1074, 1, 0, 0, 0, 205, 1080, 1, 0, 0, 0, 207, 1096, 1, 0, 0,
+		0, 209, 210, 7, 0, 0, 0, 210, 211, 7, 1, 0, 0, 211, 212, 7, 2, 0, 0, 212,
+		2, 1, 0, 0, 0, 213, 214, 7, 3, 0, 0, 214, 215, 7, 4, 0, 0, 215, 4, 1, 0,
+		0, 0, 216, 217, 7, 1, 0, 0, 217, 218, 7, 3, 0, 0, 218, 219, 7, 5, 0, 0,
+		219, 6, 1, 0, 0, 0, 220, 221, 7, 6, 0, 0, 221, 222, 7, 7, 0, 0, 222, 8,
+		1, 0, 0, 0, 223, 224, 7, 0, 0, 0, 224, 225, 7, 8, 0, 0, 225, 10, 1, 0,
+		0, 0, 226, 227, 7, 9, 0, 0, 227, 228, 7, 1, 0, 0, 228, 12, 1, 0, 0, 0,
+		229, 230, 7, 10, 0, 0, 230, 231, 7, 11, 0, 0, 231, 232, 7, 12, 0, 0, 232,
+		233, 7, 4, 0, 0, 233, 234, 7, 12, 0, 0, 234, 14, 1, 0, 0, 0, 235, 236,
+		7, 8, 0, 0, 236, 237, 7, 12, 0, 0, 237, 238, 7, 0, 0, 0, 238, 239, 7, 4,
+		0, 0, 239, 240, 7, 13, 0, 0, 240, 241, 7, 11, 0, 0, 241, 16, 1, 0, 0, 0,
+		242, 243, 7, 12, 0, 0, 243, 244, 7, 14, 0, 0, 244, 245, 7, 0, 0, 0, 245,
+		246, 7, 15, 0, 0, 246, 18, 1, 0, 0, 0, 247, 248, 7, 8, 0, 0, 248, 249,
+		7, 5, 0, 0, 249, 250, 7, 0, 0, 0, 250, 251, 7, 5, 0, 0, 251, 252, 7, 8,
+		0, 0, 252, 20, 1, 0, 0, 0, 253, 254, 7, 5, 0, 0, 254, 255, 7, 0, 0, 0,
+		255, 256, 7, 6, 0, 0, 256, 257, 7, 15, 0, 0, 257, 258, 7, 12, 0, 0, 258,
+		22, 1, 0, 0, 0, 259, 260, 7, 16, 0, 0, 260, 261, 7, 9, 0, 0, 261, 262,
+		7, 12, 0, 0, 262, 263, 7, 15, 0, 0, 263, 264, 7, 2, 0, 0, 264, 265, 7,
+		8, 0, 0, 265, 24, 1, 0, 0, 0, 266, 267, 7, 4, 0, 0, 267, 268, 7, 12, 0,
+		0, 268, 269, 7, 1, 0, 0, 269, 270, 7, 0, 0, 0, 270, 271, 7, 17, 0, 0, 271,
+		272, 7, 12, 0, 0, 272, 26, 1, 0, 0, 0, 273, 274, 7, 4, 0, 0, 274, 275,
+		7, 12, 0, 0, 275, 276, 7, 18, 0, 0, 276, 277, 7, 12, 0, 0, 277, 278, 7,
+		19, 0, 0, 278, 28, 1, 0, 0, 0, 279, 280, 7, 4, 0, 0, 280, 281, 7, 12, 0,
+		0, 281, 282, 7, 19, 0, 0, 282, 30, 1, 0, 0, 0, 283, 284, 7, 2, 0, 0, 284,
+		285, 7, 12, 0, 0, 285, 286, 7, 2, 0, 0, 286, 287, 7, 20, 0, 0, 287, 288,
+		7, 21, 0, 0, 288, 32, 1, 0, 0, 0, 289, 290, 7, 8, 0, 0, 290, 291, 7, 3,
+		0, 0, 291, 292, 7, 4, 0, 0, 292, 293, 7, 5, 0, 0, 293, 34, 1, 0, 0, 0,
+		294, 295, 7, 11, 0, 0, 295, 296, 7, 12, 0, 0, 296, 297, 7, 0, 0, 0, 297,
+		298, 7, 2, 0, 0, 298, 36, 1, 0, 0, 0, 299, 300, 7, 5, 0, 0, 300, 301, 7,
+		0, 0, 0, 301, 302, 7, 9, 0, 0, 302, 303, 7, 15, 0, 0, 303, 38, 1, 0, 0,
+		0, 304, 305, 7, 5, 0, 0, 305, 306, 7, 3, 0, 0, 306, 307, 7, 21, 0, 0, 307,
+		40, 1, 0, 0, 0, 308, 309, 7, 4, 0, 0, 309, 310, 7, 0, 0, 0, 310, 311, 7,
+		4, 0, 0, 311, 312, 7, 12, 0, 0, 312, 42, 1, 0, 0, 0, 313, 314, 7, 15, 0,
+		0, 314, 315, 7, 3, 0, 0, 315, 316, 7, 3, 0, 0, 316, 317, 7, 22, 0, 0, 317,
+		318, 7, 20, 0, 0, 318, 319, 7, 21, 0, 0, 319, 44, 1, 0, 0, 0, 320, 321,
+		7, 23, 0, 0, 321, 322, 7, 3, 0, 0, 322, 323, 7, 9, 0, 0, 323, 324, 7, 1,
+		0, 0, 324, 46, 1, 0, 0, 0, 325, 326, 7, 0, 0, 0, 326, 327, 7, 21, 0, 0,
+		327, 328, 7, 21, 0, 0, 328, 329, 7, 12, 0, 0, 329, 330, 7, 1, 0, 0, 330,
+		331, 7, 2, 0, 0, 331, 48, 1, 0, 0, 0, 332, 333, 7, 0, 0, 0, 333, 334, 7,
+		21, 0, 0, 334, 335, 7, 21, 0, 0, 335, 336, 7, 12, 0, 0, 336, 337, 7, 1,
+		0, 0, 337, 338, 7, 2, 0, 0, 338, 339, 7, 13, 0, 0, 339, 340, 7, 3, 0, 0,
+		340, 341, 7, 15, 0, 0, 341, 342, 7, 8, 0, 0, 342, 50, 1, 0, 0, 0, 343,
+		344, 7, 0, 0, 0, 344, 345, 7, 21, 0, 0, 345, 346, 7, 21, 0, 0, 346, 347,
+		7, 12, 0, 0, 347, 348, 7, 1, 0, 0, 348, 349, 7, 2, 0, 0, 349, 350, 7, 21,
+		0, 0, 350, 351, 7, 9, 0, 0, 351, 352, 7, 21, 0, 0, 352, 353, 7, 12, 0,
+		0, 353, 52, 1, 0, 0, 0, 354, 355, 7, 20, 0, 0, 355, 356, 7, 1, 0, 0, 356,
+		357, 7, 9, 0, 0, 357, 358, 7, 3, 0, 0, 358, 359, 7, 1, 0, 0, 359, 54, 1,
+		0, 0, 0, 360, 361, 7, 17, 0, 0, 361, 362, 7, 20, 0, 0, 362, 363, 7, 15,
+		0, 0, 363, 364, 7, 5, 0, 0, 364, 365, 7, 9, 0, 0, 365, 366, 7, 8, 0, 0,
+		366, 367, 7, 12, 0, 0, 367, 368, 7, 0, 0, 0, 368, 369, 7, 4, 0, 0, 369,
+		370, 7, 13, 0, 0, 370, 371, 7, 11, 0, 0, 371, 56, 1, 0, 0, 0, 372, 373,
+		7, 5, 0, 0, 373, 374, 7, 4, 0, 0, 374, 375, 7, 0, 0, 0, 375, 376, 7, 1,
+		0, 0, 376, 377, 7, 8, 0, 0, 377, 378, 7, 0, 0, 0, 378, 379, 7, 13, 0, 0,
+		379, 380, 7, 5, 0, 0, 380, 381, 7, 9, 0, 0, 381, 382, 7, 3, 0, 0, 382,
+		383, 7, 1, 0, 0, 383, 58, 1, 0, 0, 0, 384, 385, 7, 8, 0, 0, 385, 386, 7,
+		21, 0, 0, 386, 387, 7, 0, 0, 0, 387, 388, 7, 5, 0, 0, 388, 389, 7, 11,
+		0, 0, 389, 60, 1, 0, 0, 0, 390, 391, 7, 12, 0, 0, 391, 392, 7, 14, 0, 0,
+		392, 393, 7, 12, 0, 0, 393, 394, 7, 1, 0, 0, 394, 395, 7, 5, 0, 0, 395,
+		396, 7, 8, 0, 0, 396, 397, 7, 5, 0, 0, 397, 398, 7, 0, 0, 0, 398, 399,
+		7, 5, 0, 0, 399, 400, 7, 8, 0, 0, 400, 62, 1, 0, 0, 0, 401, 402, 7, 8,
+		0, 0, 402, 403, 7, 5, 0, 0, 403, 404, 7, 4, 0, 0, 404, 405, 7, 12, 0, 0,
+		405, 406, 7, 0, 0, 0, 406, 407, 7, 17, 0, 0, 407, 408, 7, 8, 0, 0, 408,
+		409, 7, 5, 0, 0, 409, 410, 7, 0, 0, 0, 410, 411, 7, 5, 0, 0, 411, 412,
+		7, 8, 0, 0, 412, 64, 1, 0, 0, 0, 413, 414, 7, 5, 0, 0, 414, 415, 7, 9,
+		0, 0, 415, 416, 7, 17, 0, 0, 416, 417, 7, 12, 0, 0, 417, 418, 7, 13, 0,
+		0, 418, 419, 7, 11, 0, 0, 419, 420, 7, 0, 0, 0, 420, 421, 7, 4, 0, 0, 421,
+		422, 7, 5, 0, 0, 422, 66, 1, 0, 0, 0, 423, 424, 7, 13, 0, 0, 424, 425,
+		7, 11, 0, 0, 425, 426, 7, 0, 0, 0, 426, 427, 7, 4, 0, 0, 427, 428, 7, 5,
+		0, 0, 428, 68, 1, 0, 0, 0, 429, 430, 7, 16, 0, 0, 430, 431, 7, 9, 0, 0,
+		431, 432, 7, 15, 0, 0, 432, 433, 7, 15, 0, 0, 433, 434, 7, 1, 0, 0, 434,
+		435, 7, 20, 0, 0, 435, 436, 7, 15, 0, 0, 436, 437, 7, 15, 0, 0, 437, 70,
+		1, 0, 0, 0, 438, 439, 7, 17, 0, 0, 439, 440, 7, 0, 0, 0, 440, 441, 7, 22,
+		0, 0, 441, 442, 7, 12, 0, 0, 442, 443, 7, 17, 0, 0, 443, 444, 7, 14, 0,
+		0, 444, 72, 1, 0, 0, 0, 445, 446, 7, 17, 0, 0, 446, 447, 7, 14, 0, 0, 447,
+		448, 7, 12, 0, 0, 448, 449, 7, 19, 0, 0, 449, 450, 7, 21, 0, 0, 450, 451,
+		7, 0, 0, 0, 451, 452, 7, 1, 0, 0, 452, 453, 7, 2, 0, 0, 453, 74, 1, 0,
+		0, 0, 454, 455, 7, 16, 0, 0, 455, 456, 7, 3, 0, 0, 456, 457, 7, 4, 0, 0,
+		457, 458, 7, 17, 0, 0, 458, 459, 7, 0, 0, 0, 459, 460, 7, 5, 0, 0, 460,
+		76, 1, 0, 0, 0, 461, 462, 7, 4, 0, 0, 462, 463, 7, 12, 0, 0, 463, 464,
+		7, 5, 0, 0, 464, 465, 7, 20, 0, 0, 465, 466, 7, 4, 0, 0, 466, 467, 7, 1,
+		0, 0, 467, 78, 1, 0, 0, 0, 468, 469, 7, 16, 0, 0, 469, 470, 7, 3, 0, 0,
+		470, 471, 7, 4, 0, 0, 471, 472, 7, 12, 0, 0, 472, 473, 7, 0, 0, 0, 473,
+		474, 7, 13, 0, 0, 474, 475, 7, 11, 0, 0, 475, 80, 1, 0, 0, 0, 476, 477,
+		7, 17, 0, 0, 477, 478, 7, 0, 0, 0, 478, 479, 7, 21, 0, 0, 479, 82, 1, 0,
+		0, 0, 480, 481, 7, 13, 0, 0, 481, 482, 7, 3, 0, 0, 482, 483, 7, 1, 0, 0,
+		483, 484, 7, 14, 0, 0, 484, 485, 7, 12, 0, 0, 485, 486, 7, 4, 0, 0, 486,
+		487, 7, 5, 0, 0, 487, 84, 1, 0, 0, 0, 488, 489, 7, 6, 0, 0, 489, 490, 7,
+		20, 0, 0, 490, 491, 7, 13, 0, 0, 491, 492, 7, 22, 0, 0, 492, 493, 7, 12,
+		0, 0, 493, 494, 7, 5, 0, 0, 494, 86, 1, 0, 0, 0, 495, 496, 7, 6, 0, 0,
+		496, 497, 7, 9, 0, 0, 497, 498, 7, 1, 0, 0, 498, 88, 1, 0, 0, 0, 499, 500,
+		7, 3, 0, 0, 500, 501, 7, 14, 0, 0, 501, 502, 7, 12, 0, 0, 502, 503, 7,
+		4, 0, 0, 503, 90, 1, 0, 0, 0, 504, 505, 7, 4, 0, 0, 505, 506, 7, 12, 0,
+		0, 506, 507, 7, 8, 0, 0, 507, 508, 7, 5, 0, 0, 508, 92, 1, 0, 0, 0, 509,
+		510, 7, 5, 0, 0, 510, 511, 7, 8, 0, 0, 511, 512, 7, 5, 0, 0, 512, 513,
+		7, 0, 0, 0, 513, 514, 7, 5, 0, 0, 514, 515, 7, 8, 0, 0, 515, 94, 1, 0,
+		0, 0, 516, 517, 7, 16, 0, 0, 517, 518, 7, 4, 0, 0, 518, 519, 7, 3, 0, 0,
+		519, 520, 7, 17, 0, 0, 520, 96, 1, 0, 0, 0, 521, 522, 7, 18, 0, 0, 522,
+		523, 7, 4, 0, 0, 523, 524, 7, 3, 0, 0, 524, 525, 7, 20, 0, 0, 525, 526,
+		7, 21, 0, 0, 526, 527, 7, 6, 0, 0, 527, 528, 7, 7, 0, 0, 528, 98, 1, 0,
+		0, 0, 529, 530, 7, 17, 0, 0, 530, 531, 7, 8, 0, 0, 531, 532, 7, 5, 0, 0,
+		532, 533, 7, 0, 0, 0, 533, 534, 7, 5, 0, 0, 534, 535, 7, 8, 0, 0, 535,
+		100, 1, 0, 0, 0, 536, 537, 7, 9, 0, 0, 537, 538, 7, 1, 0, 0, 538, 539,
+		7, 21, 0, 0, 539, 540, 7, 20, 0, 0, 540, 541, 7, 5, 0, 0, 541, 542, 7,
+		15, 0, 0, 542, 543, 7, 3, 0, 0, 543, 544, 7, 3, 0, 0, 544, 545, 7, 22,
+		0, 0, 545, 546, 7, 20, 0, 0, 546, 547, 7, 21, 0, 0, 547, 102, 1, 0, 0,
+		0, 548, 549, 7, 3, 0, 0, 549, 550, 7, 20, 0, 0, 550, 551, 7, 5, 0, 0, 551,
+		552, 7, 21, 0, 0, 552, 553, 7, 20, 0, 0, 553, 554, 7, 5, 0, 0, 554, 104,
+		1, 0, 0, 0, 555, 556, 7, 3, 0, 0, 556, 557, 7, 20, 0, 0, 557, 558, 7, 5,
+		0, 0, 558, 559, 7, 21, 0, 0, 559, 560, 7, 20, 0, 0, 560, 561, 7, 5, 0,
+		0, 561, 562, 7, 1, 0, 0, 562, 563, 7, 12, 0, 0, 563, 564, 7, 10, 0, 0,
+		564, 106, 1, 0, 0, 0, 565, 566, 5, 61, 0, 0, 566, 108, 1, 0, 0, 0, 567,
+		568, 5, 61, 0, 0, 568, 569, 5, 61, 0, 0, 569, 110, 1, 0, 0, 0, 570, 571,
+		5, 33, 0, 0, 571, 572, 5, 61, 0, 0, 572, 112, 1, 0, 0, 0, 573, 574, 5,
+		60, 0, 0, 574, 114, 1, 0, 0, 0, 575, 576, 5, 62, 0, 0, 576, 116, 1, 0,
+		0, 0, 577, 578, 5, 60, 0, 0, 578, 579, 5, 61, 0, 0, 579, 118, 1, 0, 0,
+		0, 580, 581, 5, 62, 0, 0, 581, 582, 5, 61, 0, 0, 582, 120, 1, 0, 0, 0,
+		583, 584, 7, 15, 0, 0, 584, 585, 7, 9, 0, 0, 585, 586, 7, 22, 0, 0, 586,
+		587, 7, 12, 0, 0, 587, 122, 1, 0, 0, 0, 588, 589, 7, 17, 0, 0, 589, 590,
+		7, 0, 0, 0, 590, 591, 7, 5, 0, 0, 591, 592, 7, 13, 0, 0, 592, 593, 7, 11,
+		0, 0, 593, 124, 1, 0, 0, 0, 594, 595, 7, 13, 0, 0, 595, 596, 7, 9, 0, 0,
+		596, 597, 7, 2, 0, 0, 597, 598, 7, 4, 0, 0, 598, 599, 7, 17, 0, 0, 599,
+		600, 7, 0, 0, 0, 600, 601, 7, 5, 0, 0, 601, 602, 7, 13, 0, 0, 602, 603,
+		7, 11, 0, 0, 603, 126, 1, 0, 0, 0, 604, 605, 7, 9, 0, 0, 605, 606, 7, 8,
+		0, 0, 606, 607, 7, 1, 0, 0, 607, 608, 7, 3, 0, 0, 608, 609, 7, 5, 0, 0,
+		609, 610, 7, 1, 0, 0, 610, 611, 7, 20, 0, 0, 611, 612, 7, 15, 0, 0, 612,
+		613, 7, 15, 0, 0, 613, 128, 1, 0, 0, 0, 614, 615, 7, 9, 0, 0, 615, 616,
+		7, 8, 0, 0, 616, 617, 7, 1, 0, 0, 617, 618, 7, 20, 0, 0, 618, 619, 7, 15,
+		0, 0, 619, 620, 7, 15, 0, 0, 620, 130, 1, 0, 0, 0, 621, 622, 5, 124, 0,
+		0, 622, 132, 1, 0, 0, 0, 623, 624, 5, 40, 0, 0, 624, 134, 1, 0, 0, 0, 625,
+		626, 5, 41, 0, 0, 626, 136, 1, 0, 0, 0, 627, 628, 5, 91, 0, 0, 628, 138,
+		1, 0, 0, 0, 629, 630, 5, 93, 0, 0, 630, 140, 1, 0, 0, 0, 631, 632, 5, 123,
+		0, 0, 632, 142, 1, 0, 0, 0, 633, 634, 5, 125, 0, 0, 634, 144, 1, 0, 0,
+		0, 635, 636, 5, 44, 0, 0, 636, 146, 1, 0, 0, 0, 637, 638, 5, 58, 0, 0,
+		638, 639, 5, 58, 0, 0, 639, 148, 1, 0, 0, 0, 640, 641, 5, 58, 0, 0, 641,
+		150, 1, 0, 0, 0, 642, 643, 5, 34, 0, 0, 643, 152, 1, 0, 0, 0, 644, 645,
+		5, 43, 0, 0, 645, 154, 1, 0, 0, 0, 646, 647, 5, 45, 0, 0, 647, 156, 1,
+		0, 0, 0, 648, 649, 5, 47, 0, 0, 649, 158, 1, 0, 0, 0, 650, 651, 5, 37,
+		0, 0, 651, 160, 1, 0, 0, 0, 652, 658, 5, 34, 0, 0, 653, 657, 8, 24, 0,
+		0, 654, 655, 5, 92, 0, 0, 655, 657, 9, 0, 0, 0, 656, 653, 1, 0, 0, 0, 656,
+		654, 1, 0, 0, 0, 657, 660, 1, 0, 0, 0, 658, 656, 1, 0, 0, 0, 658, 659,
+		1, 0, 0, 0, 659, 661, 1, 0, 0, 0, 660, 658, 1, 0, 0, 0, 661, 662, 5, 34,
+		0, 0, 662, 162, 1, 0, 0, 0, 663, 669, 5, 39, 0, 0, 664, 668, 8, 25, 0,
+		0, 665, 666, 5, 92, 0, 0, 666, 668, 9, 0, 0, 0, 667, 664, 1, 0, 0, 0, 667,
+		665, 1, 0, 0, 0, 668, 671, 1, 0, 0, 0, 669, 667, 1, 0, 0, 0, 669, 670,
+		1, 0, 0, 0, 670, 672, 1, 0, 0, 0, 671, 669, 1, 0, 0, 0, 672, 673, 5, 39,
+		0, 0, 673, 164, 1, 0, 0, 0, 674, 675, 5, 84, 0, 0, 675, 676, 5, 69, 0,
+		0, 676, 677, 5, 82, 0, 0, 677, 678, 5, 77, 0, 0, 678, 679, 5, 40, 0, 0,
+		679, 680, 1, 0, 0, 0, 680, 681, 3, 169, 84, 0, 681, 682, 5, 41, 0, 0, 682,
+		166, 1, 0, 0, 0, 683, 684, 5, 67, 0, 0, 684, 685, 5, 65, 0, 0, 685, 686,
+		5, 83, 0, 0, 686, 687, 5, 69, 0, 0, 687, 688, 5, 40, 0, 0, 688, 689, 1,
+		0, 0, 0, 689, 690, 3, 169, 84, 0, 690, 691, 5, 41, 0, 0, 691, 168, 1, 0,
+		0, 0, 692, 704, 8, 26, 0, 0, 693, 699, 5, 34, 0, 0, 694, 698, 8, 24, 0,
+		0, 695, 696, 5, 92, 0, 0, 696, 698, 9, 0, 0, 0, 697, 694, 1, 0, 0, 0, 697,
+		695, 1, 0, 0, 0, 698, 701, 1, 0, 0, 0, 699, 697, 1, 0, 0, 0, 699, 700,
+		1, 0, 0, 0, 700, 702, 1, 0, 0, 0, 701, 699, 1, 0, 0, 0, 702, 704, 5, 34,
+		0, 0, 703, 692, 1, 0, 0, 0, 703, 693, 1, 0, 0, 0, 704, 705, 1, 0, 0, 0,
+		705, 703, 1, 0, 0, 0, 705, 706, 1, 0, 0, 0, 706, 170, 1, 0, 0, 0, 707,
+		709, 7, 27, 0, 0, 708, 707, 1, 0, 0, 0, 708, 709, 1, 0, 0, 0, 709, 711,
+		1, 0, 0, 0, 710, 712, 7, 28, 0, 0, 711, 710, 1, 0, 0, 0, 712, 713, 1, 0,
+		0, 0, 713, 711, 1, 0, 0, 0, 713, 714, 1, 0, 0, 0, 714, 715, 1, 0, 0, 0,
+		715, 725, 3, 173, 86, 0, 716, 718, 5, 64, 0, 0, 717, 719, 7, 29, 0, 0,
+		718, 717, 1, 0, 0, 0, 719, 720, 1, 0, 0, 0, 720, 718, 1, 0, 0, 0, 720,
+		721, 1, 0, 0, 0, 721, 723, 1, 0, 0, 0, 722, 724, 7, 28, 0, 0, 723, 722,
+		1, 0, 0, 0, 723, 724, 1, 0, 0, 0, 724, 726, 1, 0, 0, 0, 725, 716, 1, 0,
+		0, 0, 725, 726, 1, 0, 0, 0, 726, 736, 1, 0, 0, 0, 727, 729, 7, 27, 0, 0,
+		728, 730, 7, 28, 0, 0, 729, 728, 1, 0, 0, 0, 730, 731, 1, 0, 0, 0, 731,
+		729, 1, 0, 0, 0, 731, 732, 1, 0, 0, 0, 732, 733, 1, 0, 0, 0, 733, 735,
+		3, 173, 86, 0, 734, 727, 1, 0, 0, 0, 735, 738, 1, 0, 0, 0, 736, 734, 1,
+		0, 0, 0, 736, 737, 1, 0, 0, 0, 737, 172, 1, 0, 0, 0, 738, 736, 1, 0, 0,
+		0, 739, 867, 5, 115, 0, 0, 740, 741, 5, 115, 0, 0, 741, 742, 5, 101, 0,
+		0, 742, 867, 5, 99, 0, 0, 743, 744, 5, 115, 0, 0, 744, 745, 5, 101, 0,
+		0, 745, 746, 5, 99, 0, 0, 746, 867, 5, 115, 0, 0, 747, 748, 5, 115, 0,
+		0, 748, 749, 5, 101, 0, 0, 749, 750, 5, 99, 0, 0, 750, 751, 5, 111, 0,
+		0, 751, 752, 5, 110, 0, 0, 752, 867, 5, 100, 0, 0, 753, 754, 5, 115, 0,
+		0, 754, 755, 5, 101, 0, 0, 755, 756, 5, 99, 0, 0, 756, 757, 5, 111, 0,
+		0, 757, 758, 5, 110, 0, 0, 758, 759, 5, 100, 0, 0, 759, 867, 5, 115, 0,
+		0, 760, 867, 5, 109, 0, 0, 761, 762, 5, 109, 0, 0, 762, 763, 5, 105, 0,
+		0, 763, 867, 5, 110, 0, 0, 764, 765, 5, 109, 0, 0, 765, 766, 5, 105, 0,
+		0, 766, 767, 5, 110, 0, 0, 767, 867, 5, 115, 0, 0, 768, 769, 5, 109, 0,
+		0, 769, 770, 5, 105, 0, 0, 770, 771, 5, 110, 0, 0, 771, 772, 5, 117, 0,
+		0, 772, 773, 5, 116, 0, 0, 773, 867, 5, 101, 0, 0, 774, 775, 5, 109, 0,
+		0, 775, 776, 5, 105, 0, 0, 776, 777, 5, 110, 0, 0, 777, 778, 5, 117, 0,
+		0, 778, 779, 5, 116, 0, 0, 779, 780, 5, 101, 0, 0, 780, 867, 5, 115, 0,
+		0, 781, 867, 5, 104, 0, 0, 782, 783, 5, 104, 0, 0, 783, 867, 5, 114, 0,
+		0, 784, 785, 5, 104, 0, 0, 785, 786, 5, 114, 0, 0, 786, 867, 5, 115, 0,
+		0, 787, 788, 5, 104, 0, 0, 788, 789, 5, 111, 0, 0, 789, 790, 5, 117, 0,
+		0, 790, 867, 5, 114, 0, 0, 791, 792, 5, 104, 0, 0, 792, 793, 5, 111, 0,
+		0, 793, 794, 5, 117, 0, 0, 794, 795, 5, 114, 0, 0, 795, 867, 5, 115, 0,
+		0, 796, 867, 5, 100, 0, 0, 797, 798, 5, 100, 0, 0, 798, 799, 5, 97, 0,
+		0, 799, 867, 5, 121, 0, 0, 800, 801, 5, 100, 0, 0, 801, 802, 5, 97, 0,
+		0, 802, 803, 5, 121, 0, 0, 803, 867, 5, 115, 0, 0, 804, 867, 5, 119, 0,
+		0, 805, 806, 5, 119, 0, 0, 806, 807, 5, 101, 0, 0, 807, 808, 5, 101, 0,
+		0, 808, 867, 5, 107, 0, 0, 809, 810, 5, 119, 0, 0, 810, 811, 5, 101, 0,
+		0, 811, 812, 5, 101, 0, 0, 812, 813, 5, 107, 0, 0, 813, 867, 5, 115, 0,
+		0, 814, 815, 5, 109, 0, 0, 815, 816, 5, 111, 0, 0, 816, 867, 5, 110, 0,
+		0, 817, 818, 5, 109, 0, 0, 818, 819, 5, 111, 0, 0, 819, 820, 5, 110, 0,
+		0, 820, 821, 5, 116, 0, 0, 821, 867, 5, 104, 0, 0, 822, 823, 5, 109, 0,
+		0, 823, 824, 5, 111, 0, 0, 824, 825, 5, 110, 0, 0, 825, 826, 5, 116, 0,
+		0, 826, 827, 5, 104, 0, 0, 827, 867, 5, 115, 0, 0, 828, 867, 7, 30, 0,
+		0, 829, 830, 5, 113, 0, 0, 830, 831, 5, 116, 0, 0, 831, 867, 5, 114, 0,
+		0, 832, 833, 5, 113, 0, 0, 833, 834, 5, 116, 0, 0, 834, 835, 5, 114, 0,
+		0, 835, 867, 5, 115, 0, 0, 836, 837, 5, 113, 0, 0, 837, 838, 5, 117, 0,
+		0, 838, 839, 5, 97, 0, 0, 839, 840, 5, 114, 0, 0, 840, 841, 5, 116, 0,
+		0, 841, 842, 5, 101, 0, 0, 842, 867, 5, 114, 0, 0, 843, 844, 5, 113, 0,
+		0, 844, 845, 5, 117, 0, 0, 845, 846, 5, 97, 0, 0, 846, 847, 5, 114, 0,
+		0, 847, 848, 5, 116, 0, 0, 848, 849, 5, 101, 0, 0, 849, 850, 5, 114, 0,
+		0, 850, 867, 5, 115, 0, 0, 851, 867, 5, 121, 0, 0, 852, 853, 5, 121, 0,
+		0, 853, 867, 5, 114, 0, 0, 854, 855, 5, 121, 0, 0, 855, 856, 5, 114, 0,
+		0, 856, 867, 5, 115, 0, 0, 857, 858, 5, 121, 0, 0, 858, 859, 5, 101, 0,
+		0, 859, 860, 5, 97, 0, 0, 860, 867, 5, 114, 0, 0, 861, 862, 5, 121, 0,
+		0, 862, 863, 5, 101, 0, 0, 863, 864, 5, 97, 0, 0, 864, 865, 5, 114, 0,
+		0, 865, 867, 5, 115, 0, 0, 866, 739, 1, 0, 0, 0, 866, 740, 1, 0, 0, 0,
+		866, 743, 1, 0, 0, 0, 866, 747, 1, 0, 0, 0, 866, 753, 1, 0, 0, 0, 866,
+		760, 1, 0, 0, 0, 866, 761, 1, 0, 0, 0, 866, 764, 1, 0, 0, 0, 866, 768,
+		1, 0, 0, 0, 866, 774, 1, 0, 0, 0, 866, 781, 1, 0, 0, 0, 866, 782, 1, 0,
+		0, 0, 866, 784, 1, 0, 0, 0, 866, 787, 1, 0, 0, 0, 866, 791, 1, 0, 0, 0,
+		866, 796, 1, 0, 0, 0, 866, 797, 1, 0, 0, 0, 866, 800, 1, 0, 0, 0, 866,
+		804, 1, 0, 0, 0, 866, 805, 1, 0, 0, 0, 866, 809, 1, 0, 0, 0, 866, 814,
+		1, 0, 0, 0, 866, 817, 1, 0, 0, 0, 866, 822, 1, 0, 0, 0, 866, 828, 1, 0,
+		0, 0, 866, 829, 1, 0, 0, 0, 866, 832, 1, 0, 0, 0, 866, 836, 1, 0, 0, 0,
+		866, 843, 1, 0, 0, 0, 866, 851, 1, 0, 0, 0, 866, 852, 1, 0, 0, 0, 866,
+		854, 1, 0, 0, 0, 866, 857, 1, 0, 0, 0, 866, 861, 1, 0, 0, 0, 867, 174,
+		1, 0, 0, 0, 868, 870, 7, 28, 0, 0, 869, 871, 7, 28, 0, 0, 870, 869, 1,
+		0, 0, 0, 870, 871, 1, 0, 0, 0, 871, 872, 1, 0, 0, 0, 872, 873, 5, 47, 0,
+		0, 873, 875, 7, 28, 0, 0, 874, 876, 7, 28, 0, 0, 875, 874, 1, 0, 0, 0,
+		875, 876, 1, 0, 0, 0, 876, 877, 1, 0, 0, 0, 877, 878, 5, 47, 0, 0, 878,
+		879, 7, 28, 0, 0, 879, 880, 7, 28, 0, 0, 880, 881, 7, 28, 0, 0, 881, 891,
+		7, 28, 0, 0, 882, 883, 5, 58, 0, 0, 883, 884, 7, 28, 0, 0, 884, 885, 7,
+		28, 0, 0, 885, 886, 5, 58, 0, 0, 886, 887, 7, 28, 0, 0, 887, 888, 7, 28,
+		0, 0, 888, 889, 5, 58, 0, 0, 889, 890, 7, 28, 0, 0, 890, 892, 7, 28, 0,
+		0, 891, 882, 1, 0, 0, 0, 891, 892, 1, 0, 0, 0, 892, 176, 1, 0, 0, 0, 893,
+		895, 3, 181, 90, 0, 894, 893, 1, 0, 0, 0, 895, 896, 1, 0, 0, 0, 896, 894,
+		1, 0, 0, 0, 896, 897, 1, 0, 0, 0, 897, 898, 1, 0, 0, 0, 898, 900, 5, 46,
+		0, 0, 899, 901, 3, 181, 90, 0, 900, 899, 1, 0, 0, 0, 901, 902, 1, 0, 0,
+		0, 902, 900, 1, 0, 0, 0, 902, 903, 1, 0, 0, 0, 903, 904, 1, 0, 0, 0, 904,
+		906, 5, 46, 0, 0, 905, 907, 3, 181, 90, 0, 906, 905, 1, 0, 0, 0, 907, 908,
+		1, 0, 0, 0, 908, 906, 1, 0, 0, 0, 908, 909, 1, 0, 0, 0, 909, 910, 1, 0,
+		0, 0, 910, 912, 5, 46, 0, 0, 911, 913, 3, 181, 90, 0, 912, 911, 1, 0, 0,
+		0, 913, 914, 1, 0, 0, 0, 914, 912, 1, 0, 0, 0, 914, 915, 1, 0, 0, 0, 915,
+		922, 1, 0, 0, 0, 916, 918, 5, 47, 0, 0, 917, 919, 3, 181, 90, 0, 918, 917,
+		1, 0, 0, 0, 919, 920, 1, 0, 0, 0, 920, 918, 1, 0, 0, 0, 920, 921, 1, 0,
+		0, 0, 921, 923, 1, 0, 0, 0, 922, 916, 1, 0, 0, 0, 922, 923, 1, 0, 0, 0,
+		923, 178, 1, 0, 0, 0, 924, 926, 3, 181, 90, 0, 925, 924, 1, 0, 0, 0, 926,
+		927, 1, 0, 0, 0, 927, 925, 1, 0, 0, 0, 927, 928, 1, 0, 0, 0, 928, 935,
+		1, 0, 0, 0, 929, 931, 5, 46, 0, 0, 930, 932, 3, 181, 90, 0, 931, 930, 1,
+		0, 0, 0, 932, 933, 1, 0, 0, 0, 933, 931, 1, 0, 0, 0, 933, 934, 1, 0, 0,
+		0, 934, 936, 1, 0, 0, 0, 935, 929, 1, 0, 0, 0, 935, 936, 1, 0, 0, 0, 936,
+		938, 1, 0, 0, 0, 937, 939, 3, 183, 91, 0, 938, 937, 1, 0, 0, 0, 938, 939,
+		1, 0, 0, 0, 939, 950, 1, 0, 0, 0, 940, 942, 5, 46, 0, 0, 941, 943, 3, 181,
+		90, 0, 942, 941, 1, 0, 0, 0, 943, 944, 1, 0, 0, 0, 944, 942, 1, 0, 0, 0,
+		944, 945, 1, 0, 0, 0, 945, 947, 1, 0, 0, 0, 946, 948, 3, 183, 91, 0, 947,
+		946, 1, 0, 0, 0, 947, 948, 1, 0, 0, 0, 948, 950, 1, 0, 0, 0, 949, 925,
+		1, 0, 0, 0, 949, 940, 1, 0, 0, 0, 950, 180, 1, 0, 0, 0, 951, 952, 7, 28,
+		0, 0, 952, 182, 1, 0, 0, 0, 953, 955, 7, 12, 0, 0, 954, 956, 7, 27, 0,
+		0, 955, 954, 1, 0, 0, 0, 955, 956, 1, 0, 0, 0, 956, 958, 1, 0, 0, 0, 957,
+		959, 3, 181, 90, 0, 958, 957, 1, 0, 0, 0, 959, 960, 1, 0, 0, 0, 960, 958,
+		1, 0, 0, 0, 960, 961, 1, 0, 0, 0, 961, 184, 1, 0, 0, 0, 962, 963, 5, 42,
+		0, 0, 963, 186, 1, 0, 0, 0, 964, 965, 5, 36, 0, 0, 965, 188, 1, 0, 0, 0,
+		966, 967, 5, 36, 0, 0, 967, 971, 7, 31, 0, 0, 968, 970, 7, 32, 0, 0, 969,
+		968, 1, 0, 0, 0, 970, 973, 1, 0, 0, 0, 971, 969, 1, 0, 0, 0, 971, 972,
+		1, 0, 0, 0, 972, 974, 1, 0, 0, 0, 973, 971, 1, 0, 0, 0, 974, 975, 5, 36,
+		0, 0, 975, 190, 1, 0, 0, 0, 976, 977, 5, 60, 0, 0, 977, 978, 5, 60, 0,
+		0, 978, 979, 1, 0, 0, 0, 979, 983, 7, 31, 0, 0, 980, 982, 7, 33, 0, 0,
+		981, 980, 1, 0, 0, 0, 982, 985, 1, 0, 0, 0, 983, 981, 1, 0, 0, 0, 983,
+		984, 1, 0, 0, 0, 984, 986, 1, 0, 0, 0, 985, 983, 1, 0, 0, 0, 986, 987,
+		5, 62, 0, 0, 987, 988, 5, 62, 0, 0, 988, 192, 1, 0, 0, 0, 989, 993, 7,
+		31, 0, 0, 990, 992, 7, 33, 0, 0, 991, 990, 1, 0, 0, 0, 992, 995, 1, 0,
+		0, 0, 993, 991, 1, 0, 0, 0, 993, 994, 1, 0, 0, 0, 994, 1016, 1, 0, 0, 0,
+		995, 993, 1, 0, 0, 0, 996, 1000, 7, 31, 0, 0, 997, 999, 7, 33, 0, 0, 998,
+		997, 1, 0, 0, 0, 999, 1002, 1, 0, 0, 0, 1000, 998, 1, 0, 0, 0, 1000, 1001,
+		1, 0, 0, 0, 1001, 1011, 1, 0, 0, 0, 1002, 1000, 1, 0, 0, 0, 1003, 1004,
+		5, 46, 0, 0, 1004, 1008, 7, 31, 0, 0, 1005, 1007, 7, 33, 0, 0, 1006, 1005,
+		1, 0, 0, 0, 1007, 1010, 1, 0, 0, 0, 1008, 1006, 1, 0, 0, 0, 1008, 1009,
+		1, 0, 0, 0, 1009, 1012, 1, 0, 0, 0, 1010, 1008, 1, 0, 0, 0, 1011, 1003,
+		1, 0, 0, 0, 1012, 1013, 1, 0, 0, 0, 1013, 1011, 1, 0, 0, 0, 1013, 1014,
+		1, 0, 0, 0, 1014, 1016, 1, 0, 0, 0, 1015, 989, 1, 0, 0, 0, 1015, 996, 1,
+		0, 0, 0, 1016, 194, 1, 0, 0, 0, 1017, 1018, 5, 46, 0, 0, 1018, 196, 1,
+		0, 0, 0, 1019, 1020, 5, 47, 0, 0, 1020, 1024, 7, 29, 0, 0, 1021, 1023,
+		7, 34, 0, 0, 1022, 1021, 1, 0, 0, 0, 1023, 1026, 1, 0, 0, 0, 1024, 1022,
+		1, 0, 0, 0, 1024, 1025, 1, 0, 0, 0, 1025, 1027, 1, 0, 0, 0, 1026, 1024,
+		1, 0, 0, 0, 1027, 1029, 5, 47, 0, 0, 1028, 1030, 7, 35, 0, 0, 1029, 1028,
+		1, 0, 0, 0, 1030, 1031, 1, 0, 0, 0, 1031, 1029, 1, 0, 0, 0, 1031, 1032,
+		1, 0, 0, 0, 1032, 1041, 1, 0, 0, 0, 1033, 1035, 5, 47, 0, 0, 1034, 1036,
+		7, 35, 0, 0, 1035, 1034, 1, 0, 0, 0, 1036, 1037, 1, 0, 0, 0, 1037, 1035,
+		1, 0, 0, 0, 1037, 1038, 1, 0, 0, 0, 1038, 1040, 1, 0, 0, 0, 1039, 1033,
+		1, 0, 0, 0, 1040, 1043, 1, 0, 0, 0, 1041, 1039, 1, 0, 0, 0, 1041, 1042,
+		1, 0, 0, 0, 1042, 198, 1, 0, 0, 0, 1043, 1041, 1, 0, 0, 0, 1044, 1046,
+		5, 96, 0, 0, 1045, 1047, 8, 36, 0, 0, 1046, 1045, 1, 0, 0, 0, 1047, 1048,
+		1, 0, 0, 0, 1048, 1046, 1, 0, 0, 0, 1048, 1049, 1, 0, 0, 0, 1049, 1050,
+		1, 0, 0, 0, 1050, 1051, 5, 96, 0, 0, 1051, 200, 1, 0, 0, 0, 1052, 1054,
+		5, 64, 0, 0, 1053, 1055, 7, 29, 0, 0, 1054, 1053, 1, 0, 0, 0, 1055, 1056,
+		1, 0, 0, 0, 1056, 1054, 1, 0, 0, 0, 1056, 1057, 1, 0, 0, 0, 1057, 1059,
+		1, 0, 0, 0, 1058, 1060, 7, 28, 0, 0, 1059, 1058, 1, 0, 0, 0, 1059, 1060,
+		1, 0, 0, 0, 1060, 1070, 1, 0, 0, 0, 1061, 1063, 7, 27, 0, 0, 1062, 1064,
+		7, 28, 0, 0, 1063, 1062, 1, 0, 0, 0, 1064, 1065, 1, 0, 0, 0, 1065, 1063,
+		1, 0, 0, 0, 1065, 1066, 1, 0, 0, 0, 1066, 1067, 1, 0, 0, 0, 1067, 1069,
+		3, 173, 86, 0, 1068, 1061, 1, 0, 0, 0, 1069, 1072, 1, 0, 0, 0, 1070, 1068,
+		1, 0, 0, 0, 1070, 1071, 1, 0, 0, 0, 1071, 202, 1, 0, 0, 0, 1072, 1070,
+		1, 0, 0, 0, 1073, 1075, 7, 37, 0, 0, 1074, 1073, 1, 0, 0, 0, 1075, 1076,
+		1, 0, 0, 0, 1076, 1074, 1, 0, 0, 0, 1076, 1077, 1, 0, 0, 0, 1077, 1078,
+		1, 0, 0, 0, 1078, 1079, 6, 101, 0, 0, 1079, 204, 1, 0, 0, 0, 1080, 1081,
+		5, 96, 0, 0, 1081, 1082, 5, 96, 0, 0, 1082, 1083, 5, 96, 0, 0, 1083, 1087,
+		1, 0, 0, 0, 1084, 1086, 9, 0, 0, 0, 1085, 1084, 1, 0, 0, 0, 1086, 1089,
+		1, 0, 0, 0, 1087, 1088, 1, 0, 0, 0, 1087, 1085, 1, 0, 0, 0, 1088, 1090,
+		1, 0, 0, 0, 1089, 1087, 1, 0, 0, 0, 1090, 1091, 5, 96, 0, 0, 1091, 1092,
+		5, 96, 0, 0, 1092, 1093, 5, 96, 0, 0, 1093, 1094, 1, 0, 0, 0, 1094, 1095,
+		6, 102, 1, 0, 1095, 206, 1, 0, 0, 0, 1096, 1097, 5, 96, 0, 0, 1097, 1098,
+		5, 96, 0, 0, 1098, 1099, 5, 96, 0, 0, 1099, 1109, 1, 0, 0, 0, 1100, 1108,
+		8, 38, 0, 0, 1101, 1102, 5, 96, 0, 0, 1102, 1108, 8, 38, 0, 0, 1103, 1104,
+		5, 96, 0, 0, 1104, 1105, 5, 96, 0, 0, 1105, 1106, 1, 0, 0, 0, 1106, 1108,
+		8, 38, 0, 0, 1107, 1100, 1, 0, 0, 0, 1107, 1101, 1, 0, 0, 0, 1107, 1103,
+		1, 0, 0, 0, 1108, 1111, 1, 0, 0, 0, 1109, 1107, 1, 0, 0, 0, 1109, 1110,
+		1, 0, 0, 0, 1110, 1113, 1, 0, 0, 0, 1111, 1109, 1, 0, 0, 0, 1112, 1114,
+		5, 96, 0, 0, 1113, 1112, 1, 0, 0, 0, 1113, 1114, 1, 0, 0, 0, 1114, 1116,
+		1, 0, 0, 0, 1115, 1117, 5, 96, 0, 0, 1116, 1115, 1, 0, 0, 0, 1116, 1117,
+		1, 0, 0, 0, 1117, 1118, 1, 0, 0, 0, 1118, 1119, 6, 103, 1, 0, 1119, 208,
+		1, 0, 0, 0, 57, 0, 656, 658, 667, 669, 697, 699, 703, 705, 708, 713, 720,
+		723, 725, 731, 736, 866, 870, 875, 891, 896, 902, 908, 914, 920, 922, 927,
+		933, 935, 938, 944, 947, 949, 955, 960, 971, 983, 993, 1000, 1008, 1013,
+		1015, 1024, 1031, 1037, 1041, 1048, 1056, 1059, 1065, 1070, 1076, 1087,
+		1107, 1109, 1113, 1116, 2, 6, 0, 0, 0, 1, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -432,85 +647,104 @@ func NewSPLLexer(input antlr.CharStream) *SPLLexer {
 
 // SPLLexer tokens.
 const (
-	SPLLexerAND           = 1
-	SPLLexerOR            = 2
-	SPLLexerNOT           = 3
-	SPLLexerBY            = 4
-	SPLLexerAS            = 5
-	SPLLexerIN            = 6
-	SPLLexerWHERE         = 7
-	SPLLexerSEARCH        = 8
-	SPLLexerEVAL          = 9
-	SPLLexerSTATS         = 10
-	SPLLexerTABLE         = 11
-	SPLLexerFIELDS        = 12
-	SPLLexerRENAME        = 13
-	SPLLexerREX           = 14
-	SPLLexerDEDUP         = 15
-	SPLLexerSORT          = 16
-	SPLLexerHEAD          = 17
-	SPLLexerTAIL          = 18
-	SPLLexerTOP           = 19
-	SPLLexerRARE          = 20
-	SPLLexerLOOKUP        = 21
-	SPLLexerJOIN          = 22
-	SPLLexerAPPEND        = 23
-	SPLLexerTRANSACTION   = 24
-	SPLLexerSPATH         = 25
-	SPLLexerEVENTSTATS    = 26
-	SPLLexerSTREAMSTATS   = 27
-	SPLLexerTIMECHART     = 28
-	SPLLexerCHART         = 29
-	SPLLexerFILLNULL      = 30
-	SPLLexerMAKEMV        = 31
-	SPLLexerMVEXPAND      = 32
-	SPLLexerFORMAT        = 33
-	SPLLexerCONVERT       = 34
-	SPLLexerBUCKET        = 35
-	SPLLexerBIN           = 36
-	SPLLexerOVER          = 37
-	SPLLexerREST          = 38
-	SPLLexerTSTATS        = 39
-	SPLLexerFROM          = 40
-	SPLLexerGROUPBY       = 41
-	SPLLexerMSTATS        = 42
-	SPLLexerINPUTLOOKUP   = 43
-	SPLLexerEQ            = 44
-	SPLLexerNEQ           = 45
-	SPLLexerLT            = 46
-	SPLLexerGT            = 47
-	SPLLexerLTE           = 48
-	SPLLexerGTE           = 49
-	SPLLexerLIKE          = 50
-	SPLLexerMATCH         = 51
-	SPLLexerCIDRMATCH     = 52
-	SPLLexerISNOTNULL     = 53
-	SPLLexerISNULL        = 54
-	SPLLexerPIPE          = 55
-	SPLLexerLPAREN        = 56
-	SPLLexerRPAREN        = 57
-	SPLLexerLBRACKET      = 58
-	SPLLexerRBRACKET      = 59
-	SPLLexerLBRACE        = 60
-	SPLLexerRBRACE        = 61
-	SPLLexerCOMMA         = 62
-	SPLLexerCOLON         = 63
-	SPLLexerDQUOTE        = 64
-	SPLLexerPLUS          = 65
-	SPLLexerMINUS         = 66
-	SPLLexerSLASH         = 67
-	SPLLexerPERCENT       = 68
-	SPLLexerQUOTED_STRING = 69
-	SPLLexerTIME_SPAN     = 70
-	SPLLexerNUMBER        = 71
-	SPLLexerWILDCARD      = 72
-	SPLLexerDOLLAR        = 73
-	SPLLexerTEMPLATE_VAR  = 74
-	SPLLexerIDENTIFIER    = 75
-	SPLLexerDOT           = 76
-	SPLLexerREST_PATH     = 77
-	SPLLexerMACRO         = 78
-	SPLLexerTIME_MODIFIER = 79
-	SPLLexerWS            = 80
-	SPLLexerLINE_COMMENT  = 81
+	SPLLexerAND            = 1
+	SPLLexerOR             = 2
+	SPLLexerNOT            = 3
+	SPLLexerBY             = 4
+	SPLLexerAS             = 5
+	SPLLexerIN             = 6
+	SPLLexerWHERE          = 7
+	SPLLexerSEARCH         = 8
+	SPLLexerEVAL           = 9
+	SPLLexerSTATS          = 10
+	SPLLexerTABLE          = 11
+	SPLLexerFIELDS         = 12
+	SPLLexerRENAME         = 13
+	SPLLexerREGEX          = 14
+	SPLLexerREX            = 15
+	SPLLexerDEDUP          = 16
+	SPLLexerSORT           = 17
+	SPLLexerHEAD           = 18
+	SPLLexerTAIL           = 19
+	SPLLexerTOP            = 20
+	SPLLexerRARE           = 21
+	SPLLexerLOOKUP         = 22
+	SPLLexerJOIN           = 23
+	SPLLexerAPPEND         = 24
+	SPLLexerAPPENDCOLS     = 25
+	SPLLexerAPPENDPIPE     = 26
+	SPLLexerUNION          = 27
+	SPLLexerMULTISEARCH    = 28
+	SPLLexerTRANSACTION    = 29
+	SPLLexerSPATH          = 30
+	SPLLexerEVENTSTATS     = 31
+	SPLLexerSTREAMSTATS    = 32
+	SPLLexerTIMECHART      = 33
+	SPLLexerCHART          = 34
+	SPLLexerFILLNULL       = 35
+	SPLLexerMAKEMV         = 36
+	SPLLexerMVEXPAND       = 37
+	SPLLexerFORMAT         = 38
+	SPLLexerRETURN         = 39
+	SPLLexerFOREACH        = 40
+	SPLLexerMAP            = 41
+	SPLLexerCONVERT        = 42
+	SPLLexerBUCKET         = 43
+	SPLLexerBIN            = 44
+	SPLLexerOVER           = 45
+	SPLLexerREST           = 46
+	SPLLexerTSTATS         = 47
+	SPLLexerFROM           = 48
+	SPLLexerGROUPBY        = 49
+	SPLLexerMSTATS         = 50
+	SPLLexerINPUTLOOKUP    = 51
+	SPLLexerOUTPUT         = 52
+	SPLLexerOUTPUTNEW      = 53
+	SPLLexerEQ             = 54
+	SPLLexerEQEQ           = 55
+	SPLLexerNEQ            = 56
+	SPLLexerLT             = 57
+	SPLLexerGT             = 58
+	SPLLexerLTE            = 59
+	SPLLexerGTE            = 60
+	SPLLexerLIKE           = 61
+	SPLLexerMATCH          = 62
+	SPLLexerCIDRMATCH      = 63
+	SPLLexerISNOTNULL      = 64
+	SPLLexerISNULL         = 65
+	SPLLexerPIPE           = 66
+	SPLLexerLPAREN         = 67
+	SPLLexerRPAREN         = 68
+	SPLLexerLBRACKET       = 69
+	SPLLexerRBRACKET       = 70
+	SPLLexerLBRACE         = 71
+	SPLLexerRBRACE         = 72
+	SPLLexerCOMMA          = 73
+	SPLLexerDOUBLE_COLON   = 74
+	SPLLexerCOLON          = 75
+	SPLLexerDQUOTE         = 76
+	SPLLexerPLUS           = 77
+	SPLLexerMINUS          = 78
+	SPLLexerSLASH          = 79
+	SPLLexerPERCENT        = 80
+	SPLLexerQUOTED_STRING  = 81
+	SPLLexerSINGLE_QUOTED  = 82
+	SPLLexerTERM_DIRECTIVE = 83
+	SPLLexerCASE_DIRECTIVE = 84
+	SPLLexerTIME_SPAN      = 85
+	SPLLexerTIME_ABSOLUTE  = 86
+	SPLLexerIP_ADDRESS     = 87
+	SPLLexerNUMBER         = 88
+	SPLLexerWILDCARD       = 89
+	SPLLexerDOLLAR         = 90
+	SPLLexerTOKEN_VAR      = 91
+	SPLLexerTEMPLATE_VAR   = 92
+	SPLLexerIDENTIFIER     = 93
+	SPLLexerDOT            = 94
+	SPLLexerREST_PATH      = 95
+	SPLLexerMACRO          = 96
+	SPLLexerTIME_MODIFIER  = 97
+	SPLLexerWS             = 98
+	SPLLexerBLOCK_COMMENT  = 99
+	SPLLexerLINE_COMMENT   = 100
 )
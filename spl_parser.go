@@ -34,554 +34,774 @@ func splparserParserInit() {
 	staticData.LiteralNames = []string{
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "'='", "'!='", "'<'", "'>'",
-		"'<='", "'>='", "", "", "", "", "", "'|'", "'('", "')'", "'['", "']'",
-		"'{'", "'}'", "','", "':'", "'\"'", "'+'", "'-'", "'/'", "'%'", "",
-		"", "", "'*'", "'$'", "", "", "'.'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "'='", "'=='", "'!='", "'<'", "'>'", "'<='", "'>='", "",
+		"", "", "", "", "'|'", "'('", "')'", "'['", "']'", "'{'", "'}'", "','",
+		"'::'", "':'", "'\"'", "'+'", "'-'", "'/'", "'%'", "", "", "", "", "",
+		"", "", "", "'*'", "'$'", "", "", "", "'.'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "AND", "OR", "NOT", "BY", "AS", "IN", "WHERE", "SEARCH", "EVAL",
-		"STATS", "TABLE", "FIELDS", "RENAME", "REX", "DEDUP", "SORT", "HEAD",
-		"TAIL", "TOP", "RARE", "LOOKUP", "JOIN", "APPEND", "TRANSACTION", "SPATH",
-		"EVENTSTATS", "STREAMSTATS", "TIMECHART", "CHART", "FILLNULL", "MAKEMV",
-		"MVEXPAND", "FORMAT", "CONVERT", "BUCKET", "BIN", "OVER", "REST", "TSTATS",
-		"FROM", "GROUPBY", "MSTATS", "INPUTLOOKUP", "EQ", "NEQ", "LT", "GT",
-		"LTE", "GTE", "LIKE", "MATCH", "CIDRMATCH", "ISNOTNULL", "ISNULL", "PIPE",
-		"LPAREN", "RPAREN", "LBRACKET", "RBRACKET", "LBRACE", "RBRACE", "COMMA",
+		"STATS", "TABLE", "FIELDS", "RENAME", "REGEX", "REX", "DEDUP", "SORT",
+		"HEAD", "TAIL", "TOP", "RARE", "LOOKUP", "JOIN", "APPEND", "APPENDCOLS",
+		"APPENDPIPE", "UNION", "MULTISEARCH", "TRANSACTION", "SPATH", "EVENTSTATS",
+		"STREAMSTATS", "TIMECHART", "CHART", "FILLNULL", "MAKEMV", "MVEXPAND",
+		"FORMAT", "RETURN", "FOREACH", "MAP", "CONVERT", "BUCKET", "BIN", "OVER",
+		"REST", "TSTATS", "FROM", "GROUPBY", "MSTATS", "INPUTLOOKUP", "OUTPUT",
+		"OUTPUTNEW", "EQ", "EQEQ", "NEQ", "LT", "GT", "LTE", "GTE", "LIKE",
+		"MATCH", "CIDRMATCH", "ISNOTNULL", "ISNULL", "PIPE", "LPAREN", "RPAREN",
+		"LBRACKET", "RBRACKET", "LBRACE", "RBRACE", "COMMA", "DOUBLE_COLON",
 		"COLON", "DQUOTE", "PLUS", "MINUS", "SLASH", "PERCENT", "QUOTED_STRING",
-		"TIME_SPAN", "NUMBER", "WILDCARD", "DOLLAR", "TEMPLATE_VAR", "IDENTIFIER",
-		"DOT", "REST_PATH", "MACRO", "TIME_MODIFIER", "WS", "LINE_COMMENT",
+		"SINGLE_QUOTED", "TERM_DIRECTIVE", "CASE_DIRECTIVE", "TIME_SPAN", "TIME_ABSOLUTE",
+		"IP_ADDRESS", "NUMBER", "WILDCARD", "DOLLAR", "TOKEN_VAR", "TEMPLATE_VAR",
+		"IDENTIFIER", "DOT", "REST_PATH", "MACRO", "TIME_MODIFIER", "WS", "BLOCK_COMMENT",
+		"LINE_COMMENT",
 	}
 	staticData.RuleNames = []string{
 		"query", "pipelineStage", "searchCommand", "whereCommand", "evalCommand",
 		"evalAssignment", "statsCommand", "statsFunction", "tableCommand", "fieldsCommand",
-		"renameCommand", "renameSpec", "rexCommand", "rexOption", "dedupCommand",
-		"dedupOption", "sortCommand", "sortField", "headCommand", "tailCommand",
-		"topCommand", "rareCommand", "lookupCommand", "lookupOption", "joinCommand",
-		"joinOption", "appendCommand", "transactionCommand", "transactionOption",
-		"spathCommand", "spathOption", "eventstatsCommand", "streamstatsCommand",
-		"timechartCommand", "timechartOption", "chartCommand", "fillnullCommand",
-		"fillnullOption", "makemvCommand", "makemvOption", "mvexpandCommand",
-		"formatCommand", "formatOption", "convertCommand", "convertOption",
-		"convertFunction", "bucketCommand", "bucketOption", "restCommand", "restArg",
-		"tstatsCommand", "tstatsPreOption", "tstatsDatamodel", "tstatsPostOption",
-		"mstatsCommand", "inputlookupCommand", "inputlookupOption", "genericCommand",
-		"genericArg", "subsearch", "searchExpression", "searchTerm", "condition",
-		"comparisonOp", "logicalOp", "expression", "orExpression", "andExpression",
-		"notExpression", "comparisonExpression", "additiveExpression", "multiplicativeExpression",
+		"renameCommand", "renameSpec", "rexCommand", "rexOption", "regexCommand",
+		"dedupCommand", "dedupOption", "sortCommand", "sortField", "headCommand",
+		"tailCommand", "topCommand", "topOption", "rareCommand", "lookupCommand",
+		"lookupOutput", "lookupFieldMapping", "lookupField", "lookupOption",
+		"joinCommand", "joinOption", "appendCommand", "appendcolsCommand", "appendpipeCommand",
+		"unionCommand", "multisearchCommand", "transactionCommand", "transactionOption",
+		"spathCommand", "spathPath", "spathOption", "eventstatsCommand", "streamstatsCommand",
+		"statsOption", "timechartCommand", "timechartOption", "chartCommand",
+		"fillnullCommand", "fillnullOption", "makemvCommand", "makemvOption",
+		"mvexpandCommand", "formatCommand", "formatArg", "formatOption", "returnCommand",
+		"returnItem", "foreachCommand", "foreachArg", "mapCommand", "mapOption",
+		"convertCommand", "convertOption", "convertFunction", "bucketCommand",
+		"bucketOption", "restCommand", "restArg", "tstatsCommand", "tstatsPreOption",
+		"tstatsDatamodel", "tstatsPostOption", "mstatsCommand", "inputlookupCommand",
+		"inputlookupOption", "genericCommand", "genericArg", "subsearch", "searchExpression",
+		"searchTerm", "condition", "comparisonOp", "logicalOp", "expression",
+		"orExpression", "andExpression", "notExpression", "comparisonExpression",
+		"productExpression", "additiveExpression", "multiplicativeExpression",
 		"unaryExpression", "primaryExpression", "functionCall", "argumentList",
-		"value", "colonValue", "extendedIdentifier", "wildcardValue", "bareWord",
-		"fieldName", "fieldNameSuffix", "fieldNameBase", "fieldList", "fieldOrQuoted",
-		"valueList",
+		"value", "keywordAsValue", "colonValue", "extendedIdentifier", "wildcardValue",
+		"bareWord", "fieldName", "fieldNameSuffix", "fieldNameBase", "fieldList",
+		"fieldOrQuoted", "valueList",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 81, 1066, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
-		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
-		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
-		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
-		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
-		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
-		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
-		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
-		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
-		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
-		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68,
-		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7,
-		73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78,
-		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
-		84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 1, 0, 3, 0, 176, 8, 0, 1, 0, 1,
-		0, 1, 0, 5, 0, 181, 8, 0, 10, 0, 12, 0, 184, 9, 0, 1, 1, 1, 1, 1, 1, 1,
+		4, 1, 100, 1448, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4,
+		7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10,
+		7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7,
+		15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20,
+		2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2,
+		26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31,
+		7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7,
+		36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41,
+		2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2,
+		47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52,
+		7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7,
+		57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62,
+		2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2,
+		68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73,
+		7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7,
+		78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83,
+		2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2,
+		89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94,
+		7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7,
+		99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103, 2,
+		104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 1, 0, 3, 0, 216, 8, 0, 1,
+		0, 1, 0, 1, 0, 5, 0, 221, 8, 0, 10, 0, 12, 0, 224, 9, 0, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 220, 8, 1, 1, 2, 3, 2, 223,
-		8, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 5, 4, 234,
-		8, 4, 10, 4, 12, 4, 237, 9, 4, 1, 5, 1, 5, 3, 5, 241, 8, 5, 1, 5, 1, 5,
-		1, 5, 1, 6, 1, 6, 1, 6, 3, 6, 249, 8, 6, 1, 6, 5, 6, 252, 8, 6, 10, 6,
-		12, 6, 255, 9, 6, 1, 6, 1, 6, 3, 6, 259, 8, 6, 1, 7, 1, 7, 1, 7, 3, 7,
-		264, 8, 7, 1, 7, 1, 7, 1, 7, 1, 7, 3, 7, 270, 8, 7, 3, 7, 272, 8, 7, 1,
-		7, 1, 7, 1, 7, 1, 7, 3, 7, 278, 8, 7, 3, 7, 280, 8, 7, 3, 7, 282, 8, 7,
-		1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 3, 9, 289, 8, 9, 1, 9, 1, 9, 1, 10, 1, 10,
-		1, 10, 1, 10, 5, 10, 297, 8, 10, 10, 10, 12, 10, 300, 9, 10, 1, 11, 1,
-		11, 1, 11, 1, 11, 3, 11, 306, 8, 11, 1, 12, 1, 12, 5, 12, 310, 8, 12, 10,
-		12, 12, 12, 313, 9, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 320,
-		8, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 3, 13, 327, 8, 13, 1, 14, 1,
-		14, 3, 14, 331, 8, 14, 1, 14, 1, 14, 5, 14, 335, 8, 14, 10, 14, 12, 14,
-		338, 9, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 3, 15, 345, 8, 15, 1, 16,
-		1, 16, 3, 16, 349, 8, 16, 1, 16, 1, 16, 1, 16, 5, 16, 354, 8, 16, 10, 16,
-		12, 16, 357, 9, 16, 1, 17, 3, 17, 360, 8, 17, 1, 17, 1, 17, 1, 18, 1, 18,
-		3, 18, 366, 8, 18, 1, 19, 1, 19, 3, 19, 370, 8, 19, 1, 20, 1, 20, 3, 20,
-		374, 8, 20, 1, 20, 1, 20, 1, 20, 3, 20, 379, 8, 20, 1, 21, 1, 21, 3, 21,
-		383, 8, 21, 1, 21, 1, 21, 1, 21, 3, 21, 388, 8, 21, 1, 22, 1, 22, 5, 22,
-		392, 8, 22, 10, 22, 12, 22, 395, 9, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1,
-		23, 1, 23, 1, 23, 1, 23, 3, 23, 405, 8, 23, 1, 24, 1, 24, 5, 24, 409, 8,
-		24, 10, 24, 12, 24, 412, 9, 24, 1, 24, 1, 24, 1, 24, 5, 24, 417, 8, 24,
-		10, 24, 12, 24, 420, 9, 24, 1, 24, 1, 24, 1, 24, 3, 24, 425, 8, 24, 1,
-		25, 1, 25, 1, 25, 1, 25, 1, 25, 3, 25, 432, 8, 25, 1, 26, 1, 26, 1, 26,
-		1, 27, 1, 27, 1, 27, 5, 27, 440, 8, 27, 10, 27, 12, 27, 443, 9, 27, 1,
-		28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 451, 8, 28, 1, 29, 1, 29,
-		5, 29, 455, 8, 29, 10, 29, 12, 29, 458, 9, 29, 1, 30, 1, 30, 1, 30, 1,
-		30, 3, 30, 464, 8, 30, 1, 31, 1, 31, 1, 31, 3, 31, 469, 8, 31, 1, 31, 5,
-		31, 472, 8, 31, 10, 31, 12, 31, 475, 9, 31, 1, 31, 1, 31, 3, 31, 479, 8,
-		31, 1, 32, 1, 32, 1, 32, 3, 32, 484, 8, 32, 1, 32, 5, 32, 487, 8, 32, 10,
-		32, 12, 32, 490, 9, 32, 1, 32, 1, 32, 3, 32, 494, 8, 32, 1, 33, 1, 33,
-		5, 33, 498, 8, 33, 10, 33, 12, 33, 501, 9, 33, 1, 33, 1, 33, 1, 33, 3,
-		33, 506, 8, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 3, 34, 514, 8,
-		34, 1, 35, 1, 35, 1, 35, 1, 35, 3, 35, 520, 8, 35, 1, 35, 1, 35, 3, 35,
-		524, 8, 35, 1, 36, 1, 36, 5, 36, 528, 8, 36, 10, 36, 12, 36, 531, 9, 36,
-		1, 36, 3, 36, 534, 8, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 5,
-		38, 542, 8, 38, 10, 38, 12, 38, 545, 9, 38, 1, 38, 1, 38, 1, 39, 1, 39,
-		1, 39, 1, 39, 1, 39, 3, 39, 554, 8, 39, 1, 40, 1, 40, 1, 40, 1, 41, 1,
-		41, 5, 41, 561, 8, 41, 10, 41, 12, 41, 564, 9, 41, 1, 42, 1, 42, 1, 42,
-		1, 42, 1, 43, 1, 43, 5, 43, 572, 8, 43, 10, 43, 12, 43, 575, 9, 43, 1,
-		43, 1, 43, 1, 43, 5, 43, 580, 8, 43, 10, 43, 12, 43, 583, 9, 43, 1, 44,
-		1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 3,
-		45, 596, 8, 45, 3, 45, 598, 8, 45, 1, 46, 1, 46, 1, 46, 5, 46, 603, 8,
-		46, 10, 46, 12, 46, 606, 9, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48,
-		5, 48, 614, 8, 48, 10, 48, 12, 48, 617, 9, 48, 1, 49, 1, 49, 1, 49, 3,
-		49, 622, 8, 49, 1, 49, 1, 49, 3, 49, 626, 8, 49, 1, 49, 1, 49, 3, 49, 630,
-		8, 49, 1, 50, 1, 50, 5, 50, 634, 8, 50, 10, 50, 12, 50, 637, 9, 50, 1,
-		50, 1, 50, 3, 50, 641, 8, 50, 1, 50, 5, 50, 644, 8, 50, 10, 50, 12, 50,
-		647, 9, 50, 3, 50, 649, 8, 50, 1, 50, 1, 50, 3, 50, 653, 8, 50, 1, 50,
-		1, 50, 3, 50, 657, 8, 50, 1, 50, 1, 50, 1, 50, 4, 50, 662, 8, 50, 11, 50,
-		12, 50, 663, 3, 50, 666, 8, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51,
-		3, 51, 674, 8, 51, 1, 51, 3, 51, 677, 8, 51, 1, 52, 1, 52, 1, 52, 1, 52,
-		1, 52, 5, 52, 684, 8, 52, 10, 52, 12, 52, 687, 9, 52, 1, 52, 1, 52, 1,
-		52, 3, 52, 692, 8, 52, 1, 52, 1, 52, 5, 52, 696, 8, 52, 10, 52, 12, 52,
-		699, 9, 52, 3, 52, 701, 8, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53,
-		3, 53, 709, 8, 53, 1, 54, 1, 54, 5, 54, 713, 8, 54, 10, 54, 12, 54, 716,
-		9, 54, 1, 54, 1, 54, 3, 54, 720, 8, 54, 1, 54, 5, 54, 723, 8, 54, 10, 54,
-		12, 54, 726, 9, 54, 3, 54, 728, 8, 54, 1, 54, 1, 54, 3, 54, 732, 8, 54,
-		1, 54, 1, 54, 1, 54, 4, 54, 737, 8, 54, 11, 54, 12, 54, 738, 3, 54, 741,
-		8, 54, 1, 55, 1, 55, 5, 55, 745, 8, 55, 10, 55, 12, 55, 748, 9, 55, 1,
-		55, 1, 55, 1, 55, 3, 55, 753, 8, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56,
-		3, 56, 760, 8, 56, 1, 57, 1, 57, 5, 57, 764, 8, 57, 10, 57, 12, 57, 767,
-		9, 57, 1, 58, 1, 58, 1, 58, 3, 58, 772, 8, 58, 1, 58, 1, 58, 3, 58, 776,
-		8, 58, 3, 58, 778, 8, 58, 1, 58, 3, 58, 781, 8, 58, 1, 58, 1, 58, 1, 58,
-		5, 58, 786, 8, 58, 10, 58, 12, 58, 789, 9, 58, 1, 58, 3, 58, 792, 8, 58,
-		1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 3, 60, 800, 8, 60, 1, 60, 5,
-		60, 803, 8, 60, 10, 60, 12, 60, 806, 9, 60, 1, 61, 1, 61, 1, 61, 1, 61,
-		1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 3, 61, 818, 8, 61, 1, 62, 1,
-		62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62,
-		1, 62, 1, 62, 1, 62, 3, 62, 835, 8, 62, 1, 63, 1, 63, 1, 64, 1, 64, 1,
-		65, 1, 65, 1, 66, 1, 66, 1, 66, 5, 66, 846, 8, 66, 10, 66, 12, 66, 849,
-		9, 66, 1, 67, 1, 67, 3, 67, 853, 8, 67, 1, 67, 5, 67, 856, 8, 67, 10, 67,
-		12, 67, 859, 9, 67, 1, 68, 1, 68, 1, 68, 3, 68, 864, 8, 68, 1, 69, 1, 69,
-		1, 69, 1, 69, 1, 69, 3, 69, 871, 8, 69, 3, 69, 873, 8, 69, 1, 70, 1, 70,
-		1, 70, 5, 70, 878, 8, 70, 10, 70, 12, 70, 881, 9, 70, 1, 71, 1, 71, 1,
-		71, 5, 71, 886, 8, 71, 10, 71, 12, 71, 889, 9, 71, 1, 72, 1, 72, 1, 72,
-		3, 72, 894, 8, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1,
-		73, 3, 73, 904, 8, 73, 1, 74, 1, 74, 1, 74, 3, 74, 909, 8, 74, 1, 74, 1,
-		74, 1, 74, 1, 74, 3, 74, 915, 8, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74,
-		1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1,
-		74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74,
-		3, 74, 943, 8, 74, 1, 75, 1, 75, 1, 75, 5, 75, 948, 8, 75, 10, 75, 12,
-		75, 951, 9, 75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 3, 76, 959, 8,
-		76, 1, 77, 1, 77, 1, 77, 4, 77, 964, 8, 77, 11, 77, 12, 77, 965, 1, 78,
-		1, 78, 1, 78, 5, 78, 971, 8, 78, 10, 78, 12, 78, 974, 9, 78, 1, 79, 1,
-		79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79,
-		1, 79, 1, 79, 1, 79, 1, 79, 3, 79, 992, 8, 79, 1, 80, 1, 80, 1, 80, 1,
-		80, 3, 80, 998, 8, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 3, 81, 1005,
-		8, 81, 5, 81, 1007, 8, 81, 10, 81, 12, 81, 1010, 9, 81, 3, 81, 1012, 8,
-		81, 1, 81, 1, 81, 1, 81, 3, 81, 1017, 8, 81, 3, 81, 1019, 8, 81, 1, 82,
-		1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 3, 82, 1029, 8, 82, 1,
-		83, 1, 83, 1, 83, 5, 83, 1034, 8, 83, 10, 83, 12, 83, 1037, 9, 83, 1, 83,
-		1, 83, 1, 83, 3, 83, 1042, 8, 83, 1, 84, 1, 84, 3, 84, 1046, 8, 84, 1,
-		84, 5, 84, 1049, 8, 84, 10, 84, 12, 84, 1052, 9, 84, 1, 85, 1, 85, 3, 85,
-		1056, 8, 85, 1, 86, 1, 86, 1, 86, 5, 86, 1061, 8, 86, 10, 86, 12, 86, 1064,
-		9, 86, 1, 86, 0, 0, 87, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24,
-		26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60,
-		62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96,
-		98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126,
-		128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156,
-		158, 160, 162, 164, 166, 168, 170, 172, 0, 12, 1, 0, 65, 66, 2, 0, 69,
-		69, 71, 71, 3, 0, 69, 69, 71, 71, 75, 75, 1, 0, 35, 36, 2, 0, 69, 71, 75,
-		75, 2, 0, 4, 4, 41, 41, 2, 0, 69, 69, 75, 75, 1, 0, 44, 49, 1, 0, 1, 2,
-		2, 0, 65, 66, 76, 76, 2, 0, 67, 68, 72, 72, 1, 0, 66, 67, 1193, 0, 175,
-		1, 0, 0, 0, 2, 219, 1, 0, 0, 0, 4, 222, 1, 0, 0, 0, 6, 226, 1, 0, 0, 0,
-		8, 229, 1, 0, 0, 0, 10, 240, 1, 0, 0, 0, 12, 245, 1, 0, 0, 0, 14, 281,
-		1, 0, 0, 0, 16, 283, 1, 0, 0, 0, 18, 286, 1, 0, 0, 0, 20, 292, 1, 0, 0,
-		0, 22, 301, 1, 0, 0, 0, 24, 307, 1, 0, 0, 0, 26, 321, 1, 0, 0, 0, 28, 328,
-		1, 0, 0, 0, 30, 339, 1, 0, 0, 0, 32, 346, 1, 0, 0, 0, 34, 359, 1, 0, 0,
-		0, 36, 363, 1, 0, 0, 0, 38, 367, 1, 0, 0, 0, 40, 371, 1, 0, 0, 0, 42, 380,
-		1, 0, 0, 0, 44, 389, 1, 0, 0, 0, 46, 399, 1, 0, 0, 0, 48, 424, 1, 0, 0,
-		0, 50, 426, 1, 0, 0, 0, 52, 433, 1, 0, 0, 0, 54, 436, 1, 0, 0, 0, 56, 444,
-		1, 0, 0, 0, 58, 452, 1, 0, 0, 0, 60, 459, 1, 0, 0, 0, 62, 465, 1, 0, 0,
-		0, 64, 480, 1, 0, 0, 0, 66, 495, 1, 0, 0, 0, 68, 507, 1, 0, 0, 0, 70, 515,
-		1, 0, 0, 0, 72, 525, 1, 0, 0, 0, 74, 535, 1, 0, 0, 0, 76, 539, 1, 0, 0,
-		0, 78, 548, 1, 0, 0, 0, 80, 555, 1, 0, 0, 0, 82, 558, 1, 0, 0, 0, 84, 565,
-		1, 0, 0, 0, 86, 569, 1, 0, 0, 0, 88, 584, 1, 0, 0, 0, 90, 588, 1, 0, 0,
-		0, 92, 599, 1, 0, 0, 0, 94, 607, 1, 0, 0, 0, 96, 611, 1, 0, 0, 0, 98, 629,
-		1, 0, 0, 0, 100, 631, 1, 0, 0, 0, 102, 676, 1, 0, 0, 0, 104, 700, 1, 0,
-		0, 0, 106, 702, 1, 0, 0, 0, 108, 710, 1, 0, 0, 0, 110, 742, 1, 0, 0, 0,
-		112, 754, 1, 0, 0, 0, 114, 761, 1, 0, 0, 0, 116, 791, 1, 0, 0, 0, 118,
-		793, 1, 0, 0, 0, 120, 797, 1, 0, 0, 0, 122, 817, 1, 0, 0, 0, 124, 834,
-		1, 0, 0, 0, 126, 836, 1, 0, 0, 0, 128, 838, 1, 0, 0, 0, 130, 840, 1, 0,
-		0, 0, 132, 842, 1, 0, 0, 0, 134, 850, 1, 0, 0, 0, 136, 863, 1, 0, 0, 0,
-		138, 872, 1, 0, 0, 0, 140, 874, 1, 0, 0, 0, 142, 882, 1, 0, 0, 0, 144,
-		893, 1, 0, 0, 0, 146, 903, 1, 0, 0, 0, 148, 942, 1, 0, 0, 0, 150, 944,
-		1, 0, 0, 0, 152, 958, 1, 0, 0, 0, 154, 960, 1, 0, 0, 0, 156, 967, 1, 0,
-		0, 0, 158, 991, 1, 0, 0, 0, 160, 997, 1, 0, 0, 0, 162, 1018, 1, 0, 0, 0,
-		164, 1028, 1, 0, 0, 0, 166, 1041, 1, 0, 0, 0, 168, 1043, 1, 0, 0, 0, 170,
-		1055, 1, 0, 0, 0, 172, 1057, 1, 0, 0, 0, 174, 176, 5, 55, 0, 0, 175, 174,
-		1, 0, 0, 0, 175, 176, 1, 0, 0, 0, 176, 177, 1, 0, 0, 0, 177, 182, 3, 2,
-		1, 0, 178, 179, 5, 55, 0, 0, 179, 181, 3, 2, 1, 0, 180, 178, 1, 0, 0, 0,
-		181, 184, 1, 0, 0, 0, 182, 180, 1, 0, 0, 0, 182, 183, 1, 0, 0, 0, 183,
-		1, 1, 0, 0, 0, 184, 182, 1, 0, 0, 0, 185, 220, 3, 4, 2, 0, 186, 220, 3,
-		6, 3, 0, 187, 220, 3, 8, 4, 0, 188, 220, 3, 12, 6, 0, 189, 220, 3, 16,
-		8, 0, 190, 220, 3, 18, 9, 0, 191, 220, 3, 20, 10, 0, 192, 220, 3, 24, 12,
-		0, 193, 220, 3, 28, 14, 0, 194, 220, 3, 32, 16, 0, 195, 220, 3, 36, 18,
-		0, 196, 220, 3, 38, 19, 0, 197, 220, 3, 40, 20, 0, 198, 220, 3, 42, 21,
-		0, 199, 220, 3, 44, 22, 0, 200, 220, 3, 48, 24, 0, 201, 220, 3, 52, 26,
-		0, 202, 220, 3, 54, 27, 0, 203, 220, 3, 58, 29, 0, 204, 220, 3, 62, 31,
-		0, 205, 220, 3, 64, 32, 0, 206, 220, 3, 66, 33, 0, 207, 220, 3, 70, 35,
-		0, 208, 220, 3, 72, 36, 0, 209, 220, 3, 76, 38, 0, 210, 220, 3, 80, 40,
-		0, 211, 220, 3, 82, 41, 0, 212, 220, 3, 86, 43, 0, 213, 220, 3, 92, 46,
-		0, 214, 220, 3, 96, 48, 0, 215, 220, 3, 100, 50, 0, 216, 220, 3, 108, 54,
-		0, 217, 220, 3, 110, 55, 0, 218, 220, 3, 114, 57, 0, 219, 185, 1, 0, 0,
-		0, 219, 186, 1, 0, 0, 0, 219, 187, 1, 0, 0, 0, 219, 188, 1, 0, 0, 0, 219,
-		189, 1, 0, 0, 0, 219, 190, 1, 0, 0, 0, 219, 191, 1, 0, 0, 0, 219, 192,
-		1, 0, 0, 0, 219, 193, 1, 0, 0, 0, 219, 194, 1, 0, 0, 0, 219, 195, 1, 0,
-		0, 0, 219, 196, 1, 0, 0, 0, 219, 197, 1, 0, 0, 0, 219, 198, 1, 0, 0, 0,
-		219, 199, 1, 0, 0, 0, 219, 200, 1, 0, 0, 0, 219, 201, 1, 0, 0, 0, 219,
-		202, 1, 0, 0, 0, 219, 203, 1, 0, 0, 0, 219, 204, 1, 0, 0, 0, 219, 205,
-		1, 0, 0, 0, 219, 206, 1, 0, 0, 0, 219, 207, 1, 0, 0, 0, 219, 208, 1, 0,
-		0, 0, 219, 209, 1, 0, 0, 0, 219, 210, 1, 0, 0, 0, 219, 211, 1, 0, 0, 0,
-		219, 212, 1, 0, 0, 0, 219, 213, 1, 0, 0, 0, 219, 214, 1, 0, 0, 0, 219,
-		215, 1, 0, 0, 0, 219, 216, 1, 0, 0, 0, 219, 217, 1, 0, 0, 0, 219, 218,
-		1, 0, 0, 0, 220, 3, 1, 0, 0, 0, 221, 223, 5, 8, 0, 0, 222, 221, 1, 0, 0,
-		0, 222, 223, 1, 0, 0, 0, 223, 224, 1, 0, 0, 0, 224, 225, 3, 120, 60, 0,
-		225, 5, 1, 0, 0, 0, 226, 227, 5, 7, 0, 0, 227, 228, 3, 130, 65, 0, 228,
-		7, 1, 0, 0, 0, 229, 230, 5, 9, 0, 0, 230, 235, 3, 10, 5, 0, 231, 232, 5,
-		62, 0, 0, 232, 234, 3, 10, 5, 0, 233, 231, 1, 0, 0, 0, 234, 237, 1, 0,
-		0, 0, 235, 233, 1, 0, 0, 0, 235, 236, 1, 0, 0, 0, 236, 9, 1, 0, 0, 0, 237,
-		235, 1, 0, 0, 0, 238, 241, 3, 162, 81, 0, 239, 241, 5, 69, 0, 0, 240, 238,
-		1, 0, 0, 0, 240, 239, 1, 0, 0, 0, 241, 242, 1, 0, 0, 0, 242, 243, 5, 44,
-		0, 0, 243, 244, 3, 130, 65, 0, 244, 11, 1, 0, 0, 0, 245, 246, 5, 10, 0,
-		0, 246, 253, 3, 14, 7, 0, 247, 249, 5, 62, 0, 0, 248, 247, 1, 0, 0, 0,
-		248, 249, 1, 0, 0, 0, 249, 250, 1, 0, 0, 0, 250, 252, 3, 14, 7, 0, 251,
-		248, 1, 0, 0, 0, 252, 255, 1, 0, 0, 0, 253, 251, 1, 0, 0, 0, 253, 254,
-		1, 0, 0, 0, 254, 258, 1, 0, 0, 0, 255, 253, 1, 0, 0, 0, 256, 257, 5, 4,
-		0, 0, 257, 259, 3, 168, 84, 0, 258, 256, 1, 0, 0, 0, 258, 259, 1, 0, 0,
-		0, 259, 13, 1, 0, 0, 0, 260, 261, 5, 75, 0, 0, 261, 263, 5, 56, 0, 0, 262,
-		264, 3, 130, 65, 0, 263, 262, 1, 0, 0, 0, 263, 264, 1, 0, 0, 0, 264, 265,
-		1, 0, 0, 0, 265, 271, 5, 57, 0, 0, 266, 269, 5, 5, 0, 0, 267, 270, 3, 162,
-		81, 0, 268, 270, 5, 69, 0, 0, 269, 267, 1, 0, 0, 0, 269, 268, 1, 0, 0,
-		0, 270, 272, 1, 0, 0, 0, 271, 266, 1, 0, 0, 0, 271, 272, 1, 0, 0, 0, 272,
-		282, 1, 0, 0, 0, 273, 279, 5, 75, 0, 0, 274, 277, 5, 5, 0, 0, 275, 278,
-		3, 162, 81, 0, 276, 278, 5, 69, 0, 0, 277, 275, 1, 0, 0, 0, 277, 276, 1,
-		0, 0, 0, 278, 280, 1, 0, 0, 0, 279, 274, 1, 0, 0, 0, 279, 280, 1, 0, 0,
-		0, 280, 282, 1, 0, 0, 0, 281, 260, 1, 0, 0, 0, 281, 273, 1, 0, 0, 0, 282,
-		15, 1, 0, 0, 0, 283, 284, 5, 11, 0, 0, 284, 285, 3, 168, 84, 0, 285, 17,
-		1, 0, 0, 0, 286, 288, 5, 12, 0, 0, 287, 289, 7, 0, 0, 0, 288, 287, 1, 0,
-		0, 0, 288, 289, 1, 0, 0, 0, 289, 290, 1, 0, 0, 0, 290, 291, 3, 168, 84,
-		0, 291, 19, 1, 0, 0, 0, 292, 293, 5, 13, 0, 0, 293, 298, 3, 22, 11, 0,
-		294, 295, 5, 62, 0, 0, 295, 297, 3, 22, 11, 0, 296, 294, 1, 0, 0, 0, 297,
-		300, 1, 0, 0, 0, 298, 296, 1, 0, 0, 0, 298, 299, 1, 0, 0, 0, 299, 21, 1,
-		0, 0, 0, 300, 298, 1, 0, 0, 0, 301, 302, 3, 162, 81, 0, 302, 305, 5, 5,
-		0, 0, 303, 306, 3, 162, 81, 0, 304, 306, 5, 69, 0, 0, 305, 303, 1, 0, 0,
-		0, 305, 304, 1, 0, 0, 0, 306, 23, 1, 0, 0, 0, 307, 311, 5, 14, 0, 0, 308,
-		310, 3, 26, 13, 0, 309, 308, 1, 0, 0, 0, 310, 313, 1, 0, 0, 0, 311, 309,
-		1, 0, 0, 0, 311, 312, 1, 0, 0, 0, 312, 319, 1, 0, 0, 0, 313, 311, 1, 0,
-		0, 0, 314, 320, 5, 69, 0, 0, 315, 316, 3, 162, 81, 0, 316, 317, 5, 44,
-		0, 0, 317, 318, 5, 69, 0, 0, 318, 320, 1, 0, 0, 0, 319, 314, 1, 0, 0, 0,
-		319, 315, 1, 0, 0, 0, 319, 320, 1, 0, 0, 0, 320, 25, 1, 0, 0, 0, 321, 322,
-		5, 75, 0, 0, 322, 326, 5, 44, 0, 0, 323, 327, 5, 69, 0, 0, 324, 327, 3,
-		162, 81, 0, 325, 327, 5, 71, 0, 0, 326, 323, 1, 0, 0, 0, 326, 324, 1, 0,
-		0, 0, 326, 325, 1, 0, 0, 0, 327, 27, 1, 0, 0, 0, 328, 330, 5, 15, 0, 0,
-		329, 331, 5, 71, 0, 0, 330, 329, 1, 0, 0, 0, 330, 331, 1, 0, 0, 0, 331,
-		332, 1, 0, 0, 0, 332, 336, 3, 168, 84, 0, 333, 335, 3, 30, 15, 0, 334,
-		333, 1, 0, 0, 0, 335, 338, 1, 0, 0, 0, 336, 334, 1, 0, 0, 0, 336, 337,
-		1, 0, 0, 0, 337, 29, 1, 0, 0, 0, 338, 336, 1, 0, 0, 0, 339, 340, 5, 75,
-		0, 0, 340, 344, 5, 44, 0, 0, 341, 345, 5, 69, 0, 0, 342, 345, 3, 162, 81,
-		0, 343, 345, 5, 71, 0, 0, 344, 341, 1, 0, 0, 0, 344, 342, 1, 0, 0, 0, 344,
-		343, 1, 0, 0, 0, 345, 31, 1, 0, 0, 0, 346, 348, 5, 16, 0, 0, 347, 349,
-		5, 71, 0, 0, 348, 347, 1, 0, 0, 0, 348, 349, 1, 0, 0, 0, 349, 350, 1, 0,
-		0, 0, 350, 355, 3, 34, 17, 0, 351, 352, 5, 62, 0, 0, 352, 354, 3, 34, 17,
-		0, 353, 351, 1, 0, 0, 0, 354, 357, 1, 0, 0, 0, 355, 353, 1, 0, 0, 0, 355,
-		356, 1, 0, 0, 0, 356, 33, 1, 0, 0, 0, 357, 355, 1, 0, 0, 0, 358, 360, 7,
-		0, 0, 0, 359, 358, 1, 0, 0, 0, 359, 360, 1, 0, 0, 0, 360, 361, 1, 0, 0,
-		0, 361, 362, 3, 162, 81, 0, 362, 35, 1, 0, 0, 0, 363, 365, 5, 17, 0, 0,
-		364, 366, 5, 71, 0, 0, 365, 364, 1, 0, 0, 0, 365, 366, 1, 0, 0, 0, 366,
-		37, 1, 0, 0, 0, 367, 369, 5, 18, 0, 0, 368, 370, 5, 71, 0, 0, 369, 368,
-		1, 0, 0, 0, 369, 370, 1, 0, 0, 0, 370, 39, 1, 0, 0, 0, 371, 373, 5, 19,
-		0, 0, 372, 374, 5, 71, 0, 0, 373, 372, 1, 0, 0, 0, 373, 374, 1, 0, 0, 0,
-		374, 375, 1, 0, 0, 0, 375, 378, 3, 168, 84, 0, 376, 377, 5, 4, 0, 0, 377,
-		379, 3, 168, 84, 0, 378, 376, 1, 0, 0, 0, 378, 379, 1, 0, 0, 0, 379, 41,
-		1, 0, 0, 0, 380, 382, 5, 20, 0, 0, 381, 383, 5, 71, 0, 0, 382, 381, 1,
-		0, 0, 0, 382, 383, 1, 0, 0, 0, 383, 384, 1, 0, 0, 0, 384, 387, 3, 168,
-		84, 0, 385, 386, 5, 4, 0, 0, 386, 388, 3, 168, 84, 0, 387, 385, 1, 0, 0,
-		0, 387, 388, 1, 0, 0, 0, 388, 43, 1, 0, 0, 0, 389, 393, 5, 21, 0, 0, 390,
-		392, 3, 46, 23, 0, 391, 390, 1, 0, 0, 0, 392, 395, 1, 0, 0, 0, 393, 391,
-		1, 0, 0, 0, 393, 394, 1, 0, 0, 0, 394, 396, 1, 0, 0, 0, 395, 393, 1, 0,
-		0, 0, 396, 397, 5, 75, 0, 0, 397, 398, 3, 168, 84, 0, 398, 45, 1, 0, 0,
-		0, 399, 400, 5, 75, 0, 0, 400, 404, 5, 44, 0, 0, 401, 405, 5, 69, 0, 0,
-		402, 405, 3, 162, 81, 0, 403, 405, 5, 71, 0, 0, 404, 401, 1, 0, 0, 0, 404,
-		402, 1, 0, 0, 0, 404, 403, 1, 0, 0, 0, 405, 47, 1, 0, 0, 0, 406, 410, 5,
-		22, 0, 0, 407, 409, 3, 50, 25, 0, 408, 407, 1, 0, 0, 0, 409, 412, 1, 0,
-		0, 0, 410, 408, 1, 0, 0, 0, 410, 411, 1, 0, 0, 0, 411, 413, 1, 0, 0, 0,
-		412, 410, 1, 0, 0, 0, 413, 425, 3, 118, 59, 0, 414, 418, 5, 22, 0, 0, 415,
-		417, 3, 50, 25, 0, 416, 415, 1, 0, 0, 0, 417, 420, 1, 0, 0, 0, 418, 416,
-		1, 0, 0, 0, 418, 419, 1, 0, 0, 0, 419, 421, 1, 0, 0, 0, 420, 418, 1, 0,
-		0, 0, 421, 422, 3, 168, 84, 0, 422, 423, 3, 118, 59, 0, 423, 425, 1, 0,
-		0, 0, 424, 406, 1, 0, 0, 0, 424, 414, 1, 0, 0, 0, 425, 49, 1, 0, 0, 0,
-		426, 427, 5, 75, 0, 0, 427, 431, 5, 44, 0, 0, 428, 432, 5, 69, 0, 0, 429,
-		432, 3, 162, 81, 0, 430, 432, 5, 71, 0, 0, 431, 428, 1, 0, 0, 0, 431, 429,
-		1, 0, 0, 0, 431, 430, 1, 0, 0, 0, 432, 51, 1, 0, 0, 0, 433, 434, 5, 23,
-		0, 0, 434, 435, 3, 118, 59, 0, 435, 53, 1, 0, 0, 0, 436, 437, 5, 24, 0,
-		0, 437, 441, 3, 168, 84, 0, 438, 440, 3, 56, 28, 0, 439, 438, 1, 0, 0,
-		0, 440, 443, 1, 0, 0, 0, 441, 439, 1, 0, 0, 0, 441, 442, 1, 0, 0, 0, 442,
-		55, 1, 0, 0, 0, 443, 441, 1, 0, 0, 0, 444, 445, 5, 75, 0, 0, 445, 450,
-		5, 44, 0, 0, 446, 451, 5, 69, 0, 0, 447, 451, 3, 162, 81, 0, 448, 451,
-		5, 71, 0, 0, 449, 451, 5, 70, 0, 0, 450, 446, 1, 0, 0, 0, 450, 447, 1,
-		0, 0, 0, 450, 448, 1, 0, 0, 0, 450, 449, 1, 0, 0, 0, 451, 57, 1, 0, 0,
-		0, 452, 456, 5, 25, 0, 0, 453, 455, 3, 60, 30, 0, 454, 453, 1, 0, 0, 0,
-		455, 458, 1, 0, 0, 0, 456, 454, 1, 0, 0, 0, 456, 457, 1, 0, 0, 0, 457,
-		59, 1, 0, 0, 0, 458, 456, 1, 0, 0, 0, 459, 460, 5, 75, 0, 0, 460, 463,
-		5, 44, 0, 0, 461, 464, 5, 69, 0, 0, 462, 464, 3, 162, 81, 0, 463, 461,
-		1, 0, 0, 0, 463, 462, 1, 0, 0, 0, 464, 61, 1, 0, 0, 0, 465, 466, 5, 26,
-		0, 0, 466, 473, 3, 14, 7, 0, 467, 469, 5, 62, 0, 0, 468, 467, 1, 0, 0,
-		0, 468, 469, 1, 0, 0, 0, 469, 470, 1, 0, 0, 0, 470, 472, 3, 14, 7, 0, 471,
-		468, 1, 0, 0, 0, 472, 475, 1, 0, 0, 0, 473, 471, 1, 0, 0, 0, 473, 474,
-		1, 0, 0, 0, 474, 478, 1, 0, 0, 0, 475, 473, 1, 0, 0, 0, 476, 477, 5, 4,
-		0, 0, 477, 479, 3, 168, 84, 0, 478, 476, 1, 0, 0, 0, 478, 479, 1, 0, 0,
-		0, 479, 63, 1, 0, 0, 0, 480, 481, 5, 27, 0, 0, 481, 488, 3, 14, 7, 0, 482,
-		484, 5, 62, 0, 0, 483, 482, 1, 0, 0, 0, 483, 484, 1, 0, 0, 0, 484, 485,
-		1, 0, 0, 0, 485, 487, 3, 14, 7, 0, 486, 483, 1, 0, 0, 0, 487, 490, 1, 0,
-		0, 0, 488, 486, 1, 0, 0, 0, 488, 489, 1, 0, 0, 0, 489, 493, 1, 0, 0, 0,
-		490, 488, 1, 0, 0, 0, 491, 492, 5, 4, 0, 0, 492, 494, 3, 168, 84, 0, 493,
-		491, 1, 0, 0, 0, 493, 494, 1, 0, 0, 0, 494, 65, 1, 0, 0, 0, 495, 499, 5,
-		28, 0, 0, 496, 498, 3, 68, 34, 0, 497, 496, 1, 0, 0, 0, 498, 501, 1, 0,
-		0, 0, 499, 497, 1, 0, 0, 0, 499, 500, 1, 0, 0, 0, 500, 502, 1, 0, 0, 0,
-		501, 499, 1, 0, 0, 0, 502, 505, 3, 14, 7, 0, 503, 504, 5, 4, 0, 0, 504,
-		506, 3, 162, 81, 0, 505, 503, 1, 0, 0, 0, 505, 506, 1, 0, 0, 0, 506, 67,
-		1, 0, 0, 0, 507, 508, 5, 75, 0, 0, 508, 513, 5, 44, 0, 0, 509, 514, 5,
-		69, 0, 0, 510, 514, 3, 162, 81, 0, 511, 514, 5, 71, 0, 0, 512, 514, 5,
-		70, 0, 0, 513, 509, 1, 0, 0, 0, 513, 510, 1, 0, 0, 0, 513, 511, 1, 0, 0,
-		0, 513, 512, 1, 0, 0, 0, 514, 69, 1, 0, 0, 0, 515, 516, 5, 29, 0, 0, 516,
-		519, 3, 14, 7, 0, 517, 518, 5, 4, 0, 0, 518, 520, 3, 168, 84, 0, 519, 517,
-		1, 0, 0, 0, 519, 520, 1, 0, 0, 0, 520, 523, 1, 0, 0, 0, 521, 522, 5, 37,
-		0, 0, 522, 524, 3, 162, 81, 0, 523, 521, 1, 0, 0, 0, 523, 524, 1, 0, 0,
-		0, 524, 71, 1, 0, 0, 0, 525, 529, 5, 30, 0, 0, 526, 528, 3, 74, 37, 0,
-		527, 526, 1, 0, 0, 0, 528, 531, 1, 0, 0, 0, 529, 527, 1, 0, 0, 0, 529,
-		530, 1, 0, 0, 0, 530, 533, 1, 0, 0, 0, 531, 529, 1, 0, 0, 0, 532, 534,
-		3, 168, 84, 0, 533, 532, 1, 0, 0, 0, 533, 534, 1, 0, 0, 0, 534, 73, 1,
-		0, 0, 0, 535, 536, 5, 75, 0, 0, 536, 537, 5, 44, 0, 0, 537, 538, 7, 1,
-		0, 0, 538, 75, 1, 0, 0, 0, 539, 543, 5, 31, 0, 0, 540, 542, 3, 78, 39,
-		0, 541, 540, 1, 0, 0, 0, 542, 545, 1, 0, 0, 0, 543, 541, 1, 0, 0, 0, 543,
-		544, 1, 0, 0, 0, 544, 546, 1, 0, 0, 0, 545, 543, 1, 0, 0, 0, 546, 547,
-		3, 162, 81, 0, 547, 77, 1, 0, 0, 0, 548, 549, 5, 75, 0, 0, 549, 553, 5,
-		44, 0, 0, 550, 554, 5, 69, 0, 0, 551, 554, 3, 162, 81, 0, 552, 554, 5,
-		71, 0, 0, 553, 550, 1, 0, 0, 0, 553, 551, 1, 0, 0, 0, 553, 552, 1, 0, 0,
-		0, 554, 79, 1, 0, 0, 0, 555, 556, 5, 32, 0, 0, 556, 557, 3, 162, 81, 0,
-		557, 81, 1, 0, 0, 0, 558, 562, 5, 33, 0, 0, 559, 561, 3, 84, 42, 0, 560,
-		559, 1, 0, 0, 0, 561, 564, 1, 0, 0, 0, 562, 560, 1, 0, 0, 0, 562, 563,
-		1, 0, 0, 0, 563, 83, 1, 0, 0, 0, 564, 562, 1, 0, 0, 0, 565, 566, 5, 75,
-		0, 0, 566, 567, 5, 44, 0, 0, 567, 568, 7, 1, 0, 0, 568, 85, 1, 0, 0, 0,
-		569, 573, 5, 34, 0, 0, 570, 572, 3, 88, 44, 0, 571, 570, 1, 0, 0, 0, 572,
-		575, 1, 0, 0, 0, 573, 571, 1, 0, 0, 0, 573, 574, 1, 0, 0, 0, 574, 576,
-		1, 0, 0, 0, 575, 573, 1, 0, 0, 0, 576, 581, 3, 90, 45, 0, 577, 578, 5,
-		62, 0, 0, 578, 580, 3, 90, 45, 0, 579, 577, 1, 0, 0, 0, 580, 583, 1, 0,
-		0, 0, 581, 579, 1, 0, 0, 0, 581, 582, 1, 0, 0, 0, 582, 87, 1, 0, 0, 0,
-		583, 581, 1, 0, 0, 0, 584, 585, 5, 75, 0, 0, 585, 586, 5, 44, 0, 0, 586,
-		587, 7, 2, 0, 0, 587, 89, 1, 0, 0, 0, 588, 589, 5, 75, 0, 0, 589, 590,
-		5, 56, 0, 0, 590, 591, 3, 162, 81, 0, 591, 597, 5, 57, 0, 0, 592, 595,
-		5, 5, 0, 0, 593, 596, 3, 162, 81, 0, 594, 596, 5, 69, 0, 0, 595, 593, 1,
-		0, 0, 0, 595, 594, 1, 0, 0, 0, 596, 598, 1, 0, 0, 0, 597, 592, 1, 0, 0,
-		0, 597, 598, 1, 0, 0, 0, 598, 91, 1, 0, 0, 0, 599, 600, 7, 3, 0, 0, 600,
-		604, 3, 162, 81, 0, 601, 603, 3, 94, 47, 0, 602, 601, 1, 0, 0, 0, 603,
-		606, 1, 0, 0, 0, 604, 602, 1, 0, 0, 0, 604, 605, 1, 0, 0, 0, 605, 93, 1,
-		0, 0, 0, 606, 604, 1, 0, 0, 0, 607, 608, 5, 75, 0, 0, 608, 609, 5, 44,
-		0, 0, 609, 610, 7, 4, 0, 0, 610, 95, 1, 0, 0, 0, 611, 615, 5, 38, 0, 0,
-		612, 614, 3, 98, 49, 0, 613, 612, 1, 0, 0, 0, 614, 617, 1, 0, 0, 0, 615,
-		613, 1, 0, 0, 0, 615, 616, 1, 0, 0, 0, 616, 97, 1, 0, 0, 0, 617, 615, 1,
-		0, 0, 0, 618, 619, 5, 75, 0, 0, 619, 621, 5, 44, 0, 0, 620, 622, 5, 66,
-		0, 0, 621, 620, 1, 0, 0, 0, 621, 622, 1, 0, 0, 0, 622, 625, 1, 0, 0, 0,
-		623, 626, 3, 152, 76, 0, 624, 626, 5, 75, 0, 0, 625, 623, 1, 0, 0, 0, 625,
-		624, 1, 0, 0, 0, 626, 630, 1, 0, 0, 0, 627, 630, 5, 77, 0, 0, 628, 630,
-		5, 75, 0, 0, 629, 618, 1, 0, 0, 0, 629, 627, 1, 0, 0, 0, 629, 628, 1, 0,
-		0, 0, 630, 99, 1, 0, 0, 0, 631, 635, 5, 39, 0, 0, 632, 634, 3, 102, 51,
-		0, 633, 632, 1, 0, 0, 0, 634, 637, 1, 0, 0, 0, 635, 633, 1, 0, 0, 0, 635,
-		636, 1, 0, 0, 0, 636, 648, 1, 0, 0, 0, 637, 635, 1, 0, 0, 0, 638, 645,
-		3, 14, 7, 0, 639, 641, 5, 62, 0, 0, 640, 639, 1, 0, 0, 0, 640, 641, 1,
-		0, 0, 0, 641, 642, 1, 0, 0, 0, 642, 644, 3, 14, 7, 0, 643, 640, 1, 0, 0,
-		0, 644, 647, 1, 0, 0, 0, 645, 643, 1, 0, 0, 0, 645, 646, 1, 0, 0, 0, 646,
-		649, 1, 0, 0, 0, 647, 645, 1, 0, 0, 0, 648, 638, 1, 0, 0, 0, 648, 649,
-		1, 0, 0, 0, 649, 652, 1, 0, 0, 0, 650, 651, 5, 40, 0, 0, 651, 653, 3, 104,
-		52, 0, 652, 650, 1, 0, 0, 0, 652, 653, 1, 0, 0, 0, 653, 656, 1, 0, 0, 0,
-		654, 655, 5, 7, 0, 0, 655, 657, 3, 120, 60, 0, 656, 654, 1, 0, 0, 0, 656,
-		657, 1, 0, 0, 0, 657, 665, 1, 0, 0, 0, 658, 661, 7, 5, 0, 0, 659, 662,
-		3, 106, 53, 0, 660, 662, 3, 170, 85, 0, 661, 659, 1, 0, 0, 0, 661, 660,
-		1, 0, 0, 0, 662, 663, 1, 0, 0, 0, 663, 661, 1, 0, 0, 0, 663, 664, 1, 0,
-		0, 0, 664, 666, 1, 0, 0, 0, 665, 658, 1, 0, 0, 0, 665, 666, 1, 0, 0, 0,
-		666, 101, 1, 0, 0, 0, 667, 668, 5, 75, 0, 0, 668, 673, 5, 44, 0, 0, 669,
-		674, 5, 69, 0, 0, 670, 674, 3, 162, 81, 0, 671, 674, 5, 71, 0, 0, 672,
-		674, 5, 70, 0, 0, 673, 669, 1, 0, 0, 0, 673, 670, 1, 0, 0, 0, 673, 671,
-		1, 0, 0, 0, 673, 672, 1, 0, 0, 0, 674, 677, 1, 0, 0, 0, 675, 677, 5, 78,
-		0, 0, 676, 667, 1, 0, 0, 0, 676, 675, 1, 0, 0, 0, 677, 103, 1, 0, 0, 0,
-		678, 679, 5, 75, 0, 0, 679, 680, 5, 44, 0, 0, 680, 685, 5, 75, 0, 0, 681,
-		682, 5, 76, 0, 0, 682, 684, 5, 75, 0, 0, 683, 681, 1, 0, 0, 0, 684, 687,
-		1, 0, 0, 0, 685, 683, 1, 0, 0, 0, 685, 686, 1, 0, 0, 0, 686, 701, 1, 0,
-		0, 0, 687, 685, 1, 0, 0, 0, 688, 691, 5, 75, 0, 0, 689, 690, 5, 63, 0,
-		0, 690, 692, 5, 75, 0, 0, 691, 689, 1, 0, 0, 0, 691, 692, 1, 0, 0, 0, 692,
-		697, 1, 0, 0, 0, 693, 694, 5, 76, 0, 0, 694, 696, 5, 75, 0, 0, 695, 693,
-		1, 0, 0, 0, 696, 699, 1, 0, 0, 0, 697, 695, 1, 0, 0, 0, 697, 698, 1, 0,
-		0, 0, 698, 701, 1, 0, 0, 0, 699, 697, 1, 0, 0, 0, 700, 678, 1, 0, 0, 0,
-		700, 688, 1, 0, 0, 0, 701, 105, 1, 0, 0, 0, 702, 703, 5, 75, 0, 0, 703,
-		708, 5, 44, 0, 0, 704, 709, 5, 69, 0, 0, 705, 709, 3, 162, 81, 0, 706,
-		709, 5, 71, 0, 0, 707, 709, 5, 70, 0, 0, 708, 704, 1, 0, 0, 0, 708, 705,
-		1, 0, 0, 0, 708, 706, 1, 0, 0, 0, 708, 707, 1, 0, 0, 0, 709, 107, 1, 0,
-		0, 0, 710, 714, 5, 42, 0, 0, 711, 713, 3, 102, 51, 0, 712, 711, 1, 0, 0,
-		0, 713, 716, 1, 0, 0, 0, 714, 712, 1, 0, 0, 0, 714, 715, 1, 0, 0, 0, 715,
-		727, 1, 0, 0, 0, 716, 714, 1, 0, 0, 0, 717, 724, 3, 14, 7, 0, 718, 720,
-		5, 62, 0, 0, 719, 718, 1, 0, 0, 0, 719, 720, 1, 0, 0, 0, 720, 721, 1, 0,
-		0, 0, 721, 723, 3, 14, 7, 0, 722, 719, 1, 0, 0, 0, 723, 726, 1, 0, 0, 0,
-		724, 722, 1, 0, 0, 0, 724, 725, 1, 0, 0, 0, 725, 728, 1, 0, 0, 0, 726,
-		724, 1, 0, 0, 0, 727, 717, 1, 0, 0, 0, 727, 728, 1, 0, 0, 0, 728, 731,
-		1, 0, 0, 0, 729, 730, 5, 7, 0, 0, 730, 732, 3, 120, 60, 0, 731, 729, 1,
-		0, 0, 0, 731, 732, 1, 0, 0, 0, 732, 740, 1, 0, 0, 0, 733, 736, 7, 5, 0,
-		0, 734, 737, 3, 106, 53, 0, 735, 737, 3, 170, 85, 0, 736, 734, 1, 0, 0,
-		0, 736, 735, 1, 0, 0, 0, 737, 738, 1, 0, 0, 0, 738, 736, 1, 0, 0, 0, 738,
-		739, 1, 0, 0, 0, 739, 741, 1, 0, 0, 0, 740, 733, 1, 0, 0, 0, 740, 741,
-		1, 0, 0, 0, 741, 109, 1, 0, 0, 0, 742, 746, 5, 43, 0, 0, 743, 745, 3, 112,
-		56, 0, 744, 743, 1, 0, 0, 0, 745, 748, 1, 0, 0, 0, 746, 744, 1, 0, 0, 0,
-		746, 747, 1, 0, 0, 0, 747, 749, 1, 0, 0, 0, 748, 746, 1, 0, 0, 0, 749,
-		752, 7, 6, 0, 0, 750, 751, 5, 7, 0, 0, 751, 753, 3, 130, 65, 0, 752, 750,
-		1, 0, 0, 0, 752, 753, 1, 0, 0, 0, 753, 111, 1, 0, 0, 0, 754, 755, 5, 75,
-		0, 0, 755, 759, 5, 44, 0, 0, 756, 760, 5, 69, 0, 0, 757, 760, 3, 162, 81,
-		0, 758, 760, 5, 71, 0, 0, 759, 756, 1, 0, 0, 0, 759, 757, 1, 0, 0, 0, 759,
-		758, 1, 0, 0, 0, 760, 113, 1, 0, 0, 0, 761, 765, 5, 75, 0, 0, 762, 764,
-		3, 116, 58, 0, 763, 762, 1, 0, 0, 0, 764, 767, 1, 0, 0, 0, 765, 763, 1,
-		0, 0, 0, 765, 766, 1, 0, 0, 0, 766, 115, 1, 0, 0, 0, 767, 765, 1, 0, 0,
-		0, 768, 777, 5, 75, 0, 0, 769, 771, 5, 44, 0, 0, 770, 772, 5, 66, 0, 0,
-		771, 770, 1, 0, 0, 0, 771, 772, 1, 0, 0, 0, 772, 775, 1, 0, 0, 0, 773,
-		776, 3, 152, 76, 0, 774, 776, 5, 75, 0, 0, 775, 773, 1, 0, 0, 0, 775, 774,
-		1, 0, 0, 0, 776, 778, 1, 0, 0, 0, 777, 769, 1, 0, 0, 0, 777, 778, 1, 0,
-		0, 0, 778, 792, 1, 0, 0, 0, 779, 781, 5, 66, 0, 0, 780, 779, 1, 0, 0, 0,
-		780, 781, 1, 0, 0, 0, 781, 782, 1, 0, 0, 0, 782, 792, 3, 152, 76, 0, 783,
-		787, 5, 56, 0, 0, 784, 786, 3, 116, 58, 0, 785, 784, 1, 0, 0, 0, 786, 789,
-		1, 0, 0, 0, 787, 785, 1, 0, 0, 0, 787, 788, 1, 0, 0, 0, 788, 790, 1, 0,
-		0, 0, 789, 787, 1, 0, 0, 0, 790, 792, 5, 57, 0, 0, 791, 768, 1, 0, 0, 0,
-		791, 780, 1, 0, 0, 0, 791, 783, 1, 0, 0, 0, 792, 117, 1, 0, 0, 0, 793,
-		794, 5, 58, 0, 0, 794, 795, 3, 0, 0, 0, 795, 796, 5, 59, 0, 0, 796, 119,
-		1, 0, 0, 0, 797, 804, 3, 122, 61, 0, 798, 800, 3, 128, 64, 0, 799, 798,
-		1, 0, 0, 0, 799, 800, 1, 0, 0, 0, 800, 801, 1, 0, 0, 0, 801, 803, 3, 122,
-		61, 0, 802, 799, 1, 0, 0, 0, 803, 806, 1, 0, 0, 0, 804, 802, 1, 0, 0, 0,
-		804, 805, 1, 0, 0, 0, 805, 121, 1, 0, 0, 0, 806, 804, 1, 0, 0, 0, 807,
-		808, 5, 3, 0, 0, 808, 818, 3, 122, 61, 0, 809, 810, 5, 56, 0, 0, 810, 811,
-		3, 120, 60, 0, 811, 812, 5, 57, 0, 0, 812, 818, 1, 0, 0, 0, 813, 818, 3,
-		124, 62, 0, 814, 818, 3, 118, 59, 0, 815, 818, 5, 78, 0, 0, 816, 818, 3,
-		160, 80, 0, 817, 807, 1, 0, 0, 0, 817, 809, 1, 0, 0, 0, 817, 813, 1, 0,
-		0, 0, 817, 814, 1, 0, 0, 0, 817, 815, 1, 0, 0, 0, 817, 816, 1, 0, 0, 0,
-		818, 123, 1, 0, 0, 0, 819, 820, 3, 162, 81, 0, 820, 821, 3, 126, 63, 0,
-		821, 822, 3, 152, 76, 0, 822, 835, 1, 0, 0, 0, 823, 824, 3, 162, 81, 0,
-		824, 825, 5, 6, 0, 0, 825, 826, 5, 56, 0, 0, 826, 827, 3, 172, 86, 0, 827,
-		828, 5, 57, 0, 0, 828, 835, 1, 0, 0, 0, 829, 830, 3, 162, 81, 0, 830, 831,
-		5, 6, 0, 0, 831, 832, 3, 118, 59, 0, 832, 835, 1, 0, 0, 0, 833, 835, 3,
-		148, 74, 0, 834, 819, 1, 0, 0, 0, 834, 823, 1, 0, 0, 0, 834, 829, 1, 0,
-		0, 0, 834, 833, 1, 0, 0, 0, 835, 125, 1, 0, 0, 0, 836, 837, 7, 7, 0, 0,
-		837, 127, 1, 0, 0, 0, 838, 839, 7, 8, 0, 0, 839, 129, 1, 0, 0, 0, 840,
-		841, 3, 132, 66, 0, 841, 131, 1, 0, 0, 0, 842, 847, 3, 134, 67, 0, 843,
-		844, 5, 2, 0, 0, 844, 846, 3, 134, 67, 0, 845, 843, 1, 0, 0, 0, 846, 849,
-		1, 0, 0, 0, 847, 845, 1, 0, 0, 0, 847, 848, 1, 0, 0, 0, 848, 133, 1, 0,
-		0, 0, 849, 847, 1, 0, 0, 0, 850, 857, 3, 136, 68, 0, 851, 853, 5, 1, 0,
-		0, 852, 851, 1, 0, 0, 0, 852, 853, 1, 0, 0, 0, 853, 854, 1, 0, 0, 0, 854,
-		856, 3, 136, 68, 0, 855, 852, 1, 0, 0, 0, 856, 859, 1, 0, 0, 0, 857, 855,
-		1, 0, 0, 0, 857, 858, 1, 0, 0, 0, 858, 135, 1, 0, 0, 0, 859, 857, 1, 0,
-		0, 0, 860, 861, 5, 3, 0, 0, 861, 864, 3, 136, 68, 0, 862, 864, 3, 138,
-		69, 0, 863, 860, 1, 0, 0, 0, 863, 862, 1, 0, 0, 0, 864, 137, 1, 0, 0, 0,
-		865, 873, 3, 124, 62, 0, 866, 870, 3, 140, 70, 0, 867, 868, 3, 126, 63,
-		0, 868, 869, 3, 140, 70, 0, 869, 871, 1, 0, 0, 0, 870, 867, 1, 0, 0, 0,
-		870, 871, 1, 0, 0, 0, 871, 873, 1, 0, 0, 0, 872, 865, 1, 0, 0, 0, 872,
-		866, 1, 0, 0, 0, 873, 139, 1, 0, 0, 0, 874, 879, 3, 142, 71, 0, 875, 876,
-		7, 9, 0, 0, 876, 878, 3, 142, 71, 0, 877, 875, 1, 0, 0, 0, 878, 881, 1,
-		0, 0, 0, 879, 877, 1, 0, 0, 0, 879, 880, 1, 0, 0, 0, 880, 141, 1, 0, 0,
-		0, 881, 879, 1, 0, 0, 0, 882, 887, 3, 144, 72, 0, 883, 884, 7, 10, 0, 0,
-		884, 886, 3, 144, 72, 0, 885, 883, 1, 0, 0, 0, 886, 889, 1, 0, 0, 0, 887,
-		885, 1, 0, 0, 0, 887, 888, 1, 0, 0, 0, 888, 143, 1, 0, 0, 0, 889, 887,
-		1, 0, 0, 0, 890, 891, 5, 66, 0, 0, 891, 894, 3, 144, 72, 0, 892, 894, 3,
-		146, 73, 0, 893, 890, 1, 0, 0, 0, 893, 892, 1, 0, 0, 0, 894, 145, 1, 0,
-		0, 0, 895, 896, 5, 56, 0, 0, 896, 897, 3, 130, 65, 0, 897, 898, 5, 57,
-		0, 0, 898, 904, 1, 0, 0, 0, 899, 904, 3, 118, 59, 0, 900, 904, 3, 148,
-		74, 0, 901, 904, 3, 152, 76, 0, 902, 904, 3, 162, 81, 0, 903, 895, 1, 0,
-		0, 0, 903, 899, 1, 0, 0, 0, 903, 900, 1, 0, 0, 0, 903, 901, 1, 0, 0, 0,
-		903, 902, 1, 0, 0, 0, 904, 147, 1, 0, 0, 0, 905, 906, 5, 75, 0, 0, 906,
-		908, 5, 56, 0, 0, 907, 909, 3, 150, 75, 0, 908, 907, 1, 0, 0, 0, 908, 909,
-		1, 0, 0, 0, 909, 910, 1, 0, 0, 0, 910, 943, 5, 57, 0, 0, 911, 912, 5, 9,
-		0, 0, 912, 914, 5, 56, 0, 0, 913, 915, 3, 150, 75, 0, 914, 913, 1, 0, 0,
-		0, 914, 915, 1, 0, 0, 0, 915, 916, 1, 0, 0, 0, 916, 943, 5, 57, 0, 0, 917,
-		918, 5, 51, 0, 0, 918, 919, 5, 56, 0, 0, 919, 920, 3, 150, 75, 0, 920,
-		921, 5, 57, 0, 0, 921, 943, 1, 0, 0, 0, 922, 923, 5, 50, 0, 0, 923, 924,
-		5, 56, 0, 0, 924, 925, 3, 150, 75, 0, 925, 926, 5, 57, 0, 0, 926, 943,
-		1, 0, 0, 0, 927, 928, 5, 52, 0, 0, 928, 929, 5, 56, 0, 0, 929, 930, 3,
-		150, 75, 0, 930, 931, 5, 57, 0, 0, 931, 943, 1, 0, 0, 0, 932, 933, 5, 53,
-		0, 0, 933, 934, 5, 56, 0, 0, 934, 935, 3, 150, 75, 0, 935, 936, 5, 57,
-		0, 0, 936, 943, 1, 0, 0, 0, 937, 938, 5, 54, 0, 0, 938, 939, 5, 56, 0,
-		0, 939, 940, 3, 150, 75, 0, 940, 941, 5, 57, 0, 0, 941, 943, 1, 0, 0, 0,
-		942, 905, 1, 0, 0, 0, 942, 911, 1, 0, 0, 0, 942, 917, 1, 0, 0, 0, 942,
-		922, 1, 0, 0, 0, 942, 927, 1, 0, 0, 0, 942, 932, 1, 0, 0, 0, 942, 937,
-		1, 0, 0, 0, 943, 149, 1, 0, 0, 0, 944, 949, 3, 130, 65, 0, 945, 946, 5,
-		62, 0, 0, 946, 948, 3, 130, 65, 0, 947, 945, 1, 0, 0, 0, 948, 951, 1, 0,
-		0, 0, 949, 947, 1, 0, 0, 0, 949, 950, 1, 0, 0, 0, 950, 151, 1, 0, 0, 0,
-		951, 949, 1, 0, 0, 0, 952, 959, 5, 69, 0, 0, 953, 959, 5, 71, 0, 0, 954,
-		959, 5, 70, 0, 0, 955, 959, 3, 158, 79, 0, 956, 959, 3, 154, 77, 0, 957,
-		959, 5, 75, 0, 0, 958, 952, 1, 0, 0, 0, 958, 953, 1, 0, 0, 0, 958, 954,
-		1, 0, 0, 0, 958, 955, 1, 0, 0, 0, 958, 956, 1, 0, 0, 0, 958, 957, 1, 0,
-		0, 0, 959, 153, 1, 0, 0, 0, 960, 963, 3, 156, 78, 0, 961, 962, 5, 63, 0,
-		0, 962, 964, 3, 156, 78, 0, 963, 961, 1, 0, 0, 0, 964, 965, 1, 0, 0, 0,
-		965, 963, 1, 0, 0, 0, 965, 966, 1, 0, 0, 0, 966, 155, 1, 0, 0, 0, 967,
-		972, 5, 75, 0, 0, 968, 969, 7, 11, 0, 0, 969, 971, 5, 75, 0, 0, 970, 968,
-		1, 0, 0, 0, 971, 974, 1, 0, 0, 0, 972, 970, 1, 0, 0, 0, 972, 973, 1, 0,
-		0, 0, 973, 157, 1, 0, 0, 0, 974, 972, 1, 0, 0, 0, 975, 976, 5, 75, 0, 0,
-		976, 977, 5, 72, 0, 0, 977, 992, 5, 73, 0, 0, 978, 979, 5, 75, 0, 0, 979,
-		992, 5, 72, 0, 0, 980, 981, 5, 72, 0, 0, 981, 982, 5, 75, 0, 0, 982, 992,
-		5, 72, 0, 0, 983, 984, 5, 72, 0, 0, 984, 992, 5, 75, 0, 0, 985, 986, 5,
-		72, 0, 0, 986, 987, 5, 76, 0, 0, 987, 992, 5, 75, 0, 0, 988, 989, 5, 72,
-		0, 0, 989, 992, 5, 73, 0, 0, 990, 992, 5, 72, 0, 0, 991, 975, 1, 0, 0,
-		0, 991, 978, 1, 0, 0, 0, 991, 980, 1, 0, 0, 0, 991, 983, 1, 0, 0, 0, 991,
-		985, 1, 0, 0, 0, 991, 988, 1, 0, 0, 0, 991, 990, 1, 0, 0, 0, 992, 159,
-		1, 0, 0, 0, 993, 998, 5, 75, 0, 0, 994, 998, 5, 71, 0, 0, 995, 998, 5,
-		69, 0, 0, 996, 998, 3, 158, 79, 0, 997, 993, 1, 0, 0, 0, 997, 994, 1, 0,
-		0, 0, 997, 995, 1, 0, 0, 0, 997, 996, 1, 0, 0, 0, 998, 161, 1, 0, 0, 0,
-		999, 1011, 3, 166, 83, 0, 1000, 1008, 3, 164, 82, 0, 1001, 1002, 5, 76,
-		0, 0, 1002, 1004, 3, 166, 83, 0, 1003, 1005, 3, 164, 82, 0, 1004, 1003,
-		1, 0, 0, 0, 1004, 1005, 1, 0, 0, 0, 1005, 1007, 1, 0, 0, 0, 1006, 1001,
-		1, 0, 0, 0, 1007, 1010, 1, 0, 0, 0, 1008, 1006, 1, 0, 0, 0, 1008, 1009,
-		1, 0, 0, 0, 1009, 1012, 1, 0, 0, 0, 1010, 1008, 1, 0, 0, 0, 1011, 1000,
-		1, 0, 0, 0, 1011, 1012, 1, 0, 0, 0, 1012, 1019, 1, 0, 0, 0, 1013, 1019,
-		5, 71, 0, 0, 1014, 1016, 5, 74, 0, 0, 1015, 1017, 5, 75, 0, 0, 1016, 1015,
-		1, 0, 0, 0, 1016, 1017, 1, 0, 0, 0, 1017, 1019, 1, 0, 0, 0, 1018, 999,
-		1, 0, 0, 0, 1018, 1013, 1, 0, 0, 0, 1018, 1014, 1, 0, 0, 0, 1019, 163,
-		1, 0, 0, 0, 1020, 1021, 5, 60, 0, 0, 1021, 1029, 5, 61, 0, 0, 1022, 1023,
-		5, 58, 0, 0, 1023, 1024, 5, 72, 0, 0, 1024, 1029, 5, 59, 0, 0, 1025, 1026,
-		5, 58, 0, 0, 1026, 1027, 5, 71, 0, 0, 1027, 1029, 5, 59, 0, 0, 1028, 1020,
-		1, 0, 0, 0, 1028, 1022, 1, 0, 0, 0, 1028, 1025, 1, 0, 0, 0, 1029, 165,
-		1, 0, 0, 0, 1030, 1035, 5, 75, 0, 0, 1031, 1032, 5, 66, 0, 0, 1032, 1034,
-		5, 75, 0, 0, 1033, 1031, 1, 0, 0, 0, 1034, 1037, 1, 0, 0, 0, 1035, 1033,
-		1, 0, 0, 0, 1035, 1036, 1, 0, 0, 0, 1036, 1042, 1, 0, 0, 0, 1037, 1035,
-		1, 0, 0, 0, 1038, 1042, 5, 40, 0, 0, 1039, 1042, 5, 42, 0, 0, 1040, 1042,
-		5, 43, 0, 0, 1041, 1030, 1, 0, 0, 0, 1041, 1038, 1, 0, 0, 0, 1041, 1039,
-		1, 0, 0, 0, 1041, 1040, 1, 0, 0, 0, 1042, 167, 1, 0, 0, 0, 1043, 1050,
-		3, 170, 85, 0, 1044, 1046, 5, 62, 0, 0, 1045, 1044, 1, 0, 0, 0, 1045, 1046,
-		1, 0, 0, 0, 1046, 1047, 1, 0, 0, 0, 1047, 1049, 3, 170, 85, 0, 1048, 1045,
-		1, 0, 0, 0, 1049, 1052, 1, 0, 0, 0, 1050, 1048, 1, 0, 0, 0, 1050, 1051,
-		1, 0, 0, 0, 1051, 169, 1, 0, 0, 0, 1052, 1050, 1, 0, 0, 0, 1053, 1056,
-		3, 162, 81, 0, 1054, 1056, 5, 69, 0, 0, 1055, 1053, 1, 0, 0, 0, 1055, 1054,
-		1, 0, 0, 0, 1056, 171, 1, 0, 0, 0, 1057, 1062, 3, 152, 76, 0, 1058, 1059,
-		5, 62, 0, 0, 1059, 1061, 3, 152, 76, 0, 1060, 1058, 1, 0, 0, 0, 1061, 1064,
-		1, 0, 0, 0, 1062, 1060, 1, 0, 0, 0, 1062, 1063, 1, 0, 0, 0, 1063, 173,
-		1, 0, 0, 0, 1064, 1062, 1, 0, 0, 0, 137, 175, 182, 219, 222, 235, 240,
-		248, 253, 258, 263, 269, 271, 277, 279, 281, 288, 298, 305, 311, 319, 326,
-		330, 336, 344, 348, 355, 359, 365, 369, 373, 378, 382, 387, 393, 404, 410,
-		418, 424, 431, 441, 450, 456, 463, 468, 473, 478, 483, 488, 493, 499, 505,
-		513, 519, 523, 529, 533, 543, 553, 562, 573, 581, 595, 597, 604, 615, 621,
-		625, 629, 635, 640, 645, 648, 652, 656, 661, 663, 665, 673, 676, 685, 691,
-		697, 700, 708, 714, 719, 724, 727, 731, 736, 738, 740, 746, 752, 759, 765,
-		771, 775, 777, 780, 787, 791, 799, 804, 817, 834, 847, 852, 857, 863, 870,
-		872, 879, 887, 893, 903, 908, 914, 942, 949, 958, 965, 972, 991, 997, 1004,
-		1008, 1011, 1016, 1018, 1028, 1035, 1041, 1045, 1050, 1055, 1062,
+		1, 1, 1, 1, 1, 1, 1, 3, 1, 268, 8, 1, 1, 2, 3, 2, 271, 8, 2, 1, 2, 1, 2,
+		1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 5, 4, 282, 8, 4, 10, 4, 12, 4,
+		285, 9, 4, 1, 5, 1, 5, 3, 5, 289, 8, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1,
+		6, 3, 6, 297, 8, 6, 1, 6, 5, 6, 300, 8, 6, 10, 6, 12, 6, 303, 9, 6, 1,
+		6, 1, 6, 3, 6, 307, 8, 6, 1, 7, 1, 7, 1, 7, 3, 7, 312, 8, 7, 1, 7, 1, 7,
+		1, 7, 1, 7, 3, 7, 318, 8, 7, 3, 7, 320, 8, 7, 1, 7, 1, 7, 1, 7, 1, 7, 3,
+		7, 326, 8, 7, 3, 7, 328, 8, 7, 3, 7, 330, 8, 7, 1, 8, 1, 8, 1, 8, 1, 9,
+		1, 9, 3, 9, 337, 8, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 3, 10, 344, 8,
+		10, 1, 10, 5, 10, 347, 8, 10, 10, 10, 12, 10, 350, 9, 10, 1, 11, 1, 11,
+		1, 11, 1, 11, 3, 11, 356, 8, 11, 1, 12, 1, 12, 5, 12, 360, 8, 12, 10, 12,
+		12, 12, 363, 9, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 370, 8, 12,
+		1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 3, 13, 377, 8, 13, 1, 14, 1, 14, 1,
+		14, 1, 14, 3, 14, 383, 8, 14, 1, 14, 1, 14, 1, 15, 1, 15, 3, 15, 389, 8,
+		15, 1, 15, 1, 15, 5, 15, 393, 8, 15, 10, 15, 12, 15, 396, 9, 15, 1, 16,
+		1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 403, 8, 16, 1, 17, 1, 17, 3, 17, 407,
+		8, 17, 1, 17, 1, 17, 1, 17, 5, 17, 412, 8, 17, 10, 17, 12, 17, 415, 9,
+		17, 1, 18, 3, 18, 418, 8, 18, 1, 18, 1, 18, 3, 18, 422, 8, 18, 1, 19, 1,
+		19, 3, 19, 426, 8, 19, 1, 20, 1, 20, 3, 20, 430, 8, 20, 1, 21, 1, 21, 3,
+		21, 434, 8, 21, 1, 21, 5, 21, 437, 8, 21, 10, 21, 12, 21, 440, 9, 21, 1,
+		21, 1, 21, 1, 21, 3, 21, 445, 8, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22,
+		3, 22, 452, 8, 22, 1, 23, 1, 23, 3, 23, 456, 8, 23, 1, 23, 5, 23, 459,
+		8, 23, 10, 23, 12, 23, 462, 9, 23, 1, 23, 1, 23, 1, 23, 3, 23, 467, 8,
+		23, 1, 24, 1, 24, 5, 24, 471, 8, 24, 10, 24, 12, 24, 474, 9, 24, 1, 24,
+		1, 24, 4, 24, 478, 8, 24, 11, 24, 12, 24, 479, 1, 24, 3, 24, 483, 8, 24,
+		1, 25, 1, 25, 5, 25, 487, 8, 25, 10, 25, 12, 25, 490, 9, 25, 1, 26, 1,
+		26, 1, 26, 3, 26, 495, 8, 26, 1, 26, 3, 26, 498, 8, 26, 1, 27, 1, 27, 1,
+		27, 5, 27, 503, 8, 27, 10, 27, 12, 27, 506, 9, 27, 1, 27, 3, 27, 509, 8,
+		27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 516, 8, 28, 1, 29, 1, 29,
+		5, 29, 520, 8, 29, 10, 29, 12, 29, 523, 9, 29, 1, 29, 1, 29, 1, 29, 5,
+		29, 528, 8, 29, 10, 29, 12, 29, 531, 9, 29, 1, 29, 1, 29, 1, 29, 3, 29,
+		536, 8, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 3, 30, 543, 8, 30, 1, 31,
+		1, 31, 1, 31, 1, 32, 1, 32, 5, 32, 550, 8, 32, 10, 32, 12, 32, 553, 9,
+		32, 1, 32, 1, 32, 1, 33, 1, 33, 5, 33, 559, 8, 33, 10, 33, 12, 33, 562,
+		9, 33, 1, 33, 1, 33, 1, 34, 1, 34, 5, 34, 568, 8, 34, 10, 34, 12, 34, 571,
+		9, 34, 1, 34, 1, 34, 4, 34, 575, 8, 34, 11, 34, 12, 34, 576, 1, 35, 1,
+		35, 4, 35, 581, 8, 35, 11, 35, 12, 35, 582, 1, 36, 1, 36, 1, 36, 5, 36,
+		588, 8, 36, 10, 36, 12, 36, 591, 9, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1,
+		37, 1, 37, 3, 37, 599, 8, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37,
+		1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 3,
+		37, 617, 8, 37, 1, 38, 1, 38, 1, 38, 5, 38, 622, 8, 38, 10, 38, 12, 38,
+		625, 9, 38, 1, 39, 1, 39, 3, 39, 629, 8, 39, 1, 40, 1, 40, 1, 40, 1, 40,
+		3, 40, 635, 8, 40, 1, 41, 1, 41, 5, 41, 639, 8, 41, 10, 41, 12, 41, 642,
+		9, 41, 1, 41, 1, 41, 3, 41, 646, 8, 41, 1, 41, 5, 41, 649, 8, 41, 10, 41,
+		12, 41, 652, 9, 41, 1, 41, 5, 41, 655, 8, 41, 10, 41, 12, 41, 658, 9, 41,
+		1, 41, 1, 41, 3, 41, 662, 8, 41, 1, 42, 1, 42, 5, 42, 666, 8, 42, 10, 42,
+		12, 42, 669, 9, 42, 1, 42, 1, 42, 3, 42, 673, 8, 42, 1, 42, 5, 42, 676,
+		8, 42, 10, 42, 12, 42, 679, 9, 42, 1, 42, 5, 42, 682, 8, 42, 10, 42, 12,
+		42, 685, 9, 42, 1, 42, 1, 42, 3, 42, 689, 8, 42, 1, 43, 1, 43, 1, 43, 1,
+		43, 1, 43, 1, 43, 3, 43, 697, 8, 43, 1, 44, 1, 44, 5, 44, 701, 8, 44, 10,
+		44, 12, 44, 704, 9, 44, 1, 44, 1, 44, 3, 44, 708, 8, 44, 1, 44, 5, 44,
+		711, 8, 44, 10, 44, 12, 44, 714, 9, 44, 1, 44, 1, 44, 3, 44, 718, 8, 44,
+		1, 44, 5, 44, 721, 8, 44, 10, 44, 12, 44, 724, 9, 44, 1, 45, 1, 45, 1,
+		45, 1, 45, 1, 45, 1, 45, 3, 45, 732, 8, 45, 1, 46, 1, 46, 5, 46, 736, 8,
+		46, 10, 46, 12, 46, 739, 9, 46, 1, 46, 1, 46, 3, 46, 743, 8, 46, 1, 46,
+		5, 46, 746, 8, 46, 10, 46, 12, 46, 749, 9, 46, 1, 46, 1, 46, 3, 46, 753,
+		8, 46, 1, 46, 1, 46, 3, 46, 757, 8, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1,
+		46, 3, 46, 764, 8, 46, 1, 46, 5, 46, 767, 8, 46, 10, 46, 12, 46, 770, 9,
+		46, 1, 47, 1, 47, 5, 47, 774, 8, 47, 10, 47, 12, 47, 777, 9, 47, 1, 47,
+		3, 47, 780, 8, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 5, 49, 788,
+		8, 49, 10, 49, 12, 49, 791, 9, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1,
+		50, 1, 50, 3, 50, 800, 8, 50, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 5, 52,
+		807, 8, 52, 10, 52, 12, 52, 810, 9, 52, 1, 53, 1, 53, 3, 53, 814, 8, 53,
+		1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 3, 55, 822, 8, 55, 1, 55, 5,
+		55, 825, 8, 55, 10, 55, 12, 55, 828, 9, 55, 1, 56, 1, 56, 1, 56, 1, 56,
+		1, 56, 1, 56, 1, 56, 3, 56, 837, 8, 56, 1, 57, 1, 57, 5, 57, 841, 8, 57,
+		10, 57, 12, 57, 844, 9, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 1, 58, 1,
+		58, 3, 58, 853, 8, 58, 1, 58, 1, 58, 1, 58, 3, 58, 858, 8, 58, 1, 59, 1,
+		59, 1, 59, 4, 59, 863, 8, 59, 11, 59, 12, 59, 864, 1, 60, 1, 60, 1, 60,
+		1, 60, 1, 60, 3, 60, 872, 8, 60, 1, 61, 1, 61, 5, 61, 876, 8, 61, 10, 61,
+		12, 61, 879, 9, 61, 1, 61, 1, 61, 1, 61, 5, 61, 884, 8, 61, 10, 61, 12,
+		61, 887, 9, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63,
+		3, 63, 897, 8, 63, 1, 63, 1, 63, 1, 63, 1, 63, 3, 63, 903, 8, 63, 3, 63,
+		905, 8, 63, 1, 64, 1, 64, 5, 64, 909, 8, 64, 10, 64, 12, 64, 912, 9, 64,
+		1, 64, 1, 64, 5, 64, 916, 8, 64, 10, 64, 12, 64, 919, 9, 64, 1, 64, 1,
+		64, 3, 64, 923, 8, 64, 1, 65, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 5, 66,
+		931, 8, 66, 10, 66, 12, 66, 934, 9, 66, 1, 67, 1, 67, 1, 67, 3, 67, 939,
+		8, 67, 1, 67, 1, 67, 1, 67, 5, 67, 944, 8, 67, 10, 67, 12, 67, 947, 9,
+		67, 1, 67, 1, 67, 3, 67, 951, 8, 67, 1, 68, 1, 68, 5, 68, 955, 8, 68, 10,
+		68, 12, 68, 958, 9, 68, 1, 68, 1, 68, 3, 68, 962, 8, 68, 1, 68, 5, 68,
+		965, 8, 68, 10, 68, 12, 68, 968, 9, 68, 3, 68, 970, 8, 68, 1, 68, 1, 68,
+		3, 68, 974, 8, 68, 1, 68, 1, 68, 3, 68, 978, 8, 68, 1, 68, 1, 68, 1, 68,
+		4, 68, 983, 8, 68, 11, 68, 12, 68, 984, 3, 68, 987, 8, 68, 1, 69, 1, 69,
+		1, 69, 1, 69, 1, 69, 1, 69, 3, 69, 995, 8, 69, 1, 69, 3, 69, 998, 8, 69,
+		1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 5, 70, 1005, 8, 70, 10, 70, 12, 70,
+		1008, 9, 70, 1, 70, 1, 70, 1, 70, 3, 70, 1013, 8, 70, 1, 70, 1, 70, 5,
+		70, 1017, 8, 70, 10, 70, 12, 70, 1020, 9, 70, 3, 70, 1022, 8, 70, 1, 71,
+		1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 3, 71, 1030, 8, 71, 1, 72, 1, 72, 5,
+		72, 1034, 8, 72, 10, 72, 12, 72, 1037, 9, 72, 1, 72, 1, 72, 3, 72, 1041,
+		8, 72, 1, 72, 5, 72, 1044, 8, 72, 10, 72, 12, 72, 1047, 9, 72, 3, 72, 1049,
+		8, 72, 1, 72, 1, 72, 3, 72, 1053, 8, 72, 1, 72, 1, 72, 1, 72, 4, 72, 1058,
+		8, 72, 11, 72, 12, 72, 1059, 3, 72, 1062, 8, 72, 1, 73, 1, 73, 5, 73, 1066,
+		8, 73, 10, 73, 12, 73, 1069, 9, 73, 1, 73, 1, 73, 1, 73, 3, 73, 1074, 8,
+		73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 3, 74, 1081, 8, 74, 1, 75, 1, 75,
+		5, 75, 1085, 8, 75, 10, 75, 12, 75, 1088, 9, 75, 1, 76, 1, 76, 1, 76, 3,
+		76, 1093, 8, 76, 1, 76, 1, 76, 3, 76, 1097, 8, 76, 3, 76, 1099, 8, 76,
+		1, 76, 3, 76, 1102, 8, 76, 1, 76, 1, 76, 1, 76, 5, 76, 1107, 8, 76, 10,
+		76, 12, 76, 1110, 9, 76, 1, 76, 1, 76, 3, 76, 1114, 8, 76, 1, 77, 1, 77,
+		1, 77, 1, 77, 1, 78, 1, 78, 3, 78, 1122, 8, 78, 1, 78, 5, 78, 1125, 8,
+		78, 10, 78, 12, 78, 1128, 9, 78, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1,
+		79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 3, 79, 1142, 8, 79, 1, 80,
+		1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1,
+		80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 3, 80, 1163, 8, 80,
+		1, 81, 1, 81, 1, 82, 1, 82, 1, 83, 1, 83, 1, 84, 1, 84, 1, 84, 5, 84, 1174,
+		8, 84, 10, 84, 12, 84, 1177, 9, 84, 1, 85, 1, 85, 3, 85, 1181, 8, 85, 1,
+		85, 5, 85, 1184, 8, 85, 10, 85, 12, 85, 1187, 9, 85, 1, 86, 1, 86, 1, 86,
+		3, 86, 1192, 8, 86, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1,
+		87, 1, 87, 3, 87, 1203, 8, 87, 3, 87, 1205, 8, 87, 1, 88, 1, 88, 1, 88,
+		1, 88, 1, 88, 5, 88, 1212, 8, 88, 10, 88, 12, 88, 1215, 9, 88, 1, 88, 1,
+		88, 5, 88, 1219, 8, 88, 10, 88, 12, 88, 1222, 9, 88, 1, 89, 1, 89, 1, 89,
+		5, 89, 1227, 8, 89, 10, 89, 12, 89, 1230, 9, 89, 1, 90, 1, 90, 1, 90, 5,
+		90, 1235, 8, 90, 10, 90, 12, 90, 1238, 9, 90, 1, 91, 1, 91, 1, 91, 3, 91,
+		1243, 8, 91, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1,
+		92, 1, 92, 1, 92, 3, 92, 1256, 8, 92, 1, 93, 1, 93, 1, 93, 3, 93, 1261,
+		8, 93, 1, 93, 1, 93, 1, 93, 1, 93, 3, 93, 1267, 8, 93, 1, 93, 1, 93, 1,
+		93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93,
+		1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1,
+		93, 1, 93, 1, 93, 3, 93, 1295, 8, 93, 1, 94, 1, 94, 1, 94, 5, 94, 1300,
+		8, 94, 10, 94, 12, 94, 1303, 9, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95,
+		1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 3, 95, 1319,
+		8, 95, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 4, 97, 1326, 8, 97, 11, 97, 12,
+		97, 1327, 1, 98, 1, 98, 1, 98, 5, 98, 1333, 8, 98, 10, 98, 12, 98, 1336,
+		9, 98, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1,
+		99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 3, 99, 1354, 8, 99, 1, 100,
+		1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 3, 100, 1364, 8,
+		100, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 3, 101, 1371, 8, 101, 5, 101,
+		1373, 8, 101, 10, 101, 12, 101, 1376, 9, 101, 3, 101, 1378, 8, 101, 1,
+		101, 1, 101, 1, 101, 3, 101, 1383, 8, 101, 1, 101, 3, 101, 1386, 8, 101,
+		1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 1, 102,
+		1, 102, 1, 102, 3, 102, 1399, 8, 102, 1, 103, 1, 103, 1, 103, 5, 103, 1404,
+		8, 103, 10, 103, 12, 103, 1407, 9, 103, 1, 103, 1, 103, 1, 103, 1, 103,
+		1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103, 1, 103,
+		1, 103, 3, 103, 1423, 8, 103, 1, 104, 1, 104, 3, 104, 1427, 8, 104, 1,
+		104, 5, 104, 1430, 8, 104, 10, 104, 12, 104, 1433, 9, 104, 1, 105, 1, 105,
+		1, 105, 3, 105, 1438, 8, 105, 1, 106, 1, 106, 1, 106, 5, 106, 1443, 8,
+		106, 10, 106, 12, 106, 1446, 9, 106, 1, 106, 0, 0, 107, 0, 2, 4, 6, 8,
+		10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44,
+		46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80,
+		82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112,
+		114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142,
+		144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172,
+		174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202,
+		204, 206, 208, 210, 212, 0, 17, 1, 0, 77, 78, 2, 0, 54, 54, 56, 56, 2,
+		0, 81, 81, 93, 93, 1, 0, 52, 53, 2, 0, 52, 52, 93, 93, 3, 0, 81, 81, 88,
+		88, 93, 93, 2, 0, 81, 81, 88, 88, 2, 0, 8, 8, 93, 93, 1, 0, 43, 44, 4,
+		0, 81, 81, 85, 85, 88, 88, 93, 93, 1, 0, 78, 79, 2, 0, 4, 4, 49, 49, 1,
+		0, 54, 60, 1, 0, 1, 2, 2, 0, 79, 80, 89, 89, 2, 0, 77, 78, 94, 94, 4, 0,
+		14, 14, 25, 28, 39, 41, 52, 53, 1656, 0, 215, 1, 0, 0, 0, 2, 267, 1, 0,
+		0, 0, 4, 270, 1, 0, 0, 0, 6, 274, 1, 0, 0, 0, 8, 277, 1, 0, 0, 0, 10, 288,
+		1, 0, 0, 0, 12, 293, 1, 0, 0, 0, 14, 329, 1, 0, 0, 0, 16, 331, 1, 0, 0,
+		0, 18, 334, 1, 0, 0, 0, 20, 340, 1, 0, 0, 0, 22, 351, 1, 0, 0, 0, 24, 357,
+		1, 0, 0, 0, 26, 371, 1, 0, 0, 0, 28, 378, 1, 0, 0, 0, 30, 386, 1, 0, 0,
+		0, 32, 397, 1, 0, 0, 0, 34, 404, 1, 0, 0, 0, 36, 417, 1, 0, 0, 0, 38, 423,
+		1, 0, 0, 0, 40, 427, 1, 0, 0, 0, 42, 431, 1, 0, 0, 0, 44, 446, 1, 0, 0,
+		0, 46, 453, 1, 0, 0, 0, 48, 468, 1, 0, 0, 0, 50, 484, 1, 0, 0, 0, 52, 491,
+		1, 0, 0, 0, 54, 508, 1, 0, 0, 0, 56, 510, 1, 0, 0, 0, 58, 535, 1, 0, 0,
+		0, 60, 537, 1, 0, 0, 0, 62, 544, 1, 0, 0, 0, 64, 547, 1, 0, 0, 0, 66, 556,
+		1, 0, 0, 0, 68, 565, 1, 0, 0, 0, 70, 578, 1, 0, 0, 0, 72, 584, 1, 0, 0,
+		0, 74, 616, 1, 0, 0, 0, 76, 618, 1, 0, 0, 0, 78, 628, 1, 0, 0, 0, 80, 630,
+		1, 0, 0, 0, 82, 636, 1, 0, 0, 0, 84, 663, 1, 0, 0, 0, 86, 690, 1, 0, 0,
+		0, 88, 698, 1, 0, 0, 0, 90, 725, 1, 0, 0, 0, 92, 733, 1, 0, 0, 0, 94, 771,
+		1, 0, 0, 0, 96, 781, 1, 0, 0, 0, 98, 785, 1, 0, 0, 0, 100, 794, 1, 0, 0,
+		0, 102, 801, 1, 0, 0, 0, 104, 804, 1, 0, 0, 0, 106, 813, 1, 0, 0, 0, 108,
+		815, 1, 0, 0, 0, 110, 819, 1, 0, 0, 0, 112, 836, 1, 0, 0, 0, 114, 838,
+		1, 0, 0, 0, 116, 857, 1, 0, 0, 0, 118, 859, 1, 0, 0, 0, 120, 866, 1, 0,
+		0, 0, 122, 873, 1, 0, 0, 0, 124, 888, 1, 0, 0, 0, 126, 892, 1, 0, 0, 0,
+		128, 906, 1, 0, 0, 0, 130, 924, 1, 0, 0, 0, 132, 928, 1, 0, 0, 0, 134,
+		950, 1, 0, 0, 0, 136, 952, 1, 0, 0, 0, 138, 997, 1, 0, 0, 0, 140, 1021,
+		1, 0, 0, 0, 142, 1023, 1, 0, 0, 0, 144, 1031, 1, 0, 0, 0, 146, 1063, 1,
+		0, 0, 0, 148, 1075, 1, 0, 0, 0, 150, 1082, 1, 0, 0, 0, 152, 1113, 1, 0,
+		0, 0, 154, 1115, 1, 0, 0, 0, 156, 1119, 1, 0, 0, 0, 158, 1141, 1, 0, 0,
+		0, 160, 1162, 1, 0, 0, 0, 162, 1164, 1, 0, 0, 0, 164, 1166, 1, 0, 0, 0,
+		166, 1168, 1, 0, 0, 0, 168, 1170, 1, 0, 0, 0, 170, 1178, 1, 0, 0, 0, 172,
+		1191, 1, 0, 0, 0, 174, 1204, 1, 0, 0, 0, 176, 1206, 1, 0, 0, 0, 178, 1223,
+		1, 0, 0, 0, 180, 1231, 1, 0, 0, 0, 182, 1242, 1, 0, 0, 0, 184, 1255, 1,
+		0, 0, 0, 186, 1294, 1, 0, 0, 0, 188, 1296, 1, 0, 0, 0, 190, 1318, 1, 0,
+		0, 0, 192, 1320, 1, 0, 0, 0, 194, 1322, 1, 0, 0, 0, 196, 1329, 1, 0, 0,
+		0, 198, 1353, 1, 0, 0, 0, 200, 1363, 1, 0, 0, 0, 202, 1385, 1, 0, 0, 0,
+		204, 1398, 1, 0, 0, 0, 206, 1422, 1, 0, 0, 0, 208, 1424, 1, 0, 0, 0, 210,
+		1437, 1, 0, 0, 0, 212, 1439, 1, 0, 0, 0, 214, 216, 5, 66, 0, 0, 215, 214,
+		1, 0, 0, 0, 215, 216, 1, 0, 0, 0, 216, 217, 1, 0, 0, 0, 217, 222, 3, 2,
+		1, 0, 218, 219, 5, 66, 0, 0, 219, 221, 3, 2, 1, 0, 220, 218, 1, 0, 0, 0,
+		221, 224, 1, 0, 0, 0, 222, 220, 1, 0, 0, 0, 222, 223, 1, 0, 0, 0, 223,
+		1, 1, 0, 0, 0, 224, 222, 1, 0, 0, 0, 225, 268, 3, 4, 2, 0, 226, 268, 3,
+		6, 3, 0, 227, 268, 3, 8, 4, 0, 228, 268, 3, 12, 6, 0, 229, 268, 3, 16,
+		8, 0, 230, 268, 3, 18, 9, 0, 231, 268, 3, 20, 10, 0, 232, 268, 3, 24, 12,
+		0, 233, 268, 3, 28, 14, 0, 234, 268, 3, 30, 15, 0, 235, 268, 3, 34, 17,
+		0, 236, 268, 3, 38, 19, 0, 237, 268, 3, 40, 20, 0, 238, 268, 3, 42, 21,
+		0, 239, 268, 3, 46, 23, 0, 240, 268, 3, 48, 24, 0, 241, 268, 3, 58, 29,
+		0, 242, 268, 3, 62, 31, 0, 243, 268, 3, 64, 32, 0, 244, 268, 3, 66, 33,
+		0, 245, 268, 3, 68, 34, 0, 246, 268, 3, 70, 35, 0, 247, 268, 3, 72, 36,
+		0, 248, 268, 3, 76, 38, 0, 249, 268, 3, 82, 41, 0, 250, 268, 3, 84, 42,
+		0, 251, 268, 3, 88, 44, 0, 252, 268, 3, 92, 46, 0, 253, 268, 3, 94, 47,
+		0, 254, 268, 3, 98, 49, 0, 255, 268, 3, 102, 51, 0, 256, 268, 3, 104, 52,
+		0, 257, 268, 3, 110, 55, 0, 258, 268, 3, 114, 57, 0, 259, 268, 3, 118,
+		59, 0, 260, 268, 3, 122, 61, 0, 261, 268, 3, 128, 64, 0, 262, 268, 3, 132,
+		66, 0, 263, 268, 3, 136, 68, 0, 264, 268, 3, 144, 72, 0, 265, 268, 3, 146,
+		73, 0, 266, 268, 3, 150, 75, 0, 267, 225, 1, 0, 0, 0, 267, 226, 1, 0, 0,
+		0, 267, 227, 1, 0, 0, 0, 267, 228, 1, 0, 0, 0, 267, 229, 1, 0, 0, 0, 267,
+		230, 1, 0, 0, 0, 267, 231, 1, 0, 0, 0, 267, 232, 1, 0, 0, 0, 267, 233,
+		1, 0, 0, 0, 267, 234, 1, 0, 0, 0, 267, 235, 1, 0, 0, 0, 267, 236, 1, 0,
+		0, 0, 267, 237, 1, 0, 0, 0, 267, 238, 1, 0, 0, 0, 267, 239, 1, 0, 0, 0,
+		267, 240, 1, 0, 0, 0, 267, 241, 1, 0, 0, 0, 267, 242, 1, 0, 0, 0, 267,
+		243, 1, 0, 0, 0, 267, 244, 1, 0, 0, 0, 267, 245, 1, 0, 0, 0, 267, 246,
+		1, 0, 0, 0, 267, 247, 1, 0, 0, 0, 267, 248, 1, 0, 0, 0, 267, 249, 1, 0,
+		0, 0, 267, 250, 1, 0, 0, 0, 267, 251, 1, 0, 0, 0, 267, 252, 1, 0, 0, 0,
+		267, 253, 1, 0, 0, 0, 267, 254, 1, 0, 0, 0, 267, 255, 1, 0, 0, 0, 267,
+		256, 1, 0, 0, 0, 267, 257, 1, 0, 0, 0, 267, 258, 1, 0, 0, 0, 267, 259,
+		1, 0, 0, 0, 267, 260, 1, 0, 0, 0, 267, 261, 1, 0, 0, 0, 267, 262, 1, 0,
+		0, 0, 267, 263, 1, 0, 0, 0, 267, 264, 1, 0, 0, 0, 267, 265, 1, 0, 0, 0,
+		267, 266, 1, 0, 0, 0, 268, 3, 1, 0, 0, 0, 269, 271, 5, 8, 0, 0, 270, 269,
+		1, 0, 0, 0, 270, 271, 1, 0, 0, 0, 271, 272, 1, 0, 0, 0, 272, 273, 3, 156,
+		78, 0, 273, 5, 1, 0, 0, 0, 274, 275, 5, 7, 0, 0, 275, 276, 3, 166, 83,
+		0, 276, 7, 1, 0, 0, 0, 277, 278, 5, 9, 0, 0, 278, 283, 3, 10, 5, 0, 279,
+		280, 5, 73, 0, 0, 280, 282, 3, 10, 5, 0, 281, 279, 1, 0, 0, 0, 282, 285,
+		1, 0, 0, 0, 283, 281, 1, 0, 0, 0, 283, 284, 1, 0, 0, 0, 284, 9, 1, 0, 0,
+		0, 285, 283, 1, 0, 0, 0, 286, 289, 3, 202, 101, 0, 287, 289, 5, 81, 0,
+		0, 288, 286, 1, 0, 0, 0, 288, 287, 1, 0, 0, 0, 289, 290, 1, 0, 0, 0, 290,
+		291, 5, 54, 0, 0, 291, 292, 3, 166, 83, 0, 292, 11, 1, 0, 0, 0, 293, 294,
+		5, 10, 0, 0, 294, 301, 3, 14, 7, 0, 295, 297, 5, 73, 0, 0, 296, 295, 1,
+		0, 0, 0, 296, 297, 1, 0, 0, 0, 297, 298, 1, 0, 0, 0, 298, 300, 3, 14, 7,
+		0, 299, 296, 1, 0, 0, 0, 300, 303, 1, 0, 0, 0, 301, 299, 1, 0, 0, 0, 301,
+		302, 1, 0, 0, 0, 302, 306, 1, 0, 0, 0, 303, 301, 1, 0, 0, 0, 304, 305,
+		5, 4, 0, 0, 305, 307, 3, 208, 104, 0, 306, 304, 1, 0, 0, 0, 306, 307, 1,
+		0, 0, 0, 307, 13, 1, 0, 0, 0, 308, 309, 5, 93, 0, 0, 309, 311, 5, 67, 0,
+		0, 310, 312, 3, 166, 83, 0, 311, 310, 1, 0, 0, 0, 311, 312, 1, 0, 0, 0,
+		312, 313, 1, 0, 0, 0, 313, 319, 5, 68, 0, 0, 314, 317, 5, 5, 0, 0, 315,
+		318, 3, 202, 101, 0, 316, 318, 5, 81, 0, 0, 317, 315, 1, 0, 0, 0, 317,
+		316, 1, 0, 0, 0, 318, 320, 1, 0, 0, 0, 319, 314, 1, 0, 0, 0, 319, 320,
+		1, 0, 0, 0, 320, 330, 1, 0, 0, 0, 321, 327, 5, 93, 0, 0, 322, 325, 5, 5,
+		0, 0, 323, 326, 3, 202, 101, 0, 324, 326, 5, 81, 0, 0, 325, 323, 1, 0,
+		0, 0, 325, 324, 1, 0, 0, 0, 326, 328, 1, 0, 0, 0, 327, 322, 1, 0, 0, 0,
+		327, 328, 1, 0, 0, 0, 328, 330, 1, 0, 0, 0, 329, 308, 1, 0, 0, 0, 329,
+		321, 1, 0, 0, 0, 330, 15, 1, 0, 0, 0, 331, 332, 5, 11, 0, 0, 332, 333,
+		3, 208, 104, 0, 333, 17, 1, 0, 0, 0, 334, 336, 5, 12, 0, 0, 335, 337, 7,
+		0, 0, 0, 336, 335, 1, 0, 0, 0, 336, 337, 1, 0, 0, 0, 337, 338, 1, 0, 0,
+		0, 338, 339, 3, 208, 104, 0, 339, 19, 1, 0, 0, 0, 340, 341, 5, 13, 0, 0,
+		341, 348, 3, 22, 11, 0, 342, 344, 5, 73, 0, 0, 343, 342, 1, 0, 0, 0, 343,
+		344, 1, 0, 0, 0, 344, 345, 1, 0, 0, 0, 345, 347, 3, 22, 11, 0, 346, 343,
+		1, 0, 0, 0, 347, 350, 1, 0, 0, 0, 348, 346, 1, 0, 0, 0, 348, 349, 1, 0,
+		0, 0, 349, 21, 1, 0, 0, 0, 350, 348, 1, 0, 0, 0, 351, 352, 3, 202, 101,
+		0, 352, 355, 5, 5, 0, 0, 353, 356, 3, 202, 101, 0, 354, 356, 5, 81, 0,
+		0, 355, 353, 1, 0, 0, 0, 355, 354, 1, 0, 0, 0, 356, 23, 1, 0, 0, 0, 357,
+		361, 5, 15, 0, 0, 358, 360, 3, 26, 13, 0, 359, 358, 1, 0, 0, 0, 360, 363,
+		1, 0, 0, 0, 361, 359, 1, 0, 0, 0, 361, 362, 1, 0, 0, 0, 362, 369, 1, 0,
+		0, 0, 363, 361, 1, 0, 0, 0, 364, 370, 5, 81, 0, 0, 365, 366, 3, 202, 101,
+		0, 366, 367, 5, 54, 0, 0, 367, 368, 5, 81, 0, 0, 368, 370, 1, 0, 0, 0,
+		369, 364, 1, 0, 0, 0, 369, 365, 1, 0, 0, 0, 369, 370, 1, 0, 0, 0, 370,
+		25, 1, 0, 0, 0, 371, 372, 5, 93, 0, 0, 372, 376, 5, 54, 0, 0, 373, 377,
+		5, 81, 0, 0, 374, 377, 3, 202, 101, 0, 375, 377, 5, 88, 0, 0, 376, 373,
+		1, 0, 0, 0, 376, 374, 1, 0, 0, 0, 376, 375, 1, 0, 0, 0, 377, 27, 1, 0,
+		0, 0, 378, 382, 5, 14, 0, 0, 379, 380, 3, 202, 101, 0, 380, 381, 7, 1,
+		0, 0, 381, 383, 1, 0, 0, 0, 382, 379, 1, 0, 0, 0, 382, 383, 1, 0, 0, 0,
+		383, 384, 1, 0, 0, 0, 384, 385, 5, 81, 0, 0, 385, 29, 1, 0, 0, 0, 386,
+		388, 5, 16, 0, 0, 387, 389, 5, 88, 0, 0, 388, 387, 1, 0, 0, 0, 388, 389,
+		1, 0, 0, 0, 389, 390, 1, 0, 0, 0, 390, 394, 3, 208, 104, 0, 391, 393, 3,
+		32, 16, 0, 392, 391, 1, 0, 0, 0, 393, 396, 1, 0, 0, 0, 394, 392, 1, 0,
+		0, 0, 394, 395, 1, 0, 0, 0, 395, 31, 1, 0, 0, 0, 396, 394, 1, 0, 0, 0,
+		397, 398, 5, 93, 0, 0, 398, 402, 5, 54, 0, 0, 399, 403, 5, 81, 0, 0, 400,
+		403, 3, 202, 101, 0, 401, 403, 5, 88, 0, 0, 402, 399, 1, 0, 0, 0, 402,
+		400, 1, 0, 0, 0, 402, 401, 1, 0, 0, 0, 403, 33, 1, 0, 0, 0, 404, 406, 5,
+		17, 0, 0, 405, 407, 5, 88, 0, 0, 406, 405, 1, 0, 0, 0, 406, 407, 1, 0,
+		0, 0, 407, 408, 1, 0, 0, 0, 408, 413, 3, 36, 18, 0, 409, 410, 5, 73, 0,
+		0, 410, 412, 3, 36, 18, 0, 411, 409, 1, 0, 0, 0, 412, 415, 1, 0, 0, 0,
+		413, 411, 1, 0, 0, 0, 413, 414, 1, 0, 0, 0, 414, 35, 1, 0, 0, 0, 415, 413,
+		1, 0, 0, 0, 416, 418, 7, 0, 0, 0, 417, 416, 1, 0, 0, 0, 417, 418, 1, 0,
+		0, 0, 418, 421, 1, 0, 0, 0, 419, 422, 3, 202, 101, 0, 420, 422, 5, 81,
+		0, 0, 421, 419, 1, 0, 0, 0, 421, 420, 1, 0, 0, 0, 422, 37, 1, 0, 0, 0,
+		423, 425, 5, 18, 0, 0, 424, 426, 5, 88, 0, 0, 425, 424, 1, 0, 0, 0, 425,
+		426, 1, 0, 0, 0, 426, 39, 1, 0, 0, 0, 427, 429, 5, 19, 0, 0, 428, 430,
+		5, 88, 0, 0, 429, 428, 1, 0, 0, 0, 429, 430, 1, 0, 0, 0, 430, 41, 1, 0,
+		0, 0, 431, 433, 5, 20, 0, 0, 432, 434, 5, 88, 0, 0, 433, 432, 1, 0, 0,
+		0, 433, 434, 1, 0, 0, 0, 434, 438, 1, 0, 0, 0, 435, 437, 3, 44, 22, 0,
+		436, 435, 1, 0, 0, 0, 437, 440, 1, 0, 0, 0, 438, 436, 1, 0, 0, 0, 438,
+		439, 1, 0, 0, 0, 439, 441, 1, 0, 0, 0, 440, 438, 1, 0, 0, 0, 441, 444,
+		3, 208, 104, 0, 442, 443, 5, 4, 0, 0, 443, 445, 3, 208, 104, 0, 444, 442,
+		1, 0, 0, 0, 444, 445, 1, 0, 0, 0, 445, 43, 1, 0, 0, 0, 446, 447, 5, 93,
+		0, 0, 447, 451, 5, 54, 0, 0, 448, 452, 5, 81, 0, 0, 449, 452, 3, 202, 101,
+		0, 450, 452, 5, 88, 0, 0, 451, 448, 1, 0, 0, 0, 451, 449, 1, 0, 0, 0, 451,
+		450, 1, 0, 0, 0, 452, 45, 1, 0, 0, 0, 453, 455, 5, 21, 0, 0, 454, 456,
+		5, 88, 0, 0, 455, 454, 1, 0, 0, 0, 455, 456, 1, 0, 0, 0, 456, 460, 1, 0,
+		0, 0, 457, 459, 3, 44, 22, 0, 458, 457, 1, 0, 0, 0, 459, 462, 1, 0, 0,
+		0, 460, 458, 1, 0, 0, 0, 460, 461, 1, 0, 0, 0, 461, 463, 1, 0, 0, 0, 462,
+		460, 1, 0, 0, 0, 463, 466, 3, 208, 104, 0, 464, 465, 5, 4, 0, 0, 465, 467,
+		3, 208, 104, 0, 466, 464, 1, 0, 0, 0, 466, 467, 1, 0, 0, 0, 467, 47, 1,
+		0, 0, 0, 468, 472, 5, 22, 0, 0, 469, 471, 3, 56, 28, 0, 470, 469, 1, 0,
+		0, 0, 471, 474, 1, 0, 0, 0, 472, 470, 1, 0, 0, 0, 472, 473, 1, 0, 0, 0,
+		473, 475, 1, 0, 0, 0, 474, 472, 1, 0, 0, 0, 475, 477, 7, 2, 0, 0, 476,
+		478, 3, 52, 26, 0, 477, 476, 1, 0, 0, 0, 478, 479, 1, 0, 0, 0, 479, 477,
+		1, 0, 0, 0, 479, 480, 1, 0, 0, 0, 480, 482, 1, 0, 0, 0, 481, 483, 3, 50,
+		25, 0, 482, 481, 1, 0, 0, 0, 482, 483, 1, 0, 0, 0, 483, 49, 1, 0, 0, 0,
+		484, 488, 7, 3, 0, 0, 485, 487, 3, 52, 26, 0, 486, 485, 1, 0, 0, 0, 487,
+		490, 1, 0, 0, 0, 488, 486, 1, 0, 0, 0, 488, 489, 1, 0, 0, 0, 489, 51, 1,
+		0, 0, 0, 490, 488, 1, 0, 0, 0, 491, 494, 3, 54, 27, 0, 492, 493, 5, 5,
+		0, 0, 493, 495, 3, 54, 27, 0, 494, 492, 1, 0, 0, 0, 494, 495, 1, 0, 0,
+		0, 495, 497, 1, 0, 0, 0, 496, 498, 5, 73, 0, 0, 497, 496, 1, 0, 0, 0, 497,
+		498, 1, 0, 0, 0, 498, 53, 1, 0, 0, 0, 499, 504, 5, 93, 0, 0, 500, 501,
+		5, 78, 0, 0, 501, 503, 5, 93, 0, 0, 502, 500, 1, 0, 0, 0, 503, 506, 1,
+		0, 0, 0, 504, 502, 1, 0, 0, 0, 504, 505, 1, 0, 0, 0, 505, 509, 1, 0, 0,
+		0, 506, 504, 1, 0, 0, 0, 507, 509, 5, 81, 0, 0, 508, 499, 1, 0, 0, 0, 508,
+		507, 1, 0, 0, 0, 509, 55, 1, 0, 0, 0, 510, 511, 5, 93, 0, 0, 511, 515,
+		5, 54, 0, 0, 512, 516, 5, 81, 0, 0, 513, 516, 3, 202, 101, 0, 514, 516,
+		5, 88, 0, 0, 515, 512, 1, 0, 0, 0, 515, 513, 1, 0, 0, 0, 515, 514, 1, 0,
+		0, 0, 516, 57, 1, 0, 0, 0, 517, 521, 5, 23, 0, 0, 518, 520, 3, 60, 30,
+		0, 519, 518, 1, 0, 0, 0, 520, 523, 1, 0, 0, 0, 521, 519, 1, 0, 0, 0, 521,
+		522, 1, 0, 0, 0, 522, 524, 1, 0, 0, 0, 523, 521, 1, 0, 0, 0, 524, 536,
+		3, 154, 77, 0, 525, 529, 5, 23, 0, 0, 526, 528, 3, 60, 30, 0, 527, 526,
+		1, 0, 0, 0, 528, 531, 1, 0, 0, 0, 529, 527, 1, 0, 0, 0, 529, 530, 1, 0,
+		0, 0, 530, 532, 1, 0, 0, 0, 531, 529, 1, 0, 0, 0, 532, 533, 3, 208, 104,
+		0, 533, 534, 3, 154, 77, 0, 534, 536, 1, 0, 0, 0, 535, 517, 1, 0, 0, 0,
+		535, 525, 1, 0, 0, 0, 536, 59, 1, 0, 0, 0, 537, 538, 5, 93, 0, 0, 538,
+		542, 5, 54, 0, 0, 539, 543, 5, 81, 0, 0, 540, 543, 3, 202, 101, 0, 541,
+		543, 5, 88, 0, 0, 542, 539, 1, 0, 0, 0, 542, 540, 1, 0, 0, 0, 542, 541,
+		1, 0, 0, 0, 543, 61, 1, 0, 0, 0, 544, 545, 5, 24, 0, 0, 545, 546, 3, 154,
+		77, 0, 546, 63, 1, 0, 0, 0, 547, 551, 5, 25, 0, 0, 548, 550, 3, 60, 30,
+		0, 549, 548, 1, 0, 0, 0, 550, 553, 1, 0, 0, 0, 551, 549, 1, 0, 0, 0, 551,
+		552, 1, 0, 0, 0, 552, 554, 1, 0, 0, 0, 553, 551, 1, 0, 0, 0, 554, 555,
+		3, 154, 77, 0, 555, 65, 1, 0, 0, 0, 556, 560, 5, 26, 0, 0, 557, 559, 3,
+		60, 30, 0, 558, 557, 1, 0, 0, 0, 559, 562, 1, 0, 0, 0, 560, 558, 1, 0,
+		0, 0, 560, 561, 1, 0, 0, 0, 561, 563, 1, 0, 0, 0, 562, 560, 1, 0, 0, 0,
+		563, 564, 3, 154, 77, 0, 564, 67, 1, 0, 0, 0, 565, 569, 5, 27, 0, 0, 566,
+		568, 3, 60, 30, 0, 567, 566, 1, 0, 0, 0, 568, 571, 1, 0, 0, 0, 569, 567,
+		1, 0, 0, 0, 569, 570, 1, 0, 0, 0, 570, 574, 1, 0, 0, 0, 571, 569, 1, 0,
+		0, 0, 572, 575, 3, 154, 77, 0, 573, 575, 3, 190, 95, 0, 574, 572, 1, 0,
+		0, 0, 574, 573, 1, 0, 0, 0, 575, 576, 1, 0, 0, 0, 576, 574, 1, 0, 0, 0,
+		576, 577, 1, 0, 0, 0, 577, 69, 1, 0, 0, 0, 578, 580, 5, 28, 0, 0, 579,
+		581, 3, 154, 77, 0, 580, 579, 1, 0, 0, 0, 581, 582, 1, 0, 0, 0, 582, 580,
+		1, 0, 0, 0, 582, 583, 1, 0, 0, 0, 583, 71, 1, 0, 0, 0, 584, 585, 5, 29,
+		0, 0, 585, 589, 3, 208, 104, 0, 586, 588, 3, 74, 37, 0, 587, 586, 1, 0,
+		0, 0, 588, 591, 1, 0, 0, 0, 589, 587, 1, 0, 0, 0, 589, 590, 1, 0, 0, 0,
+		590, 73, 1, 0, 0, 0, 591, 589, 1, 0, 0, 0, 592, 593, 5, 93, 0, 0, 593,
+		598, 5, 54, 0, 0, 594, 599, 5, 81, 0, 0, 595, 599, 3, 202, 101, 0, 596,
+		599, 5, 88, 0, 0, 597, 599, 5, 85, 0, 0, 598, 594, 1, 0, 0, 0, 598, 595,
+		1, 0, 0, 0, 598, 596, 1, 0, 0, 0, 598, 597, 1, 0, 0, 0, 599, 617, 1, 0,
+		0, 0, 600, 601, 5, 93, 0, 0, 601, 602, 5, 54, 0, 0, 602, 603, 5, 67, 0,
+		0, 603, 604, 3, 156, 78, 0, 604, 605, 5, 68, 0, 0, 605, 617, 1, 0, 0, 0,
+		606, 607, 5, 93, 0, 0, 607, 608, 5, 54, 0, 0, 608, 609, 5, 9, 0, 0, 609,
+		610, 5, 67, 0, 0, 610, 611, 3, 166, 83, 0, 611, 612, 5, 68, 0, 0, 612,
+		617, 1, 0, 0, 0, 613, 614, 5, 93, 0, 0, 614, 615, 5, 54, 0, 0, 615, 617,
+		3, 160, 80, 0, 616, 592, 1, 0, 0, 0, 616, 600, 1, 0, 0, 0, 616, 606, 1,
+		0, 0, 0, 616, 613, 1, 0, 0, 0, 617, 75, 1, 0, 0, 0, 618, 623, 5, 30, 0,
+		0, 619, 622, 3, 80, 40, 0, 620, 622, 3, 78, 39, 0, 621, 619, 1, 0, 0, 0,
+		621, 620, 1, 0, 0, 0, 622, 625, 1, 0, 0, 0, 623, 621, 1, 0, 0, 0, 623,
+		624, 1, 0, 0, 0, 624, 77, 1, 0, 0, 0, 625, 623, 1, 0, 0, 0, 626, 629, 3,
+		202, 101, 0, 627, 629, 5, 81, 0, 0, 628, 626, 1, 0, 0, 0, 628, 627, 1,
+		0, 0, 0, 629, 79, 1, 0, 0, 0, 630, 631, 7, 4, 0, 0, 631, 634, 5, 54, 0,
+		0, 632, 635, 5, 81, 0, 0, 633, 635, 3, 202, 101, 0, 634, 632, 1, 0, 0,
+		0, 634, 633, 1, 0, 0, 0, 635, 81, 1, 0, 0, 0, 636, 640, 5, 31, 0, 0, 637,
+		639, 3, 86, 43, 0, 638, 637, 1, 0, 0, 0, 639, 642, 1, 0, 0, 0, 640, 638,
+		1, 0, 0, 0, 640, 641, 1, 0, 0, 0, 641, 643, 1, 0, 0, 0, 642, 640, 1, 0,
+		0, 0, 643, 650, 3, 14, 7, 0, 644, 646, 5, 73, 0, 0, 645, 644, 1, 0, 0,
+		0, 645, 646, 1, 0, 0, 0, 646, 647, 1, 0, 0, 0, 647, 649, 3, 14, 7, 0, 648,
+		645, 1, 0, 0, 0, 649, 652, 1, 0, 0, 0, 650, 648, 1, 0, 0, 0, 650, 651,
+		1, 0, 0, 0, 651, 656, 1, 0, 0, 0, 652, 650, 1, 0, 0, 0, 653, 655, 3, 86,
+		43, 0, 654, 653, 1, 0, 0, 0, 655, 658, 1, 0, 0, 0, 656, 654, 1, 0, 0, 0,
+		656, 657, 1, 0, 0, 0, 657, 661, 1, 0, 0, 0, 658, 656, 1, 0, 0, 0, 659,
+		660, 5, 4, 0, 0, 660, 662, 3, 208, 104, 0, 661, 659, 1, 0, 0, 0, 661, 662,
+		1, 0, 0, 0, 662, 83, 1, 0, 0, 0, 663, 667, 5, 32, 0, 0, 664, 666, 3, 86,
+		43, 0, 665, 664, 1, 0, 0, 0, 666, 669, 1, 0, 0, 0, 667, 665, 1, 0, 0, 0,
+		667, 668, 1, 0, 0, 0, 668, 670, 1, 0, 0, 0, 669, 667, 1, 0, 0, 0, 670,
+		677, 3, 14, 7, 0, 671, 673, 5, 73, 0, 0, 672, 671, 1, 0, 0, 0, 672, 673,
+		1, 0, 0, 0, 673, 674, 1, 0, 0, 0, 674, 676, 3, 14, 7, 0, 675, 672, 1, 0,
+		0, 0, 676, 679, 1, 0, 0, 0, 677, 675, 1, 0, 0, 0, 677, 678, 1, 0, 0, 0,
+		678, 683, 1, 0, 0, 0, 679, 677, 1, 0, 0, 0, 680, 682, 3, 86, 43, 0, 681,
+		680, 1, 0, 0, 0, 682, 685, 1, 0, 0, 0, 683, 681, 1, 0, 0, 0, 683, 684,
+		1, 0, 0, 0, 684, 688, 1, 0, 0, 0, 685, 683, 1, 0, 0, 0, 686, 687, 5, 4,
+		0, 0, 687, 689, 3, 208, 104, 0, 688, 686, 1, 0, 0, 0, 688, 689, 1, 0, 0,
+		0, 689, 85, 1, 0, 0, 0, 690, 691, 5, 93, 0, 0, 691, 696, 5, 54, 0, 0, 692,
+		697, 5, 81, 0, 0, 693, 697, 3, 202, 101, 0, 694, 697, 5, 88, 0, 0, 695,
+		697, 5, 85, 0, 0, 696, 692, 1, 0, 0, 0, 696, 693, 1, 0, 0, 0, 696, 694,
+		1, 0, 0, 0, 696, 695, 1, 0, 0, 0, 697, 87, 1, 0, 0, 0, 698, 702, 5, 33,
+		0, 0, 699, 701, 3, 90, 45, 0, 700, 699, 1, 0, 0, 0, 701, 704, 1, 0, 0,
+		0, 702, 700, 1, 0, 0, 0, 702, 703, 1, 0, 0, 0, 703, 705, 1, 0, 0, 0, 704,
+		702, 1, 0, 0, 0, 705, 712, 3, 14, 7, 0, 706, 708, 5, 73, 0, 0, 707, 706,
+		1, 0, 0, 0, 707, 708, 1, 0, 0, 0, 708, 709, 1, 0, 0, 0, 709, 711, 3, 14,
+		7, 0, 710, 707, 1, 0, 0, 0, 711, 714, 1, 0, 0, 0, 712, 710, 1, 0, 0, 0,
+		712, 713, 1, 0, 0, 0, 713, 717, 1, 0, 0, 0, 714, 712, 1, 0, 0, 0, 715,
+		716, 5, 4, 0, 0, 716, 718, 3, 202, 101, 0, 717, 715, 1, 0, 0, 0, 717, 718,
+		1, 0, 0, 0, 718, 722, 1, 0, 0, 0, 719, 721, 3, 90, 45, 0, 720, 719, 1,
+		0, 0, 0, 721, 724, 1, 0, 0, 0, 722, 720, 1, 0, 0, 0, 722, 723, 1, 0, 0,
+		0, 723, 89, 1, 0, 0, 0, 724, 722, 1, 0, 0, 0, 725, 726, 5, 93, 0, 0, 726,
+		731, 5, 54, 0, 0, 727, 732, 5, 81, 0, 0, 728, 732, 3, 202, 101, 0, 729,
+		732, 5, 88, 0, 0, 730, 732, 5, 85, 0, 0, 731, 727, 1, 0, 0, 0, 731, 728,
+		1, 0, 0, 0, 731, 729, 1, 0, 0, 0, 731, 730, 1, 0, 0, 0, 732, 91, 1, 0,
+		0, 0, 733, 737, 5, 34, 0, 0, 734, 736, 3, 86, 43, 0, 735, 734, 1, 0, 0,
+		0, 736, 739, 1, 0, 0, 0, 737, 735, 1, 0, 0, 0, 737, 738, 1, 0, 0, 0, 738,
+		740, 1, 0, 0, 0, 739, 737, 1, 0, 0, 0, 740, 747, 3, 14, 7, 0, 741, 743,
+		5, 73, 0, 0, 742, 741, 1, 0, 0, 0, 742, 743, 1, 0, 0, 0, 743, 744, 1, 0,
+		0, 0, 744, 746, 3, 14, 7, 0, 745, 742, 1, 0, 0, 0, 746, 749, 1, 0, 0, 0,
+		747, 745, 1, 0, 0, 0, 747, 748, 1, 0, 0, 0, 748, 763, 1, 0, 0, 0, 749,
+		747, 1, 0, 0, 0, 750, 751, 5, 4, 0, 0, 751, 753, 3, 208, 104, 0, 752, 750,
+		1, 0, 0, 0, 752, 753, 1, 0, 0, 0, 753, 756, 1, 0, 0, 0, 754, 755, 5, 45,
+		0, 0, 755, 757, 3, 202, 101, 0, 756, 754, 1, 0, 0, 0, 756, 757, 1, 0, 0,
+		0, 757, 764, 1, 0, 0, 0, 758, 759, 5, 45, 0, 0, 759, 760, 3, 202, 101,
+		0, 760, 761, 5, 4, 0, 0, 761, 762, 3, 208, 104, 0, 762, 764, 1, 0, 0, 0,
+		763, 752, 1, 0, 0, 0, 763, 758, 1, 0, 0, 0, 764, 768, 1, 0, 0, 0, 765,
+		767, 3, 86, 43, 0, 766, 765, 1, 0, 0, 0, 767, 770, 1, 0, 0, 0, 768, 766,
+		1, 0, 0, 0, 768, 769, 1, 0, 0, 0, 769, 93, 1, 0, 0, 0, 770, 768, 1, 0,
+		0, 0, 771, 775, 5, 35, 0, 0, 772, 774, 3, 96, 48, 0, 773, 772, 1, 0, 0,
+		0, 774, 777, 1, 0, 0, 0, 775, 773, 1, 0, 0, 0, 775, 776, 1, 0, 0, 0, 776,
+		779, 1, 0, 0, 0, 777, 775, 1, 0, 0, 0, 778, 780, 3, 208, 104, 0, 779, 778,
+		1, 0, 0, 0, 779, 780, 1, 0, 0, 0, 780, 95, 1, 0, 0, 0, 781, 782, 5, 93,
+		0, 0, 782, 783, 5, 54, 0, 0, 783, 784, 7, 5, 0, 0, 784, 97, 1, 0, 0, 0,
+		785, 789, 5, 36, 0, 0, 786, 788, 3, 100, 50, 0, 787, 786, 1, 0, 0, 0, 788,
+		791, 1, 0, 0, 0, 789, 787, 1, 0, 0, 0, 789, 790, 1, 0, 0, 0, 790, 792,
+		1, 0, 0, 0, 791, 789, 1, 0, 0, 0, 792, 793, 3, 202, 101, 0, 793, 99, 1,
+		0, 0, 0, 794, 795, 5, 93, 0, 0, 795, 799, 5, 54, 0, 0, 796, 800, 5, 81,
+		0, 0, 797, 800, 3, 202, 101, 0, 798, 800, 5, 88, 0, 0, 799, 796, 1, 0,
+		0, 0, 799, 797, 1, 0, 0, 0, 799, 798, 1, 0, 0, 0, 800, 101, 1, 0, 0, 0,
+		801, 802, 5, 37, 0, 0, 802, 803, 3, 202, 101, 0, 803, 103, 1, 0, 0, 0,
+		804, 808, 5, 38, 0, 0, 805, 807, 3, 106, 53, 0, 806, 805, 1, 0, 0, 0, 807,
+		810, 1, 0, 0, 0, 808, 806, 1, 0, 0, 0, 808, 809, 1, 0, 0, 0, 809, 105,
+		1, 0, 0, 0, 810, 808, 1, 0, 0, 0, 811, 814, 3, 108, 54, 0, 812, 814, 5,
+		81, 0, 0, 813, 811, 1, 0, 0, 0, 813, 812, 1, 0, 0, 0, 814, 107, 1, 0, 0,
+		0, 815, 816, 5, 93, 0, 0, 816, 817, 5, 54, 0, 0, 817, 818, 7, 6, 0, 0,
+		818, 109, 1, 0, 0, 0, 819, 821, 5, 39, 0, 0, 820, 822, 5, 88, 0, 0, 821,
+		820, 1, 0, 0, 0, 821, 822, 1, 0, 0, 0, 822, 826, 1, 0, 0, 0, 823, 825,
+		3, 112, 56, 0, 824, 823, 1, 0, 0, 0, 825, 828, 1, 0, 0, 0, 826, 824, 1,
+		0, 0, 0, 826, 827, 1, 0, 0, 0, 827, 111, 1, 0, 0, 0, 828, 826, 1, 0, 0,
+		0, 829, 830, 3, 202, 101, 0, 830, 831, 5, 54, 0, 0, 831, 832, 3, 202, 101,
+		0, 832, 837, 1, 0, 0, 0, 833, 834, 5, 90, 0, 0, 834, 837, 3, 202, 101,
+		0, 835, 837, 3, 202, 101, 0, 836, 829, 1, 0, 0, 0, 836, 833, 1, 0, 0, 0,
+		836, 835, 1, 0, 0, 0, 837, 113, 1, 0, 0, 0, 838, 842, 5, 40, 0, 0, 839,
+		841, 3, 116, 58, 0, 840, 839, 1, 0, 0, 0, 841, 844, 1, 0, 0, 0, 842, 840,
+		1, 0, 0, 0, 842, 843, 1, 0, 0, 0, 843, 845, 1, 0, 0, 0, 844, 842, 1, 0,
+		0, 0, 845, 846, 3, 154, 77, 0, 846, 115, 1, 0, 0, 0, 847, 848, 5, 93, 0,
+		0, 848, 852, 5, 54, 0, 0, 849, 853, 5, 81, 0, 0, 850, 853, 3, 202, 101,
+		0, 851, 853, 5, 88, 0, 0, 852, 849, 1, 0, 0, 0, 852, 850, 1, 0, 0, 0, 852,
+		851, 1, 0, 0, 0, 853, 858, 1, 0, 0, 0, 854, 858, 3, 198, 99, 0, 855, 858,
+		3, 202, 101, 0, 856, 858, 5, 81, 0, 0, 857, 847, 1, 0, 0, 0, 857, 854,
+		1, 0, 0, 0, 857, 855, 1, 0, 0, 0, 857, 856, 1, 0, 0, 0, 858, 117, 1, 0,
+		0, 0, 859, 862, 5, 41, 0, 0, 860, 863, 3, 120, 60, 0, 861, 863, 3, 154,
+		77, 0, 862, 860, 1, 0, 0, 0, 862, 861, 1, 0, 0, 0, 863, 864, 1, 0, 0, 0,
+		864, 862, 1, 0, 0, 0, 864, 865, 1, 0, 0, 0, 865, 119, 1, 0, 0, 0, 866,
+		867, 7, 7, 0, 0, 867, 871, 5, 54, 0, 0, 868, 872, 5, 81, 0, 0, 869, 872,
+		5, 88, 0, 0, 870, 872, 3, 202, 101, 0, 871, 868, 1, 0, 0, 0, 871, 869,
+		1, 0, 0, 0, 871, 870, 1, 0, 0, 0, 872, 121, 1, 0, 0, 0, 873, 877, 5, 42,
+		0, 0, 874, 876, 3, 124, 62, 0, 875, 874, 1, 0, 0, 0, 876, 879, 1, 0, 0,
+		0, 877, 875, 1, 0, 0, 0, 877, 878, 1, 0, 0, 0, 878, 880, 1, 0, 0, 0, 879,
+		877, 1, 0, 0, 0, 880, 885, 3, 126, 63, 0, 881, 882, 5, 73, 0, 0, 882, 884,
+		3, 126, 63, 0, 883, 881, 1, 0, 0, 0, 884, 887, 1, 0, 0, 0, 885, 883, 1,
+		0, 0, 0, 885, 886, 1, 0, 0, 0, 886, 123, 1, 0, 0, 0, 887, 885, 1, 0, 0,
+		0, 888, 889, 5, 93, 0, 0, 889, 890, 5, 54, 0, 0, 890, 891, 7, 5, 0, 0,
+		891, 125, 1, 0, 0, 0, 892, 893, 5, 93, 0, 0, 893, 896, 5, 67, 0, 0, 894,
+		897, 3, 202, 101, 0, 895, 897, 5, 81, 0, 0, 896, 894, 1, 0, 0, 0, 896,
+		895, 1, 0, 0, 0, 897, 898, 1, 0, 0, 0, 898, 904, 5, 68, 0, 0, 899, 902,
+		5, 5, 0, 0, 900, 903, 3, 202, 101, 0, 901, 903, 5, 81, 0, 0, 902, 900,
+		1, 0, 0, 0, 902, 901, 1, 0, 0, 0, 903, 905, 1, 0, 0, 0, 904, 899, 1, 0,
+		0, 0, 904, 905, 1, 0, 0, 0, 905, 127, 1, 0, 0, 0, 906, 910, 7, 8, 0, 0,
+		907, 909, 3, 130, 65, 0, 908, 907, 1, 0, 0, 0, 909, 912, 1, 0, 0, 0, 910,
+		908, 1, 0, 0, 0, 910, 911, 1, 0, 0, 0, 911, 913, 1, 0, 0, 0, 912, 910,
+		1, 0, 0, 0, 913, 917, 3, 202, 101, 0, 914, 916, 3, 130, 65, 0, 915, 914,
+		1, 0, 0, 0, 916, 919, 1, 0, 0, 0, 917, 915, 1, 0, 0, 0, 917, 918, 1, 0,
+		0, 0, 918, 922, 1, 0, 0, 0, 919, 917, 1, 0, 0, 0, 920, 921, 5, 5, 0, 0,
+		921, 923, 3, 202, 101, 0, 922, 920, 1, 0, 0, 0, 922, 923, 1, 0, 0, 0, 923,
+		129, 1, 0, 0, 0, 924, 925, 5, 93, 0, 0, 925, 926, 5, 54, 0, 0, 926, 927,
+		7, 9, 0, 0, 927, 131, 1, 0, 0, 0, 928, 932, 5, 46, 0, 0, 929, 931, 3, 134,
+		67, 0, 930, 929, 1, 0, 0, 0, 931, 934, 1, 0, 0, 0, 932, 930, 1, 0, 0, 0,
+		932, 933, 1, 0, 0, 0, 933, 133, 1, 0, 0, 0, 934, 932, 1, 0, 0, 0, 935,
+		936, 5, 93, 0, 0, 936, 938, 5, 54, 0, 0, 937, 939, 5, 78, 0, 0, 938, 937,
+		1, 0, 0, 0, 938, 939, 1, 0, 0, 0, 939, 940, 1, 0, 0, 0, 940, 945, 3, 190,
+		95, 0, 941, 942, 7, 10, 0, 0, 942, 944, 5, 93, 0, 0, 943, 941, 1, 0, 0,
+		0, 944, 947, 1, 0, 0, 0, 945, 943, 1, 0, 0, 0, 945, 946, 1, 0, 0, 0, 946,
+		951, 1, 0, 0, 0, 947, 945, 1, 0, 0, 0, 948, 951, 5, 95, 0, 0, 949, 951,
+		5, 93, 0, 0, 950, 935, 1, 0, 0, 0, 950, 948, 1, 0, 0, 0, 950, 949, 1, 0,
+		0, 0, 951, 135, 1, 0, 0, 0, 952, 956, 5, 47, 0, 0, 953, 955, 3, 138, 69,
+		0, 954, 953, 1, 0, 0, 0, 955, 958, 1, 0, 0, 0, 956, 954, 1, 0, 0, 0, 956,
+		957, 1, 0, 0, 0, 957, 969, 1, 0, 0, 0, 958, 956, 1, 0, 0, 0, 959, 966,
+		3, 14, 7, 0, 960, 962, 5, 73, 0, 0, 961, 960, 1, 0, 0, 0, 961, 962, 1,
+		0, 0, 0, 962, 963, 1, 0, 0, 0, 963, 965, 3, 14, 7, 0, 964, 961, 1, 0, 0,
+		0, 965, 968, 1, 0, 0, 0, 966, 964, 1, 0, 0, 0, 966, 967, 1, 0, 0, 0, 967,
+		970, 1, 0, 0, 0, 968, 966, 1, 0, 0, 0, 969, 959, 1, 0, 0, 0, 969, 970,
+		1, 0, 0, 0, 970, 973, 1, 0, 0, 0, 971, 972, 5, 48, 0, 0, 972, 974, 3, 140,
+		70, 0, 973, 971, 1, 0, 0, 0, 973, 974, 1, 0, 0, 0, 974, 977, 1, 0, 0, 0,
+		975, 976, 5, 7, 0, 0, 976, 978, 3, 156, 78, 0, 977, 975, 1, 0, 0, 0, 977,
+		978, 1, 0, 0, 0, 978, 986, 1, 0, 0, 0, 979, 982, 7, 11, 0, 0, 980, 983,
+		3, 142, 71, 0, 981, 983, 3, 210, 105, 0, 982, 980, 1, 0, 0, 0, 982, 981,
+		1, 0, 0, 0, 983, 984, 1, 0, 0, 0, 984, 982, 1, 0, 0, 0, 984, 985, 1, 0,
+		0, 0, 985, 987, 1, 0, 0, 0, 986, 979, 1, 0, 0, 0, 986, 987, 1, 0, 0, 0,
+		987, 137, 1, 0, 0, 0, 988, 989, 5, 93, 0, 0, 989, 994, 5, 54, 0, 0, 990,
+		995, 5, 81, 0, 0, 991, 995, 3, 202, 101, 0, 992, 995, 5, 88, 0, 0, 993,
+		995, 5, 85, 0, 0, 994, 990, 1, 0, 0, 0, 994, 991, 1, 0, 0, 0, 994, 992,
+		1, 0, 0, 0, 994, 993, 1, 0, 0, 0, 995, 998, 1, 0, 0, 0, 996, 998, 5, 96,
+		0, 0, 997, 988, 1, 0, 0, 0, 997, 996, 1, 0, 0, 0, 998, 139, 1, 0, 0, 0,
+		999, 1000, 5, 93, 0, 0, 1000, 1001, 5, 54, 0, 0, 1001, 1006, 5, 93, 0,
+		0, 1002, 1003, 5, 94, 0, 0, 1003, 1005, 5, 93, 0, 0, 1004, 1002, 1, 0,
+		0, 0, 1005, 1008, 1, 0, 0, 0, 1006, 1004, 1, 0, 0, 0, 1006, 1007, 1, 0,
+		0, 0, 1007, 1022, 1, 0, 0, 0, 1008, 1006, 1, 0, 0, 0, 1009, 1012, 5, 93,
+		0, 0, 1010, 1011, 5, 75, 0, 0, 1011, 1013, 5, 93, 0, 0, 1012, 1010, 1,
+		0, 0, 0, 1012, 1013, 1, 0, 0, 0, 1013, 1018, 1, 0, 0, 0, 1014, 1015, 5,
+		94, 0, 0, 1015, 1017, 5, 93, 0, 0, 1016, 1014, 1, 0, 0, 0, 1017, 1020,
+		1, 0, 0, 0, 1018, 1016, 1, 0, 0, 0, 1018, 1019, 1, 0, 0, 0, 1019, 1022,
+		1, 0, 0, 0, 1020, 1018, 1, 0, 0, 0, 1021, 999, 1, 0, 0, 0, 1021, 1009,
+		1, 0, 0, 0, 1022, 141, 1, 0, 0, 0, 1023, 1024, 5, 93, 0, 0, 1024, 1029,
+		5, 54, 0, 0, 1025, 1030, 5, 81, 0, 0, 1026, 1030, 3, 202, 101, 0, 1027,
+		1030, 5, 88, 0, 0, 1028, 1030, 5, 85, 0, 0, 1029, 1025, 1, 0, 0, 0, 1029,
+		1026, 1, 0, 0, 0, 1029, 1027, 1, 0, 0, 0, 1029, 1028, 1, 0, 0, 0, 1030,
+		143, 1, 0, 0, 0, 1031, 1035, 5, 50, 0, 0, 1032, 1034, 3, 138, 69, 0, 1033,
+		1032, 1, 0, 0, 0, 1034, 1037, 1, 0, 0, 0, 1035, 1033, 1, 0, 0, 0, 1035,
+		1036, 1, 0, 0, 0, 1036, 1048, 1, 0, 0, 0, 1037, 1035, 1, 0, 0, 0, 1038,
+		1045, 3, 14, 7, 0, 1039, 1041, 5, 73, 0, 0, 1040, 1039, 1, 0, 0, 0, 1040,
+		1041, 1, 0, 0, 0, 1041, 1042, 1, 0, 0, 0, 1042, 1044, 3, 14, 7, 0, 1043,
+		1040, 1, 0, 0, 0, 1044, 1047, 1, 0, 0, 0, 1045, 1043, 1, 0, 0, 0, 1045,
+		1046, 1, 0, 0, 0, 1046, 1049, 1, 0, 0, 0, 1047, 1045, 1, 0, 0, 0, 1048,
+		1038, 1, 0, 0, 0, 1048, 1049, 1, 0, 0, 0, 1049, 1052, 1, 0, 0, 0, 1050,
+		1051, 5, 7, 0, 0, 1051, 1053, 3, 156, 78, 0, 1052, 1050, 1, 0, 0, 0, 1052,
+		1053, 1, 0, 0, 0, 1053, 1061, 1, 0, 0, 0, 1054, 1057, 7, 11, 0, 0, 1055,
+		1058, 3, 142, 71, 0, 1056, 1058, 3, 210, 105, 0, 1057, 1055, 1, 0, 0, 0,
+		1057, 1056, 1, 0, 0, 0, 1058, 1059, 1, 0, 0, 0, 1059, 1057, 1, 0, 0, 0,
+		1059, 1060, 1, 0, 0, 0, 1060, 1062, 1, 0, 0, 0, 1061, 1054, 1, 0, 0, 0,
+		1061, 1062, 1, 0, 0, 0, 1062, 145, 1, 0, 0, 0, 1063, 1067, 5, 51, 0, 0,
+		1064, 1066, 3, 148, 74, 0, 1065, 1064, 1, 0, 0, 0, 1066, 1069, 1, 0, 0,
+		0, 1067, 1065, 1, 0, 0, 0, 1067, 1068, 1, 0, 0, 0, 1068, 1070, 1, 0, 0,
+		0, 1069, 1067, 1, 0, 0, 0, 1070, 1073, 7, 2, 0, 0, 1071, 1072, 5, 7, 0,
+		0, 1072, 1074, 3, 166, 83, 0, 1073, 1071, 1, 0, 0, 0, 1073, 1074, 1, 0,
+		0, 0, 1074, 147, 1, 0, 0, 0, 1075, 1076, 5, 93, 0, 0, 1076, 1080, 5, 54,
+		0, 0, 1077, 1081, 5, 81, 0, 0, 1078, 1081, 3, 202, 101, 0, 1079, 1081,
+		5, 88, 0, 0, 1080, 1077, 1, 0, 0, 0, 1080, 1078, 1, 0, 0, 0, 1080, 1079,
+		1, 0, 0, 0, 1081, 149, 1, 0, 0, 0, 1082, 1086, 5, 93, 0, 0, 1083, 1085,
+		3, 152, 76, 0, 1084, 1083, 1, 0, 0, 0, 1085, 1088, 1, 0, 0, 0, 1086, 1084,
+		1, 0, 0, 0, 1086, 1087, 1, 0, 0, 0, 1087, 151, 1, 0, 0, 0, 1088, 1086,
+		1, 0, 0, 0, 1089, 1098, 5, 93, 0, 0, 1090, 1092, 5, 54, 0, 0, 1091, 1093,
+		5, 78, 0, 0, 1092, 1091, 1, 0, 0, 0, 1092, 1093, 1, 0, 0, 0, 1093, 1096,
+		1, 0, 0, 0, 1094, 1097, 3, 190, 95, 0, 1095, 1097, 5, 93, 0, 0, 1096, 1094,
+		1, 0, 0, 0, 1096, 1095, 1, 0, 0, 0, 1097, 1099, 1, 0, 0, 0, 1098, 1090,
+		1, 0, 0, 0, 1098, 1099, 1, 0, 0, 0, 1099, 1114, 1, 0, 0, 0, 1100, 1102,
+		5, 78, 0, 0, 1101, 1100, 1, 0, 0, 0, 1101, 1102, 1, 0, 0, 0, 1102, 1103,
+		1, 0, 0, 0, 1103, 1114, 3, 190, 95, 0, 1104, 1108, 5, 67, 0, 0, 1105, 1107,
+		3, 152, 76, 0, 1106, 1105, 1, 0, 0, 0, 1107, 1110, 1, 0, 0, 0, 1108, 1106,
+		1, 0, 0, 0, 1108, 1109, 1, 0, 0, 0, 1109, 1111, 1, 0, 0, 0, 1110, 1108,
+		1, 0, 0, 0, 1111, 1114, 5, 68, 0, 0, 1112, 1114, 5, 5, 0, 0, 1113, 1089,
+		1, 0, 0, 0, 1113, 1101, 1, 0, 0, 0, 1113, 1104, 1, 0, 0, 0, 1113, 1112,
+		1, 0, 0, 0, 1114, 153, 1, 0, 0, 0, 1115, 1116, 5, 69, 0, 0, 1116, 1117,
+		3, 0, 0, 0, 1117, 1118, 5, 70, 0, 0, 1118, 155, 1, 0, 0, 0, 1119, 1126,
+		3, 158, 79, 0, 1120, 1122, 3, 164, 82, 0, 1121, 1120, 1, 0, 0, 0, 1121,
+		1122, 1, 0, 0, 0, 1122, 1123, 1, 0, 0, 0, 1123, 1125, 3, 158, 79, 0, 1124,
+		1121, 1, 0, 0, 0, 1125, 1128, 1, 0, 0, 0, 1126, 1124, 1, 0, 0, 0, 1126,
+		1127, 1, 0, 0, 0, 1127, 157, 1, 0, 0, 0, 1128, 1126, 1, 0, 0, 0, 1129,
+		1130, 5, 3, 0, 0, 1130, 1142, 3, 158, 79, 0, 1131, 1132, 5, 67, 0, 0, 1132,
+		1133, 3, 156, 78, 0, 1133, 1134, 5, 68, 0, 0, 1134, 1142, 1, 0, 0, 0, 1135,
+		1136, 5, 67, 0, 0, 1136, 1142, 5, 68, 0, 0, 1137, 1142, 3, 160, 80, 0,
+		1138, 1142, 3, 154, 77, 0, 1139, 1142, 5, 96, 0, 0, 1140, 1142, 3, 200,
+		100, 0, 1141, 1129, 1, 0, 0, 0, 1141, 1131, 1, 0, 0, 0, 1141, 1135, 1,
+		0, 0, 0, 1141, 1137, 1, 0, 0, 0, 1141, 1138, 1, 0, 0, 0, 1141, 1139, 1,
+		0, 0, 0, 1141, 1140, 1, 0, 0, 0, 1142, 159, 1, 0, 0, 0, 1143, 1144, 3,
+		202, 101, 0, 1144, 1145, 3, 162, 81, 0, 1145, 1146, 3, 190, 95, 0, 1146,
+		1163, 1, 0, 0, 0, 1147, 1148, 3, 202, 101, 0, 1148, 1149, 5, 74, 0, 0,
+		1149, 1150, 3, 190, 95, 0, 1150, 1163, 1, 0, 0, 0, 1151, 1152, 3, 202,
+		101, 0, 1152, 1153, 5, 6, 0, 0, 1153, 1154, 5, 67, 0, 0, 1154, 1155, 3,
+		212, 106, 0, 1155, 1156, 5, 68, 0, 0, 1156, 1163, 1, 0, 0, 0, 1157, 1158,
+		3, 202, 101, 0, 1158, 1159, 5, 6, 0, 0, 1159, 1160, 3, 154, 77, 0, 1160,
+		1163, 1, 0, 0, 0, 1161, 1163, 3, 186, 93, 0, 1162, 1143, 1, 0, 0, 0, 1162,
+		1147, 1, 0, 0, 0, 1162, 1151, 1, 0, 0, 0, 1162, 1157, 1, 0, 0, 0, 1162,
+		1161, 1, 0, 0, 0, 1163, 161, 1, 0, 0, 0, 1164, 1165, 7, 12, 0, 0, 1165,
+		163, 1, 0, 0, 0, 1166, 1167, 7, 13, 0, 0, 1167, 165, 1, 0, 0, 0, 1168,
+		1169, 3, 168, 84, 0, 1169, 167, 1, 0, 0, 0, 1170, 1175, 3, 170, 85, 0,
+		1171, 1172, 5, 2, 0, 0, 1172, 1174, 3, 170, 85, 0, 1173, 1171, 1, 0, 0,
+		0, 1174, 1177, 1, 0, 0, 0, 1175, 1173, 1, 0, 0, 0, 1175, 1176, 1, 0, 0,
+		0, 1176, 169, 1, 0, 0, 0, 1177, 1175, 1, 0, 0, 0, 1178, 1185, 3, 172, 86,
+		0, 1179, 1181, 5, 1, 0, 0, 1180, 1179, 1, 0, 0, 0, 1180, 1181, 1, 0, 0,
+		0, 1181, 1182, 1, 0, 0, 0, 1182, 1184, 3, 172, 86, 0, 1183, 1180, 1, 0,
+		0, 0, 1184, 1187, 1, 0, 0, 0, 1185, 1183, 1, 0, 0, 0, 1185, 1186, 1, 0,
+		0, 0, 1186, 171, 1, 0, 0, 0, 1187, 1185, 1, 0, 0, 0, 1188, 1189, 5, 3,
+		0, 0, 1189, 1192, 3, 172, 86, 0, 1190, 1192, 3, 174, 87, 0, 1191, 1188,
+		1, 0, 0, 0, 1191, 1190, 1, 0, 0, 0, 1192, 173, 1, 0, 0, 0, 1193, 1194,
+		3, 178, 89, 0, 1194, 1195, 3, 162, 81, 0, 1195, 1196, 3, 176, 88, 0, 1196,
+		1205, 1, 0, 0, 0, 1197, 1205, 3, 160, 80, 0, 1198, 1202, 3, 178, 89, 0,
+		1199, 1200, 3, 162, 81, 0, 1200, 1201, 3, 178, 89, 0, 1201, 1203, 1, 0,
+		0, 0, 1202, 1199, 1, 0, 0, 0, 1202, 1203, 1, 0, 0, 0, 1203, 1205, 1, 0,
+		0, 0, 1204, 1193, 1, 0, 0, 0, 1204, 1197, 1, 0, 0, 0, 1204, 1198, 1, 0,
+		0, 0, 1205, 175, 1, 0, 0, 0, 1206, 1207, 3, 182, 91, 0, 1207, 1208, 5,
+		89, 0, 0, 1208, 1213, 3, 182, 91, 0, 1209, 1210, 7, 14, 0, 0, 1210, 1212,
+		3, 182, 91, 0, 1211, 1209, 1, 0, 0, 0, 1212, 1215, 1, 0, 0, 0, 1213, 1211,
+		1, 0, 0, 0, 1213, 1214, 1, 0, 0, 0, 1214, 1220, 1, 0, 0, 0, 1215, 1213,
+		1, 0, 0, 0, 1216, 1217, 7, 15, 0, 0, 1217, 1219, 3, 180, 90, 0, 1218, 1216,
+		1, 0, 0, 0, 1219, 1222, 1, 0, 0, 0, 1220, 1218, 1, 0, 0, 0, 1220, 1221,
+		1, 0, 0, 0, 1221, 177, 1, 0, 0, 0, 1222, 1220, 1, 0, 0, 0, 1223, 1228,
+		3, 180, 90, 0, 1224, 1225, 7, 15, 0, 0, 1225, 1227, 3, 180, 90, 0, 1226,
+		1224, 1, 0, 0, 0, 1227, 1230, 1, 0, 0, 0, 1228, 1226, 1, 0, 0, 0, 1228,
+		1229, 1, 0, 0, 0, 1229, 179, 1, 0, 0, 0, 1230, 1228, 1, 0, 0, 0, 1231,
+		1236, 3, 182, 91, 0, 1232, 1233, 7, 14, 0, 0, 1233, 1235, 3, 182, 91, 0,
+		1234, 1232, 1, 0, 0, 0, 1235, 1238, 1, 0, 0, 0, 1236, 1234, 1, 0, 0, 0,
+		1236, 1237, 1, 0, 0, 0, 1237, 181, 1, 0, 0, 0, 1238, 1236, 1, 0, 0, 0,
+		1239, 1240, 5, 78, 0, 0, 1240, 1243, 3, 182, 91, 0, 1241, 1243, 3, 184,
+		92, 0, 1242, 1239, 1, 0, 0, 0, 1242, 1241, 1, 0, 0, 0, 1243, 183, 1, 0,
+		0, 0, 1244, 1245, 5, 67, 0, 0, 1245, 1246, 3, 166, 83, 0, 1246, 1247, 5,
+		68, 0, 0, 1247, 1256, 1, 0, 0, 0, 1248, 1256, 3, 154, 77, 0, 1249, 1256,
+		3, 186, 93, 0, 1250, 1256, 5, 81, 0, 0, 1251, 1256, 5, 88, 0, 0, 1252,
+		1256, 5, 85, 0, 0, 1253, 1256, 3, 194, 97, 0, 1254, 1256, 3, 202, 101,
+		0, 1255, 1244, 1, 0, 0, 0, 1255, 1248, 1, 0, 0, 0, 1255, 1249, 1, 0, 0,
+		0, 1255, 1250, 1, 0, 0, 0, 1255, 1251, 1, 0, 0, 0, 1255, 1252, 1, 0, 0,
+		0, 1255, 1253, 1, 0, 0, 0, 1255, 1254, 1, 0, 0, 0, 1256, 185, 1, 0, 0,
+		0, 1257, 1258, 5, 93, 0, 0, 1258, 1260, 5, 67, 0, 0, 1259, 1261, 3, 188,
+		94, 0, 1260, 1259, 1, 0, 0, 0, 1260, 1261, 1, 0, 0, 0, 1261, 1262, 1, 0,
+		0, 0, 1262, 1295, 5, 68, 0, 0, 1263, 1264, 5, 9, 0, 0, 1264, 1266, 5, 67,
+		0, 0, 1265, 1267, 3, 188, 94, 0, 1266, 1265, 1, 0, 0, 0, 1266, 1267, 1,
+		0, 0, 0, 1267, 1268, 1, 0, 0, 0, 1268, 1295, 5, 68, 0, 0, 1269, 1270, 5,
+		62, 0, 0, 1270, 1271, 5, 67, 0, 0, 1271, 1272, 3, 188, 94, 0, 1272, 1273,
+		5, 68, 0, 0, 1273, 1295, 1, 0, 0, 0, 1274, 1275, 5, 61, 0, 0, 1275, 1276,
+		5, 67, 0, 0, 1276, 1277, 3, 188, 94, 0, 1277, 1278, 5, 68, 0, 0, 1278,
+		1295, 1, 0, 0, 0, 1279, 1280, 5, 63, 0, 0, 1280, 1281, 5, 67, 0, 0, 1281,
+		1282, 3, 188, 94, 0, 1282, 1283, 5, 68, 0, 0, 1283, 1295, 1, 0, 0, 0, 1284,
+		1285, 5, 64, 0, 0, 1285, 1286, 5, 67, 0, 0, 1286, 1287, 3, 188, 94, 0,
+		1287, 1288, 5, 68, 0, 0, 1288, 1295, 1, 0, 0, 0, 1289, 1290, 5, 65, 0,
+		0, 1290, 1291, 5, 67, 0, 0, 1291, 1292, 3, 188, 94, 0, 1292, 1293, 5, 68,
+		0, 0, 1293, 1295, 1, 0, 0, 0, 1294, 1257, 1, 0, 0, 0, 1294, 1263, 1, 0,
+		0, 0, 1294, 1269, 1, 0, 0, 0, 1294, 1274, 1, 0, 0, 0, 1294, 1279, 1, 0,
+		0, 0, 1294, 1284, 1, 0, 0, 0, 1294, 1289, 1, 0, 0, 0, 1295, 187, 1, 0,
+		0, 0, 1296, 1301, 3, 166, 83, 0, 1297, 1298, 5, 73, 0, 0, 1298, 1300, 3,
+		166, 83, 0, 1299, 1297, 1, 0, 0, 0, 1300, 1303, 1, 0, 0, 0, 1301, 1299,
+		1, 0, 0, 0, 1301, 1302, 1, 0, 0, 0, 1302, 189, 1, 0, 0, 0, 1303, 1301,
+		1, 0, 0, 0, 1304, 1319, 5, 81, 0, 0, 1305, 1319, 5, 88, 0, 0, 1306, 1319,
+		5, 87, 0, 0, 1307, 1319, 5, 85, 0, 0, 1308, 1319, 5, 97, 0, 0, 1309, 1319,
+		5, 86, 0, 0, 1310, 1319, 3, 198, 99, 0, 1311, 1319, 3, 194, 97, 0, 1312,
+		1319, 5, 93, 0, 0, 1313, 1319, 5, 91, 0, 0, 1314, 1319, 5, 82, 0, 0, 1315,
+		1319, 5, 83, 0, 0, 1316, 1319, 5, 84, 0, 0, 1317, 1319, 3, 192, 96, 0,
+		1318, 1304, 1, 0, 0, 0, 1318, 1305, 1, 0, 0, 0, 1318, 1306, 1, 0, 0, 0,
+		1318, 1307, 1, 0, 0, 0, 1318, 1308, 1, 0, 0, 0, 1318, 1309, 1, 0, 0, 0,
+		1318, 1310, 1, 0, 0, 0, 1318, 1311, 1, 0, 0, 0, 1318, 1312, 1, 0, 0, 0,
+		1318, 1313, 1, 0, 0, 0, 1318, 1314, 1, 0, 0, 0, 1318, 1315, 1, 0, 0, 0,
+		1318, 1316, 1, 0, 0, 0, 1318, 1317, 1, 0, 0, 0, 1319, 191, 1, 0, 0, 0,
+		1320, 1321, 7, 16, 0, 0, 1321, 193, 1, 0, 0, 0, 1322, 1325, 3, 196, 98,
+		0, 1323, 1324, 5, 75, 0, 0, 1324, 1326, 3, 196, 98, 0, 1325, 1323, 1, 0,
+		0, 0, 1326, 1327, 1, 0, 0, 0, 1327, 1325, 1, 0, 0, 0, 1327, 1328, 1, 0,
+		0, 0, 1328, 195, 1, 0, 0, 0, 1329, 1334, 5, 93, 0, 0, 1330, 1331, 7, 10,
+		0, 0, 1331, 1333, 5, 93, 0, 0, 1332, 1330, 1, 0, 0, 0, 1333, 1336, 1, 0,
+		0, 0, 1334, 1332, 1, 0, 0, 0, 1334, 1335, 1, 0, 0, 0, 1335, 197, 1, 0,
+		0, 0, 1336, 1334, 1, 0, 0, 0, 1337, 1338, 5, 93, 0, 0, 1338, 1339, 5, 89,
+		0, 0, 1339, 1354, 5, 90, 0, 0, 1340, 1341, 5, 93, 0, 0, 1341, 1354, 5,
+		89, 0, 0, 1342, 1343, 5, 89, 0, 0, 1343, 1344, 5, 93, 0, 0, 1344, 1354,
+		5, 89, 0, 0, 1345, 1346, 5, 89, 0, 0, 1346, 1354, 5, 93, 0, 0, 1347, 1348,
+		5, 89, 0, 0, 1348, 1349, 5, 94, 0, 0, 1349, 1354, 5, 93, 0, 0, 1350, 1351,
+		5, 89, 0, 0, 1351, 1354, 5, 90, 0, 0, 1352, 1354, 5, 89, 0, 0, 1353, 1337,
+		1, 0, 0, 0, 1353, 1340, 1, 0, 0, 0, 1353, 1342, 1, 0, 0, 0, 1353, 1345,
+		1, 0, 0, 0, 1353, 1347, 1, 0, 0, 0, 1353, 1350, 1, 0, 0, 0, 1353, 1352,
+		1, 0, 0, 0, 1354, 199, 1, 0, 0, 0, 1355, 1364, 5, 93, 0, 0, 1356, 1364,
+		5, 88, 0, 0, 1357, 1364, 5, 87, 0, 0, 1358, 1364, 5, 81, 0, 0, 1359, 1364,
+		5, 82, 0, 0, 1360, 1364, 3, 198, 99, 0, 1361, 1364, 5, 83, 0, 0, 1362,
+		1364, 5, 84, 0, 0, 1363, 1355, 1, 0, 0, 0, 1363, 1356, 1, 0, 0, 0, 1363,
+		1357, 1, 0, 0, 0, 1363, 1358, 1, 0, 0, 0, 1363, 1359, 1, 0, 0, 0, 1363,
+		1360, 1, 0, 0, 0, 1363, 1361, 1, 0, 0, 0, 1363, 1362, 1, 0, 0, 0, 1364,
+		201, 1, 0, 0, 0, 1365, 1377, 3, 206, 103, 0, 1366, 1374, 3, 204, 102, 0,
+		1367, 1368, 5, 94, 0, 0, 1368, 1370, 3, 206, 103, 0, 1369, 1371, 3, 204,
+		102, 0, 1370, 1369, 1, 0, 0, 0, 1370, 1371, 1, 0, 0, 0, 1371, 1373, 1,
+		0, 0, 0, 1372, 1367, 1, 0, 0, 0, 1373, 1376, 1, 0, 0, 0, 1374, 1372, 1,
+		0, 0, 0, 1374, 1375, 1, 0, 0, 0, 1375, 1378, 1, 0, 0, 0, 1376, 1374, 1,
+		0, 0, 0, 1377, 1366, 1, 0, 0, 0, 1377, 1378, 1, 0, 0, 0, 1378, 1386, 1,
+		0, 0, 0, 1379, 1386, 5, 88, 0, 0, 1380, 1382, 5, 92, 0, 0, 1381, 1383,
+		5, 93, 0, 0, 1382, 1381, 1, 0, 0, 0, 1382, 1383, 1, 0, 0, 0, 1383, 1386,
+		1, 0, 0, 0, 1384, 1386, 5, 82, 0, 0, 1385, 1365, 1, 0, 0, 0, 1385, 1379,
+		1, 0, 0, 0, 1385, 1380, 1, 0, 0, 0, 1385, 1384, 1, 0, 0, 0, 1386, 203,
+		1, 0, 0, 0, 1387, 1388, 5, 71, 0, 0, 1388, 1399, 5, 72, 0, 0, 1389, 1390,
+		5, 71, 0, 0, 1390, 1391, 5, 88, 0, 0, 1391, 1399, 5, 72, 0, 0, 1392, 1393,
+		5, 69, 0, 0, 1393, 1394, 5, 89, 0, 0, 1394, 1399, 5, 70, 0, 0, 1395, 1396,
+		5, 69, 0, 0, 1396, 1397, 5, 88, 0, 0, 1397, 1399, 5, 70, 0, 0, 1398, 1387,
+		1, 0, 0, 0, 1398, 1389, 1, 0, 0, 0, 1398, 1392, 1, 0, 0, 0, 1398, 1395,
+		1, 0, 0, 0, 1399, 205, 1, 0, 0, 0, 1400, 1405, 5, 93, 0, 0, 1401, 1402,
+		5, 78, 0, 0, 1402, 1404, 5, 93, 0, 0, 1403, 1401, 1, 0, 0, 0, 1404, 1407,
+		1, 0, 0, 0, 1405, 1403, 1, 0, 0, 0, 1405, 1406, 1, 0, 0, 0, 1406, 1423,
+		1, 0, 0, 0, 1407, 1405, 1, 0, 0, 0, 1408, 1423, 5, 48, 0, 0, 1409, 1423,
+		5, 50, 0, 0, 1410, 1423, 5, 51, 0, 0, 1411, 1423, 5, 52, 0, 0, 1412, 1423,
+		5, 53, 0, 0, 1413, 1423, 5, 14, 0, 0, 1414, 1423, 5, 39, 0, 0, 1415, 1423,
+		5, 40, 0, 0, 1416, 1423, 5, 41, 0, 0, 1417, 1423, 5, 8, 0, 0, 1418, 1423,
+		5, 27, 0, 0, 1419, 1423, 5, 25, 0, 0, 1420, 1423, 5, 26, 0, 0, 1421, 1423,
+		5, 28, 0, 0, 1422, 1400, 1, 0, 0, 0, 1422, 1408, 1, 0, 0, 0, 1422, 1409,
+		1, 0, 0, 0, 1422, 1410, 1, 0, 0, 0, 1422, 1411, 1, 0, 0, 0, 1422, 1412,
+		1, 0, 0, 0, 1422, 1413, 1, 0, 0, 0, 1422, 1414, 1, 0, 0, 0, 1422, 1415,
+		1, 0, 0, 0, 1422, 1416, 1, 0, 0, 0, 1422, 1417, 1, 0, 0, 0, 1422, 1418,
+		1, 0, 0, 0, 1422, 1419, 1, 0, 0, 0, 1422, 1420, 1, 0, 0, 0, 1422, 1421,
+		1, 0, 0, 0, 1423, 207, 1, 0, 0, 0, 1424, 1431, 3, 210, 105, 0, 1425, 1427,
+		5, 73, 0, 0, 1426, 1425, 1, 0, 0, 0, 1426, 1427, 1, 0, 0, 0, 1427, 1428,
+		1, 0, 0, 0, 1428, 1430, 3, 210, 105, 0, 1429, 1426, 1, 0, 0, 0, 1430, 1433,
+		1, 0, 0, 0, 1431, 1429, 1, 0, 0, 0, 1431, 1432, 1, 0, 0, 0, 1432, 209,
+		1, 0, 0, 0, 1433, 1431, 1, 0, 0, 0, 1434, 1438, 3, 202, 101, 0, 1435, 1438,
+		5, 81, 0, 0, 1436, 1438, 3, 198, 99, 0, 1437, 1434, 1, 0, 0, 0, 1437, 1435,
+		1, 0, 0, 0, 1437, 1436, 1, 0, 0, 0, 1438, 211, 1, 0, 0, 0, 1439, 1444,
+		3, 190, 95, 0, 1440, 1441, 5, 73, 0, 0, 1441, 1443, 3, 190, 95, 0, 1442,
+		1440, 1, 0, 0, 0, 1443, 1446, 1, 0, 0, 0, 1444, 1442, 1, 0, 0, 0, 1444,
+		1445, 1, 0, 0, 0, 1445, 213, 1, 0, 0, 0, 1446, 1444, 1, 0, 0, 0, 187, 215,
+		222, 267, 270, 283, 288, 296, 301, 306, 311, 317, 319, 325, 327, 329, 336,
+		343, 348, 355, 361, 369, 376, 382, 388, 394, 402, 406, 413, 417, 421, 425,
+		429, 433, 438, 444, 451, 455, 460, 466, 472, 479, 482, 488, 494, 497, 504,
+		508, 515, 521, 529, 535, 542, 551, 560, 569, 574, 576, 582, 589, 598, 616,
+		621, 623, 628, 634, 640, 645, 650, 656, 661, 667, 672, 677, 683, 688, 696,
+		702, 707, 712, 717, 722, 731, 737, 742, 747, 752, 756, 763, 768, 775, 779,
+		789, 799, 808, 813, 821, 826, 836, 842, 852, 857, 862, 864, 871, 877, 885,
+		896, 902, 904, 910, 917, 922, 932, 938, 945, 950, 956, 961, 966, 969, 973,
+		977, 982, 984, 986, 994, 997, 1006, 1012, 1018, 1021, 1029, 1035, 1040,
+		1045, 1048, 1052, 1057, 1059, 1061, 1067, 1073, 1080, 1086, 1092, 1096,
+		1098, 1101, 1108, 1113, 1121, 1126, 1141, 1162, 1175, 1180, 1185, 1191,
+		1202, 1204, 1213, 1220, 1228, 1236, 1242, 1255, 1260, 1266, 1294, 1301,
+		1318, 1327, 1334, 1353, 1363, 1370, 1374, 1377, 1382, 1385, 1398, 1405,
+		1422, 1426, 1431, 1437, 1444,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -619,88 +839,107 @@ func NewSPLParser(input antlr.TokenStream) *SPLParser {
 
 // SPLParser tokens.
 const (
-	SPLParserEOF           = antlr.TokenEOF
-	SPLParserAND           = 1
-	SPLParserOR            = 2
-	SPLParserNOT           = 3
-	SPLParserBY            = 4
-	SPLParserAS            = 5
-	SPLParserIN            = 6
-	SPLParserWHERE         = 7
-	SPLParserSEARCH        = 8
-	SPLParserEVAL          = 9
-	SPLParserSTATS         = 10
-	SPLParserTABLE         = 11
-	SPLParserFIELDS        = 12
-	SPLParserRENAME        = 13
-	SPLParserREX           = 14
-	SPLParserDEDUP         = 15
-	SPLParserSORT          = 16
-	SPLParserHEAD          = 17
-	SPLParserTAIL          = 18
-	SPLParserTOP           = 19
-	SPLParserRARE          = 20
-	SPLParserLOOKUP        = 21
-	SPLParserJOIN          = 22
-	SPLParserAPPEND        = 23
-	SPLParserTRANSACTION   = 24
-	SPLParserSPATH         = 25
-	SPLParserEVENTSTATS    = 26
-	SPLParserSTREAMSTATS   = 27
-	SPLParserTIMECHART     = 28
-	SPLParserCHART         = 29
-	SPLParserFILLNULL      = 30
-	SPLParserMAKEMV        = 31
-	SPLParserMVEXPAND      = 32
-	SPLParserFORMAT        = 33
-	SPLParserCONVERT       = 34
-	SPLParserBUCKET        = 35
-	SPLParserBIN           = 36
-	SPLParserOVER          = 37
-	SPLParserREST          = 38
-	SPLParserTSTATS        = 39
-	SPLParserFROM          = 40
-	SPLParserGROUPBY       = 41
-	SPLParserMSTATS        = 42
-	SPLParserINPUTLOOKUP   = 43
-	SPLParserEQ            = 44
-	SPLParserNEQ           = 45
-	SPLParserLT            = 46
-	SPLParserGT            = 47
-	SPLParserLTE           = 48
-	SPLParserGTE           = 49
-	SPLParserLIKE          = 50
-	SPLParserMATCH         = 51
-	SPLParserCIDRMATCH     = 52
-	SPLParserISNOTNULL     = 53
-	SPLParserISNULL        = 54
-	SPLParserPIPE          = 55
-	SPLParserLPAREN        = 56
-	SPLParserRPAREN        = 57
-	SPLParserLBRACKET      = 58
-	SPLParserRBRACKET      = 59
-	SPLParserLBRACE        = 60
-	SPLParserRBRACE        = 61
-	SPLParserCOMMA         = 62
-	SPLParserCOLON         = 63
-	SPLParserDQUOTE        = 64
-	SPLParserPLUS          = 65
-	SPLParserMINUS         = 66
-	SPLParserSLASH         = 67
-	SPLParserPERCENT       = 68
-	SPLParserQUOTED_STRING = 69
-	SPLParserTIME_SPAN     = 70
-	SPLParserNUMBER        = 71
-	SPLParserWILDCARD      = 72
-	SPLParserDOLLAR        = 73
-	SPLParserTEMPLATE_VAR  = 74
-	SPLParserIDENTIFIER    = 75
-	SPLParserDOT           = 76
-	SPLParserREST_PATH     = 77
-	SPLParserMACRO         = 78
-	SPLParserTIME_MODIFIER = 79
-	SPLParserWS            = 80
-	SPLParserLINE_COMMENT  = 81
+	SPLParserEOF            = antlr.TokenEOF
+	SPLParserAND            = 1
+	SPLParserOR             = 2
+	SPLParserNOT            = 3
+	SPLParserBY             = 4
+	SPLParserAS             = 5
+	SPLParserIN             = 6
+	SPLParserWHERE          = 7
+	SPLParserSEARCH         = 8
+	SPLParserEVAL           = 9
+	SPLParserSTATS          = 10
+	SPLParserTABLE          = 11
+	SPLParserFIELDS         = 12
+	SPLParserRENAME         = 13
+	SPLParserREGEX          = 14
+	SPLParserREX            = 15
+	SPLParserDEDUP          = 16
+	SPLParserSORT           = 17
+	SPLParserHEAD           = 18
+	SPLParserTAIL           = 19
+	SPLParserTOP            = 20
+	SPLParserRARE           = 21
+	SPLParserLOOKUP         = 22
+	SPLParserJOIN           = 23
+	SPLParserAPPEND         = 24
+	SPLParserAPPENDCOLS     = 25
+	SPLParserAPPENDPIPE     = 26
+	SPLParserUNION          = 27
+	SPLParserMULTISEARCH    = 28
+	SPLParserTRANSACTION    = 29
+	SPLParserSPATH          = 30
+	SPLParserEVENTSTATS     = 31
+	SPLParserSTREAMSTATS    = 32
+	SPLParserTIMECHART      = 33
+	SPLParserCHART          = 34
+	SPLParserFILLNULL       = 35
+	SPLParserMAKEMV         = 36
+	SPLParserMVEXPAND       = 37
+	SPLParserFORMAT         = 38
+	SPLParserRETURN         = 39
+	SPLParserFOREACH        = 40
+	SPLParserMAP            = 41
+	SPLParserCONVERT        = 42
+	SPLParserBUCKET         = 43
+	SPLParserBIN            = 44
+	SPLParserOVER           = 45
+	SPLParserREST           = 46
+	SPLParserTSTATS         = 47
+	SPLParserFROM           = 48
+	SPLParserGROUPBY        = 49
+	SPLParserMSTATS         = 50
+	SPLParserINPUTLOOKUP    = 51
+	SPLParserOUTPUT         = 52
+	SPLParserOUTPUTNEW      = 53
+	SPLParserEQ             = 54
+	SPLParserEQEQ           = 55
+	SPLParserNEQ            = 56
+	SPLParserLT             = 57
+	SPLParserGT             = 58
+	SPLParserLTE            = 59
+	SPLParserGTE            = 60
+	SPLParserLIKE           = 61
+	SPLParserMATCH          = 62
+	SPLParserCIDRMATCH      = 63
+	SPLParserISNOTNULL      = 64
+	SPLParserISNULL         = 65
+	SPLParserPIPE           = 66
+	SPLParserLPAREN         = 67
+	SPLParserRPAREN         = 68
+	SPLParserLBRACKET       = 69
+	SPLParserRBRACKET       = 70
+	SPLParserLBRACE         = 71
+	SPLParserRBRACE         = 72
+	SPLParserCOMMA          = 73
+	SPLParserDOUBLE_COLON   = 74
+	SPLParserCOLON          = 75
+	SPLParserDQUOTE         = 76
+	SPLParserPLUS           = 77
+	SPLParserMINUS          = 78
+	SPLParserSLASH          = 79
+	SPLParserPERCENT        = 80
+	SPLParserQUOTED_STRING  = 81
+	SPLParserSINGLE_QUOTED  = 82
+	SPLParserTERM_DIRECTIVE = 83
+	SPLParserCASE_DIRECTIVE = 84
+	SPLParserTIME_SPAN      = 85
+	SPLParserTIME_ABSOLUTE  = 86
+	SPLParserIP_ADDRESS     = 87
+	SPLParserNUMBER         = 88
+	SPLParserWILDCARD       = 89
+	SPLParserDOLLAR         = 90
+	SPLParserTOKEN_VAR      = 91
+	SPLParserTEMPLATE_VAR   = 92
+	SPLParserIDENTIFIER     = 93
+	SPLParserDOT            = 94
+	SPLParserREST_PATH      = 95
+	SPLParserMACRO          = 96
+	SPLParserTIME_MODIFIER  = 97
+	SPLParserWS             = 98
+	SPLParserBLOCK_COMMENT  = 99
+	SPLParserLINE_COMMENT   = 100
 )
 
 // SPLParser rules.
@@ -719,79 +958,99 @@ const (
 	SPLParserRULE_renameSpec               = 11
 	SPLParserRULE_rexCommand               = 12
 	SPLParserRULE_rexOption                = 13
-	SPLParserRULE_dedupCommand             = 14
-	SPLParserRULE_dedupOption              = 15
-	SPLParserRULE_sortCommand              = 16
-	SPLParserRULE_sortField                = 17
-	SPLParserRULE_headCommand              = 18
-	SPLParserRULE_tailCommand              = 19
-	SPLParserRULE_topCommand               = 20
-	SPLParserRULE_rareCommand              = 21
-	SPLParserRULE_lookupCommand            = 22
-	SPLParserRULE_lookupOption             = 23
-	SPLParserRULE_joinCommand              = 24
-	SPLParserRULE_joinOption               = 25
-	SPLParserRULE_appendCommand            = 26
-	SPLParserRULE_transactionCommand       = 27
-	SPLParserRULE_transactionOption        = 28
-	SPLParserRULE_spathCommand             = 29
-	SPLParserRULE_spathOption              = 30
-	SPLParserRULE_eventstatsCommand        = 31
-	SPLParserRULE_streamstatsCommand       = 32
-	SPLParserRULE_timechartCommand         = 33
-	SPLParserRULE_timechartOption          = 34
-	SPLParserRULE_chartCommand             = 35
-	SPLParserRULE_fillnullCommand          = 36
-	SPLParserRULE_fillnullOption           = 37
-	SPLParserRULE_makemvCommand            = 38
-	SPLParserRULE_makemvOption             = 39
-	SPLParserRULE_mvexpandCommand          = 40
-	SPLParserRULE_formatCommand            = 41
-	SPLParserRULE_formatOption             = 42
-	SPLParserRULE_convertCommand           = 43
-	SPLParserRULE_convertOption            = 44
-	SPLParserRULE_convertFunction          = 45
-	SPLParserRULE_bucketCommand            = 46
-	SPLParserRULE_bucketOption             = 47
-	SPLParserRULE_restCommand              = 48
-	SPLParserRULE_restArg                  = 49
-	SPLParserRULE_tstatsCommand            = 50
-	SPLParserRULE_tstatsPreOption          = 51
-	SPLParserRULE_tstatsDatamodel          = 52
-	SPLParserRULE_tstatsPostOption         = 53
-	SPLParserRULE_mstatsCommand            = 54
-	SPLParserRULE_inputlookupCommand       = 55
-	SPLParserRULE_inputlookupOption        = 56
-	SPLParserRULE_genericCommand           = 57
-	SPLParserRULE_genericArg               = 58
-	SPLParserRULE_subsearch                = 59
-	SPLParserRULE_searchExpression         = 60
-	SPLParserRULE_searchTerm               = 61
-	SPLParserRULE_condition                = 62
-	SPLParserRULE_comparisonOp             = 63
-	SPLParserRULE_logicalOp                = 64
-	SPLParserRULE_expression               = 65
-	SPLParserRULE_orExpression             = 66
-	SPLParserRULE_andExpression            = 67
-	SPLParserRULE_notExpression            = 68
-	SPLParserRULE_comparisonExpression     = 69
-	SPLParserRULE_additiveExpression       = 70
-	SPLParserRULE_multiplicativeExpression = 71
-	SPLParserRULE_unaryExpression          = 72
-	SPLParserRULE_primaryExpression        = 73
-	SPLParserRULE_functionCall             = 74
-	SPLParserRULE_argumentList             = 75
-	SPLParserRULE_value                    = 76
-	SPLParserRULE_colonValue               = 77
-	SPLParserRULE_extendedIdentifier       = 78
-	SPLParserRULE_wildcardValue            = 79
-	SPLParserRULE_bareWord                 = 80
-	SPLParserRULE_fieldName                = 81
-	SPLParserRULE_fieldNameSuffix          = 82
-	SPLParserRULE_fieldNameBase            = 83
-	SPLParserRULE_fieldList                = 84
-	SPLParserRULE_fieldOrQuoted            = 85
-	SPLParserRULE_valueList                = 86
+	SPLParserRULE_regexCommand             = 14
+	SPLParserRULE_dedupCommand             = 15
+	SPLParserRULE_dedupOption              = 16
+	SPLParserRULE_sortCommand              = 17
+	SPLParserRULE_sortField                = 18
+	SPLParserRULE_headCommand              = 19
+	SPLParserRULE_tailCommand              = 20
+	SPLParserRULE_topCommand               = 21
+	SPLParserRULE_topOption                = 22
+	SPLParserRULE_rareCommand              = 23
+	SPLParserRULE_lookupCommand            = 24
+	SPLParserRULE_lookupOutput             = 25
+	SPLParserRULE_lookupFieldMapping       = 26
+	SPLParserRULE_lookupField              = 27
+	SPLParserRULE_lookupOption             = 28
+	SPLParserRULE_joinCommand              = 29
+	SPLParserRULE_joinOption               = 30
+	SPLParserRULE_appendCommand            = 31
+	SPLParserRULE_appendcolsCommand        = 32
+	SPLParserRULE_appendpipeCommand        = 33
+	SPLParserRULE_unionCommand             = 34
+	SPLParserRULE_multisearchCommand       = 35
+	SPLParserRULE_transactionCommand       = 36
+	SPLParserRULE_transactionOption        = 37
+	SPLParserRULE_spathCommand             = 38
+	SPLParserRULE_spathPath                = 39
+	SPLParserRULE_spathOption              = 40
+	SPLParserRULE_eventstatsCommand        = 41
+	SPLParserRULE_streamstatsCommand       = 42
+	SPLParserRULE_statsOption              = 43
+	SPLParserRULE_timechartCommand         = 44
+	SPLParserRULE_timechartOption          = 45
+	SPLParserRULE_chartCommand             = 46
+	SPLParserRULE_fillnullCommand          = 47
+	SPLParserRULE_fillnullOption           = 48
+	SPLParserRULE_makemvCommand            = 49
+	SPLParserRULE_makemvOption             = 50
+	SPLParserRULE_mvexpandCommand          = 51
+	SPLParserRULE_formatCommand            = 52
+	SPLParserRULE_formatArg                = 53
+	SPLParserRULE_formatOption             = 54
+	SPLParserRULE_returnCommand            = 55
+	SPLParserRULE_returnItem               = 56
+	SPLParserRULE_foreachCommand           = 57
+	SPLParserRULE_foreachArg               = 58
+	SPLParserRULE_mapCommand               = 59
+	SPLParserRULE_mapOption                = 60
+	SPLParserRULE_convertCommand           = 61
+	SPLParserRULE_convertOption            = 62
+	SPLParserRULE_convertFunction          = 63
+	SPLParserRULE_bucketCommand            = 64
+	SPLParserRULE_bucketOption             = 65
+	SPLParserRULE_restCommand              = 66
+	SPLParserRULE_restArg                  = 67
+	SPLParserRULE_tstatsCommand            = 68
+	SPLParserRULE_tstatsPreOption          = 69
+	SPLParserRULE_tstatsDatamodel          = 70
+	SPLParserRULE_tstatsPostOption         = 71
+	SPLParserRULE_mstatsCommand            = 72
+	SPLParserRULE_inputlookupCommand       = 73
+	SPLParserRULE_inputlookupOption        = 74
+	SPLParserRULE_genericCommand           = 75
+	SPLParserRULE_genericArg               = 76
+	SPLParserRULE_subsearch                = 77
+	SPLParserRULE_searchExpression         = 78
+	SPLParserRULE_searchTerm               = 79
+	SPLParserRULE_condition                = 80
+	SPLParserRULE_comparisonOp             = 81
+	SPLParserRULE_logicalOp                = 82
+	SPLParserRULE_expression               = 83
+	SPLParserRULE_orExpression             = 84
+	SPLParserRULE_andExpression            = 85
+	SPLParserRULE_notExpression            = 86
+	SPLParserRULE_comparisonExpression     = 87
+	SPLParserRULE_productExpression        = 88
+	SPLParserRULE_additiveExpression       = 89
+	SPLParserRULE_multiplicativeExpression = 90
+	SPLParserRULE_unaryExpression          = 91
+	SPLParserRULE_primaryExpression        = 92
+	SPLParserRULE_functionCall             = 93
+	SPLParserRULE_argumentList             = 94
+	SPLParserRULE_value                    = 95
+	SPLParserRULE_keywordAsValue           = 96
+	SPLParserRULE_colonValue               = 97
+	SPLParserRULE_extendedIdentifier       = 98
+	SPLParserRULE_wildcardValue            = 99
+	SPLParserRULE_bareWord                 = 100
+	SPLParserRULE_fieldName                = 101
+	SPLParserRULE_fieldNameSuffix          = 102
+	SPLParserRULE_fieldNameBase            = 103
+	SPLParserRULE_fieldList                = 104
+	SPLParserRULE_fieldOrQuoted            = 105
+	SPLParserRULE_valueList                = 106
 )
 
 // IQueryContext is an interface to support dynamic dispatch.
@@ -928,7 +1187,7 @@ func (p *SPLParser) Query() (localctx IQueryContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(175)
+	p.SetState(215)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -937,7 +1196,7 @@ func (p *SPLParser) Query() (localctx IQueryContext) {
 
 	if _la == SPLParserPIPE {
 		{
-			p.SetState(174)
+			p.SetState(214)
 			p.Match(SPLParserPIPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -947,10 +1206,10 @@ func (p *SPLParser) Query() (localctx IQueryContext) {
 
 	}
 	{
-		p.SetState(177)
+		p.SetState(217)
 		p.PipelineStage()
 	}
-	p.SetState(182)
+	p.SetState(222)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -959,7 +1218,7 @@ func (p *SPLParser) Query() (localctx IQueryContext) {
 
 	for _la == SPLParserPIPE {
 		{
-			p.SetState(178)
+			p.SetState(218)
 			p.Match(SPLParserPIPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -967,11 +1226,11 @@ func (p *SPLParser) Query() (localctx IQueryContext) {
 			}
 		}
 		{
-			p.SetState(179)
+			p.SetState(219)
 			p.PipelineStage()
 		}
 
-		p.SetState(184)
+		p.SetState(224)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1008,6 +1267,7 @@ type IPipelineStageContext interface {
 	FieldsCommand() IFieldsCommandContext
 	RenameCommand() IRenameCommandContext
 	RexCommand() IRexCommandContext
+	RegexCommand() IRegexCommandContext
 	DedupCommand() IDedupCommandContext
 	SortCommand() ISortCommandContext
 	HeadCommand() IHeadCommandContext
@@ -1017,6 +1277,10 @@ type IPipelineStageContext interface {
 	LookupCommand() ILookupCommandContext
 	JoinCommand() IJoinCommandContext
 	AppendCommand() IAppendCommandContext
+	AppendcolsCommand() IAppendcolsCommandContext
+	AppendpipeCommand() IAppendpipeCommandContext
+	UnionCommand() IUnionCommandContext
+	MultisearchCommand() IMultisearchCommandContext
 	TransactionCommand() ITransactionCommandContext
 	SpathCommand() ISpathCommandContext
 	EventstatsCommand() IEventstatsCommandContext
@@ -1027,6 +1291,9 @@ type IPipelineStageContext interface {
 	MakemvCommand() IMakemvCommandContext
 	MvexpandCommand() IMvexpandCommandContext
 	FormatCommand() IFormatCommandContext
+	ReturnCommand() IReturnCommandContext
+	ForeachCommand() IForeachCommandContext
+	MapCommand() IMapCommandContext
 	ConvertCommand() IConvertCommandContext
 	BucketCommand() IBucketCommandContext
 	RestCommand() IRestCommandContext
@@ -1199,6 +1466,22 @@ func (s *PipelineStageContext) RexCommand() IRexCommandContext {
 	return t.(IRexCommandContext)
 }
 
+func (s *PipelineStageContext) RegexCommand() IRegexCommandContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRegexCommandContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRegexCommandContext)
+}
+
 func (s *PipelineStageContext) DedupCommand() IDedupCommandContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1343,6 +1626,70 @@ func (s *PipelineStageContext) AppendCommand() IAppendCommandContext {
 	return t.(IAppendCommandContext)
 }
 
+func (s *PipelineStageContext) AppendcolsCommand() IAppendcolsCommandContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAppendcolsCommandContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAppendcolsCommandContext)
+}
+
+func (s *PipelineStageContext) AppendpipeCommand() IAppendpipeCommandContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAppendpipeCommandContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAppendpipeCommandContext)
+}
+
+func (s *PipelineStageContext) UnionCommand() IUnionCommandContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnionCommandContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnionCommandContext)
+}
+
+func (s *PipelineStageContext) MultisearchCommand() IMultisearchCommandContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMultisearchCommandContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMultisearchCommandContext)
+}
+
 func (s *PipelineStageContext) TransactionCommand() ITransactionCommandContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1503,6 +1850,54 @@ func (s *PipelineStageContext) FormatCommand() IFormatCommandContext {
 	return t.(IFormatCommandContext)
 }
 
+func (s *PipelineStageContext) ReturnCommand() IReturnCommandContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IReturnCommandContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IReturnCommandContext)
+}
+
+func (s *PipelineStageContext) ForeachCommand() IForeachCommandContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IForeachCommandContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IForeachCommandContext)
+}
+
+func (s *PipelineStageContext) MapCommand() IMapCommandContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMapCommandContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapCommandContext)
+}
+
 func (s *PipelineStageContext) ConvertCommand() IConvertCommandContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1648,7 +2043,7 @@ func (s *PipelineStageContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 func (p *SPLParser) PipelineStage() (localctx IPipelineStageContext) {
 	localctx = NewPipelineStageContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 2, SPLParserRULE_pipelineStage)
-	p.SetState(219)
+	p.SetState(267)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1658,238 +2053,294 @@ func (p *SPLParser) PipelineStage() (localctx IPipelineStageContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(185)
+			p.SetState(225)
 			p.SearchCommand()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(186)
+			p.SetState(226)
 			p.WhereCommand()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(187)
+			p.SetState(227)
 			p.EvalCommand()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(188)
+			p.SetState(228)
 			p.StatsCommand()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(189)
+			p.SetState(229)
 			p.TableCommand()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(190)
+			p.SetState(230)
 			p.FieldsCommand()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(191)
+			p.SetState(231)
 			p.RenameCommand()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(192)
+			p.SetState(232)
 			p.RexCommand()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(193)
-			p.DedupCommand()
+			p.SetState(233)
+			p.RegexCommand()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(194)
-			p.SortCommand()
+			p.SetState(234)
+			p.DedupCommand()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(195)
-			p.HeadCommand()
+			p.SetState(235)
+			p.SortCommand()
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(196)
-			p.TailCommand()
+			p.SetState(236)
+			p.HeadCommand()
 		}
 
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(197)
-			p.TopCommand()
+			p.SetState(237)
+			p.TailCommand()
 		}
 
 	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(198)
-			p.RareCommand()
+			p.SetState(238)
+			p.TopCommand()
 		}
 
 	case 15:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(199)
-			p.LookupCommand()
+			p.SetState(239)
+			p.RareCommand()
 		}
 
 	case 16:
 		p.EnterOuterAlt(localctx, 16)
 		{
-			p.SetState(200)
-			p.JoinCommand()
+			p.SetState(240)
+			p.LookupCommand()
 		}
 
 	case 17:
 		p.EnterOuterAlt(localctx, 17)
 		{
-			p.SetState(201)
-			p.AppendCommand()
+			p.SetState(241)
+			p.JoinCommand()
 		}
 
 	case 18:
 		p.EnterOuterAlt(localctx, 18)
 		{
-			p.SetState(202)
-			p.TransactionCommand()
+			p.SetState(242)
+			p.AppendCommand()
 		}
 
 	case 19:
 		p.EnterOuterAlt(localctx, 19)
 		{
-			p.SetState(203)
-			p.SpathCommand()
+			p.SetState(243)
+			p.AppendcolsCommand()
 		}
 
 	case 20:
 		p.EnterOuterAlt(localctx, 20)
 		{
-			p.SetState(204)
-			p.EventstatsCommand()
+			p.SetState(244)
+			p.AppendpipeCommand()
 		}
 
 	case 21:
 		p.EnterOuterAlt(localctx, 21)
 		{
-			p.SetState(205)
-			p.StreamstatsCommand()
+			p.SetState(245)
+			p.UnionCommand()
 		}
 
 	case 22:
 		p.EnterOuterAlt(localctx, 22)
 		{
-			p.SetState(206)
-			p.TimechartCommand()
+			p.SetState(246)
+			p.MultisearchCommand()
 		}
 
 	case 23:
 		p.EnterOuterAlt(localctx, 23)
 		{
-			p.SetState(207)
-			p.ChartCommand()
+			p.SetState(247)
+			p.TransactionCommand()
 		}
 
 	case 24:
 		p.EnterOuterAlt(localctx, 24)
 		{
-			p.SetState(208)
-			p.FillnullCommand()
+			p.SetState(248)
+			p.SpathCommand()
 		}
 
 	case 25:
 		p.EnterOuterAlt(localctx, 25)
 		{
-			p.SetState(209)
-			p.MakemvCommand()
+			p.SetState(249)
+			p.EventstatsCommand()
 		}
 
 	case 26:
 		p.EnterOuterAlt(localctx, 26)
 		{
-			p.SetState(210)
-			p.MvexpandCommand()
+			p.SetState(250)
+			p.StreamstatsCommand()
 		}
 
 	case 27:
 		p.EnterOuterAlt(localctx, 27)
 		{
-			p.SetState(211)
-			p.FormatCommand()
+			p.SetState(251)
+			p.TimechartCommand()
 		}
 
 	case 28:
 		p.EnterOuterAlt(localctx, 28)
 		{
-			p.SetState(212)
-			p.ConvertCommand()
+			p.SetState(252)
+			p.ChartCommand()
 		}
 
 	case 29:
 		p.EnterOuterAlt(localctx, 29)
 		{
-			p.SetState(213)
-			p.BucketCommand()
+			p.SetState(253)
+			p.FillnullCommand()
 		}
 
 	case 30:
 		p.EnterOuterAlt(localctx, 30)
 		{
-			p.SetState(214)
-			p.RestCommand()
+			p.SetState(254)
+			p.MakemvCommand()
 		}
 
 	case 31:
 		p.EnterOuterAlt(localctx, 31)
 		{
-			p.SetState(215)
-			p.TstatsCommand()
+			p.SetState(255)
+			p.MvexpandCommand()
 		}
 
 	case 32:
 		p.EnterOuterAlt(localctx, 32)
 		{
-			p.SetState(216)
-			p.MstatsCommand()
+			p.SetState(256)
+			p.FormatCommand()
 		}
 
 	case 33:
 		p.EnterOuterAlt(localctx, 33)
 		{
-			p.SetState(217)
-			p.InputlookupCommand()
+			p.SetState(257)
+			p.ReturnCommand()
 		}
 
 	case 34:
 		p.EnterOuterAlt(localctx, 34)
 		{
-			p.SetState(218)
+			p.SetState(258)
+			p.ForeachCommand()
+		}
+
+	case 35:
+		p.EnterOuterAlt(localctx, 35)
+		{
+			p.SetState(259)
+			p.MapCommand()
+		}
+
+	case 36:
+		p.EnterOuterAlt(localctx, 36)
+		{
+			p.SetState(260)
+			p.ConvertCommand()
+		}
+
+	case 37:
+		p.EnterOuterAlt(localctx, 37)
+		{
+			p.SetState(261)
+			p.BucketCommand()
+		}
+
+	case 38:
+		p.EnterOuterAlt(localctx, 38)
+		{
+			p.SetState(262)
+			p.RestCommand()
+		}
+
+	case 39:
+		p.EnterOuterAlt(localctx, 39)
+		{
+			p.SetState(263)
+			p.TstatsCommand()
+		}
+
+	case 40:
+		p.EnterOuterAlt(localctx, 40)
+		{
+			p.SetState(264)
+			p.MstatsCommand()
+		}
+
+	case 41:
+		p.EnterOuterAlt(localctx, 41)
+		{
+			p.SetState(265)
+			p.InputlookupCommand()
+		}
+
+	case 42:
+		p.EnterOuterAlt(localctx, 42)
+		{
+			p.SetState(266)
 			p.GenericCommand()
 		}
 
@@ -2010,19 +2461,13 @@ func (s *SearchCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 func (p *SPLParser) SearchCommand() (localctx ISearchCommandContext) {
 	localctx = NewSearchCommandContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 4, SPLParserRULE_searchCommand)
-	var _la int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(222)
+	p.SetState(270)
 	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SPLParserSEARCH {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(221)
+			p.SetState(269)
 			p.Match(SPLParserSEARCH)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2030,9 +2475,11 @@ func (p *SPLParser) SearchCommand() (localctx ISearchCommandContext) {
 			}
 		}
 
+	} else if p.HasError() { // JIM
+		goto errorExit
 	}
 	{
-		p.SetState(224)
+		p.SetState(272)
 		p.SearchExpression()
 	}
 
@@ -2151,7 +2598,7 @@ func (p *SPLParser) WhereCommand() (localctx IWhereCommandContext) {
 	p.EnterRule(localctx, 6, SPLParserRULE_whereCommand)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(226)
+		p.SetState(274)
 		p.Match(SPLParserWHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2159,7 +2606,7 @@ func (p *SPLParser) WhereCommand() (localctx IWhereCommandContext) {
 		}
 	}
 	{
-		p.SetState(227)
+		p.SetState(275)
 		p.Expression()
 	}
 
@@ -2316,7 +2763,7 @@ func (p *SPLParser) EvalCommand() (localctx IEvalCommandContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(229)
+		p.SetState(277)
 		p.Match(SPLParserEVAL)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2324,10 +2771,10 @@ func (p *SPLParser) EvalCommand() (localctx IEvalCommandContext) {
 		}
 	}
 	{
-		p.SetState(230)
+		p.SetState(278)
 		p.EvalAssignment()
 	}
-	p.SetState(235)
+	p.SetState(283)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2336,7 +2783,7 @@ func (p *SPLParser) EvalCommand() (localctx IEvalCommandContext) {
 
 	for _la == SPLParserCOMMA {
 		{
-			p.SetState(231)
+			p.SetState(279)
 			p.Match(SPLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2344,11 +2791,11 @@ func (p *SPLParser) EvalCommand() (localctx IEvalCommandContext) {
 			}
 		}
 		{
-			p.SetState(232)
+			p.SetState(280)
 			p.EvalAssignment()
 		}
 
-		p.SetState(237)
+		p.SetState(285)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2492,22 +2939,22 @@ func (p *SPLParser) EvalAssignment() (localctx IEvalAssignmentContext) {
 	localctx = NewEvalAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 10, SPLParserRULE_evalAssignment)
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(240)
+	p.SetState(288)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+	case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
 		{
-			p.SetState(238)
+			p.SetState(286)
 			p.FieldName()
 		}
 
 	case SPLParserQUOTED_STRING:
 		{
-			p.SetState(239)
+			p.SetState(287)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2520,7 +2967,7 @@ func (p *SPLParser) EvalAssignment() (localctx IEvalAssignmentContext) {
 		goto errorExit
 	}
 	{
-		p.SetState(242)
+		p.SetState(290)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2528,7 +2975,7 @@ func (p *SPLParser) EvalAssignment() (localctx IEvalAssignmentContext) {
 		}
 	}
 	{
-		p.SetState(243)
+		p.SetState(291)
 		p.Expression()
 	}
 
@@ -2707,7 +3154,7 @@ func (p *SPLParser) StatsCommand() (localctx IStatsCommandContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(245)
+		p.SetState(293)
 		p.Match(SPLParserSTATS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2715,10 +3162,10 @@ func (p *SPLParser) StatsCommand() (localctx IStatsCommandContext) {
 		}
 	}
 	{
-		p.SetState(246)
+		p.SetState(294)
 		p.StatsFunction()
 	}
-	p.SetState(253)
+	p.SetState(301)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2726,7 +3173,7 @@ func (p *SPLParser) StatsCommand() (localctx IStatsCommandContext) {
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SPLParserCOMMA || _la == SPLParserIDENTIFIER {
-		p.SetState(248)
+		p.SetState(296)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2735,7 +3182,7 @@ func (p *SPLParser) StatsCommand() (localctx IStatsCommandContext) {
 
 		if _la == SPLParserCOMMA {
 			{
-				p.SetState(247)
+				p.SetState(295)
 				p.Match(SPLParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -2745,18 +3192,18 @@ func (p *SPLParser) StatsCommand() (localctx IStatsCommandContext) {
 
 		}
 		{
-			p.SetState(250)
+			p.SetState(298)
 			p.StatsFunction()
 		}
 
-		p.SetState(255)
+		p.SetState(303)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(258)
+	p.SetState(306)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2765,7 +3212,7 @@ func (p *SPLParser) StatsCommand() (localctx IStatsCommandContext) {
 
 	if _la == SPLParserBY {
 		{
-			p.SetState(256)
+			p.SetState(304)
 			p.Match(SPLParserBY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2773,7 +3220,7 @@ func (p *SPLParser) StatsCommand() (localctx IStatsCommandContext) {
 			}
 		}
 		{
-			p.SetState(257)
+			p.SetState(305)
 			p.FieldList()
 		}
 
@@ -2931,7 +3378,7 @@ func (p *SPLParser) StatsFunction() (localctx IStatsFunctionContext) {
 	p.EnterRule(localctx, 14, SPLParserRULE_statsFunction)
 	var _la int
 
-	p.SetState(281)
+	p.SetState(329)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2941,7 +3388,7 @@ func (p *SPLParser) StatsFunction() (localctx IStatsFunctionContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(260)
+			p.SetState(308)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2949,36 +3396,36 @@ func (p *SPLParser) StatsFunction() (localctx IStatsFunctionContext) {
 			}
 		}
 		{
-			p.SetState(261)
+			p.SetState(309)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(263)
+		p.SetState(311)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&395205160952922632) != 0) || ((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&889) != 0) {
+		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2288669186840313080) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&824590379) != 0) {
 			{
-				p.SetState(262)
+				p.SetState(310)
 				p.Expression()
 			}
 
 		}
 		{
-			p.SetState(265)
+			p.SetState(313)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(271)
+		p.SetState(319)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2987,29 +3434,29 @@ func (p *SPLParser) StatsFunction() (localctx IStatsFunctionContext) {
 
 		if _la == SPLParserAS {
 			{
-				p.SetState(266)
+				p.SetState(314)
 				p.Match(SPLParserAS)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-			p.SetState(269)
+			p.SetState(317)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
 			switch p.GetTokenStream().LA(1) {
-			case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+			case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
 				{
-					p.SetState(267)
+					p.SetState(315)
 					p.FieldName()
 				}
 
 			case SPLParserQUOTED_STRING:
 				{
-					p.SetState(268)
+					p.SetState(316)
 					p.Match(SPLParserQUOTED_STRING)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -3027,14 +3474,14 @@ func (p *SPLParser) StatsFunction() (localctx IStatsFunctionContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(273)
+			p.SetState(321)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(279)
+		p.SetState(327)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3043,29 +3490,29 @@ func (p *SPLParser) StatsFunction() (localctx IStatsFunctionContext) {
 
 		if _la == SPLParserAS {
 			{
-				p.SetState(274)
+				p.SetState(322)
 				p.Match(SPLParserAS)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-			p.SetState(277)
+			p.SetState(325)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
 			switch p.GetTokenStream().LA(1) {
-			case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+			case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
 				{
-					p.SetState(275)
+					p.SetState(323)
 					p.FieldName()
 				}
 
 			case SPLParserQUOTED_STRING:
 				{
-					p.SetState(276)
+					p.SetState(324)
 					p.Match(SPLParserQUOTED_STRING)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -3199,7 +3646,7 @@ func (p *SPLParser) TableCommand() (localctx ITableCommandContext) {
 	p.EnterRule(localctx, 16, SPLParserRULE_tableCommand)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(283)
+		p.SetState(331)
 		p.Match(SPLParserTABLE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3207,7 +3654,7 @@ func (p *SPLParser) TableCommand() (localctx ITableCommandContext) {
 		}
 	}
 	{
-		p.SetState(284)
+		p.SetState(332)
 		p.FieldList()
 	}
 
@@ -3338,14 +3785,14 @@ func (p *SPLParser) FieldsCommand() (localctx IFieldsCommandContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(286)
+		p.SetState(334)
 		p.Match(SPLParserFIELDS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(288)
+	p.SetState(336)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3354,7 +3801,7 @@ func (p *SPLParser) FieldsCommand() (localctx IFieldsCommandContext) {
 
 	if _la == SPLParserPLUS || _la == SPLParserMINUS {
 		{
-			p.SetState(287)
+			p.SetState(335)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SPLParserPLUS || _la == SPLParserMINUS) {
@@ -3367,7 +3814,7 @@ func (p *SPLParser) FieldsCommand() (localctx IFieldsCommandContext) {
 
 	}
 	{
-		p.SetState(290)
+		p.SetState(338)
 		p.FieldList()
 	}
 
@@ -3524,7 +3971,7 @@ func (p *SPLParser) RenameCommand() (localctx IRenameCommandContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(292)
+		p.SetState(340)
 		p.Match(SPLParserRENAME)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3532,31 +3979,41 @@ func (p *SPLParser) RenameCommand() (localctx IRenameCommandContext) {
 		}
 	}
 	{
-		p.SetState(293)
+		p.SetState(341)
 		p.RenameSpec()
 	}
-	p.SetState(298)
+	p.SetState(348)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == SPLParserCOMMA {
-		{
-			p.SetState(294)
-			p.Match(SPLParserCOMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17173822373380352) != 0) || ((int64((_la-73)) & ^0x3f) == 0 && ((int64(1)<<(_la-73))&1606145) != 0) {
+		p.SetState(343)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SPLParserCOMMA {
+			{
+				p.SetState(342)
+				p.Match(SPLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
 			}
+
 		}
 		{
-			p.SetState(295)
+			p.SetState(345)
 			p.RenameSpec()
 		}
 
-		p.SetState(300)
+		p.SetState(350)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3710,33 +4167,33 @@ func (p *SPLParser) RenameSpec() (localctx IRenameSpecContext) {
 	p.EnterRule(localctx, 22, SPLParserRULE_renameSpec)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(301)
+		p.SetState(351)
 		p.FieldName()
 	}
 	{
-		p.SetState(302)
+		p.SetState(352)
 		p.Match(SPLParserAS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(305)
+	p.SetState(355)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+	case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
 		{
-			p.SetState(303)
+			p.SetState(353)
 			p.FieldName()
 		}
 
 	case SPLParserQUOTED_STRING:
 		{
-			p.SetState(304)
+			p.SetState(354)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3919,41 +4376,41 @@ func (p *SPLParser) RexCommand() (localctx IRexCommandContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(307)
+		p.SetState(357)
 		p.Match(SPLParserREX)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(311)
+	p.SetState(361)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 18, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 19, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(308)
+				p.SetState(358)
 				p.RexOption()
 			}
 
 		}
-		p.SetState(313)
+		p.SetState(363)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 18, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 19, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(319)
+	p.SetState(369)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3961,7 +4418,7 @@ func (p *SPLParser) RexCommand() (localctx IRexCommandContext) {
 	switch p.GetTokenStream().LA(1) {
 	case SPLParserQUOTED_STRING:
 		{
-			p.SetState(314)
+			p.SetState(364)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3969,13 +4426,13 @@ func (p *SPLParser) RexCommand() (localctx IRexCommandContext) {
 			}
 		}
 
-	case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+	case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
 		{
-			p.SetState(315)
+			p.SetState(365)
 			p.FieldName()
 		}
 		{
-			p.SetState(316)
+			p.SetState(366)
 			p.Match(SPLParserEQ)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3983,7 +4440,7 @@ func (p *SPLParser) RexCommand() (localctx IRexCommandContext) {
 			}
 		}
 		{
-			p.SetState(317)
+			p.SetState(367)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4126,7 +4583,7 @@ func (p *SPLParser) RexOption() (localctx IRexOptionContext) {
 	p.EnterRule(localctx, 26, SPLParserRULE_rexOption)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(321)
+		p.SetState(371)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4134,23 +4591,23 @@ func (p *SPLParser) RexOption() (localctx IRexOptionContext) {
 		}
 	}
 	{
-		p.SetState(322)
+		p.SetState(372)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(326)
+	p.SetState(376)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(323)
+			p.SetState(373)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4160,13 +4617,13 @@ func (p *SPLParser) RexOption() (localctx IRexOptionContext) {
 
 	case 2:
 		{
-			p.SetState(324)
+			p.SetState(374)
 			p.FieldName()
 		}
 
 	case 3:
 		{
-			p.SetState(325)
+			p.SetState(375)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4191,6 +4648,179 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// IRegexCommandContext is an interface to support dynamic dispatch.
+type IRegexCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	REGEX() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	EQ() antlr.TerminalNode
+	NEQ() antlr.TerminalNode
+
+	// IsRegexCommandContext differentiates from other interfaces.
+	IsRegexCommandContext()
+}
+
+type RegexCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRegexCommandContext() *RegexCommandContext {
+	var p = new(RegexCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_regexCommand
+	return p
+}
+
+func InitEmptyRegexCommandContext(p *RegexCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_regexCommand
+}
+
+func (*RegexCommandContext) IsRegexCommandContext() {}
+
+func NewRegexCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RegexCommandContext {
+	var p = new(RegexCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_regexCommand
+
+	return p
+}
+
+func (s *RegexCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RegexCommandContext) REGEX() antlr.TerminalNode {
+	return s.GetToken(SPLParserREGEX, 0)
+}
+
+func (s *RegexCommandContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *RegexCommandContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *RegexCommandContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *RegexCommandContext) NEQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserNEQ, 0)
+}
+
+func (s *RegexCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RegexCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RegexCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterRegexCommand(s)
+	}
+}
+
+func (s *RegexCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitRegexCommand(s)
+	}
+}
+
+func (s *RegexCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitRegexCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) RegexCommand() (localctx IRegexCommandContext) {
+	localctx = NewRegexCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, SPLParserRULE_regexCommand)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(378)
+		p.Match(SPLParserREGEX)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(382)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17173822373380352) != 0) || ((int64((_la-82)) & ^0x3f) == 0 && ((int64(1)<<(_la-82))&3137) != 0) {
+		{
+			p.SetState(379)
+			p.FieldName()
+		}
+		{
+			p.SetState(380)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SPLParserEQ || _la == SPLParserNEQ) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	}
+	{
+		p.SetState(384)
+		p.Match(SPLParserQUOTED_STRING)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 // IDedupCommandContext is an interface to support dynamic dispatch.
 type IDedupCommandContext interface {
 	antlr.ParserRuleContext
@@ -4338,24 +4968,24 @@ func (s *DedupCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) DedupCommand() (localctx IDedupCommandContext) {
 	localctx = NewDedupCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SPLParserRULE_dedupCommand)
+	p.EnterRule(localctx, 30, SPLParserRULE_dedupCommand)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(328)
+		p.SetState(386)
 		p.Match(SPLParserDEDUP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(330)
+	p.SetState(388)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 23, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(329)
+			p.SetState(387)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4367,10 +4997,10 @@ func (p *SPLParser) DedupCommand() (localctx IDedupCommandContext) {
 		goto errorExit
 	}
 	{
-		p.SetState(332)
+		p.SetState(390)
 		p.FieldList()
 	}
-	p.SetState(336)
+	p.SetState(394)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4379,11 +5009,11 @@ func (p *SPLParser) DedupCommand() (localctx IDedupCommandContext) {
 
 	for _la == SPLParserIDENTIFIER {
 		{
-			p.SetState(333)
+			p.SetState(391)
 			p.DedupOption()
 		}
 
-		p.SetState(338)
+		p.SetState(396)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4518,10 +5148,10 @@ func (s *DedupOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) DedupOption() (localctx IDedupOptionContext) {
 	localctx = NewDedupOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SPLParserRULE_dedupOption)
+	p.EnterRule(localctx, 32, SPLParserRULE_dedupOption)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(339)
+		p.SetState(397)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4529,23 +5159,23 @@ func (p *SPLParser) DedupOption() (localctx IDedupOptionContext) {
 		}
 	}
 	{
-		p.SetState(340)
+		p.SetState(398)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(344)
+	p.SetState(402)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 23, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 25, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(341)
+			p.SetState(399)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4555,13 +5185,13 @@ func (p *SPLParser) DedupOption() (localctx IDedupOptionContext) {
 
 	case 2:
 		{
-			p.SetState(342)
+			p.SetState(400)
 			p.FieldName()
 		}
 
 	case 3:
 		{
-			p.SetState(343)
+			p.SetState(401)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4726,24 +5356,24 @@ func (s *SortCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) SortCommand() (localctx ISortCommandContext) {
 	localctx = NewSortCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SPLParserRULE_sortCommand)
+	p.EnterRule(localctx, 34, SPLParserRULE_sortCommand)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(346)
+		p.SetState(404)
 		p.Match(SPLParserSORT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(348)
+	p.SetState(406)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 26, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(347)
+			p.SetState(405)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4755,10 +5385,10 @@ func (p *SPLParser) SortCommand() (localctx ISortCommandContext) {
 		goto errorExit
 	}
 	{
-		p.SetState(350)
+		p.SetState(408)
 		p.SortField()
 	}
-	p.SetState(355)
+	p.SetState(413)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4767,7 +5397,7 @@ func (p *SPLParser) SortCommand() (localctx ISortCommandContext) {
 
 	for _la == SPLParserCOMMA {
 		{
-			p.SetState(351)
+			p.SetState(409)
 			p.Match(SPLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4775,11 +5405,11 @@ func (p *SPLParser) SortCommand() (localctx ISortCommandContext) {
 			}
 		}
 		{
-			p.SetState(352)
+			p.SetState(410)
 			p.SortField()
 		}
 
-		p.SetState(357)
+		p.SetState(415)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4809,6 +5439,7 @@ type ISortFieldContext interface {
 
 	// Getter signatures
 	FieldName() IFieldNameContext
+	QUOTED_STRING() antlr.TerminalNode
 	PLUS() antlr.TerminalNode
 	MINUS() antlr.TerminalNode
 
@@ -4864,6 +5495,10 @@ func (s *SortFieldContext) FieldName() IFieldNameContext {
 	return t.(IFieldNameContext)
 }
 
+func (s *SortFieldContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
 func (s *SortFieldContext) PLUS() antlr.TerminalNode {
 	return s.GetToken(SPLParserPLUS, 0)
 }
@@ -4904,11 +5539,11 @@ func (s *SortFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) SortField() (localctx ISortFieldContext) {
 	localctx = NewSortFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SPLParserRULE_sortField)
+	p.EnterRule(localctx, 36, SPLParserRULE_sortField)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(359)
+	p.SetState(417)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4917,7 +5552,7 @@ func (p *SPLParser) SortField() (localctx ISortFieldContext) {
 
 	if _la == SPLParserPLUS || _la == SPLParserMINUS {
 		{
-			p.SetState(358)
+			p.SetState(416)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SPLParserPLUS || _la == SPLParserMINUS) {
@@ -4929,9 +5564,32 @@ func (p *SPLParser) SortField() (localctx ISortFieldContext) {
 		}
 
 	}
-	{
-		p.SetState(361)
-		p.FieldName()
+	p.SetState(421)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+		{
+			p.SetState(419)
+			p.FieldName()
+		}
+
+	case SPLParserQUOTED_STRING:
+		{
+			p.SetState(420)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -5034,19 +5692,19 @@ func (s *HeadCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) HeadCommand() (localctx IHeadCommandContext) {
 	localctx = NewHeadCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SPLParserRULE_headCommand)
+	p.EnterRule(localctx, 38, SPLParserRULE_headCommand)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(363)
+		p.SetState(423)
 		p.Match(SPLParserHEAD)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(365)
+	p.SetState(425)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5055,7 +5713,7 @@ func (p *SPLParser) HeadCommand() (localctx IHeadCommandContext) {
 
 	if _la == SPLParserNUMBER {
 		{
-			p.SetState(364)
+			p.SetState(424)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5165,19 +5823,19 @@ func (s *TailCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) TailCommand() (localctx ITailCommandContext) {
 	localctx = NewTailCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SPLParserRULE_tailCommand)
+	p.EnterRule(localctx, 40, SPLParserRULE_tailCommand)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(367)
+		p.SetState(427)
 		p.Match(SPLParserTAIL)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(369)
+	p.SetState(429)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5186,7 +5844,7 @@ func (p *SPLParser) TailCommand() (localctx ITailCommandContext) {
 
 	if _la == SPLParserNUMBER {
 		{
-			p.SetState(368)
+			p.SetState(428)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5221,6 +5879,8 @@ type ITopCommandContext interface {
 	AllFieldList() []IFieldListContext
 	FieldList(i int) IFieldListContext
 	NUMBER() antlr.TerminalNode
+	AllTopOption() []ITopOptionContext
+	TopOption(i int) ITopOptionContext
 	BY() antlr.TerminalNode
 
 	// IsTopCommandContext differentiates from other interfaces.
@@ -5308,6 +5968,47 @@ func (s *TopCommandContext) NUMBER() antlr.TerminalNode {
 	return s.GetToken(SPLParserNUMBER, 0)
 }
 
+func (s *TopCommandContext) AllTopOption() []ITopOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITopOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITopOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITopOptionContext); ok {
+			tst[i] = t.(ITopOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TopCommandContext) TopOption(i int) ITopOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITopOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITopOptionContext)
+}
+
 func (s *TopCommandContext) BY() antlr.TerminalNode {
 	return s.GetToken(SPLParserBY, 0)
 }
@@ -5344,24 +6045,26 @@ func (s *TopCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) TopCommand() (localctx ITopCommandContext) {
 	localctx = NewTopCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SPLParserRULE_topCommand)
+	p.EnterRule(localctx, 42, SPLParserRULE_topCommand)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(371)
+		p.SetState(431)
 		p.Match(SPLParserTOP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(373)
+	p.SetState(433)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(372)
+			p.SetState(432)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5372,11 +6075,38 @@ func (p *SPLParser) TopCommand() (localctx ITopCommandContext) {
 	} else if p.HasError() { // JIM
 		goto errorExit
 	}
+	p.SetState(438)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(435)
+				p.TopOption()
+			}
+
+		}
+		p.SetState(440)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
 	{
-		p.SetState(375)
+		p.SetState(441)
 		p.FieldList()
 	}
-	p.SetState(378)
+	p.SetState(444)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5385,7 +6115,7 @@ func (p *SPLParser) TopCommand() (localctx ITopCommandContext) {
 
 	if _la == SPLParserBY {
 		{
-			p.SetState(376)
+			p.SetState(442)
 			p.Match(SPLParserBY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5393,7 +6123,7 @@ func (p *SPLParser) TopCommand() (localctx ITopCommandContext) {
 			}
 		}
 		{
-			p.SetState(377)
+			p.SetState(443)
 			p.FieldList()
 		}
 
@@ -5412,21 +6142,205 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IRareCommandContext is an interface to support dynamic dispatch.
-type IRareCommandContext interface {
+// ITopOptionContext is an interface to support dynamic dispatch.
+type ITopOptionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	RARE() antlr.TerminalNode
-	AllFieldList() []IFieldListContext
-	FieldList(i int) IFieldListContext
+	IDENTIFIER() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
 	NUMBER() antlr.TerminalNode
-	BY() antlr.TerminalNode
 
-	// IsRareCommandContext differentiates from other interfaces.
+	// IsTopOptionContext differentiates from other interfaces.
+	IsTopOptionContext()
+}
+
+type TopOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTopOptionContext() *TopOptionContext {
+	var p = new(TopOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_topOption
+	return p
+}
+
+func InitEmptyTopOptionContext(p *TopOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_topOption
+}
+
+func (*TopOptionContext) IsTopOptionContext() {}
+
+func NewTopOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TopOptionContext {
+	var p = new(TopOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_topOption
+
+	return p
+}
+
+func (s *TopOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TopOptionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, 0)
+}
+
+func (s *TopOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *TopOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *TopOptionContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *TopOptionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *TopOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TopOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TopOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterTopOption(s)
+	}
+}
+
+func (s *TopOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitTopOption(s)
+	}
+}
+
+func (s *TopOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitTopOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) TopOption() (localctx ITopOptionContext) {
+	localctx = NewTopOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SPLParserRULE_topOption)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(446)
+		p.Match(SPLParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(447)
+		p.Match(SPLParserEQ)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(451)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 35, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(448)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(449)
+			p.FieldName()
+		}
+
+	case 3:
+		{
+			p.SetState(450)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRareCommandContext is an interface to support dynamic dispatch.
+type IRareCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	RARE() antlr.TerminalNode
+	AllFieldList() []IFieldListContext
+	FieldList(i int) IFieldListContext
+	NUMBER() antlr.TerminalNode
+	AllTopOption() []ITopOptionContext
+	TopOption(i int) ITopOptionContext
+	BY() antlr.TerminalNode
+
+	// IsRareCommandContext differentiates from other interfaces.
 	IsRareCommandContext()
 }
 
@@ -5511,6 +6425,47 @@ func (s *RareCommandContext) NUMBER() antlr.TerminalNode {
 	return s.GetToken(SPLParserNUMBER, 0)
 }
 
+func (s *RareCommandContext) AllTopOption() []ITopOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITopOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITopOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITopOptionContext); ok {
+			tst[i] = t.(ITopOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RareCommandContext) TopOption(i int) ITopOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITopOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITopOptionContext)
+}
+
 func (s *RareCommandContext) BY() antlr.TerminalNode {
 	return s.GetToken(SPLParserBY, 0)
 }
@@ -5547,24 +6502,26 @@ func (s *RareCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) RareCommand() (localctx IRareCommandContext) {
 	localctx = NewRareCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SPLParserRULE_rareCommand)
+	p.EnterRule(localctx, 46, SPLParserRULE_rareCommand)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(380)
+		p.SetState(453)
 		p.Match(SPLParserRARE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(382)
+	p.SetState(455)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(381)
+			p.SetState(454)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5575,11 +6532,38 @@ func (p *SPLParser) RareCommand() (localctx IRareCommandContext) {
 	} else if p.HasError() { // JIM
 		goto errorExit
 	}
+	p.SetState(460)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 37, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(457)
+				p.TopOption()
+			}
+
+		}
+		p.SetState(462)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 37, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
 	{
-		p.SetState(384)
+		p.SetState(463)
 		p.FieldList()
 	}
-	p.SetState(387)
+	p.SetState(466)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5588,7 +6572,7 @@ func (p *SPLParser) RareCommand() (localctx IRareCommandContext) {
 
 	if _la == SPLParserBY {
 		{
-			p.SetState(385)
+			p.SetState(464)
 			p.Match(SPLParserBY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5596,7 +6580,7 @@ func (p *SPLParser) RareCommand() (localctx IRareCommandContext) {
 			}
 		}
 		{
-			p.SetState(386)
+			p.SetState(465)
 			p.FieldList()
 		}
 
@@ -5625,9 +6609,12 @@ type ILookupCommandContext interface {
 	// Getter signatures
 	LOOKUP() antlr.TerminalNode
 	IDENTIFIER() antlr.TerminalNode
-	FieldList() IFieldListContext
+	QUOTED_STRING() antlr.TerminalNode
 	AllLookupOption() []ILookupOptionContext
 	LookupOption(i int) ILookupOptionContext
+	AllLookupFieldMapping() []ILookupFieldMappingContext
+	LookupFieldMapping(i int) ILookupFieldMappingContext
+	LookupOutput() ILookupOutputContext
 
 	// IsLookupCommandContext differentiates from other interfaces.
 	IsLookupCommandContext()
@@ -5673,20 +6660,8 @@ func (s *LookupCommandContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(SPLParserIDENTIFIER, 0)
 }
 
-func (s *LookupCommandContext) FieldList() IFieldListContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFieldListContext)
+func (s *LookupCommandContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
 func (s *LookupCommandContext) AllLookupOption() []ILookupOptionContext {
@@ -5730,88 +6705,180 @@ func (s *LookupCommandContext) LookupOption(i int) ILookupOptionContext {
 	return t.(ILookupOptionContext)
 }
 
-func (s *LookupCommandContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *LookupCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *LookupCommandContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterLookupCommand(s)
+func (s *LookupCommandContext) AllLookupFieldMapping() []ILookupFieldMappingContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ILookupFieldMappingContext); ok {
+			len++
+		}
 	}
-}
 
-func (s *LookupCommandContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitLookupCommand(s)
+	tst := make([]ILookupFieldMappingContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ILookupFieldMappingContext); ok {
+			tst[i] = t.(ILookupFieldMappingContext)
+			i++
+		}
 	}
-}
 
-func (s *LookupCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SPLParserVisitor:
-		return t.VisitLookupCommand(s)
+	return tst
+}
 
-	default:
-		return t.VisitChildren(s)
+func (s *LookupCommandContext) LookupFieldMapping(i int) ILookupFieldMappingContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILookupFieldMappingContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILookupFieldMappingContext)
+}
+
+func (s *LookupCommandContext) LookupOutput() ILookupOutputContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILookupOutputContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILookupOutputContext)
+}
+
+func (s *LookupCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LookupCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LookupCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterLookupCommand(s)
+	}
+}
+
+func (s *LookupCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitLookupCommand(s)
+	}
+}
+
+func (s *LookupCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitLookupCommand(s)
+
+	default:
+		return t.VisitChildren(s)
 	}
 }
 
 func (p *SPLParser) LookupCommand() (localctx ILookupCommandContext) {
 	localctx = NewLookupCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SPLParserRULE_lookupCommand)
+	p.EnterRule(localctx, 48, SPLParserRULE_lookupCommand)
+	var _la int
+
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(389)
+		p.SetState(468)
 		p.Match(SPLParserLOOKUP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(393)
+	p.SetState(472)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 39, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(390)
+				p.SetState(469)
 				p.LookupOption()
 			}
 
 		}
-		p.SetState(395)
+		p.SetState(474)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 39, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(396)
-		p.Match(SPLParserIDENTIFIER)
+		p.SetState(475)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SPLParserQUOTED_STRING || _la == SPLParserIDENTIFIER) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	p.SetState(477)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == SPLParserQUOTED_STRING || _la == SPLParserIDENTIFIER {
+		{
+			p.SetState(476)
+			p.LookupFieldMapping()
+		}
+
+		p.SetState(479)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(397)
-		p.FieldList()
+	p.SetState(482)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SPLParserOUTPUT || _la == SPLParserOUTPUTNEW {
+		{
+			p.SetState(481)
+			p.LookupOutput()
+		}
+
 	}
 
 errorExit:
@@ -5827,74 +6894,94 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ILookupOptionContext is an interface to support dynamic dispatch.
-type ILookupOptionContext interface {
+// ILookupOutputContext is an interface to support dynamic dispatch.
+type ILookupOutputContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	EQ() antlr.TerminalNode
-	QUOTED_STRING() antlr.TerminalNode
-	FieldName() IFieldNameContext
-	NUMBER() antlr.TerminalNode
+	OUTPUT() antlr.TerminalNode
+	OUTPUTNEW() antlr.TerminalNode
+	AllLookupFieldMapping() []ILookupFieldMappingContext
+	LookupFieldMapping(i int) ILookupFieldMappingContext
 
-	// IsLookupOptionContext differentiates from other interfaces.
-	IsLookupOptionContext()
+	// IsLookupOutputContext differentiates from other interfaces.
+	IsLookupOutputContext()
 }
 
-type LookupOptionContext struct {
+type LookupOutputContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLookupOptionContext() *LookupOptionContext {
-	var p = new(LookupOptionContext)
+func NewEmptyLookupOutputContext() *LookupOutputContext {
+	var p = new(LookupOutputContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_lookupOption
+	p.RuleIndex = SPLParserRULE_lookupOutput
 	return p
 }
 
-func InitEmptyLookupOptionContext(p *LookupOptionContext) {
+func InitEmptyLookupOutputContext(p *LookupOutputContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_lookupOption
+	p.RuleIndex = SPLParserRULE_lookupOutput
 }
 
-func (*LookupOptionContext) IsLookupOptionContext() {}
+func (*LookupOutputContext) IsLookupOutputContext() {}
 
-func NewLookupOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LookupOptionContext {
-	var p = new(LookupOptionContext)
+func NewLookupOutputContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LookupOutputContext {
+	var p = new(LookupOutputContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_lookupOption
+	p.RuleIndex = SPLParserRULE_lookupOutput
 
 	return p
 }
 
-func (s *LookupOptionContext) GetParser() antlr.Parser { return s.parser }
+func (s *LookupOutputContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LookupOptionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SPLParserIDENTIFIER, 0)
+func (s *LookupOutputContext) OUTPUT() antlr.TerminalNode {
+	return s.GetToken(SPLParserOUTPUT, 0)
 }
 
-func (s *LookupOptionContext) EQ() antlr.TerminalNode {
-	return s.GetToken(SPLParserEQ, 0)
+func (s *LookupOutputContext) OUTPUTNEW() antlr.TerminalNode {
+	return s.GetToken(SPLParserOUTPUTNEW, 0)
 }
 
-func (s *LookupOptionContext) QUOTED_STRING() antlr.TerminalNode {
-	return s.GetToken(SPLParserQUOTED_STRING, 0)
+func (s *LookupOutputContext) AllLookupFieldMapping() []ILookupFieldMappingContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ILookupFieldMappingContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ILookupFieldMappingContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ILookupFieldMappingContext); ok {
+			tst[i] = t.(ILookupFieldMappingContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *LookupOptionContext) FieldName() IFieldNameContext {
+func (s *LookupOutputContext) LookupFieldMapping(i int) ILookupFieldMappingContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ILookupFieldMappingContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -5902,98 +6989,75 @@ func (s *LookupOptionContext) FieldName() IFieldNameContext {
 		return nil
 	}
 
-	return t.(IFieldNameContext)
-}
-
-func (s *LookupOptionContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(SPLParserNUMBER, 0)
+	return t.(ILookupFieldMappingContext)
 }
 
-func (s *LookupOptionContext) GetRuleContext() antlr.RuleContext {
+func (s *LookupOutputContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LookupOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LookupOutputContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LookupOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LookupOutputContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterLookupOption(s)
+		listenerT.EnterLookupOutput(s)
 	}
 }
 
-func (s *LookupOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LookupOutputContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitLookupOption(s)
+		listenerT.ExitLookupOutput(s)
 	}
 }
 
-func (s *LookupOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LookupOutputContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitLookupOption(s)
+		return t.VisitLookupOutput(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) LookupOption() (localctx ILookupOptionContext) {
-	localctx = NewLookupOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SPLParserRULE_lookupOption)
+func (p *SPLParser) LookupOutput() (localctx ILookupOutputContext) {
+	localctx = NewLookupOutputContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SPLParserRULE_lookupOutput)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(399)
-		p.Match(SPLParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(400)
-		p.Match(SPLParserEQ)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		p.SetState(484)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SPLParserOUTPUT || _la == SPLParserOUTPUTNEW) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 	}
-	p.SetState(404)
+	p.SetState(488)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(401)
-			p.Match(SPLParserQUOTED_STRING)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case 2:
+	for _la == SPLParserQUOTED_STRING || _la == SPLParserIDENTIFIER {
 		{
-			p.SetState(402)
-			p.FieldName()
+			p.SetState(485)
+			p.LookupFieldMapping()
 		}
 
-	case 3:
-		{
-			p.SetState(403)
-			p.Match(SPLParserNUMBER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+		p.SetState(490)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -6009,90 +7073,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IJoinCommandContext is an interface to support dynamic dispatch.
-type IJoinCommandContext interface {
+// ILookupFieldMappingContext is an interface to support dynamic dispatch.
+type ILookupFieldMappingContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	JOIN() antlr.TerminalNode
-	Subsearch() ISubsearchContext
-	AllJoinOption() []IJoinOptionContext
-	JoinOption(i int) IJoinOptionContext
-	FieldList() IFieldListContext
+	AllLookupField() []ILookupFieldContext
+	LookupField(i int) ILookupFieldContext
+	AS() antlr.TerminalNode
+	COMMA() antlr.TerminalNode
 
-	// IsJoinCommandContext differentiates from other interfaces.
-	IsJoinCommandContext()
+	// IsLookupFieldMappingContext differentiates from other interfaces.
+	IsLookupFieldMappingContext()
 }
 
-type JoinCommandContext struct {
+type LookupFieldMappingContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyJoinCommandContext() *JoinCommandContext {
-	var p = new(JoinCommandContext)
+func NewEmptyLookupFieldMappingContext() *LookupFieldMappingContext {
+	var p = new(LookupFieldMappingContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_joinCommand
+	p.RuleIndex = SPLParserRULE_lookupFieldMapping
 	return p
 }
 
-func InitEmptyJoinCommandContext(p *JoinCommandContext) {
+func InitEmptyLookupFieldMappingContext(p *LookupFieldMappingContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_joinCommand
+	p.RuleIndex = SPLParserRULE_lookupFieldMapping
 }
 
-func (*JoinCommandContext) IsJoinCommandContext() {}
+func (*LookupFieldMappingContext) IsLookupFieldMappingContext() {}
 
-func NewJoinCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinCommandContext {
-	var p = new(JoinCommandContext)
+func NewLookupFieldMappingContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LookupFieldMappingContext {
+	var p = new(LookupFieldMappingContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_joinCommand
+	p.RuleIndex = SPLParserRULE_lookupFieldMapping
 
 	return p
 }
 
-func (s *JoinCommandContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *JoinCommandContext) JOIN() antlr.TerminalNode {
-	return s.GetToken(SPLParserJOIN, 0)
-}
-
-func (s *JoinCommandContext) Subsearch() ISubsearchContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISubsearchContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISubsearchContext)
-}
+func (s *LookupFieldMappingContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *JoinCommandContext) AllJoinOption() []IJoinOptionContext {
+func (s *LookupFieldMappingContext) AllLookupField() []ILookupFieldContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IJoinOptionContext); ok {
+		if _, ok := ctx.(ILookupFieldContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IJoinOptionContext, len)
+	tst := make([]ILookupFieldContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IJoinOptionContext); ok {
-			tst[i] = t.(IJoinOptionContext)
+		if t, ok := ctx.(ILookupFieldContext); ok {
+			tst[i] = t.(ILookupFieldContext)
 			i++
 		}
 	}
@@ -6100,11 +7143,11 @@ func (s *JoinCommandContext) AllJoinOption() []IJoinOptionContext {
 	return tst
 }
 
-func (s *JoinCommandContext) JoinOption(i int) IJoinOptionContext {
+func (s *LookupFieldMappingContext) LookupField(i int) ILookupFieldContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJoinOptionContext); ok {
+		if _, ok := ctx.(ILookupFieldContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -6117,152 +7160,96 @@ func (s *JoinCommandContext) JoinOption(i int) IJoinOptionContext {
 		return nil
 	}
 
-	return t.(IJoinOptionContext)
+	return t.(ILookupFieldContext)
 }
 
-func (s *JoinCommandContext) FieldList() IFieldListContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *LookupFieldMappingContext) AS() antlr.TerminalNode {
+	return s.GetToken(SPLParserAS, 0)
+}
 
-	return t.(IFieldListContext)
+func (s *LookupFieldMappingContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(SPLParserCOMMA, 0)
 }
 
-func (s *JoinCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *LookupFieldMappingContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *JoinCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LookupFieldMappingContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *JoinCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LookupFieldMappingContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterJoinCommand(s)
+		listenerT.EnterLookupFieldMapping(s)
 	}
 }
 
-func (s *JoinCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LookupFieldMappingContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitJoinCommand(s)
+		listenerT.ExitLookupFieldMapping(s)
 	}
 }
 
-func (s *JoinCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LookupFieldMappingContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitJoinCommand(s)
+		return t.VisitLookupFieldMapping(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) JoinCommand() (localctx IJoinCommandContext) {
-	localctx = NewJoinCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SPLParserRULE_joinCommand)
+func (p *SPLParser) LookupFieldMapping() (localctx ILookupFieldMappingContext) {
+	localctx = NewLookupFieldMappingContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SPLParserRULE_lookupFieldMapping)
 	var _la int
 
-	var _alt int
-
-	p.SetState(424)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(491)
+		p.LookupField()
+	}
+	p.SetState(494)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 37, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	if _la == SPLParserAS {
 		{
-			p.SetState(406)
-			p.Match(SPLParserJOIN)
+			p.SetState(492)
+			p.Match(SPLParserAS)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(410)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == SPLParserIDENTIFIER {
-			{
-				p.SetState(407)
-				p.JoinOption()
-			}
-
-			p.SetState(412)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
 		{
-			p.SetState(413)
-			p.Subsearch()
+			p.SetState(493)
+			p.LookupField()
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+	}
+	p.SetState(497)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SPLParserCOMMA {
 		{
-			p.SetState(414)
-			p.Match(SPLParserJOIN)
+			p.SetState(496)
+			p.Match(SPLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(418)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 36, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				{
-					p.SetState(415)
-					p.JoinOption()
-				}
-
-			}
-			p.SetState(420)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 36, p.GetParserRuleContext())
-			if p.HasError() {
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(421)
-			p.FieldList()
-		}
-		{
-			p.SetState(422)
-			p.Subsearch()
-		}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -6278,172 +7265,174 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IJoinOptionContext is an interface to support dynamic dispatch.
-type IJoinOptionContext interface {
+// ILookupFieldContext is an interface to support dynamic dispatch.
+type ILookupFieldContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	EQ() antlr.TerminalNode
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
+	AllMINUS() []antlr.TerminalNode
+	MINUS(i int) antlr.TerminalNode
 	QUOTED_STRING() antlr.TerminalNode
-	FieldName() IFieldNameContext
-	NUMBER() antlr.TerminalNode
 
-	// IsJoinOptionContext differentiates from other interfaces.
-	IsJoinOptionContext()
+	// IsLookupFieldContext differentiates from other interfaces.
+	IsLookupFieldContext()
 }
 
-type JoinOptionContext struct {
+type LookupFieldContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyJoinOptionContext() *JoinOptionContext {
-	var p = new(JoinOptionContext)
+func NewEmptyLookupFieldContext() *LookupFieldContext {
+	var p = new(LookupFieldContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_joinOption
+	p.RuleIndex = SPLParserRULE_lookupField
 	return p
 }
 
-func InitEmptyJoinOptionContext(p *JoinOptionContext) {
+func InitEmptyLookupFieldContext(p *LookupFieldContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_joinOption
+	p.RuleIndex = SPLParserRULE_lookupField
 }
 
-func (*JoinOptionContext) IsJoinOptionContext() {}
+func (*LookupFieldContext) IsLookupFieldContext() {}
 
-func NewJoinOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinOptionContext {
-	var p = new(JoinOptionContext)
+func NewLookupFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LookupFieldContext {
+	var p = new(LookupFieldContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_joinOption
+	p.RuleIndex = SPLParserRULE_lookupField
 
 	return p
 }
 
-func (s *JoinOptionContext) GetParser() antlr.Parser { return s.parser }
+func (s *LookupFieldContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *JoinOptionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SPLParserIDENTIFIER, 0)
+func (s *LookupFieldContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserIDENTIFIER)
 }
 
-func (s *JoinOptionContext) EQ() antlr.TerminalNode {
-	return s.GetToken(SPLParserEQ, 0)
+func (s *LookupFieldContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, i)
 }
 
-func (s *JoinOptionContext) QUOTED_STRING() antlr.TerminalNode {
-	return s.GetToken(SPLParserQUOTED_STRING, 0)
+func (s *LookupFieldContext) AllMINUS() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserMINUS)
 }
 
-func (s *JoinOptionContext) FieldName() IFieldNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFieldNameContext)
+func (s *LookupFieldContext) MINUS(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserMINUS, i)
 }
 
-func (s *JoinOptionContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(SPLParserNUMBER, 0)
+func (s *LookupFieldContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
-func (s *JoinOptionContext) GetRuleContext() antlr.RuleContext {
+func (s *LookupFieldContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *JoinOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LookupFieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *JoinOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LookupFieldContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterJoinOption(s)
+		listenerT.EnterLookupField(s)
 	}
 }
 
-func (s *JoinOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LookupFieldContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitJoinOption(s)
+		listenerT.ExitLookupField(s)
 	}
 }
 
-func (s *JoinOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LookupFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitJoinOption(s)
+		return t.VisitLookupField(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) JoinOption() (localctx IJoinOptionContext) {
-	localctx = NewJoinOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SPLParserRULE_joinOption)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(426)
-		p.Match(SPLParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(427)
-		p.Match(SPLParserEQ)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(431)
+func (p *SPLParser) LookupField() (localctx ILookupFieldContext) {
+	localctx = NewLookupFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SPLParserRULE_lookupField)
+	var _la int
+
+	p.SetState(508)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 38, p.GetParserRuleContext()) {
-	case 1:
+	switch p.GetTokenStream().LA(1) {
+	case SPLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(428)
-			p.Match(SPLParserQUOTED_STRING)
+			p.SetState(499)
+			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+		p.SetState(504)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-	case 2:
-		{
-			p.SetState(429)
-			p.FieldName()
+		for _la == SPLParserMINUS {
+			{
+				p.SetState(500)
+				p.Match(SPLParserMINUS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(501)
+				p.Match(SPLParserIDENTIFIER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(506)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 		}
 
-	case 3:
+	case SPLParserQUOTED_STRING:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(430)
-			p.Match(SPLParserNUMBER)
+			p.SetState(507)
+			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	case antlr.ATNInvalidAltNumber:
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
@@ -6460,61 +7449,72 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IAppendCommandContext is an interface to support dynamic dispatch.
-type IAppendCommandContext interface {
+// ILookupOptionContext is an interface to support dynamic dispatch.
+type ILookupOptionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	APPEND() antlr.TerminalNode
-	Subsearch() ISubsearchContext
+	IDENTIFIER() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	NUMBER() antlr.TerminalNode
 
-	// IsAppendCommandContext differentiates from other interfaces.
-	IsAppendCommandContext()
+	// IsLookupOptionContext differentiates from other interfaces.
+	IsLookupOptionContext()
 }
 
-type AppendCommandContext struct {
+type LookupOptionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAppendCommandContext() *AppendCommandContext {
-	var p = new(AppendCommandContext)
+func NewEmptyLookupOptionContext() *LookupOptionContext {
+	var p = new(LookupOptionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_appendCommand
+	p.RuleIndex = SPLParserRULE_lookupOption
 	return p
 }
 
-func InitEmptyAppendCommandContext(p *AppendCommandContext) {
+func InitEmptyLookupOptionContext(p *LookupOptionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_appendCommand
+	p.RuleIndex = SPLParserRULE_lookupOption
 }
 
-func (*AppendCommandContext) IsAppendCommandContext() {}
-
-func NewAppendCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AppendCommandContext {
-	var p = new(AppendCommandContext)
+func (*LookupOptionContext) IsLookupOptionContext() {}
+
+func NewLookupOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LookupOptionContext {
+	var p = new(LookupOptionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_appendCommand
+	p.RuleIndex = SPLParserRULE_lookupOption
 
 	return p
 }
 
-func (s *AppendCommandContext) GetParser() antlr.Parser { return s.parser }
+func (s *LookupOptionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AppendCommandContext) APPEND() antlr.TerminalNode {
-	return s.GetToken(SPLParserAPPEND, 0)
+func (s *LookupOptionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, 0)
 }
 
-func (s *AppendCommandContext) Subsearch() ISubsearchContext {
+func (s *LookupOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *LookupOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *LookupOptionContext) FieldName() IFieldNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISubsearchContext); ok {
+		if _, ok := ctx.(IFieldNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6524,54 +7524,98 @@ func (s *AppendCommandContext) Subsearch() ISubsearchContext {
 		return nil
 	}
 
-	return t.(ISubsearchContext)
+	return t.(IFieldNameContext)
 }
 
-func (s *AppendCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *LookupOptionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *LookupOptionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AppendCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LookupOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AppendCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LookupOptionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterAppendCommand(s)
+		listenerT.EnterLookupOption(s)
 	}
 }
 
-func (s *AppendCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LookupOptionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitAppendCommand(s)
+		listenerT.ExitLookupOption(s)
 	}
 }
 
-func (s *AppendCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LookupOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitAppendCommand(s)
+		return t.VisitLookupOption(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) AppendCommand() (localctx IAppendCommandContext) {
-	localctx = NewAppendCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SPLParserRULE_appendCommand)
+func (p *SPLParser) LookupOption() (localctx ILookupOptionContext) {
+	localctx = NewLookupOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SPLParserRULE_lookupOption)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(433)
-		p.Match(SPLParserAPPEND)
+		p.SetState(510)
+		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(434)
-		p.Subsearch()
+		p.SetState(511)
+		p.Match(SPLParserEQ)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(515)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(512)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(513)
+			p.FieldName()
+		}
+
+	case 3:
+		{
+			p.SetState(514)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -6587,63 +7631,64 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITransactionCommandContext is an interface to support dynamic dispatch.
-type ITransactionCommandContext interface {
+// IJoinCommandContext is an interface to support dynamic dispatch.
+type IJoinCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	TRANSACTION() antlr.TerminalNode
+	JOIN() antlr.TerminalNode
+	Subsearch() ISubsearchContext
+	AllJoinOption() []IJoinOptionContext
+	JoinOption(i int) IJoinOptionContext
 	FieldList() IFieldListContext
-	AllTransactionOption() []ITransactionOptionContext
-	TransactionOption(i int) ITransactionOptionContext
 
-	// IsTransactionCommandContext differentiates from other interfaces.
-	IsTransactionCommandContext()
+	// IsJoinCommandContext differentiates from other interfaces.
+	IsJoinCommandContext()
 }
 
-type TransactionCommandContext struct {
+type JoinCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTransactionCommandContext() *TransactionCommandContext {
-	var p = new(TransactionCommandContext)
+func NewEmptyJoinCommandContext() *JoinCommandContext {
+	var p = new(JoinCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_transactionCommand
+	p.RuleIndex = SPLParserRULE_joinCommand
 	return p
 }
 
-func InitEmptyTransactionCommandContext(p *TransactionCommandContext) {
+func InitEmptyJoinCommandContext(p *JoinCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_transactionCommand
+	p.RuleIndex = SPLParserRULE_joinCommand
 }
 
-func (*TransactionCommandContext) IsTransactionCommandContext() {}
+func (*JoinCommandContext) IsJoinCommandContext() {}
 
-func NewTransactionCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TransactionCommandContext {
-	var p = new(TransactionCommandContext)
+func NewJoinCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinCommandContext {
+	var p = new(JoinCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_transactionCommand
+	p.RuleIndex = SPLParserRULE_joinCommand
 
 	return p
 }
 
-func (s *TransactionCommandContext) GetParser() antlr.Parser { return s.parser }
+func (s *JoinCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TransactionCommandContext) TRANSACTION() antlr.TerminalNode {
-	return s.GetToken(SPLParserTRANSACTION, 0)
+func (s *JoinCommandContext) JOIN() antlr.TerminalNode {
+	return s.GetToken(SPLParserJOIN, 0)
 }
 
-func (s *TransactionCommandContext) FieldList() IFieldListContext {
+func (s *JoinCommandContext) Subsearch() ISubsearchContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldListContext); ok {
+		if _, ok := ctx.(ISubsearchContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6653,23 +7698,23 @@ func (s *TransactionCommandContext) FieldList() IFieldListContext {
 		return nil
 	}
 
-	return t.(IFieldListContext)
+	return t.(ISubsearchContext)
 }
 
-func (s *TransactionCommandContext) AllTransactionOption() []ITransactionOptionContext {
+func (s *JoinCommandContext) AllJoinOption() []IJoinOptionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ITransactionOptionContext); ok {
+		if _, ok := ctx.(IJoinOptionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ITransactionOptionContext, len)
+	tst := make([]IJoinOptionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ITransactionOptionContext); ok {
-			tst[i] = t.(ITransactionOptionContext)
+		if t, ok := ctx.(IJoinOptionContext); ok {
+			tst[i] = t.(IJoinOptionContext)
 			i++
 		}
 	}
@@ -6677,11 +7722,11 @@ func (s *TransactionCommandContext) AllTransactionOption() []ITransactionOptionC
 	return tst
 }
 
-func (s *TransactionCommandContext) TransactionOption(i int) ITransactionOptionContext {
+func (s *JoinCommandContext) JoinOption(i int) IJoinOptionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITransactionOptionContext); ok {
+		if _, ok := ctx.(IJoinOptionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -6694,155 +7739,230 @@ func (s *TransactionCommandContext) TransactionOption(i int) ITransactionOptionC
 		return nil
 	}
 
-	return t.(ITransactionOptionContext)
+	return t.(IJoinOptionContext)
 }
 
-func (s *TransactionCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *JoinCommandContext) FieldList() IFieldListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldListContext)
+}
+
+func (s *JoinCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TransactionCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *JoinCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TransactionCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *JoinCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterTransactionCommand(s)
+		listenerT.EnterJoinCommand(s)
 	}
 }
 
-func (s *TransactionCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *JoinCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitTransactionCommand(s)
+		listenerT.ExitJoinCommand(s)
 	}
 }
 
-func (s *TransactionCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *JoinCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitTransactionCommand(s)
+		return t.VisitJoinCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) TransactionCommand() (localctx ITransactionCommandContext) {
-	localctx = NewTransactionCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SPLParserRULE_transactionCommand)
+func (p *SPLParser) JoinCommand() (localctx IJoinCommandContext) {
+	localctx = NewJoinCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SPLParserRULE_joinCommand)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(436)
-		p.Match(SPLParserTRANSACTION)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(437)
-		p.FieldList()
-	}
-	p.SetState(441)
+	var _alt int
+
+	p.SetState(535)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == SPLParserIDENTIFIER {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 50, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(438)
-			p.TransactionOption()
+			p.SetState(517)
+			p.Match(SPLParserJOIN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-
-		p.SetState(443)
+		p.SetState(521)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// ITransactionOptionContext is an interface to support dynamic dispatch.
-type ITransactionOptionContext interface {
-	antlr.ParserRuleContext
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+		for _la == SPLParserIDENTIFIER {
+			{
+				p.SetState(518)
+				p.JoinOption()
+			}
 
-	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	EQ() antlr.TerminalNode
-	QUOTED_STRING() antlr.TerminalNode
-	FieldName() IFieldNameContext
-	NUMBER() antlr.TerminalNode
-	TIME_SPAN() antlr.TerminalNode
+			p.SetState(523)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(524)
+			p.Subsearch()
+		}
 
-	// IsTransactionOptionContext differentiates from other interfaces.
-	IsTransactionOptionContext()
-}
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(525)
+			p.Match(SPLParserJOIN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(529)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 49, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(526)
+					p.JoinOption()
+				}
 
-type TransactionOptionContext struct {
+			}
+			p.SetState(531)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 49, p.GetParserRuleContext())
+			if p.HasError() {
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(532)
+			p.FieldList()
+		}
+		{
+			p.SetState(533)
+			p.Subsearch()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IJoinOptionContext is an interface to support dynamic dispatch.
+type IJoinOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	NUMBER() antlr.TerminalNode
+
+	// IsJoinOptionContext differentiates from other interfaces.
+	IsJoinOptionContext()
+}
+
+type JoinOptionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTransactionOptionContext() *TransactionOptionContext {
-	var p = new(TransactionOptionContext)
+func NewEmptyJoinOptionContext() *JoinOptionContext {
+	var p = new(JoinOptionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_transactionOption
+	p.RuleIndex = SPLParserRULE_joinOption
 	return p
 }
 
-func InitEmptyTransactionOptionContext(p *TransactionOptionContext) {
+func InitEmptyJoinOptionContext(p *JoinOptionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_transactionOption
+	p.RuleIndex = SPLParserRULE_joinOption
 }
 
-func (*TransactionOptionContext) IsTransactionOptionContext() {}
+func (*JoinOptionContext) IsJoinOptionContext() {}
 
-func NewTransactionOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TransactionOptionContext {
-	var p = new(TransactionOptionContext)
+func NewJoinOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinOptionContext {
+	var p = new(JoinOptionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_transactionOption
+	p.RuleIndex = SPLParserRULE_joinOption
 
 	return p
 }
 
-func (s *TransactionOptionContext) GetParser() antlr.Parser { return s.parser }
+func (s *JoinOptionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TransactionOptionContext) IDENTIFIER() antlr.TerminalNode {
+func (s *JoinOptionContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(SPLParserIDENTIFIER, 0)
 }
 
-func (s *TransactionOptionContext) EQ() antlr.TerminalNode {
+func (s *JoinOptionContext) EQ() antlr.TerminalNode {
 	return s.GetToken(SPLParserEQ, 0)
 }
 
-func (s *TransactionOptionContext) QUOTED_STRING() antlr.TerminalNode {
+func (s *JoinOptionContext) QUOTED_STRING() antlr.TerminalNode {
 	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
-func (s *TransactionOptionContext) FieldName() IFieldNameContext {
+func (s *JoinOptionContext) FieldName() IFieldNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IFieldNameContext); ok {
@@ -6858,50 +7978,46 @@ func (s *TransactionOptionContext) FieldName() IFieldNameContext {
 	return t.(IFieldNameContext)
 }
 
-func (s *TransactionOptionContext) NUMBER() antlr.TerminalNode {
+func (s *JoinOptionContext) NUMBER() antlr.TerminalNode {
 	return s.GetToken(SPLParserNUMBER, 0)
 }
 
-func (s *TransactionOptionContext) TIME_SPAN() antlr.TerminalNode {
-	return s.GetToken(SPLParserTIME_SPAN, 0)
-}
-
-func (s *TransactionOptionContext) GetRuleContext() antlr.RuleContext {
+func (s *JoinOptionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TransactionOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *JoinOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TransactionOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *JoinOptionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterTransactionOption(s)
+		listenerT.EnterJoinOption(s)
 	}
 }
 
-func (s *TransactionOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *JoinOptionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitTransactionOption(s)
+		listenerT.ExitJoinOption(s)
 	}
 }
 
-func (s *TransactionOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *JoinOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitTransactionOption(s)
+		return t.VisitJoinOption(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) TransactionOption() (localctx ITransactionOptionContext) {
-	localctx = NewTransactionOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, SPLParserRULE_transactionOption)
+func (p *SPLParser) JoinOption() (localctx IJoinOptionContext) {
+	localctx = NewJoinOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SPLParserRULE_joinOption)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(444)
+		p.SetState(537)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6909,23 +8025,23 @@ func (p *SPLParser) TransactionOption() (localctx ITransactionOptionContext) {
 		}
 	}
 	{
-		p.SetState(445)
+		p.SetState(538)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(450)
+	p.SetState(542)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 40, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 51, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(446)
+			p.SetState(539)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6935,13 +8051,13 @@ func (p *SPLParser) TransactionOption() (localctx ITransactionOptionContext) {
 
 	case 2:
 		{
-			p.SetState(447)
+			p.SetState(540)
 			p.FieldName()
 		}
 
 	case 3:
 		{
-			p.SetState(448)
+			p.SetState(541)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6949,16 +8065,6 @@ func (p *SPLParser) TransactionOption() (localctx ITransactionOptionContext) {
 			}
 		}
 
-	case 4:
-		{
-			p.SetState(449)
-			p.Match(SPLParserTIME_SPAN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -6976,89 +8082,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISpathCommandContext is an interface to support dynamic dispatch.
-type ISpathCommandContext interface {
+// IAppendCommandContext is an interface to support dynamic dispatch.
+type IAppendCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SPATH() antlr.TerminalNode
-	AllSpathOption() []ISpathOptionContext
-	SpathOption(i int) ISpathOptionContext
+	APPEND() antlr.TerminalNode
+	Subsearch() ISubsearchContext
 
-	// IsSpathCommandContext differentiates from other interfaces.
-	IsSpathCommandContext()
+	// IsAppendCommandContext differentiates from other interfaces.
+	IsAppendCommandContext()
 }
 
-type SpathCommandContext struct {
+type AppendCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySpathCommandContext() *SpathCommandContext {
-	var p = new(SpathCommandContext)
+func NewEmptyAppendCommandContext() *AppendCommandContext {
+	var p = new(AppendCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_spathCommand
+	p.RuleIndex = SPLParserRULE_appendCommand
 	return p
 }
 
-func InitEmptySpathCommandContext(p *SpathCommandContext) {
+func InitEmptyAppendCommandContext(p *AppendCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_spathCommand
+	p.RuleIndex = SPLParserRULE_appendCommand
 }
 
-func (*SpathCommandContext) IsSpathCommandContext() {}
+func (*AppendCommandContext) IsAppendCommandContext() {}
 
-func NewSpathCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpathCommandContext {
-	var p = new(SpathCommandContext)
+func NewAppendCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AppendCommandContext {
+	var p = new(AppendCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_spathCommand
+	p.RuleIndex = SPLParserRULE_appendCommand
 
 	return p
 }
 
-func (s *SpathCommandContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SpathCommandContext) SPATH() antlr.TerminalNode {
-	return s.GetToken(SPLParserSPATH, 0)
-}
-
-func (s *SpathCommandContext) AllSpathOption() []ISpathOptionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ISpathOptionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]ISpathOptionContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ISpathOptionContext); ok {
-			tst[i] = t.(ISpathOptionContext)
-			i++
-		}
-	}
+func (s *AppendCommandContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *AppendCommandContext) APPEND() antlr.TerminalNode {
+	return s.GetToken(SPLParserAPPEND, 0)
 }
 
-func (s *SpathCommandContext) SpathOption(i int) ISpathOptionContext {
+func (s *AppendCommandContext) Subsearch() ISubsearchContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISpathOptionContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ISubsearchContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -7066,72 +8146,54 @@ func (s *SpathCommandContext) SpathOption(i int) ISpathOptionContext {
 		return nil
 	}
 
-	return t.(ISpathOptionContext)
+	return t.(ISubsearchContext)
 }
 
-func (s *SpathCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *AppendCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SpathCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AppendCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SpathCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AppendCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterSpathCommand(s)
+		listenerT.EnterAppendCommand(s)
 	}
 }
 
-func (s *SpathCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AppendCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitSpathCommand(s)
+		listenerT.ExitAppendCommand(s)
 	}
 }
 
-func (s *SpathCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AppendCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitSpathCommand(s)
+		return t.VisitAppendCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) SpathCommand() (localctx ISpathCommandContext) {
-	localctx = NewSpathCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, SPLParserRULE_spathCommand)
-	var _la int
-
+func (p *SPLParser) AppendCommand() (localctx IAppendCommandContext) {
+	localctx = NewAppendCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SPLParserRULE_appendCommand)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(452)
-		p.Match(SPLParserSPATH)
+		p.SetState(544)
+		p.Match(SPLParserAPPEND)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(456)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == SPLParserIDENTIFIER {
-		{
-			p.SetState(453)
-			p.SpathOption()
-		}
-
-		p.SetState(458)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+	{
+		p.SetState(545)
+		p.Subsearch()
 	}
 
 errorExit:
@@ -7147,73 +8209,106 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISpathOptionContext is an interface to support dynamic dispatch.
-type ISpathOptionContext interface {
+// IAppendcolsCommandContext is an interface to support dynamic dispatch.
+type IAppendcolsCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	EQ() antlr.TerminalNode
-	QUOTED_STRING() antlr.TerminalNode
-	FieldName() IFieldNameContext
+	APPENDCOLS() antlr.TerminalNode
+	Subsearch() ISubsearchContext
+	AllJoinOption() []IJoinOptionContext
+	JoinOption(i int) IJoinOptionContext
 
-	// IsSpathOptionContext differentiates from other interfaces.
-	IsSpathOptionContext()
+	// IsAppendcolsCommandContext differentiates from other interfaces.
+	IsAppendcolsCommandContext()
 }
 
-type SpathOptionContext struct {
+type AppendcolsCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySpathOptionContext() *SpathOptionContext {
-	var p = new(SpathOptionContext)
+func NewEmptyAppendcolsCommandContext() *AppendcolsCommandContext {
+	var p = new(AppendcolsCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_spathOption
+	p.RuleIndex = SPLParserRULE_appendcolsCommand
 	return p
 }
 
-func InitEmptySpathOptionContext(p *SpathOptionContext) {
+func InitEmptyAppendcolsCommandContext(p *AppendcolsCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_spathOption
+	p.RuleIndex = SPLParserRULE_appendcolsCommand
 }
 
-func (*SpathOptionContext) IsSpathOptionContext() {}
+func (*AppendcolsCommandContext) IsAppendcolsCommandContext() {}
 
-func NewSpathOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpathOptionContext {
-	var p = new(SpathOptionContext)
+func NewAppendcolsCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AppendcolsCommandContext {
+	var p = new(AppendcolsCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_spathOption
+	p.RuleIndex = SPLParserRULE_appendcolsCommand
 
 	return p
 }
 
-func (s *SpathOptionContext) GetParser() antlr.Parser { return s.parser }
+func (s *AppendcolsCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SpathOptionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SPLParserIDENTIFIER, 0)
+func (s *AppendcolsCommandContext) APPENDCOLS() antlr.TerminalNode {
+	return s.GetToken(SPLParserAPPENDCOLS, 0)
 }
 
-func (s *SpathOptionContext) EQ() antlr.TerminalNode {
-	return s.GetToken(SPLParserEQ, 0)
+func (s *AppendcolsCommandContext) Subsearch() ISubsearchContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubsearchContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubsearchContext)
 }
 
-func (s *SpathOptionContext) QUOTED_STRING() antlr.TerminalNode {
-	return s.GetToken(SPLParserQUOTED_STRING, 0)
+func (s *AppendcolsCommandContext) AllJoinOption() []IJoinOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IJoinOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IJoinOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IJoinOptionContext); ok {
+			tst[i] = t.(IJoinOptionContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *SpathOptionContext) FieldName() IFieldNameContext {
+func (s *AppendcolsCommandContext) JoinOption(i int) IJoinOptionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IJoinOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -7221,85 +8316,76 @@ func (s *SpathOptionContext) FieldName() IFieldNameContext {
 		return nil
 	}
 
-	return t.(IFieldNameContext)
+	return t.(IJoinOptionContext)
 }
 
-func (s *SpathOptionContext) GetRuleContext() antlr.RuleContext {
+func (s *AppendcolsCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SpathOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AppendcolsCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SpathOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AppendcolsCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterSpathOption(s)
+		listenerT.EnterAppendcolsCommand(s)
 	}
 }
 
-func (s *SpathOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AppendcolsCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitSpathOption(s)
+		listenerT.ExitAppendcolsCommand(s)
 	}
 }
 
-func (s *SpathOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AppendcolsCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitSpathOption(s)
+		return t.VisitAppendcolsCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) SpathOption() (localctx ISpathOptionContext) {
-	localctx = NewSpathOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, SPLParserRULE_spathOption)
+func (p *SPLParser) AppendcolsCommand() (localctx IAppendcolsCommandContext) {
+	localctx = NewAppendcolsCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SPLParserRULE_appendcolsCommand)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(459)
-		p.Match(SPLParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(460)
-		p.Match(SPLParserEQ)
+		p.SetState(547)
+		p.Match(SPLParserAPPENDCOLS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(463)
+	p.SetState(551)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SPLParserQUOTED_STRING:
+	for _la == SPLParserIDENTIFIER {
 		{
-			p.SetState(461)
-			p.Match(SPLParserQUOTED_STRING)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(548)
+			p.JoinOption()
 		}
 
-	case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
-		{
-			p.SetState(462)
-			p.FieldName()
+		p.SetState(553)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(554)
+		p.Subsearch()
 	}
 
 errorExit:
@@ -7315,76 +8401,89 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IEventstatsCommandContext is an interface to support dynamic dispatch.
-type IEventstatsCommandContext interface {
+// IAppendpipeCommandContext is an interface to support dynamic dispatch.
+type IAppendpipeCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	EVENTSTATS() antlr.TerminalNode
-	AllStatsFunction() []IStatsFunctionContext
-	StatsFunction(i int) IStatsFunctionContext
-	BY() antlr.TerminalNode
-	FieldList() IFieldListContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	APPENDPIPE() antlr.TerminalNode
+	Subsearch() ISubsearchContext
+	AllJoinOption() []IJoinOptionContext
+	JoinOption(i int) IJoinOptionContext
 
-	// IsEventstatsCommandContext differentiates from other interfaces.
-	IsEventstatsCommandContext()
+	// IsAppendpipeCommandContext differentiates from other interfaces.
+	IsAppendpipeCommandContext()
 }
 
-type EventstatsCommandContext struct {
+type AppendpipeCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyEventstatsCommandContext() *EventstatsCommandContext {
-	var p = new(EventstatsCommandContext)
+func NewEmptyAppendpipeCommandContext() *AppendpipeCommandContext {
+	var p = new(AppendpipeCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_eventstatsCommand
+	p.RuleIndex = SPLParserRULE_appendpipeCommand
 	return p
 }
 
-func InitEmptyEventstatsCommandContext(p *EventstatsCommandContext) {
+func InitEmptyAppendpipeCommandContext(p *AppendpipeCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_eventstatsCommand
+	p.RuleIndex = SPLParserRULE_appendpipeCommand
 }
 
-func (*EventstatsCommandContext) IsEventstatsCommandContext() {}
+func (*AppendpipeCommandContext) IsAppendpipeCommandContext() {}
 
-func NewEventstatsCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EventstatsCommandContext {
-	var p = new(EventstatsCommandContext)
+func NewAppendpipeCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AppendpipeCommandContext {
+	var p = new(AppendpipeCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_eventstatsCommand
+	p.RuleIndex = SPLParserRULE_appendpipeCommand
 
 	return p
 }
 
-func (s *EventstatsCommandContext) GetParser() antlr.Parser { return s.parser }
+func (s *AppendpipeCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *EventstatsCommandContext) EVENTSTATS() antlr.TerminalNode {
-	return s.GetToken(SPLParserEVENTSTATS, 0)
+func (s *AppendpipeCommandContext) APPENDPIPE() antlr.TerminalNode {
+	return s.GetToken(SPLParserAPPENDPIPE, 0)
 }
 
-func (s *EventstatsCommandContext) AllStatsFunction() []IStatsFunctionContext {
+func (s *AppendpipeCommandContext) Subsearch() ISubsearchContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubsearchContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubsearchContext)
+}
+
+func (s *AppendpipeCommandContext) AllJoinOption() []IJoinOptionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IStatsFunctionContext); ok {
+		if _, ok := ctx.(IJoinOptionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IStatsFunctionContext, len)
+	tst := make([]IJoinOptionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IStatsFunctionContext); ok {
-			tst[i] = t.(IStatsFunctionContext)
+		if t, ok := ctx.(IJoinOptionContext); ok {
+			tst[i] = t.(IJoinOptionContext)
 			i++
 		}
 	}
@@ -7392,11 +8491,11 @@ func (s *EventstatsCommandContext) AllStatsFunction() []IStatsFunctionContext {
 	return tst
 }
 
-func (s *EventstatsCommandContext) StatsFunction(i int) IStatsFunctionContext {
+func (s *AppendpipeCommandContext) JoinOption(i int) IJoinOptionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStatsFunctionContext); ok {
+		if _, ok := ctx.(IJoinOptionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -7409,229 +8508,161 @@ func (s *EventstatsCommandContext) StatsFunction(i int) IStatsFunctionContext {
 		return nil
 	}
 
-	return t.(IStatsFunctionContext)
-}
-
-func (s *EventstatsCommandContext) BY() antlr.TerminalNode {
-	return s.GetToken(SPLParserBY, 0)
-}
-
-func (s *EventstatsCommandContext) FieldList() IFieldListContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFieldListContext)
-}
-
-func (s *EventstatsCommandContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(SPLParserCOMMA)
-}
-
-func (s *EventstatsCommandContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SPLParserCOMMA, i)
+	return t.(IJoinOptionContext)
 }
 
-func (s *EventstatsCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *AppendpipeCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EventstatsCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AppendpipeCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *EventstatsCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AppendpipeCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterEventstatsCommand(s)
+		listenerT.EnterAppendpipeCommand(s)
 	}
 }
 
-func (s *EventstatsCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AppendpipeCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitEventstatsCommand(s)
+		listenerT.ExitAppendpipeCommand(s)
 	}
 }
 
-func (s *EventstatsCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AppendpipeCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitEventstatsCommand(s)
+		return t.VisitAppendpipeCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) EventstatsCommand() (localctx IEventstatsCommandContext) {
-	localctx = NewEventstatsCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, SPLParserRULE_eventstatsCommand)
+func (p *SPLParser) AppendpipeCommand() (localctx IAppendpipeCommandContext) {
+	localctx = NewAppendpipeCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SPLParserRULE_appendpipeCommand)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(465)
-		p.Match(SPLParserEVENTSTATS)
+		p.SetState(556)
+		p.Match(SPLParserAPPENDPIPE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(466)
-		p.StatsFunction()
-	}
-	p.SetState(473)
+	p.SetState(560)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == SPLParserCOMMA || _la == SPLParserIDENTIFIER {
-		p.SetState(468)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == SPLParserCOMMA {
-			{
-				p.SetState(467)
-				p.Match(SPLParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-
-		}
+	for _la == SPLParserIDENTIFIER {
 		{
-			p.SetState(470)
-			p.StatsFunction()
+			p.SetState(557)
+			p.JoinOption()
 		}
 
-		p.SetState(475)
+		p.SetState(562)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(478)
-	p.GetErrorHandler().Sync(p)
+	{
+		p.SetState(563)
+		p.Subsearch()
+	}
+
+errorExit:
 	if p.HasError() {
-		goto errorExit
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
-	_la = p.GetTokenStream().LA(1)
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	if _la == SPLParserBY {
-		{
-			p.SetState(476)
-			p.Match(SPLParserBY)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(477)
-			p.FieldList()
-		}
-
-	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IStreamstatsCommandContext is an interface to support dynamic dispatch.
-type IStreamstatsCommandContext interface {
+// IUnionCommandContext is an interface to support dynamic dispatch.
+type IUnionCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	STREAMSTATS() antlr.TerminalNode
-	AllStatsFunction() []IStatsFunctionContext
-	StatsFunction(i int) IStatsFunctionContext
-	BY() antlr.TerminalNode
-	FieldList() IFieldListContext
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	UNION() antlr.TerminalNode
+	AllJoinOption() []IJoinOptionContext
+	JoinOption(i int) IJoinOptionContext
+	AllSubsearch() []ISubsearchContext
+	Subsearch(i int) ISubsearchContext
+	AllValue() []IValueContext
+	Value(i int) IValueContext
 
-	// IsStreamstatsCommandContext differentiates from other interfaces.
-	IsStreamstatsCommandContext()
+	// IsUnionCommandContext differentiates from other interfaces.
+	IsUnionCommandContext()
 }
 
-type StreamstatsCommandContext struct {
+type UnionCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStreamstatsCommandContext() *StreamstatsCommandContext {
-	var p = new(StreamstatsCommandContext)
+func NewEmptyUnionCommandContext() *UnionCommandContext {
+	var p = new(UnionCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_streamstatsCommand
+	p.RuleIndex = SPLParserRULE_unionCommand
 	return p
 }
 
-func InitEmptyStreamstatsCommandContext(p *StreamstatsCommandContext) {
+func InitEmptyUnionCommandContext(p *UnionCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_streamstatsCommand
+	p.RuleIndex = SPLParserRULE_unionCommand
 }
 
-func (*StreamstatsCommandContext) IsStreamstatsCommandContext() {}
+func (*UnionCommandContext) IsUnionCommandContext() {}
 
-func NewStreamstatsCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StreamstatsCommandContext {
-	var p = new(StreamstatsCommandContext)
+func NewUnionCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionCommandContext {
+	var p = new(UnionCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_streamstatsCommand
+	p.RuleIndex = SPLParserRULE_unionCommand
 
 	return p
 }
 
-func (s *StreamstatsCommandContext) GetParser() antlr.Parser { return s.parser }
+func (s *UnionCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StreamstatsCommandContext) STREAMSTATS() antlr.TerminalNode {
-	return s.GetToken(SPLParserSTREAMSTATS, 0)
+func (s *UnionCommandContext) UNION() antlr.TerminalNode {
+	return s.GetToken(SPLParserUNION, 0)
 }
 
-func (s *StreamstatsCommandContext) AllStatsFunction() []IStatsFunctionContext {
+func (s *UnionCommandContext) AllJoinOption() []IJoinOptionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IStatsFunctionContext); ok {
+		if _, ok := ctx.(IJoinOptionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IStatsFunctionContext, len)
+	tst := make([]IJoinOptionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IStatsFunctionContext); ok {
-			tst[i] = t.(IStatsFunctionContext)
+		if t, ok := ctx.(IJoinOptionContext); ok {
+			tst[i] = t.(IJoinOptionContext)
 			i++
 		}
 	}
@@ -7639,11 +8670,11 @@ func (s *StreamstatsCommandContext) AllStatsFunction() []IStatsFunctionContext {
 	return tst
 }
 
-func (s *StreamstatsCommandContext) StatsFunction(i int) IStatsFunctionContext {
+func (s *UnionCommandContext) JoinOption(i int) IJoinOptionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStatsFunctionContext); ok {
+		if _, ok := ctx.(IJoinOptionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -7656,19 +8687,40 @@ func (s *StreamstatsCommandContext) StatsFunction(i int) IStatsFunctionContext {
 		return nil
 	}
 
-	return t.(IStatsFunctionContext)
+	return t.(IJoinOptionContext)
 }
 
-func (s *StreamstatsCommandContext) BY() antlr.TerminalNode {
-	return s.GetToken(SPLParserBY, 0)
+func (s *UnionCommandContext) AllSubsearch() []ISubsearchContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISubsearchContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISubsearchContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISubsearchContext); ok {
+			tst[i] = t.(ISubsearchContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *StreamstatsCommandContext) FieldList() IFieldListContext {
+func (s *UnionCommandContext) Subsearch(i int) ISubsearchContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ISubsearchContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -7676,124 +8728,161 @@ func (s *StreamstatsCommandContext) FieldList() IFieldListContext {
 		return nil
 	}
 
-	return t.(IFieldListContext)
+	return t.(ISubsearchContext)
 }
 
-func (s *StreamstatsCommandContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(SPLParserCOMMA)
+func (s *UnionCommandContext) AllValue() []IValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IValueContext); ok {
+			tst[i] = t.(IValueContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *StreamstatsCommandContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SPLParserCOMMA, i)
+func (s *UnionCommandContext) Value(i int) IValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IValueContext)
 }
 
-func (s *StreamstatsCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *UnionCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StreamstatsCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UnionCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StreamstatsCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UnionCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterStreamstatsCommand(s)
+		listenerT.EnterUnionCommand(s)
 	}
 }
 
-func (s *StreamstatsCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UnionCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitStreamstatsCommand(s)
+		listenerT.ExitUnionCommand(s)
 	}
 }
 
-func (s *StreamstatsCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UnionCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitStreamstatsCommand(s)
+		return t.VisitUnionCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) StreamstatsCommand() (localctx IStreamstatsCommandContext) {
-	localctx = NewStreamstatsCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, SPLParserRULE_streamstatsCommand)
+func (p *SPLParser) UnionCommand() (localctx IUnionCommandContext) {
+	localctx = NewUnionCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SPLParserRULE_unionCommand)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(480)
-		p.Match(SPLParserSTREAMSTATS)
+		p.SetState(565)
+		p.Match(SPLParserUNION)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(481)
-		p.StatsFunction()
-	}
-	p.SetState(488)
+	p.SetState(569)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == SPLParserCOMMA || _la == SPLParserIDENTIFIER {
-		p.SetState(483)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == SPLParserCOMMA {
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
 			{
-				p.SetState(482)
-				p.Match(SPLParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
+				p.SetState(566)
+				p.JoinOption()
 			}
 
 		}
-		{
-			p.SetState(485)
-			p.StatsFunction()
-		}
-
-		p.SetState(490)
+		p.SetState(571)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
-	p.SetState(493)
+	p.SetState(574)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SPLParserBY {
-		{
-			p.SetState(491)
-			p.Match(SPLParserBY)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&13514647676141568) != 0) || ((int64((_la-69)) & ^0x3f) == 0 && ((int64(1)<<(_la-69))&291500033) != 0) {
+		p.SetState(574)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-		{
-			p.SetState(492)
-			p.FieldList()
+
+		switch p.GetTokenStream().LA(1) {
+		case SPLParserLBRACKET:
+			{
+				p.SetState(572)
+				p.Subsearch()
+			}
+
+		case SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserQUOTED_STRING, SPLParserSINGLE_QUOTED, SPLParserTERM_DIRECTIVE, SPLParserCASE_DIRECTIVE, SPLParserTIME_SPAN, SPLParserTIME_ABSOLUTE, SPLParserIP_ADDRESS, SPLParserNUMBER, SPLParserWILDCARD, SPLParserTOKEN_VAR, SPLParserIDENTIFIER, SPLParserTIME_MODIFIER:
+			{
+				p.SetState(573)
+				p.Value()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
+		p.SetState(576)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -7809,91 +8898,72 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITimechartCommandContext is an interface to support dynamic dispatch.
-type ITimechartCommandContext interface {
+// IMultisearchCommandContext is an interface to support dynamic dispatch.
+type IMultisearchCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	TIMECHART() antlr.TerminalNode
-	StatsFunction() IStatsFunctionContext
-	AllTimechartOption() []ITimechartOptionContext
-	TimechartOption(i int) ITimechartOptionContext
-	BY() antlr.TerminalNode
-	FieldName() IFieldNameContext
+	MULTISEARCH() antlr.TerminalNode
+	AllSubsearch() []ISubsearchContext
+	Subsearch(i int) ISubsearchContext
 
-	// IsTimechartCommandContext differentiates from other interfaces.
-	IsTimechartCommandContext()
+	// IsMultisearchCommandContext differentiates from other interfaces.
+	IsMultisearchCommandContext()
 }
 
-type TimechartCommandContext struct {
+type MultisearchCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTimechartCommandContext() *TimechartCommandContext {
-	var p = new(TimechartCommandContext)
+func NewEmptyMultisearchCommandContext() *MultisearchCommandContext {
+	var p = new(MultisearchCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_timechartCommand
+	p.RuleIndex = SPLParserRULE_multisearchCommand
 	return p
 }
 
-func InitEmptyTimechartCommandContext(p *TimechartCommandContext) {
+func InitEmptyMultisearchCommandContext(p *MultisearchCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_timechartCommand
+	p.RuleIndex = SPLParserRULE_multisearchCommand
 }
 
-func (*TimechartCommandContext) IsTimechartCommandContext() {}
+func (*MultisearchCommandContext) IsMultisearchCommandContext() {}
 
-func NewTimechartCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimechartCommandContext {
-	var p = new(TimechartCommandContext)
+func NewMultisearchCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultisearchCommandContext {
+	var p = new(MultisearchCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_timechartCommand
+	p.RuleIndex = SPLParserRULE_multisearchCommand
 
 	return p
 }
 
-func (s *TimechartCommandContext) GetParser() antlr.Parser { return s.parser }
+func (s *MultisearchCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TimechartCommandContext) TIMECHART() antlr.TerminalNode {
-	return s.GetToken(SPLParserTIMECHART, 0)
+func (s *MultisearchCommandContext) MULTISEARCH() antlr.TerminalNode {
+	return s.GetToken(SPLParserMULTISEARCH, 0)
 }
 
-func (s *TimechartCommandContext) StatsFunction() IStatsFunctionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStatsFunctionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *MultisearchCommandContext) AllSubsearch() []ISubsearchContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISubsearchContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStatsFunctionContext)
-}
-
-func (s *TimechartCommandContext) AllTimechartOption() []ITimechartOptionContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ITimechartOptionContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]ITimechartOptionContext, len)
+	tst := make([]ISubsearchContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ITimechartOptionContext); ok {
-			tst[i] = t.(ITimechartOptionContext)
+		if t, ok := ctx.(ISubsearchContext); ok {
+			tst[i] = t.(ISubsearchContext)
 			i++
 		}
 	}
@@ -7901,11 +8971,11 @@ func (s *TimechartCommandContext) AllTimechartOption() []ITimechartOptionContext
 	return tst
 }
 
-func (s *TimechartCommandContext) TimechartOption(i int) ITimechartOptionContext {
+func (s *MultisearchCommandContext) Subsearch(i int) ISubsearchContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITimechartOptionContext); ok {
+		if _, ok := ctx.(ISubsearchContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -7918,17 +8988,144 @@ func (s *TimechartCommandContext) TimechartOption(i int) ITimechartOptionContext
 		return nil
 	}
 
-	return t.(ITimechartOptionContext)
+	return t.(ISubsearchContext)
 }
 
-func (s *TimechartCommandContext) BY() antlr.TerminalNode {
-	return s.GetToken(SPLParserBY, 0)
+func (s *MultisearchCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *TimechartCommandContext) FieldName() IFieldNameContext {
+func (s *MultisearchCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MultisearchCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterMultisearchCommand(s)
+	}
+}
+
+func (s *MultisearchCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitMultisearchCommand(s)
+	}
+}
+
+func (s *MultisearchCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitMultisearchCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) MultisearchCommand() (localctx IMultisearchCommandContext) {
+	localctx = NewMultisearchCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SPLParserRULE_multisearchCommand)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(578)
+		p.Match(SPLParserMULTISEARCH)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(580)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == SPLParserLBRACKET {
+		{
+			p.SetState(579)
+			p.Subsearch()
+		}
+
+		p.SetState(582)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITransactionCommandContext is an interface to support dynamic dispatch.
+type ITransactionCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	TRANSACTION() antlr.TerminalNode
+	FieldList() IFieldListContext
+	AllTransactionOption() []ITransactionOptionContext
+	TransactionOption(i int) ITransactionOptionContext
+
+	// IsTransactionCommandContext differentiates from other interfaces.
+	IsTransactionCommandContext()
+}
+
+type TransactionCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTransactionCommandContext() *TransactionCommandContext {
+	var p = new(TransactionCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_transactionCommand
+	return p
+}
+
+func InitEmptyTransactionCommandContext(p *TransactionCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_transactionCommand
+}
+
+func (*TransactionCommandContext) IsTransactionCommandContext() {}
+
+func NewTransactionCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TransactionCommandContext {
+	var p = new(TransactionCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_transactionCommand
+
+	return p
+}
+
+func (s *TransactionCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TransactionCommandContext) TRANSACTION() antlr.TerminalNode {
+	return s.GetToken(SPLParserTRANSACTION, 0)
+}
+
+func (s *TransactionCommandContext) FieldList() IFieldListContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldNameContext); ok {
+		if _, ok := ctx.(IFieldListContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7938,107 +9135,3951 @@ func (s *TimechartCommandContext) FieldName() IFieldNameContext {
 		return nil
 	}
 
-	return t.(IFieldNameContext)
+	return t.(IFieldListContext)
 }
 
-func (s *TimechartCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *TransactionCommandContext) AllTransactionOption() []ITransactionOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITransactionOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITransactionOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITransactionOptionContext); ok {
+			tst[i] = t.(ITransactionOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TransactionCommandContext) TransactionOption(i int) ITransactionOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITransactionOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITransactionOptionContext)
+}
+
+func (s *TransactionCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TimechartCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TransactionCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TimechartCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TransactionCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterTimechartCommand(s)
+		listenerT.EnterTransactionCommand(s)
 	}
 }
 
-func (s *TimechartCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TransactionCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitTimechartCommand(s)
+		listenerT.ExitTransactionCommand(s)
 	}
 }
 
-func (s *TimechartCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TransactionCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitTimechartCommand(s)
+		return t.VisitTransactionCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) TimechartCommand() (localctx ITimechartCommandContext) {
-	localctx = NewTimechartCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, SPLParserRULE_timechartCommand)
+func (p *SPLParser) TransactionCommand() (localctx ITransactionCommandContext) {
+	localctx = NewTransactionCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, SPLParserRULE_transactionCommand)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(495)
-		p.Match(SPLParserTIMECHART)
+		p.SetState(584)
+		p.Match(SPLParserTRANSACTION)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(499)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	{
+		p.SetState(585)
+		p.FieldList()
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 49, p.GetParserRuleContext())
+	p.SetState(589)
+	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(496)
-				p.TimechartOption()
-			}
+	_la = p.GetTokenStream().LA(1)
 
+	for _la == SPLParserIDENTIFIER {
+		{
+			p.SetState(586)
+			p.TransactionOption()
 		}
-		p.SetState(501)
+
+		p.SetState(591)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 49, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(502)
-		p.StatsFunction()
+		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(505)
-	p.GetErrorHandler().Sync(p)
+
+errorExit:
 	if p.HasError() {
-		goto errorExit
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
-	_la = p.GetTokenStream().LA(1)
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	if _la == SPLParserBY {
-		{
-			p.SetState(503)
-			p.Match(SPLParserBY)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+// ITransactionOptionContext is an interface to support dynamic dispatch.
+type ITransactionOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	NUMBER() antlr.TerminalNode
+	TIME_SPAN() antlr.TerminalNode
+	LPAREN() antlr.TerminalNode
+	SearchExpression() ISearchExpressionContext
+	RPAREN() antlr.TerminalNode
+	EVAL() antlr.TerminalNode
+	Expression() IExpressionContext
+	Condition() IConditionContext
+
+	// IsTransactionOptionContext differentiates from other interfaces.
+	IsTransactionOptionContext()
+}
+
+type TransactionOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTransactionOptionContext() *TransactionOptionContext {
+	var p = new(TransactionOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_transactionOption
+	return p
+}
+
+func InitEmptyTransactionOptionContext(p *TransactionOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_transactionOption
+}
+
+func (*TransactionOptionContext) IsTransactionOptionContext() {}
+
+func NewTransactionOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TransactionOptionContext {
+	var p = new(TransactionOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_transactionOption
+
+	return p
+}
+
+func (s *TransactionOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TransactionOptionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, 0)
+}
+
+func (s *TransactionOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *TransactionOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *TransactionOptionContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *TransactionOptionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *TransactionOptionContext) TIME_SPAN() antlr.TerminalNode {
+	return s.GetToken(SPLParserTIME_SPAN, 0)
+}
+
+func (s *TransactionOptionContext) LPAREN() antlr.TerminalNode {
+	return s.GetToken(SPLParserLPAREN, 0)
+}
+
+func (s *TransactionOptionContext) SearchExpression() ISearchExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISearchExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISearchExpressionContext)
+}
+
+func (s *TransactionOptionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SPLParserRPAREN, 0)
+}
+
+func (s *TransactionOptionContext) EVAL() antlr.TerminalNode {
+	return s.GetToken(SPLParserEVAL, 0)
+}
+
+func (s *TransactionOptionContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *TransactionOptionContext) Condition() IConditionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConditionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionContext)
+}
+
+func (s *TransactionOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TransactionOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TransactionOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterTransactionOption(s)
+	}
+}
+
+func (s *TransactionOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitTransactionOption(s)
+	}
+}
+
+func (s *TransactionOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitTransactionOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) TransactionOption() (localctx ITransactionOptionContext) {
+	localctx = NewTransactionOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SPLParserRULE_transactionOption)
+	p.SetState(616)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(592)
+			p.Match(SPLParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(593)
+			p.Match(SPLParserEQ)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(598)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 59, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(594)
+				p.Match(SPLParserQUOTED_STRING)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case 2:
+			{
+				p.SetState(595)
+				p.FieldName()
+			}
+
+		case 3:
+			{
+				p.SetState(596)
+				p.Match(SPLParserNUMBER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case 4:
+			{
+				p.SetState(597)
+				p.Match(SPLParserTIME_SPAN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case antlr.ATNInvalidAltNumber:
+			goto errorExit
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(600)
+			p.Match(SPLParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(601)
+			p.Match(SPLParserEQ)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(602)
+			p.Match(SPLParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(603)
+			p.SearchExpression()
+		}
+		{
+			p.SetState(604)
+			p.Match(SPLParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(606)
+			p.Match(SPLParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(607)
+			p.Match(SPLParserEQ)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(608)
+			p.Match(SPLParserEVAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(609)
+			p.Match(SPLParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(610)
+			p.Expression()
+		}
+		{
+			p.SetState(611)
+			p.Match(SPLParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(613)
+			p.Match(SPLParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(614)
+			p.Match(SPLParserEQ)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(615)
+			p.Condition()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISpathCommandContext is an interface to support dynamic dispatch.
+type ISpathCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SPATH() antlr.TerminalNode
+	AllSpathOption() []ISpathOptionContext
+	SpathOption(i int) ISpathOptionContext
+	AllSpathPath() []ISpathPathContext
+	SpathPath(i int) ISpathPathContext
+
+	// IsSpathCommandContext differentiates from other interfaces.
+	IsSpathCommandContext()
+}
+
+type SpathCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySpathCommandContext() *SpathCommandContext {
+	var p = new(SpathCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_spathCommand
+	return p
+}
+
+func InitEmptySpathCommandContext(p *SpathCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_spathCommand
+}
+
+func (*SpathCommandContext) IsSpathCommandContext() {}
+
+func NewSpathCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpathCommandContext {
+	var p = new(SpathCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_spathCommand
+
+	return p
+}
+
+func (s *SpathCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SpathCommandContext) SPATH() antlr.TerminalNode {
+	return s.GetToken(SPLParserSPATH, 0)
+}
+
+func (s *SpathCommandContext) AllSpathOption() []ISpathOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISpathOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISpathOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISpathOptionContext); ok {
+			tst[i] = t.(ISpathOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SpathCommandContext) SpathOption(i int) ISpathOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISpathOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISpathOptionContext)
+}
+
+func (s *SpathCommandContext) AllSpathPath() []ISpathPathContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISpathPathContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISpathPathContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISpathPathContext); ok {
+			tst[i] = t.(ISpathPathContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SpathCommandContext) SpathPath(i int) ISpathPathContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISpathPathContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISpathPathContext)
+}
+
+func (s *SpathCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SpathCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SpathCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterSpathCommand(s)
+	}
+}
+
+func (s *SpathCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitSpathCommand(s)
+	}
+}
+
+func (s *SpathCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitSpathCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) SpathCommand() (localctx ISpathCommandContext) {
+	localctx = NewSpathCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SPLParserRULE_spathCommand)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(618)
+		p.Match(SPLParserSPATH)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(623)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17173822373380352) != 0) || ((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&6275) != 0) {
+		p.SetState(621)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 61, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(619)
+				p.SpathOption()
+			}
+
+		case 2:
+			{
+				p.SetState(620)
+				p.SpathPath()
+			}
+
+		case antlr.ATNInvalidAltNumber:
+			goto errorExit
+		}
+
+		p.SetState(625)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISpathPathContext is an interface to support dynamic dispatch.
+type ISpathPathContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	FieldName() IFieldNameContext
+	QUOTED_STRING() antlr.TerminalNode
+
+	// IsSpathPathContext differentiates from other interfaces.
+	IsSpathPathContext()
+}
+
+type SpathPathContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySpathPathContext() *SpathPathContext {
+	var p = new(SpathPathContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_spathPath
+	return p
+}
+
+func InitEmptySpathPathContext(p *SpathPathContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_spathPath
+}
+
+func (*SpathPathContext) IsSpathPathContext() {}
+
+func NewSpathPathContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpathPathContext {
+	var p = new(SpathPathContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_spathPath
+
+	return p
+}
+
+func (s *SpathPathContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SpathPathContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *SpathPathContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *SpathPathContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SpathPathContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SpathPathContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterSpathPath(s)
+	}
+}
+
+func (s *SpathPathContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitSpathPath(s)
+	}
+}
+
+func (s *SpathPathContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitSpathPath(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) SpathPath() (localctx ISpathPathContext) {
+	localctx = NewSpathPathContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, SPLParserRULE_spathPath)
+	p.SetState(628)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(626)
+			p.FieldName()
+		}
+
+	case SPLParserQUOTED_STRING:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(627)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISpathOptionContext is an interface to support dynamic dispatch.
+type ISpathOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	EQ() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	OUTPUT() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
+
+	// IsSpathOptionContext differentiates from other interfaces.
+	IsSpathOptionContext()
+}
+
+type SpathOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySpathOptionContext() *SpathOptionContext {
+	var p = new(SpathOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_spathOption
+	return p
+}
+
+func InitEmptySpathOptionContext(p *SpathOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_spathOption
+}
+
+func (*SpathOptionContext) IsSpathOptionContext() {}
+
+func NewSpathOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SpathOptionContext {
+	var p = new(SpathOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_spathOption
+
+	return p
+}
+
+func (s *SpathOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SpathOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *SpathOptionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, 0)
+}
+
+func (s *SpathOptionContext) OUTPUT() antlr.TerminalNode {
+	return s.GetToken(SPLParserOUTPUT, 0)
+}
+
+func (s *SpathOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *SpathOptionContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *SpathOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SpathOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SpathOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterSpathOption(s)
+	}
+}
+
+func (s *SpathOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitSpathOption(s)
+	}
+}
+
+func (s *SpathOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitSpathOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) SpathOption() (localctx ISpathOptionContext) {
+	localctx = NewSpathOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, SPLParserRULE_spathOption)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(630)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SPLParserOUTPUT || _la == SPLParserIDENTIFIER) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+	{
+		p.SetState(631)
+		p.Match(SPLParserEQ)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(634)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SPLParserQUOTED_STRING:
+		{
+			p.SetState(632)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+		{
+			p.SetState(633)
+			p.FieldName()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IEventstatsCommandContext is an interface to support dynamic dispatch.
+type IEventstatsCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	EVENTSTATS() antlr.TerminalNode
+	AllStatsFunction() []IStatsFunctionContext
+	StatsFunction(i int) IStatsFunctionContext
+	AllStatsOption() []IStatsOptionContext
+	StatsOption(i int) IStatsOptionContext
+	BY() antlr.TerminalNode
+	FieldList() IFieldListContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsEventstatsCommandContext differentiates from other interfaces.
+	IsEventstatsCommandContext()
+}
+
+type EventstatsCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyEventstatsCommandContext() *EventstatsCommandContext {
+	var p = new(EventstatsCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_eventstatsCommand
+	return p
+}
+
+func InitEmptyEventstatsCommandContext(p *EventstatsCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_eventstatsCommand
+}
+
+func (*EventstatsCommandContext) IsEventstatsCommandContext() {}
+
+func NewEventstatsCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EventstatsCommandContext {
+	var p = new(EventstatsCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_eventstatsCommand
+
+	return p
+}
+
+func (s *EventstatsCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *EventstatsCommandContext) EVENTSTATS() antlr.TerminalNode {
+	return s.GetToken(SPLParserEVENTSTATS, 0)
+}
+
+func (s *EventstatsCommandContext) AllStatsFunction() []IStatsFunctionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatsFunctionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatsFunctionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatsFunctionContext); ok {
+			tst[i] = t.(IStatsFunctionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EventstatsCommandContext) StatsFunction(i int) IStatsFunctionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatsFunctionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatsFunctionContext)
+}
+
+func (s *EventstatsCommandContext) AllStatsOption() []IStatsOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatsOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatsOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatsOptionContext); ok {
+			tst[i] = t.(IStatsOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *EventstatsCommandContext) StatsOption(i int) IStatsOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatsOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatsOptionContext)
+}
+
+func (s *EventstatsCommandContext) BY() antlr.TerminalNode {
+	return s.GetToken(SPLParserBY, 0)
+}
+
+func (s *EventstatsCommandContext) FieldList() IFieldListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldListContext)
+}
+
+func (s *EventstatsCommandContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserCOMMA)
+}
+
+func (s *EventstatsCommandContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserCOMMA, i)
+}
+
+func (s *EventstatsCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EventstatsCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *EventstatsCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterEventstatsCommand(s)
+	}
+}
+
+func (s *EventstatsCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitEventstatsCommand(s)
+	}
+}
+
+func (s *EventstatsCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitEventstatsCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) EventstatsCommand() (localctx IEventstatsCommandContext) {
+	localctx = NewEventstatsCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, SPLParserRULE_eventstatsCommand)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(636)
+		p.Match(SPLParserEVENTSTATS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(640)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 65, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(637)
+				p.StatsOption()
+			}
+
+		}
+		p.SetState(642)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 65, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(643)
+		p.StatsFunction()
+	}
+	p.SetState(650)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 67, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(645)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == SPLParserCOMMA {
+				{
+					p.SetState(644)
+					p.Match(SPLParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(647)
+				p.StatsFunction()
+			}
+
+		}
+		p.SetState(652)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 67, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(656)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SPLParserIDENTIFIER {
+		{
+			p.SetState(653)
+			p.StatsOption()
+		}
+
+		p.SetState(658)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(661)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SPLParserBY {
+		{
+			p.SetState(659)
+			p.Match(SPLParserBY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(660)
+			p.FieldList()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStreamstatsCommandContext is an interface to support dynamic dispatch.
+type IStreamstatsCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	STREAMSTATS() antlr.TerminalNode
+	AllStatsFunction() []IStatsFunctionContext
+	StatsFunction(i int) IStatsFunctionContext
+	AllStatsOption() []IStatsOptionContext
+	StatsOption(i int) IStatsOptionContext
+	BY() antlr.TerminalNode
+	FieldList() IFieldListContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsStreamstatsCommandContext differentiates from other interfaces.
+	IsStreamstatsCommandContext()
+}
+
+type StreamstatsCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStreamstatsCommandContext() *StreamstatsCommandContext {
+	var p = new(StreamstatsCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_streamstatsCommand
+	return p
+}
+
+func InitEmptyStreamstatsCommandContext(p *StreamstatsCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_streamstatsCommand
+}
+
+func (*StreamstatsCommandContext) IsStreamstatsCommandContext() {}
+
+func NewStreamstatsCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StreamstatsCommandContext {
+	var p = new(StreamstatsCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_streamstatsCommand
+
+	return p
+}
+
+func (s *StreamstatsCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StreamstatsCommandContext) STREAMSTATS() antlr.TerminalNode {
+	return s.GetToken(SPLParserSTREAMSTATS, 0)
+}
+
+func (s *StreamstatsCommandContext) AllStatsFunction() []IStatsFunctionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatsFunctionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatsFunctionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatsFunctionContext); ok {
+			tst[i] = t.(IStatsFunctionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *StreamstatsCommandContext) StatsFunction(i int) IStatsFunctionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatsFunctionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatsFunctionContext)
+}
+
+func (s *StreamstatsCommandContext) AllStatsOption() []IStatsOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatsOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatsOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatsOptionContext); ok {
+			tst[i] = t.(IStatsOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *StreamstatsCommandContext) StatsOption(i int) IStatsOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatsOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatsOptionContext)
+}
+
+func (s *StreamstatsCommandContext) BY() antlr.TerminalNode {
+	return s.GetToken(SPLParserBY, 0)
+}
+
+func (s *StreamstatsCommandContext) FieldList() IFieldListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldListContext)
+}
+
+func (s *StreamstatsCommandContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserCOMMA)
+}
+
+func (s *StreamstatsCommandContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserCOMMA, i)
+}
+
+func (s *StreamstatsCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StreamstatsCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StreamstatsCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterStreamstatsCommand(s)
+	}
+}
+
+func (s *StreamstatsCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitStreamstatsCommand(s)
+	}
+}
+
+func (s *StreamstatsCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitStreamstatsCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) StreamstatsCommand() (localctx IStreamstatsCommandContext) {
+	localctx = NewStreamstatsCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, SPLParserRULE_streamstatsCommand)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(663)
+		p.Match(SPLParserSTREAMSTATS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(667)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 70, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(664)
+				p.StatsOption()
+			}
+
+		}
+		p.SetState(669)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 70, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(670)
+		p.StatsFunction()
+	}
+	p.SetState(677)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 72, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(672)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == SPLParserCOMMA {
+				{
+					p.SetState(671)
+					p.Match(SPLParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(674)
+				p.StatsFunction()
+			}
+
+		}
+		p.SetState(679)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 72, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(683)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SPLParserIDENTIFIER {
+		{
+			p.SetState(680)
+			p.StatsOption()
+		}
+
+		p.SetState(685)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(688)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SPLParserBY {
+		{
+			p.SetState(686)
+			p.Match(SPLParserBY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(687)
+			p.FieldList()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IStatsOptionContext is an interface to support dynamic dispatch.
+type IStatsOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	NUMBER() antlr.TerminalNode
+	TIME_SPAN() antlr.TerminalNode
+
+	// IsStatsOptionContext differentiates from other interfaces.
+	IsStatsOptionContext()
+}
+
+type StatsOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStatsOptionContext() *StatsOptionContext {
+	var p = new(StatsOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_statsOption
+	return p
+}
+
+func InitEmptyStatsOptionContext(p *StatsOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_statsOption
+}
+
+func (*StatsOptionContext) IsStatsOptionContext() {}
+
+func NewStatsOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatsOptionContext {
+	var p = new(StatsOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_statsOption
+
+	return p
+}
+
+func (s *StatsOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StatsOptionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, 0)
+}
+
+func (s *StatsOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *StatsOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *StatsOptionContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *StatsOptionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *StatsOptionContext) TIME_SPAN() antlr.TerminalNode {
+	return s.GetToken(SPLParserTIME_SPAN, 0)
+}
+
+func (s *StatsOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StatsOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StatsOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterStatsOption(s)
+	}
+}
+
+func (s *StatsOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitStatsOption(s)
+	}
+}
+
+func (s *StatsOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitStatsOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) StatsOption() (localctx IStatsOptionContext) {
+	localctx = NewStatsOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, SPLParserRULE_statsOption)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(690)
+		p.Match(SPLParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(691)
+		p.Match(SPLParserEQ)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(696)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 75, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(692)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(693)
+			p.FieldName()
+		}
+
+	case 3:
+		{
+			p.SetState(694)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 4:
+		{
+			p.SetState(695)
+			p.Match(SPLParserTIME_SPAN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITimechartCommandContext is an interface to support dynamic dispatch.
+type ITimechartCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	TIMECHART() antlr.TerminalNode
+	AllStatsFunction() []IStatsFunctionContext
+	StatsFunction(i int) IStatsFunctionContext
+	AllTimechartOption() []ITimechartOptionContext
+	TimechartOption(i int) ITimechartOptionContext
+	BY() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsTimechartCommandContext differentiates from other interfaces.
+	IsTimechartCommandContext()
+}
+
+type TimechartCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTimechartCommandContext() *TimechartCommandContext {
+	var p = new(TimechartCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_timechartCommand
+	return p
+}
+
+func InitEmptyTimechartCommandContext(p *TimechartCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_timechartCommand
+}
+
+func (*TimechartCommandContext) IsTimechartCommandContext() {}
+
+func NewTimechartCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimechartCommandContext {
+	var p = new(TimechartCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_timechartCommand
+
+	return p
+}
+
+func (s *TimechartCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TimechartCommandContext) TIMECHART() antlr.TerminalNode {
+	return s.GetToken(SPLParserTIMECHART, 0)
+}
+
+func (s *TimechartCommandContext) AllStatsFunction() []IStatsFunctionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatsFunctionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatsFunctionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatsFunctionContext); ok {
+			tst[i] = t.(IStatsFunctionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TimechartCommandContext) StatsFunction(i int) IStatsFunctionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatsFunctionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatsFunctionContext)
+}
+
+func (s *TimechartCommandContext) AllTimechartOption() []ITimechartOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITimechartOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITimechartOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITimechartOptionContext); ok {
+			tst[i] = t.(ITimechartOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TimechartCommandContext) TimechartOption(i int) ITimechartOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITimechartOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITimechartOptionContext)
+}
+
+func (s *TimechartCommandContext) BY() antlr.TerminalNode {
+	return s.GetToken(SPLParserBY, 0)
+}
+
+func (s *TimechartCommandContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *TimechartCommandContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserCOMMA)
+}
+
+func (s *TimechartCommandContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserCOMMA, i)
+}
+
+func (s *TimechartCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TimechartCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TimechartCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterTimechartCommand(s)
+	}
+}
+
+func (s *TimechartCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitTimechartCommand(s)
+	}
+}
+
+func (s *TimechartCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitTimechartCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) TimechartCommand() (localctx ITimechartCommandContext) {
+	localctx = NewTimechartCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, SPLParserRULE_timechartCommand)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(698)
+		p.Match(SPLParserTIMECHART)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(702)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 76, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(699)
+				p.TimechartOption()
+			}
+
+		}
+		p.SetState(704)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 76, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(705)
+		p.StatsFunction()
+	}
+	p.SetState(712)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 78, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(707)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == SPLParserCOMMA {
+				{
+					p.SetState(706)
+					p.Match(SPLParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(709)
+				p.StatsFunction()
+			}
+
+		}
+		p.SetState(714)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 78, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(717)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SPLParserBY {
+		{
+			p.SetState(715)
+			p.Match(SPLParserBY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(716)
+			p.FieldName()
+		}
+
+	}
+	p.SetState(722)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SPLParserIDENTIFIER {
+		{
+			p.SetState(719)
+			p.TimechartOption()
+		}
+
+		p.SetState(724)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITimechartOptionContext is an interface to support dynamic dispatch.
+type ITimechartOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	NUMBER() antlr.TerminalNode
+	TIME_SPAN() antlr.TerminalNode
+
+	// IsTimechartOptionContext differentiates from other interfaces.
+	IsTimechartOptionContext()
+}
+
+type TimechartOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTimechartOptionContext() *TimechartOptionContext {
+	var p = new(TimechartOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_timechartOption
+	return p
+}
+
+func InitEmptyTimechartOptionContext(p *TimechartOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_timechartOption
+}
+
+func (*TimechartOptionContext) IsTimechartOptionContext() {}
+
+func NewTimechartOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimechartOptionContext {
+	var p = new(TimechartOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_timechartOption
+
+	return p
+}
+
+func (s *TimechartOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TimechartOptionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, 0)
+}
+
+func (s *TimechartOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *TimechartOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *TimechartOptionContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *TimechartOptionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *TimechartOptionContext) TIME_SPAN() antlr.TerminalNode {
+	return s.GetToken(SPLParserTIME_SPAN, 0)
+}
+
+func (s *TimechartOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TimechartOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TimechartOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterTimechartOption(s)
+	}
+}
+
+func (s *TimechartOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitTimechartOption(s)
+	}
+}
+
+func (s *TimechartOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitTimechartOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) TimechartOption() (localctx ITimechartOptionContext) {
+	localctx = NewTimechartOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, SPLParserRULE_timechartOption)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(725)
+		p.Match(SPLParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(726)
+		p.Match(SPLParserEQ)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(731)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 81, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(727)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(728)
+			p.FieldName()
+		}
+
+	case 3:
+		{
+			p.SetState(729)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 4:
+		{
+			p.SetState(730)
+			p.Match(SPLParserTIME_SPAN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IChartCommandContext is an interface to support dynamic dispatch.
+type IChartCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CHART() antlr.TerminalNode
+	AllStatsFunction() []IStatsFunctionContext
+	StatsFunction(i int) IStatsFunctionContext
+	OVER() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	BY() antlr.TerminalNode
+	FieldList() IFieldListContext
+	AllStatsOption() []IStatsOptionContext
+	StatsOption(i int) IStatsOptionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsChartCommandContext differentiates from other interfaces.
+	IsChartCommandContext()
+}
+
+type ChartCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyChartCommandContext() *ChartCommandContext {
+	var p = new(ChartCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_chartCommand
+	return p
+}
+
+func InitEmptyChartCommandContext(p *ChartCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_chartCommand
+}
+
+func (*ChartCommandContext) IsChartCommandContext() {}
+
+func NewChartCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChartCommandContext {
+	var p = new(ChartCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_chartCommand
+
+	return p
+}
+
+func (s *ChartCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ChartCommandContext) CHART() antlr.TerminalNode {
+	return s.GetToken(SPLParserCHART, 0)
+}
+
+func (s *ChartCommandContext) AllStatsFunction() []IStatsFunctionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatsFunctionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatsFunctionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatsFunctionContext); ok {
+			tst[i] = t.(IStatsFunctionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ChartCommandContext) StatsFunction(i int) IStatsFunctionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatsFunctionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatsFunctionContext)
+}
+
+func (s *ChartCommandContext) OVER() antlr.TerminalNode {
+	return s.GetToken(SPLParserOVER, 0)
+}
+
+func (s *ChartCommandContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *ChartCommandContext) BY() antlr.TerminalNode {
+	return s.GetToken(SPLParserBY, 0)
+}
+
+func (s *ChartCommandContext) FieldList() IFieldListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldListContext)
+}
+
+func (s *ChartCommandContext) AllStatsOption() []IStatsOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatsOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatsOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatsOptionContext); ok {
+			tst[i] = t.(IStatsOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ChartCommandContext) StatsOption(i int) IStatsOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatsOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatsOptionContext)
+}
+
+func (s *ChartCommandContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserCOMMA)
+}
+
+func (s *ChartCommandContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserCOMMA, i)
+}
+
+func (s *ChartCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ChartCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ChartCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterChartCommand(s)
+	}
+}
+
+func (s *ChartCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitChartCommand(s)
+	}
+}
+
+func (s *ChartCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitChartCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) ChartCommand() (localctx IChartCommandContext) {
+	localctx = NewChartCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, SPLParserRULE_chartCommand)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(733)
+		p.Match(SPLParserCHART)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(737)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 82, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(734)
+				p.StatsOption()
+			}
+
+		}
+		p.SetState(739)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 82, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(740)
+		p.StatsFunction()
+	}
+	p.SetState(747)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 84, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(742)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == SPLParserCOMMA {
+				{
+					p.SetState(741)
+					p.Match(SPLParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(744)
+				p.StatsFunction()
+			}
+
+		}
+		p.SetState(749)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 84, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(763)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 87, p.GetParserRuleContext()) {
+	case 1:
+		p.SetState(752)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SPLParserBY {
+			{
+				p.SetState(750)
+				p.Match(SPLParserBY)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(751)
+				p.FieldList()
+			}
+
+		}
+		p.SetState(756)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SPLParserOVER {
+			{
+				p.SetState(754)
+				p.Match(SPLParserOVER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(755)
+				p.FieldName()
+			}
+
+		}
+
+	case 2:
+		{
+			p.SetState(758)
+			p.Match(SPLParserOVER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(759)
+			p.FieldName()
+		}
+		{
+			p.SetState(760)
+			p.Match(SPLParserBY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(761)
+			p.FieldList()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+	p.SetState(768)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SPLParserIDENTIFIER {
+		{
+			p.SetState(765)
+			p.StatsOption()
+		}
+
+		p.SetState(770)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFillnullCommandContext is an interface to support dynamic dispatch.
+type IFillnullCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	FILLNULL() antlr.TerminalNode
+	AllFillnullOption() []IFillnullOptionContext
+	FillnullOption(i int) IFillnullOptionContext
+	FieldList() IFieldListContext
+
+	// IsFillnullCommandContext differentiates from other interfaces.
+	IsFillnullCommandContext()
+}
+
+type FillnullCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFillnullCommandContext() *FillnullCommandContext {
+	var p = new(FillnullCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_fillnullCommand
+	return p
+}
+
+func InitEmptyFillnullCommandContext(p *FillnullCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_fillnullCommand
+}
+
+func (*FillnullCommandContext) IsFillnullCommandContext() {}
+
+func NewFillnullCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FillnullCommandContext {
+	var p = new(FillnullCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_fillnullCommand
+
+	return p
+}
+
+func (s *FillnullCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FillnullCommandContext) FILLNULL() antlr.TerminalNode {
+	return s.GetToken(SPLParserFILLNULL, 0)
+}
+
+func (s *FillnullCommandContext) AllFillnullOption() []IFillnullOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFillnullOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFillnullOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFillnullOptionContext); ok {
+			tst[i] = t.(IFillnullOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FillnullCommandContext) FillnullOption(i int) IFillnullOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFillnullOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFillnullOptionContext)
+}
+
+func (s *FillnullCommandContext) FieldList() IFieldListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldListContext)
+}
+
+func (s *FillnullCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FillnullCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FillnullCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterFillnullCommand(s)
+	}
+}
+
+func (s *FillnullCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitFillnullCommand(s)
+	}
+}
+
+func (s *FillnullCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitFillnullCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) FillnullCommand() (localctx IFillnullCommandContext) {
+	localctx = NewFillnullCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, SPLParserRULE_fillnullCommand)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(771)
+		p.Match(SPLParserFILLNULL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(775)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 89, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(772)
+				p.FillnullOption()
+			}
+
+		}
+		p.SetState(777)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 89, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	p.SetState(779)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17173822373380352) != 0) || ((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&6531) != 0) {
+		{
+			p.SetState(778)
+			p.FieldList()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFillnullOptionContext is an interface to support dynamic dispatch.
+type IFillnullOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	NUMBER() antlr.TerminalNode
+
+	// IsFillnullOptionContext differentiates from other interfaces.
+	IsFillnullOptionContext()
+}
+
+type FillnullOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFillnullOptionContext() *FillnullOptionContext {
+	var p = new(FillnullOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_fillnullOption
+	return p
+}
+
+func InitEmptyFillnullOptionContext(p *FillnullOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_fillnullOption
+}
+
+func (*FillnullOptionContext) IsFillnullOptionContext() {}
+
+func NewFillnullOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FillnullOptionContext {
+	var p = new(FillnullOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_fillnullOption
+
+	return p
+}
+
+func (s *FillnullOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FillnullOptionContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserIDENTIFIER)
+}
+
+func (s *FillnullOptionContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, i)
+}
+
+func (s *FillnullOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *FillnullOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *FillnullOptionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *FillnullOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FillnullOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FillnullOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterFillnullOption(s)
+	}
+}
+
+func (s *FillnullOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitFillnullOption(s)
+	}
+}
+
+func (s *FillnullOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitFillnullOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) FillnullOption() (localctx IFillnullOptionContext) {
+	localctx = NewFillnullOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, SPLParserRULE_fillnullOption)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(781)
+		p.Match(SPLParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(782)
+		p.Match(SPLParserEQ)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(783)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&4225) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMakemvCommandContext is an interface to support dynamic dispatch.
+type IMakemvCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MAKEMV() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	AllMakemvOption() []IMakemvOptionContext
+	MakemvOption(i int) IMakemvOptionContext
+
+	// IsMakemvCommandContext differentiates from other interfaces.
+	IsMakemvCommandContext()
+}
+
+type MakemvCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMakemvCommandContext() *MakemvCommandContext {
+	var p = new(MakemvCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_makemvCommand
+	return p
+}
+
+func InitEmptyMakemvCommandContext(p *MakemvCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_makemvCommand
+}
+
+func (*MakemvCommandContext) IsMakemvCommandContext() {}
+
+func NewMakemvCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MakemvCommandContext {
+	var p = new(MakemvCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_makemvCommand
+
+	return p
+}
+
+func (s *MakemvCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MakemvCommandContext) MAKEMV() antlr.TerminalNode {
+	return s.GetToken(SPLParserMAKEMV, 0)
+}
+
+func (s *MakemvCommandContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *MakemvCommandContext) AllMakemvOption() []IMakemvOptionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMakemvOptionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMakemvOptionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMakemvOptionContext); ok {
+			tst[i] = t.(IMakemvOptionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MakemvCommandContext) MakemvOption(i int) IMakemvOptionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMakemvOptionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMakemvOptionContext)
+}
+
+func (s *MakemvCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MakemvCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MakemvCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterMakemvCommand(s)
+	}
+}
+
+func (s *MakemvCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitMakemvCommand(s)
+	}
+}
+
+func (s *MakemvCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitMakemvCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) MakemvCommand() (localctx IMakemvCommandContext) {
+	localctx = NewMakemvCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, SPLParserRULE_makemvCommand)
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(785)
+		p.Match(SPLParserMAKEMV)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(789)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 91, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(786)
+				p.MakemvOption()
+			}
+
+		}
+		p.SetState(791)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 91, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(792)
+		p.FieldName()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMakemvOptionContext is an interface to support dynamic dispatch.
+type IMakemvOptionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IDENTIFIER() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	FieldName() IFieldNameContext
+	NUMBER() antlr.TerminalNode
+
+	// IsMakemvOptionContext differentiates from other interfaces.
+	IsMakemvOptionContext()
+}
+
+type MakemvOptionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMakemvOptionContext() *MakemvOptionContext {
+	var p = new(MakemvOptionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_makemvOption
+	return p
+}
+
+func InitEmptyMakemvOptionContext(p *MakemvOptionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_makemvOption
+}
+
+func (*MakemvOptionContext) IsMakemvOptionContext() {}
+
+func NewMakemvOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MakemvOptionContext {
+	var p = new(MakemvOptionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_makemvOption
+
+	return p
+}
+
+func (s *MakemvOptionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MakemvOptionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, 0)
+}
+
+func (s *MakemvOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *MakemvOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *MakemvOptionContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *MakemvOptionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *MakemvOptionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MakemvOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MakemvOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterMakemvOption(s)
+	}
+}
+
+func (s *MakemvOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitMakemvOption(s)
+	}
+}
+
+func (s *MakemvOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitMakemvOption(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) MakemvOption() (localctx IMakemvOptionContext) {
+	localctx = NewMakemvOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, SPLParserRULE_makemvOption)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(794)
+		p.Match(SPLParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(795)
+		p.Match(SPLParserEQ)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(799)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 92, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(796)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(797)
+			p.FieldName()
+		}
+
+	case 3:
+		{
+			p.SetState(798)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMvexpandCommandContext is an interface to support dynamic dispatch.
+type IMvexpandCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MVEXPAND() antlr.TerminalNode
+	FieldName() IFieldNameContext
+
+	// IsMvexpandCommandContext differentiates from other interfaces.
+	IsMvexpandCommandContext()
+}
+
+type MvexpandCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMvexpandCommandContext() *MvexpandCommandContext {
+	var p = new(MvexpandCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_mvexpandCommand
+	return p
+}
+
+func InitEmptyMvexpandCommandContext(p *MvexpandCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_mvexpandCommand
+}
+
+func (*MvexpandCommandContext) IsMvexpandCommandContext() {}
+
+func NewMvexpandCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MvexpandCommandContext {
+	var p = new(MvexpandCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_mvexpandCommand
+
+	return p
+}
+
+func (s *MvexpandCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MvexpandCommandContext) MVEXPAND() antlr.TerminalNode {
+	return s.GetToken(SPLParserMVEXPAND, 0)
+}
+
+func (s *MvexpandCommandContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *MvexpandCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MvexpandCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MvexpandCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterMvexpandCommand(s)
+	}
+}
+
+func (s *MvexpandCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitMvexpandCommand(s)
+	}
+}
+
+func (s *MvexpandCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitMvexpandCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) MvexpandCommand() (localctx IMvexpandCommandContext) {
+	localctx = NewMvexpandCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, SPLParserRULE_mvexpandCommand)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(801)
+		p.Match(SPLParserMVEXPAND)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(802)
+		p.FieldName()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFormatCommandContext is an interface to support dynamic dispatch.
+type IFormatCommandContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	FORMAT() antlr.TerminalNode
+	AllFormatArg() []IFormatArgContext
+	FormatArg(i int) IFormatArgContext
+
+	// IsFormatCommandContext differentiates from other interfaces.
+	IsFormatCommandContext()
+}
+
+type FormatCommandContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFormatCommandContext() *FormatCommandContext {
+	var p = new(FormatCommandContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_formatCommand
+	return p
+}
+
+func InitEmptyFormatCommandContext(p *FormatCommandContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_formatCommand
+}
+
+func (*FormatCommandContext) IsFormatCommandContext() {}
+
+func NewFormatCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FormatCommandContext {
+	var p = new(FormatCommandContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_formatCommand
+
+	return p
+}
+
+func (s *FormatCommandContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FormatCommandContext) FORMAT() antlr.TerminalNode {
+	return s.GetToken(SPLParserFORMAT, 0)
+}
+
+func (s *FormatCommandContext) AllFormatArg() []IFormatArgContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFormatArgContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFormatArgContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFormatArgContext); ok {
+			tst[i] = t.(IFormatArgContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FormatCommandContext) FormatArg(i int) IFormatArgContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFormatArgContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFormatArgContext)
+}
+
+func (s *FormatCommandContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FormatCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FormatCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterFormatCommand(s)
+	}
+}
+
+func (s *FormatCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitFormatCommand(s)
+	}
+}
+
+func (s *FormatCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitFormatCommand(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) FormatCommand() (localctx IFormatCommandContext) {
+	localctx = NewFormatCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, SPLParserRULE_formatCommand)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(804)
+		p.Match(SPLParserFORMAT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(808)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SPLParserQUOTED_STRING || _la == SPLParserIDENTIFIER {
 		{
-			p.SetState(504)
-			p.FieldName()
+			p.SetState(805)
+			p.FormatArg()
 		}
 
+		p.SetState(810)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -8054,73 +13095,57 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITimechartOptionContext is an interface to support dynamic dispatch.
-type ITimechartOptionContext interface {
+// IFormatArgContext is an interface to support dynamic dispatch.
+type IFormatArgContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
-	EQ() antlr.TerminalNode
+	FormatOption() IFormatOptionContext
 	QUOTED_STRING() antlr.TerminalNode
-	FieldName() IFieldNameContext
-	NUMBER() antlr.TerminalNode
-	TIME_SPAN() antlr.TerminalNode
 
-	// IsTimechartOptionContext differentiates from other interfaces.
-	IsTimechartOptionContext()
+	// IsFormatArgContext differentiates from other interfaces.
+	IsFormatArgContext()
 }
 
-type TimechartOptionContext struct {
+type FormatArgContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTimechartOptionContext() *TimechartOptionContext {
-	var p = new(TimechartOptionContext)
+func NewEmptyFormatArgContext() *FormatArgContext {
+	var p = new(FormatArgContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_timechartOption
+	p.RuleIndex = SPLParserRULE_formatArg
 	return p
 }
 
-func InitEmptyTimechartOptionContext(p *TimechartOptionContext) {
+func InitEmptyFormatArgContext(p *FormatArgContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_timechartOption
+	p.RuleIndex = SPLParserRULE_formatArg
 }
 
-func (*TimechartOptionContext) IsTimechartOptionContext() {}
+func (*FormatArgContext) IsFormatArgContext() {}
 
-func NewTimechartOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimechartOptionContext {
-	var p = new(TimechartOptionContext)
+func NewFormatArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FormatArgContext {
+	var p = new(FormatArgContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_timechartOption
+	p.RuleIndex = SPLParserRULE_formatArg
 
 	return p
 }
 
-func (s *TimechartOptionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TimechartOptionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SPLParserIDENTIFIER, 0)
-}
-
-func (s *TimechartOptionContext) EQ() antlr.TerminalNode {
-	return s.GetToken(SPLParserEQ, 0)
-}
-
-func (s *TimechartOptionContext) QUOTED_STRING() antlr.TerminalNode {
-	return s.GetToken(SPLParserQUOTED_STRING, 0)
-}
+func (s *FormatArgContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TimechartOptionContext) FieldName() IFieldNameContext {
+func (s *FormatArgContext) FormatOption() IFormatOptionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldNameContext); ok {
+		if _, ok := ctx.(IFormatOptionContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8130,111 +13155,73 @@ func (s *TimechartOptionContext) FieldName() IFieldNameContext {
 		return nil
 	}
 
-	return t.(IFieldNameContext)
-}
-
-func (s *TimechartOptionContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(SPLParserNUMBER, 0)
+	return t.(IFormatOptionContext)
 }
 
-func (s *TimechartOptionContext) TIME_SPAN() antlr.TerminalNode {
-	return s.GetToken(SPLParserTIME_SPAN, 0)
+func (s *FormatArgContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
-func (s *TimechartOptionContext) GetRuleContext() antlr.RuleContext {
+func (s *FormatArgContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TimechartOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FormatArgContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TimechartOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FormatArgContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterTimechartOption(s)
+		listenerT.EnterFormatArg(s)
 	}
 }
 
-func (s *TimechartOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FormatArgContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitTimechartOption(s)
+		listenerT.ExitFormatArg(s)
 	}
 }
 
-func (s *TimechartOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FormatArgContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitTimechartOption(s)
+		return t.VisitFormatArg(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) TimechartOption() (localctx ITimechartOptionContext) {
-	localctx = NewTimechartOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, SPLParserRULE_timechartOption)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(507)
-		p.Match(SPLParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(508)
-		p.Match(SPLParserEQ)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(513)
+func (p *SPLParser) FormatArg() (localctx IFormatArgContext) {
+	localctx = NewFormatArgContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, SPLParserRULE_formatArg)
+	p.SetState(813)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 51, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(509)
-			p.Match(SPLParserQUOTED_STRING)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case 2:
-		{
-			p.SetState(510)
-			p.FieldName()
-		}
-
-	case 3:
+	switch p.GetTokenStream().LA(1) {
+	case SPLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(511)
-			p.Match(SPLParserNUMBER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(811)
+			p.FormatOption()
 		}
 
-	case 4:
+	case SPLParserQUOTED_STRING:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(512)
-			p.Match(SPLParserTIME_SPAN)
+			p.SetState(812)
+			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	case antlr.ATNInvalidAltNumber:
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
@@ -8251,208 +13238,133 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IChartCommandContext is an interface to support dynamic dispatch.
-type IChartCommandContext interface {
+// IFormatOptionContext is an interface to support dynamic dispatch.
+type IFormatOptionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	CHART() antlr.TerminalNode
-	StatsFunction() IStatsFunctionContext
-	BY() antlr.TerminalNode
-	FieldList() IFieldListContext
-	OVER() antlr.TerminalNode
-	FieldName() IFieldNameContext
+	IDENTIFIER() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	QUOTED_STRING() antlr.TerminalNode
+	NUMBER() antlr.TerminalNode
 
-	// IsChartCommandContext differentiates from other interfaces.
-	IsChartCommandContext()
+	// IsFormatOptionContext differentiates from other interfaces.
+	IsFormatOptionContext()
 }
 
-type ChartCommandContext struct {
+type FormatOptionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyChartCommandContext() *ChartCommandContext {
-	var p = new(ChartCommandContext)
+func NewEmptyFormatOptionContext() *FormatOptionContext {
+	var p = new(FormatOptionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_chartCommand
+	p.RuleIndex = SPLParserRULE_formatOption
 	return p
 }
 
-func InitEmptyChartCommandContext(p *ChartCommandContext) {
+func InitEmptyFormatOptionContext(p *FormatOptionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_chartCommand
+	p.RuleIndex = SPLParserRULE_formatOption
 }
 
-func (*ChartCommandContext) IsChartCommandContext() {}
+func (*FormatOptionContext) IsFormatOptionContext() {}
 
-func NewChartCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChartCommandContext {
-	var p = new(ChartCommandContext)
+func NewFormatOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FormatOptionContext {
+	var p = new(FormatOptionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_chartCommand
+	p.RuleIndex = SPLParserRULE_formatOption
 
 	return p
 }
 
-func (s *ChartCommandContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ChartCommandContext) CHART() antlr.TerminalNode {
-	return s.GetToken(SPLParserCHART, 0)
-}
-
-func (s *ChartCommandContext) StatsFunction() IStatsFunctionContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IStatsFunctionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStatsFunctionContext)
-}
+func (s *FormatOptionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ChartCommandContext) BY() antlr.TerminalNode {
-	return s.GetToken(SPLParserBY, 0)
+func (s *FormatOptionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserIDENTIFIER, 0)
 }
 
-func (s *ChartCommandContext) FieldList() IFieldListContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFieldListContext)
+func (s *FormatOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
 }
 
-func (s *ChartCommandContext) OVER() antlr.TerminalNode {
-	return s.GetToken(SPLParserOVER, 0)
+func (s *FormatOptionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
-func (s *ChartCommandContext) FieldName() IFieldNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFieldNameContext)
+func (s *FormatOptionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
 }
 
-func (s *ChartCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *FormatOptionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ChartCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FormatOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ChartCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FormatOptionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterChartCommand(s)
+		listenerT.EnterFormatOption(s)
 	}
 }
 
-func (s *ChartCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FormatOptionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitChartCommand(s)
+		listenerT.ExitFormatOption(s)
 	}
 }
 
-func (s *ChartCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FormatOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitChartCommand(s)
+		return t.VisitFormatOption(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) ChartCommand() (localctx IChartCommandContext) {
-	localctx = NewChartCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, SPLParserRULE_chartCommand)
+func (p *SPLParser) FormatOption() (localctx IFormatOptionContext) {
+	localctx = NewFormatOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, SPLParserRULE_formatOption)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(515)
-		p.Match(SPLParserCHART)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(516)
-		p.StatsFunction()
-	}
-	p.SetState(519)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SPLParserBY {
-		{
-			p.SetState(517)
-			p.Match(SPLParserBY)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(518)
-			p.FieldList()
+		p.SetState(815)
+		p.Match(SPLParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
 	}
-	p.SetState(523)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	{
+		p.SetState(816)
+		p.Match(SPLParserEQ)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	_la = p.GetTokenStream().LA(1)
+	{
+		p.SetState(817)
+		_la = p.GetTokenStream().LA(1)
 
-	if _la == SPLParserOVER {
-		{
-			p.SetState(521)
-			p.Match(SPLParserOVER)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(522)
-			p.FieldName()
+		if !(_la == SPLParserQUOTED_STRING || _la == SPLParserNUMBER) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
 	}
 
 errorExit:
@@ -8468,73 +13380,77 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFillnullCommandContext is an interface to support dynamic dispatch.
-type IFillnullCommandContext interface {
+// IReturnCommandContext is an interface to support dynamic dispatch.
+type IReturnCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	FILLNULL() antlr.TerminalNode
-	AllFillnullOption() []IFillnullOptionContext
-	FillnullOption(i int) IFillnullOptionContext
-	FieldList() IFieldListContext
+	RETURN() antlr.TerminalNode
+	NUMBER() antlr.TerminalNode
+	AllReturnItem() []IReturnItemContext
+	ReturnItem(i int) IReturnItemContext
 
-	// IsFillnullCommandContext differentiates from other interfaces.
-	IsFillnullCommandContext()
+	// IsReturnCommandContext differentiates from other interfaces.
+	IsReturnCommandContext()
 }
 
-type FillnullCommandContext struct {
+type ReturnCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFillnullCommandContext() *FillnullCommandContext {
-	var p = new(FillnullCommandContext)
+func NewEmptyReturnCommandContext() *ReturnCommandContext {
+	var p = new(ReturnCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_fillnullCommand
+	p.RuleIndex = SPLParserRULE_returnCommand
 	return p
 }
 
-func InitEmptyFillnullCommandContext(p *FillnullCommandContext) {
+func InitEmptyReturnCommandContext(p *ReturnCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_fillnullCommand
+	p.RuleIndex = SPLParserRULE_returnCommand
 }
 
-func (*FillnullCommandContext) IsFillnullCommandContext() {}
+func (*ReturnCommandContext) IsReturnCommandContext() {}
 
-func NewFillnullCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FillnullCommandContext {
-	var p = new(FillnullCommandContext)
+func NewReturnCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnCommandContext {
+	var p = new(ReturnCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_fillnullCommand
+	p.RuleIndex = SPLParserRULE_returnCommand
 
 	return p
 }
 
-func (s *FillnullCommandContext) GetParser() antlr.Parser { return s.parser }
+func (s *ReturnCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FillnullCommandContext) FILLNULL() antlr.TerminalNode {
-	return s.GetToken(SPLParserFILLNULL, 0)
+func (s *ReturnCommandContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(SPLParserRETURN, 0)
 }
 
-func (s *FillnullCommandContext) AllFillnullOption() []IFillnullOptionContext {
+func (s *ReturnCommandContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *ReturnCommandContext) AllReturnItem() []IReturnItemContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IFillnullOptionContext); ok {
+		if _, ok := ctx.(IReturnItemContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IFillnullOptionContext, len)
+	tst := make([]IReturnItemContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IFillnullOptionContext); ok {
-			tst[i] = t.(IFillnullOptionContext)
+		if t, ok := ctx.(IReturnItemContext); ok {
+			tst[i] = t.(IReturnItemContext)
 			i++
 		}
 	}
@@ -8542,11 +13458,11 @@ func (s *FillnullCommandContext) AllFillnullOption() []IFillnullOptionContext {
 	return tst
 }
 
-func (s *FillnullCommandContext) FillnullOption(i int) IFillnullOptionContext {
+func (s *ReturnCommandContext) ReturnItem(i int) IReturnItemContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFillnullOptionContext); ok {
+		if _, ok := ctx.(IReturnItemContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -8559,111 +13475,88 @@ func (s *FillnullCommandContext) FillnullOption(i int) IFillnullOptionContext {
 		return nil
 	}
 
-	return t.(IFillnullOptionContext)
-}
-
-func (s *FillnullCommandContext) FieldList() IFieldListContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFieldListContext)
+	return t.(IReturnItemContext)
 }
 
-func (s *FillnullCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *ReturnCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FillnullCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReturnCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FillnullCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ReturnCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterFillnullCommand(s)
+		listenerT.EnterReturnCommand(s)
 	}
 }
 
-func (s *FillnullCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ReturnCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitFillnullCommand(s)
+		listenerT.ExitReturnCommand(s)
 	}
 }
 
-func (s *FillnullCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ReturnCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitFillnullCommand(s)
+		return t.VisitReturnCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) FillnullCommand() (localctx IFillnullCommandContext) {
-	localctx = NewFillnullCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, SPLParserRULE_fillnullCommand)
+func (p *SPLParser) ReturnCommand() (localctx IReturnCommandContext) {
+	localctx = NewReturnCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, SPLParserRULE_returnCommand)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(525)
-		p.Match(SPLParserFILLNULL)
+		p.SetState(819)
+		p.Match(SPLParserRETURN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(529)
+	p.SetState(821)
 	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(526)
-				p.FillnullOption()
-			}
 
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 95, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(820)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		p.SetState(531)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
 	}
-	p.SetState(533)
+	p.SetState(826)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-40)) & ^0x3f) == 0 && ((int64(1)<<(_la-40))&54223962125) != 0 {
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17173822373380352) != 0) || ((int64((_la-82)) & ^0x3f) == 0 && ((int64(1)<<(_la-82))&3393) != 0) {
 		{
-			p.SetState(532)
-			p.FieldList()
+			p.SetState(823)
+			p.ReturnItem()
 		}
 
+		p.SetState(828)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -8679,133 +13572,187 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFillnullOptionContext is an interface to support dynamic dispatch.
-type IFillnullOptionContext interface {
+// IReturnItemContext is an interface to support dynamic dispatch.
+type IReturnItemContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
+	AllFieldName() []IFieldNameContext
+	FieldName(i int) IFieldNameContext
 	EQ() antlr.TerminalNode
-	QUOTED_STRING() antlr.TerminalNode
-	NUMBER() antlr.TerminalNode
+	DOLLAR() antlr.TerminalNode
 
-	// IsFillnullOptionContext differentiates from other interfaces.
-	IsFillnullOptionContext()
+	// IsReturnItemContext differentiates from other interfaces.
+	IsReturnItemContext()
 }
 
-type FillnullOptionContext struct {
+type ReturnItemContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFillnullOptionContext() *FillnullOptionContext {
-	var p = new(FillnullOptionContext)
+func NewEmptyReturnItemContext() *ReturnItemContext {
+	var p = new(ReturnItemContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_fillnullOption
+	p.RuleIndex = SPLParserRULE_returnItem
 	return p
 }
 
-func InitEmptyFillnullOptionContext(p *FillnullOptionContext) {
+func InitEmptyReturnItemContext(p *ReturnItemContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_fillnullOption
+	p.RuleIndex = SPLParserRULE_returnItem
 }
 
-func (*FillnullOptionContext) IsFillnullOptionContext() {}
+func (*ReturnItemContext) IsReturnItemContext() {}
 
-func NewFillnullOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FillnullOptionContext {
-	var p = new(FillnullOptionContext)
+func NewReturnItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnItemContext {
+	var p = new(ReturnItemContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_fillnullOption
+	p.RuleIndex = SPLParserRULE_returnItem
 
 	return p
 }
 
-func (s *FillnullOptionContext) GetParser() antlr.Parser { return s.parser }
+func (s *ReturnItemContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FillnullOptionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SPLParserIDENTIFIER, 0)
+func (s *ReturnItemContext) AllFieldName() []IFieldNameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFieldNameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldNameContext); ok {
+			tst[i] = t.(IFieldNameContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *FillnullOptionContext) EQ() antlr.TerminalNode {
-	return s.GetToken(SPLParserEQ, 0)
+func (s *ReturnItemContext) FieldName(i int) IFieldNameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
 }
 
-func (s *FillnullOptionContext) QUOTED_STRING() antlr.TerminalNode {
-	return s.GetToken(SPLParserQUOTED_STRING, 0)
+func (s *ReturnItemContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
 }
 
-func (s *FillnullOptionContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(SPLParserNUMBER, 0)
+func (s *ReturnItemContext) DOLLAR() antlr.TerminalNode {
+	return s.GetToken(SPLParserDOLLAR, 0)
 }
 
-func (s *FillnullOptionContext) GetRuleContext() antlr.RuleContext {
+func (s *ReturnItemContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FillnullOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReturnItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FillnullOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ReturnItemContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterFillnullOption(s)
+		listenerT.EnterReturnItem(s)
 	}
 }
 
-func (s *FillnullOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ReturnItemContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitFillnullOption(s)
+		listenerT.ExitReturnItem(s)
 	}
 }
 
-func (s *FillnullOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ReturnItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitFillnullOption(s)
+		return t.VisitReturnItem(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) FillnullOption() (localctx IFillnullOptionContext) {
-	localctx = NewFillnullOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, SPLParserRULE_fillnullOption)
-	var _la int
+func (p *SPLParser) ReturnItem() (localctx IReturnItemContext) {
+	localctx = NewReturnItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, SPLParserRULE_returnItem)
+	p.SetState(836)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(535)
-		p.Match(SPLParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 97, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(829)
+			p.FieldName()
 		}
-	}
-	{
-		p.SetState(536)
-		p.Match(SPLParserEQ)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(830)
+			p.Match(SPLParserEQ)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(831)
+			p.FieldName()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(833)
+			p.Match(SPLParserDOLLAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(834)
+			p.FieldName()
 		}
-	}
-	{
-		p.SetState(537)
-		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == SPLParserQUOTED_STRING || _la == SPLParserNUMBER) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(835)
+			p.FieldName()
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -8821,63 +13768,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMakemvCommandContext is an interface to support dynamic dispatch.
-type IMakemvCommandContext interface {
+// IForeachCommandContext is an interface to support dynamic dispatch.
+type IForeachCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	MAKEMV() antlr.TerminalNode
-	FieldName() IFieldNameContext
-	AllMakemvOption() []IMakemvOptionContext
-	MakemvOption(i int) IMakemvOptionContext
+	FOREACH() antlr.TerminalNode
+	Subsearch() ISubsearchContext
+	AllForeachArg() []IForeachArgContext
+	ForeachArg(i int) IForeachArgContext
 
-	// IsMakemvCommandContext differentiates from other interfaces.
-	IsMakemvCommandContext()
+	// IsForeachCommandContext differentiates from other interfaces.
+	IsForeachCommandContext()
 }
 
-type MakemvCommandContext struct {
+type ForeachCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMakemvCommandContext() *MakemvCommandContext {
-	var p = new(MakemvCommandContext)
+func NewEmptyForeachCommandContext() *ForeachCommandContext {
+	var p = new(ForeachCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_makemvCommand
+	p.RuleIndex = SPLParserRULE_foreachCommand
 	return p
 }
 
-func InitEmptyMakemvCommandContext(p *MakemvCommandContext) {
+func InitEmptyForeachCommandContext(p *ForeachCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_makemvCommand
+	p.RuleIndex = SPLParserRULE_foreachCommand
 }
 
-func (*MakemvCommandContext) IsMakemvCommandContext() {}
+func (*ForeachCommandContext) IsForeachCommandContext() {}
 
-func NewMakemvCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MakemvCommandContext {
-	var p = new(MakemvCommandContext)
+func NewForeachCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForeachCommandContext {
+	var p = new(ForeachCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_makemvCommand
+	p.RuleIndex = SPLParserRULE_foreachCommand
 
 	return p
 }
 
-func (s *MakemvCommandContext) GetParser() antlr.Parser { return s.parser }
+func (s *ForeachCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MakemvCommandContext) MAKEMV() antlr.TerminalNode {
-	return s.GetToken(SPLParserMAKEMV, 0)
+func (s *ForeachCommandContext) FOREACH() antlr.TerminalNode {
+	return s.GetToken(SPLParserFOREACH, 0)
 }
 
-func (s *MakemvCommandContext) FieldName() IFieldNameContext {
+func (s *ForeachCommandContext) Subsearch() ISubsearchContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldNameContext); ok {
+		if _, ok := ctx.(ISubsearchContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8887,23 +13834,23 @@ func (s *MakemvCommandContext) FieldName() IFieldNameContext {
 		return nil
 	}
 
-	return t.(IFieldNameContext)
+	return t.(ISubsearchContext)
 }
 
-func (s *MakemvCommandContext) AllMakemvOption() []IMakemvOptionContext {
+func (s *ForeachCommandContext) AllForeachArg() []IForeachArgContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IMakemvOptionContext); ok {
+		if _, ok := ctx.(IForeachArgContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IMakemvOptionContext, len)
+	tst := make([]IForeachArgContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IMakemvOptionContext); ok {
-			tst[i] = t.(IMakemvOptionContext)
+		if t, ok := ctx.(IForeachArgContext); ok {
+			tst[i] = t.(IForeachArgContext)
 			i++
 		}
 	}
@@ -8911,11 +13858,11 @@ func (s *MakemvCommandContext) AllMakemvOption() []IMakemvOptionContext {
 	return tst
 }
 
-func (s *MakemvCommandContext) MakemvOption(i int) IMakemvOptionContext {
+func (s *ForeachCommandContext) ForeachArg(i int) IForeachArgContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMakemvOptionContext); ok {
+		if _, ok := ctx.(IForeachArgContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -8928,83 +13875,76 @@ func (s *MakemvCommandContext) MakemvOption(i int) IMakemvOptionContext {
 		return nil
 	}
 
-	return t.(IMakemvOptionContext)
+	return t.(IForeachArgContext)
 }
 
-func (s *MakemvCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *ForeachCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MakemvCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ForeachCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MakemvCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ForeachCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterMakemvCommand(s)
+		listenerT.EnterForeachCommand(s)
 	}
 }
 
-func (s *MakemvCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ForeachCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitMakemvCommand(s)
+		listenerT.ExitForeachCommand(s)
 	}
 }
 
-func (s *MakemvCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ForeachCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitMakemvCommand(s)
+		return t.VisitForeachCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) MakemvCommand() (localctx IMakemvCommandContext) {
-	localctx = NewMakemvCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, SPLParserRULE_makemvCommand)
-	var _alt int
+func (p *SPLParser) ForeachCommand() (localctx IForeachCommandContext) {
+	localctx = NewForeachCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, SPLParserRULE_foreachCommand)
+	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(539)
-		p.Match(SPLParserMAKEMV)
+		p.SetState(838)
+		p.Match(SPLParserFOREACH)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(543)
+	p.SetState(842)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 56, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(540)
-				p.MakemvOption()
-			}
+	_la = p.GetTokenStream().LA(1)
 
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17173822373380352) != 0) || ((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&6531) != 0) {
+		{
+			p.SetState(839)
+			p.ForeachArg()
 		}
-		p.SetState(545)
+
+		p.SetState(844)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 56, p.GetParserRuleContext())
-		if p.HasError() {
-			goto errorExit
-		}
+		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(546)
-		p.FieldName()
+		p.SetState(845)
+		p.Subsearch()
 	}
 
 errorExit:
@@ -9020,8 +13960,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMakemvOptionContext is an interface to support dynamic dispatch.
-type IMakemvOptionContext interface {
+// IForeachArgContext is an interface to support dynamic dispatch.
+type IForeachArgContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -9033,56 +13973,57 @@ type IMakemvOptionContext interface {
 	QUOTED_STRING() antlr.TerminalNode
 	FieldName() IFieldNameContext
 	NUMBER() antlr.TerminalNode
+	WildcardValue() IWildcardValueContext
 
-	// IsMakemvOptionContext differentiates from other interfaces.
-	IsMakemvOptionContext()
+	// IsForeachArgContext differentiates from other interfaces.
+	IsForeachArgContext()
 }
 
-type MakemvOptionContext struct {
+type ForeachArgContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMakemvOptionContext() *MakemvOptionContext {
-	var p = new(MakemvOptionContext)
+func NewEmptyForeachArgContext() *ForeachArgContext {
+	var p = new(ForeachArgContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_makemvOption
+	p.RuleIndex = SPLParserRULE_foreachArg
 	return p
 }
 
-func InitEmptyMakemvOptionContext(p *MakemvOptionContext) {
+func InitEmptyForeachArgContext(p *ForeachArgContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_makemvOption
+	p.RuleIndex = SPLParserRULE_foreachArg
 }
 
-func (*MakemvOptionContext) IsMakemvOptionContext() {}
+func (*ForeachArgContext) IsForeachArgContext() {}
 
-func NewMakemvOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MakemvOptionContext {
-	var p = new(MakemvOptionContext)
+func NewForeachArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForeachArgContext {
+	var p = new(ForeachArgContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_makemvOption
+	p.RuleIndex = SPLParserRULE_foreachArg
 
 	return p
 }
 
-func (s *MakemvOptionContext) GetParser() antlr.Parser { return s.parser }
+func (s *ForeachArgContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MakemvOptionContext) IDENTIFIER() antlr.TerminalNode {
+func (s *ForeachArgContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(SPLParserIDENTIFIER, 0)
 }
 
-func (s *MakemvOptionContext) EQ() antlr.TerminalNode {
+func (s *ForeachArgContext) EQ() antlr.TerminalNode {
 	return s.GetToken(SPLParserEQ, 0)
 }
 
-func (s *MakemvOptionContext) QUOTED_STRING() antlr.TerminalNode {
+func (s *ForeachArgContext) QUOTED_STRING() antlr.TerminalNode {
 	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
-func (s *MakemvOptionContext) FieldName() IFieldNameContext {
+func (s *ForeachArgContext) FieldName() IFieldNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IFieldNameContext); ok {
@@ -9098,222 +14039,148 @@ func (s *MakemvOptionContext) FieldName() IFieldNameContext {
 	return t.(IFieldNameContext)
 }
 
-func (s *MakemvOptionContext) NUMBER() antlr.TerminalNode {
+func (s *ForeachArgContext) NUMBER() antlr.TerminalNode {
 	return s.GetToken(SPLParserNUMBER, 0)
 }
 
-func (s *MakemvOptionContext) GetRuleContext() antlr.RuleContext {
+func (s *ForeachArgContext) WildcardValue() IWildcardValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWildcardValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWildcardValueContext)
+}
+
+func (s *ForeachArgContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MakemvOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ForeachArgContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MakemvOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ForeachArgContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterMakemvOption(s)
+		listenerT.EnterForeachArg(s)
 	}
 }
 
-func (s *MakemvOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ForeachArgContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitMakemvOption(s)
+		listenerT.ExitForeachArg(s)
 	}
 }
 
-func (s *MakemvOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ForeachArgContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitMakemvOption(s)
+		return t.VisitForeachArg(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) MakemvOption() (localctx IMakemvOptionContext) {
-	localctx = NewMakemvOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, SPLParserRULE_makemvOption)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(548)
-		p.Match(SPLParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(549)
-		p.Match(SPLParserEQ)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(553)
+func (p *SPLParser) ForeachArg() (localctx IForeachArgContext) {
+	localctx = NewForeachArgContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, SPLParserRULE_foreachArg)
+	p.SetState(857)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 100, p.GetParserRuleContext()) {
 	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(550)
-			p.Match(SPLParserQUOTED_STRING)
+			p.SetState(847)
+			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	case 2:
-		{
-			p.SetState(551)
-			p.FieldName()
-		}
-
-	case 3:
 		{
-			p.SetState(552)
-			p.Match(SPLParserNUMBER)
+			p.SetState(848)
+			p.Match(SPLParserEQ)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
-	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IMvexpandCommandContext is an interface to support dynamic dispatch.
-type IMvexpandCommandContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// Getter signatures
-	MVEXPAND() antlr.TerminalNode
-	FieldName() IFieldNameContext
-
-	// IsMvexpandCommandContext differentiates from other interfaces.
-	IsMvexpandCommandContext()
-}
-
-type MvexpandCommandContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyMvexpandCommandContext() *MvexpandCommandContext {
-	var p = new(MvexpandCommandContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_mvexpandCommand
-	return p
-}
-
-func InitEmptyMvexpandCommandContext(p *MvexpandCommandContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_mvexpandCommand
-}
-
-func (*MvexpandCommandContext) IsMvexpandCommandContext() {}
-
-func NewMvexpandCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MvexpandCommandContext {
-	var p = new(MvexpandCommandContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = SPLParserRULE_mvexpandCommand
-
-	return p
-}
-
-func (s *MvexpandCommandContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *MvexpandCommandContext) MVEXPAND() antlr.TerminalNode {
-	return s.GetToken(SPLParserMVEXPAND, 0)
-}
-
-func (s *MvexpandCommandContext) FieldName() IFieldNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		p.SetState(852)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFieldNameContext)
-}
 
-func (s *MvexpandCommandContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 99, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(849)
+				p.Match(SPLParserQUOTED_STRING)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-func (s *MvexpandCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+		case 2:
+			{
+				p.SetState(850)
+				p.FieldName()
+			}
 
-func (s *MvexpandCommandContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterMvexpandCommand(s)
-	}
-}
+		case 3:
+			{
+				p.SetState(851)
+				p.Match(SPLParserNUMBER)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-func (s *MvexpandCommandContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitMvexpandCommand(s)
-	}
-}
+		case antlr.ATNInvalidAltNumber:
+			goto errorExit
+		}
 
-func (s *MvexpandCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SPLParserVisitor:
-		return t.VisitMvexpandCommand(s)
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(854)
+			p.WildcardValue()
+		}
 
-	default:
-		return t.VisitChildren(s)
-	}
-}
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(855)
+			p.FieldName()
+		}
 
-func (p *SPLParser) MvexpandCommand() (localctx IMvexpandCommandContext) {
-	localctx = NewMvexpandCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, SPLParserRULE_mvexpandCommand)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(555)
-		p.Match(SPLParserMVEXPAND)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(856)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	{
-		p.SetState(556)
-		p.FieldName()
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -9329,72 +14196,74 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFormatCommandContext is an interface to support dynamic dispatch.
-type IFormatCommandContext interface {
+// IMapCommandContext is an interface to support dynamic dispatch.
+type IMapCommandContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	FORMAT() antlr.TerminalNode
-	AllFormatOption() []IFormatOptionContext
-	FormatOption(i int) IFormatOptionContext
+	MAP() antlr.TerminalNode
+	AllMapOption() []IMapOptionContext
+	MapOption(i int) IMapOptionContext
+	AllSubsearch() []ISubsearchContext
+	Subsearch(i int) ISubsearchContext
 
-	// IsFormatCommandContext differentiates from other interfaces.
-	IsFormatCommandContext()
+	// IsMapCommandContext differentiates from other interfaces.
+	IsMapCommandContext()
 }
 
-type FormatCommandContext struct {
+type MapCommandContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFormatCommandContext() *FormatCommandContext {
-	var p = new(FormatCommandContext)
+func NewEmptyMapCommandContext() *MapCommandContext {
+	var p = new(MapCommandContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_formatCommand
+	p.RuleIndex = SPLParserRULE_mapCommand
 	return p
 }
 
-func InitEmptyFormatCommandContext(p *FormatCommandContext) {
+func InitEmptyMapCommandContext(p *MapCommandContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_formatCommand
+	p.RuleIndex = SPLParserRULE_mapCommand
 }
 
-func (*FormatCommandContext) IsFormatCommandContext() {}
+func (*MapCommandContext) IsMapCommandContext() {}
 
-func NewFormatCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FormatCommandContext {
-	var p = new(FormatCommandContext)
+func NewMapCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapCommandContext {
+	var p = new(MapCommandContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_formatCommand
+	p.RuleIndex = SPLParserRULE_mapCommand
 
 	return p
 }
 
-func (s *FormatCommandContext) GetParser() antlr.Parser { return s.parser }
+func (s *MapCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FormatCommandContext) FORMAT() antlr.TerminalNode {
-	return s.GetToken(SPLParserFORMAT, 0)
+func (s *MapCommandContext) MAP() antlr.TerminalNode {
+	return s.GetToken(SPLParserMAP, 0)
 }
 
-func (s *FormatCommandContext) AllFormatOption() []IFormatOptionContext {
+func (s *MapCommandContext) AllMapOption() []IMapOptionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IFormatOptionContext); ok {
+		if _, ok := ctx.(IMapOptionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IFormatOptionContext, len)
+	tst := make([]IMapOptionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IFormatOptionContext); ok {
-			tst[i] = t.(IFormatOptionContext)
+		if t, ok := ctx.(IMapOptionContext); ok {
+			tst[i] = t.(IMapOptionContext)
 			i++
 		}
 	}
@@ -9402,11 +14271,11 @@ func (s *FormatCommandContext) AllFormatOption() []IFormatOptionContext {
 	return tst
 }
 
-func (s *FormatCommandContext) FormatOption(i int) IFormatOptionContext {
+func (s *MapCommandContext) MapOption(i int) IMapOptionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFormatOptionContext); ok {
+		if _, ok := ctx.(IMapOptionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -9419,67 +14288,127 @@ func (s *FormatCommandContext) FormatOption(i int) IFormatOptionContext {
 		return nil
 	}
 
-	return t.(IFormatOptionContext)
+	return t.(IMapOptionContext)
 }
 
-func (s *FormatCommandContext) GetRuleContext() antlr.RuleContext {
+func (s *MapCommandContext) AllSubsearch() []ISubsearchContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISubsearchContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISubsearchContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISubsearchContext); ok {
+			tst[i] = t.(ISubsearchContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MapCommandContext) Subsearch(i int) ISubsearchContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubsearchContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubsearchContext)
+}
+
+func (s *MapCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FormatCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MapCommandContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FormatCommandContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MapCommandContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterFormatCommand(s)
+		listenerT.EnterMapCommand(s)
 	}
 }
 
-func (s *FormatCommandContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MapCommandContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitFormatCommand(s)
+		listenerT.ExitMapCommand(s)
 	}
 }
 
-func (s *FormatCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MapCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitFormatCommand(s)
+		return t.VisitMapCommand(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) FormatCommand() (localctx IFormatCommandContext) {
-	localctx = NewFormatCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, SPLParserRULE_formatCommand)
+func (p *SPLParser) MapCommand() (localctx IMapCommandContext) {
+	localctx = NewMapCommandContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, SPLParserRULE_mapCommand)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(558)
-		p.Match(SPLParserFORMAT)
+		p.SetState(859)
+		p.Match(SPLParserMAP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(562)
+	p.SetState(862)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == SPLParserIDENTIFIER {
-		{
-			p.SetState(559)
-			p.FormatOption()
+	for ok := true; ok; ok = _la == SPLParserSEARCH || _la == SPLParserLBRACKET || _la == SPLParserIDENTIFIER {
+		p.SetState(862)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case SPLParserSEARCH, SPLParserIDENTIFIER:
+			{
+				p.SetState(860)
+				p.MapOption()
+			}
+
+		case SPLParserLBRACKET:
+			{
+				p.SetState(861)
+				p.Subsearch()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
-		p.SetState(564)
+		p.SetState(864)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -9500,133 +14429,183 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFormatOptionContext is an interface to support dynamic dispatch.
-type IFormatOptionContext interface {
+// IMapOptionContext is an interface to support dynamic dispatch.
+type IMapOptionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	IDENTIFIER() antlr.TerminalNode
 	EQ() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	SEARCH() antlr.TerminalNode
 	QUOTED_STRING() antlr.TerminalNode
 	NUMBER() antlr.TerminalNode
+	FieldName() IFieldNameContext
 
-	// IsFormatOptionContext differentiates from other interfaces.
-	IsFormatOptionContext()
+	// IsMapOptionContext differentiates from other interfaces.
+	IsMapOptionContext()
 }
 
-type FormatOptionContext struct {
+type MapOptionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFormatOptionContext() *FormatOptionContext {
-	var p = new(FormatOptionContext)
+func NewEmptyMapOptionContext() *MapOptionContext {
+	var p = new(MapOptionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_formatOption
+	p.RuleIndex = SPLParserRULE_mapOption
 	return p
 }
 
-func InitEmptyFormatOptionContext(p *FormatOptionContext) {
+func InitEmptyMapOptionContext(p *MapOptionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_formatOption
+	p.RuleIndex = SPLParserRULE_mapOption
 }
 
-func (*FormatOptionContext) IsFormatOptionContext() {}
+func (*MapOptionContext) IsMapOptionContext() {}
 
-func NewFormatOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FormatOptionContext {
-	var p = new(FormatOptionContext)
+func NewMapOptionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapOptionContext {
+	var p = new(MapOptionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_formatOption
+	p.RuleIndex = SPLParserRULE_mapOption
 
 	return p
 }
 
-func (s *FormatOptionContext) GetParser() antlr.Parser { return s.parser }
+func (s *MapOptionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FormatOptionContext) IDENTIFIER() antlr.TerminalNode {
+func (s *MapOptionContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQ, 0)
+}
+
+func (s *MapOptionContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(SPLParserIDENTIFIER, 0)
 }
 
-func (s *FormatOptionContext) EQ() antlr.TerminalNode {
-	return s.GetToken(SPLParserEQ, 0)
+func (s *MapOptionContext) SEARCH() antlr.TerminalNode {
+	return s.GetToken(SPLParserSEARCH, 0)
 }
 
-func (s *FormatOptionContext) QUOTED_STRING() antlr.TerminalNode {
+func (s *MapOptionContext) QUOTED_STRING() antlr.TerminalNode {
 	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
-func (s *FormatOptionContext) NUMBER() antlr.TerminalNode {
+func (s *MapOptionContext) NUMBER() antlr.TerminalNode {
 	return s.GetToken(SPLParserNUMBER, 0)
 }
 
-func (s *FormatOptionContext) GetRuleContext() antlr.RuleContext {
+func (s *MapOptionContext) FieldName() IFieldNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldNameContext)
+}
+
+func (s *MapOptionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FormatOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MapOptionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FormatOptionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MapOptionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterFormatOption(s)
+		listenerT.EnterMapOption(s)
 	}
 }
 
-func (s *FormatOptionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MapOptionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitFormatOption(s)
+		listenerT.ExitMapOption(s)
 	}
 }
 
-func (s *FormatOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MapOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitFormatOption(s)
+		return t.VisitMapOption(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) FormatOption() (localctx IFormatOptionContext) {
-	localctx = NewFormatOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, SPLParserRULE_formatOption)
+func (p *SPLParser) MapOption() (localctx IMapOptionContext) {
+	localctx = NewMapOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, SPLParserRULE_mapOption)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(565)
-		p.Match(SPLParserIDENTIFIER)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		p.SetState(866)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SPLParserSEARCH || _la == SPLParserIDENTIFIER) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 	}
 	{
-		p.SetState(566)
+		p.SetState(867)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(567)
-		_la = p.GetTokenStream().LA(1)
+	p.SetState(871)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
 
-		if !(_la == SPLParserQUOTED_STRING || _la == SPLParserNUMBER) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 103, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(868)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(869)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		{
+			p.SetState(870)
+			p.FieldName()
 		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -9820,52 +14799,52 @@ func (s *ConvertCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *SPLParser) ConvertCommand() (localctx IConvertCommandContext) {
 	localctx = NewConvertCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, SPLParserRULE_convertCommand)
+	p.EnterRule(localctx, 122, SPLParserRULE_convertCommand)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(569)
+		p.SetState(873)
 		p.Match(SPLParserCONVERT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(573)
+	p.SetState(877)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 59, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 104, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(570)
+				p.SetState(874)
 				p.ConvertOption()
 			}
 
 		}
-		p.SetState(575)
+		p.SetState(879)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 59, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 104, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(576)
+		p.SetState(880)
 		p.ConvertFunction()
 	}
-	p.SetState(581)
+	p.SetState(885)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9874,7 +14853,7 @@ func (p *SPLParser) ConvertCommand() (localctx IConvertCommandContext) {
 
 	for _la == SPLParserCOMMA {
 		{
-			p.SetState(577)
+			p.SetState(881)
 			p.Match(SPLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9882,11 +14861,11 @@ func (p *SPLParser) ConvertCommand() (localctx IConvertCommandContext) {
 			}
 		}
 		{
-			p.SetState(578)
+			p.SetState(882)
 			p.ConvertFunction()
 		}
 
-		p.SetState(583)
+		p.SetState(887)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10009,12 +14988,12 @@ func (s *ConvertOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) ConvertOption() (localctx IConvertOptionContext) {
 	localctx = NewConvertOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, SPLParserRULE_convertOption)
+	p.EnterRule(localctx, 124, SPLParserRULE_convertOption)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(584)
+		p.SetState(888)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -10022,7 +15001,7 @@ func (p *SPLParser) ConvertOption() (localctx IConvertOptionContext) {
 		}
 	}
 	{
-		p.SetState(585)
+		p.SetState(889)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -10030,10 +15009,10 @@ func (p *SPLParser) ConvertOption() (localctx IConvertOptionContext) {
 		}
 	}
 	{
-		p.SetState(586)
+		p.SetState(890)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-69)) & ^0x3f) == 0 && ((int64(1)<<(_la-69))&69) != 0) {
+		if !((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&4225) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -10064,11 +15043,12 @@ type IConvertFunctionContext interface {
 	// Getter signatures
 	IDENTIFIER() antlr.TerminalNode
 	LPAREN() antlr.TerminalNode
+	RPAREN() antlr.TerminalNode
 	AllFieldName() []IFieldNameContext
 	FieldName(i int) IFieldNameContext
-	RPAREN() antlr.TerminalNode
+	AllQUOTED_STRING() []antlr.TerminalNode
+	QUOTED_STRING(i int) antlr.TerminalNode
 	AS() antlr.TerminalNode
-	QUOTED_STRING() antlr.TerminalNode
 
 	// IsConvertFunctionContext differentiates from other interfaces.
 	IsConvertFunctionContext()
@@ -10114,6 +15094,10 @@ func (s *ConvertFunctionContext) LPAREN() antlr.TerminalNode {
 	return s.GetToken(SPLParserLPAREN, 0)
 }
 
+func (s *ConvertFunctionContext) RPAREN() antlr.TerminalNode {
+	return s.GetToken(SPLParserRPAREN, 0)
+}
+
 func (s *ConvertFunctionContext) AllFieldName() []IFieldNameContext {
 	children := s.GetChildren()
 	len := 0
@@ -10155,16 +15139,16 @@ func (s *ConvertFunctionContext) FieldName(i int) IFieldNameContext {
 	return t.(IFieldNameContext)
 }
 
-func (s *ConvertFunctionContext) RPAREN() antlr.TerminalNode {
-	return s.GetToken(SPLParserRPAREN, 0)
+func (s *ConvertFunctionContext) AllQUOTED_STRING() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserQUOTED_STRING)
 }
 
-func (s *ConvertFunctionContext) AS() antlr.TerminalNode {
-	return s.GetToken(SPLParserAS, 0)
+func (s *ConvertFunctionContext) QUOTED_STRING(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, i)
 }
 
-func (s *ConvertFunctionContext) QUOTED_STRING() antlr.TerminalNode {
-	return s.GetToken(SPLParserQUOTED_STRING, 0)
+func (s *ConvertFunctionContext) AS() antlr.TerminalNode {
+	return s.GetToken(SPLParserAS, 0)
 }
 
 func (s *ConvertFunctionContext) GetRuleContext() antlr.RuleContext {
@@ -10199,12 +15183,12 @@ func (s *ConvertFunctionContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *SPLParser) ConvertFunction() (localctx IConvertFunctionContext) {
 	localctx = NewConvertFunctionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, SPLParserRULE_convertFunction)
+	p.EnterRule(localctx, 126, SPLParserRULE_convertFunction)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(588)
+		p.SetState(892)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -10212,26 +15196,49 @@ func (p *SPLParser) ConvertFunction() (localctx IConvertFunctionContext) {
 		}
 	}
 	{
-		p.SetState(589)
+		p.SetState(893)
 		p.Match(SPLParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(590)
-		p.FieldName()
+	p.SetState(896)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+		{
+			p.SetState(894)
+			p.FieldName()
+		}
+
+	case SPLParserQUOTED_STRING:
+		{
+			p.SetState(895)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 	{
-		p.SetState(591)
+		p.SetState(898)
 		p.Match(SPLParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(597)
+	p.SetState(904)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10240,29 +15247,29 @@ func (p *SPLParser) ConvertFunction() (localctx IConvertFunctionContext) {
 
 	if _la == SPLParserAS {
 		{
-			p.SetState(592)
+			p.SetState(899)
 			p.Match(SPLParserAS)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(595)
+		p.SetState(902)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 
 		switch p.GetTokenStream().LA(1) {
-		case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+		case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserSINGLE_QUOTED, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
 			{
-				p.SetState(593)
+				p.SetState(900)
 				p.FieldName()
 			}
 
 		case SPLParserQUOTED_STRING:
 			{
-				p.SetState(594)
+				p.SetState(901)
 				p.Match(SPLParserQUOTED_STRING)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -10298,11 +15305,13 @@ type IBucketCommandContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	FieldName() IFieldNameContext
+	AllFieldName() []IFieldNameContext
+	FieldName(i int) IFieldNameContext
 	BUCKET() antlr.TerminalNode
 	BIN() antlr.TerminalNode
 	AllBucketOption() []IBucketOptionContext
 	BucketOption(i int) IBucketOptionContext
+	AS() antlr.TerminalNode
 
 	// IsBucketCommandContext differentiates from other interfaces.
 	IsBucketCommandContext()
@@ -10340,12 +15349,37 @@ func NewBucketCommandContext(parser antlr.Parser, parent antlr.ParserRuleContext
 
 func (s *BucketCommandContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BucketCommandContext) FieldName() IFieldNameContext {
+func (s *BucketCommandContext) AllFieldName() []IFieldNameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldNameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFieldNameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldNameContext); ok {
+			tst[i] = t.(IFieldNameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BucketCommandContext) FieldName(i int) IFieldNameContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IFieldNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -10405,6 +15439,10 @@ func (s *BucketCommandContext) BucketOption(i int) IBucketOptionContext {
 	return t.(IBucketOptionContext)
 }
 
+func (s *BucketCommandContext) AS() antlr.TerminalNode {
+	return s.GetToken(SPLParserAS, 0)
+}
+
 func (s *BucketCommandContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -10437,12 +15475,14 @@ func (s *BucketCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) BucketCommand() (localctx IBucketCommandContext) {
 	localctx = NewBucketCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, SPLParserRULE_bucketCommand)
+	p.EnterRule(localctx, 128, SPLParserRULE_bucketCommand)
 	var _la int
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(599)
+		p.SetState(906)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SPLParserBUCKET || _la == SPLParserBIN) {
@@ -10452,11 +15492,38 @@ func (p *SPLParser) BucketCommand() (localctx IBucketCommandContext) {
 			p.Consume()
 		}
 	}
+	p.SetState(910)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 109, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(907)
+				p.BucketOption()
+			}
+
+		}
+		p.SetState(912)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 109, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
 	{
-		p.SetState(600)
+		p.SetState(913)
 		p.FieldName()
 	}
-	p.SetState(604)
+	p.SetState(917)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10465,17 +15532,39 @@ func (p *SPLParser) BucketCommand() (localctx IBucketCommandContext) {
 
 	for _la == SPLParserIDENTIFIER {
 		{
-			p.SetState(601)
+			p.SetState(914)
 			p.BucketOption()
 		}
 
-		p.SetState(606)
+		p.SetState(919)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
+	p.SetState(922)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SPLParserAS {
+		{
+			p.SetState(920)
+			p.Match(SPLParserAS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(921)
+			p.FieldName()
+		}
+
+	}
 
 errorExit:
 	if p.HasError() {
@@ -10597,12 +15686,12 @@ func (s *BucketOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) BucketOption() (localctx IBucketOptionContext) {
 	localctx = NewBucketOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, SPLParserRULE_bucketOption)
+	p.EnterRule(localctx, 130, SPLParserRULE_bucketOption)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(607)
+		p.SetState(924)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -10610,7 +15699,7 @@ func (p *SPLParser) BucketOption() (localctx IBucketOptionContext) {
 		}
 	}
 	{
-		p.SetState(608)
+		p.SetState(925)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -10618,10 +15707,10 @@ func (p *SPLParser) BucketOption() (localctx IBucketOptionContext) {
 		}
 	}
 	{
-		p.SetState(609)
+		p.SetState(926)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-69)) & ^0x3f) == 0 && ((int64(1)<<(_la-69))&71) != 0) {
+		if !((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&4241) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -10767,19 +15856,19 @@ func (s *RestCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) RestCommand() (localctx IRestCommandContext) {
 	localctx = NewRestCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, SPLParserRULE_restCommand)
+	p.EnterRule(localctx, 132, SPLParserRULE_restCommand)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(611)
+		p.SetState(928)
 		p.Match(SPLParserREST)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(615)
+	p.SetState(932)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10788,11 +15877,11 @@ func (p *SPLParser) RestCommand() (localctx IRestCommandContext) {
 
 	for _la == SPLParserIDENTIFIER || _la == SPLParserREST_PATH {
 		{
-			p.SetState(612)
+			p.SetState(929)
 			p.RestArg()
 		}
 
-		p.SetState(617)
+		p.SetState(934)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10825,7 +15914,10 @@ type IRestArgContext interface {
 	IDENTIFIER(i int) antlr.TerminalNode
 	EQ() antlr.TerminalNode
 	Value() IValueContext
-	MINUS() antlr.TerminalNode
+	AllMINUS() []antlr.TerminalNode
+	MINUS(i int) antlr.TerminalNode
+	AllSLASH() []antlr.TerminalNode
+	SLASH(i int) antlr.TerminalNode
 	REST_PATH() antlr.TerminalNode
 
 	// IsRestArgContext differentiates from other interfaces.
@@ -10892,8 +15984,20 @@ func (s *RestArgContext) Value() IValueContext {
 	return t.(IValueContext)
 }
 
-func (s *RestArgContext) MINUS() antlr.TerminalNode {
-	return s.GetToken(SPLParserMINUS, 0)
+func (s *RestArgContext) AllMINUS() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserMINUS)
+}
+
+func (s *RestArgContext) MINUS(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserMINUS, i)
+}
+
+func (s *RestArgContext) AllSLASH() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserSLASH)
+}
+
+func (s *RestArgContext) SLASH(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserSLASH, i)
 }
 
 func (s *RestArgContext) REST_PATH() antlr.TerminalNode {
@@ -10932,20 +16036,20 @@ func (s *RestArgContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) RestArg() (localctx IRestArgContext) {
 	localctx = NewRestArgContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, SPLParserRULE_restArg)
+	p.EnterRule(localctx, 134, SPLParserRULE_restArg)
 	var _la int
 
-	p.SetState(629)
+	p.SetState(950)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 67, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 115, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(618)
+			p.SetState(935)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10953,14 +16057,14 @@ func (p *SPLParser) RestArg() (localctx IRestArgContext) {
 			}
 		}
 		{
-			p.SetState(619)
+			p.SetState(936)
 			p.Match(SPLParserEQ)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(621)
+		p.SetState(938)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10969,7 +16073,7 @@ func (p *SPLParser) RestArg() (localctx IRestArgContext) {
 
 		if _la == SPLParserMINUS {
 			{
-				p.SetState(620)
+				p.SetState(937)
 				p.Match(SPLParserMINUS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -10978,22 +16082,31 @@ func (p *SPLParser) RestArg() (localctx IRestArgContext) {
 			}
 
 		}
-		p.SetState(625)
+		{
+			p.SetState(940)
+			p.Value()
+		}
+		p.SetState(945)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 
-		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 66, p.GetParserRuleContext()) {
-		case 1:
+		for _la == SPLParserMINUS || _la == SPLParserSLASH {
 			{
-				p.SetState(623)
-				p.Value()
-			}
+				p.SetState(941)
+				_la = p.GetTokenStream().LA(1)
 
-		case 2:
+				if !(_la == SPLParserMINUS || _la == SPLParserSLASH) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
 			{
-				p.SetState(624)
+				p.SetState(942)
 				p.Match(SPLParserIDENTIFIER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -11001,14 +16114,18 @@ func (p *SPLParser) RestArg() (localctx IRestArgContext) {
 				}
 			}
 
-		case antlr.ATNInvalidAltNumber:
-			goto errorExit
+			p.SetState(947)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(627)
+			p.SetState(948)
 			p.Match(SPLParserREST_PATH)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11019,7 +16136,7 @@ func (p *SPLParser) RestArg() (localctx IRestArgContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(628)
+			p.SetState(949)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11362,48 +16479,48 @@ func (s *TstatsCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 	localctx = NewTstatsCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, SPLParserRULE_tstatsCommand)
+	p.EnterRule(localctx, 136, SPLParserRULE_tstatsCommand)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(631)
+		p.SetState(952)
 		p.Match(SPLParserTSTATS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(635)
+	p.SetState(956)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 68, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 116, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(632)
+				p.SetState(953)
 				p.TstatsPreOption()
 			}
 
 		}
-		p.SetState(637)
+		p.SetState(958)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 68, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 116, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(648)
+	p.SetState(969)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11412,10 +16529,10 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 
 	if _la == SPLParserIDENTIFIER {
 		{
-			p.SetState(638)
+			p.SetState(959)
 			p.StatsFunction()
 		}
-		p.SetState(645)
+		p.SetState(966)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -11423,7 +16540,7 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SPLParserCOMMA || _la == SPLParserIDENTIFIER {
-			p.SetState(640)
+			p.SetState(961)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -11432,7 +16549,7 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 
 			if _la == SPLParserCOMMA {
 				{
-					p.SetState(639)
+					p.SetState(960)
 					p.Match(SPLParserCOMMA)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -11442,11 +16559,11 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 
 			}
 			{
-				p.SetState(642)
+				p.SetState(963)
 				p.StatsFunction()
 			}
 
-			p.SetState(647)
+			p.SetState(968)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -11455,7 +16572,7 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 		}
 
 	}
-	p.SetState(652)
+	p.SetState(973)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11464,7 +16581,7 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 
 	if _la == SPLParserFROM {
 		{
-			p.SetState(650)
+			p.SetState(971)
 			p.Match(SPLParserFROM)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11472,12 +16589,12 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 			}
 		}
 		{
-			p.SetState(651)
+			p.SetState(972)
 			p.TstatsDatamodel()
 		}
 
 	}
-	p.SetState(656)
+	p.SetState(977)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11486,7 +16603,7 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 
 	if _la == SPLParserWHERE {
 		{
-			p.SetState(654)
+			p.SetState(975)
 			p.Match(SPLParserWHERE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11494,12 +16611,12 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 			}
 		}
 		{
-			p.SetState(655)
+			p.SetState(976)
 			p.SearchExpression()
 		}
 
 	}
-	p.SetState(665)
+	p.SetState(986)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11508,7 +16625,7 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 
 	if _la == SPLParserBY || _la == SPLParserGROUPBY {
 		{
-			p.SetState(658)
+			p.SetState(979)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SPLParserBY || _la == SPLParserGROUPBY) {
@@ -11518,30 +16635,30 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 				p.Consume()
 			}
 		}
-		p.SetState(661)
+		p.SetState(982)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		for ok := true; ok; ok = ((int64((_la-40)) & ^0x3f) == 0 && ((int64(1)<<(_la-40))&54223962125) != 0) {
-			p.SetState(661)
+		for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17173822373380352) != 0) || ((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&6531) != 0) {
+			p.SetState(982)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 74, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 122, p.GetParserRuleContext()) {
 			case 1:
 				{
-					p.SetState(659)
+					p.SetState(980)
 					p.TstatsPostOption()
 				}
 
 			case 2:
 				{
-					p.SetState(660)
+					p.SetState(981)
 					p.FieldOrQuoted()
 				}
 
@@ -11549,7 +16666,7 @@ func (p *SPLParser) TstatsCommand() (localctx ITstatsCommandContext) {
 				goto errorExit
 			}
 
-			p.SetState(663)
+			p.SetState(984)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -11696,8 +16813,8 @@ func (s *TstatsPreOptionContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *SPLParser) TstatsPreOption() (localctx ITstatsPreOptionContext) {
 	localctx = NewTstatsPreOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, SPLParserRULE_tstatsPreOption)
-	p.SetState(676)
+	p.EnterRule(localctx, 138, SPLParserRULE_tstatsPreOption)
+	p.SetState(997)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11707,7 +16824,7 @@ func (p *SPLParser) TstatsPreOption() (localctx ITstatsPreOptionContext) {
 	case SPLParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(667)
+			p.SetState(988)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11715,23 +16832,23 @@ func (p *SPLParser) TstatsPreOption() (localctx ITstatsPreOptionContext) {
 			}
 		}
 		{
-			p.SetState(668)
+			p.SetState(989)
 			p.Match(SPLParserEQ)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(673)
+		p.SetState(994)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 
-		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 77, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 125, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(669)
+				p.SetState(990)
 				p.Match(SPLParserQUOTED_STRING)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -11741,13 +16858,13 @@ func (p *SPLParser) TstatsPreOption() (localctx ITstatsPreOptionContext) {
 
 		case 2:
 			{
-				p.SetState(670)
+				p.SetState(991)
 				p.FieldName()
 			}
 
 		case 3:
 			{
-				p.SetState(671)
+				p.SetState(992)
 				p.Match(SPLParserNUMBER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -11757,7 +16874,7 @@ func (p *SPLParser) TstatsPreOption() (localctx ITstatsPreOptionContext) {
 
 		case 4:
 			{
-				p.SetState(672)
+				p.SetState(993)
 				p.Match(SPLParserTIME_SPAN)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -11772,7 +16889,7 @@ func (p *SPLParser) TstatsPreOption() (localctx ITstatsPreOptionContext) {
 	case SPLParserMACRO:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(675)
+			p.SetState(996)
 			p.Match(SPLParserMACRO)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11905,20 +17022,20 @@ func (s *TstatsDatamodelContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 	localctx = NewTstatsDatamodelContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, SPLParserRULE_tstatsDatamodel)
+	p.EnterRule(localctx, 140, SPLParserRULE_tstatsDatamodel)
 	var _la int
 
-	p.SetState(700)
+	p.SetState(1021)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 82, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 130, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(678)
+			p.SetState(999)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11926,7 +17043,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 			}
 		}
 		{
-			p.SetState(679)
+			p.SetState(1000)
 			p.Match(SPLParserEQ)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11934,14 +17051,14 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 			}
 		}
 		{
-			p.SetState(680)
+			p.SetState(1001)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(685)
+		p.SetState(1006)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -11950,7 +17067,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 
 		for _la == SPLParserDOT {
 			{
-				p.SetState(681)
+				p.SetState(1002)
 				p.Match(SPLParserDOT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -11958,7 +17075,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 				}
 			}
 			{
-				p.SetState(682)
+				p.SetState(1003)
 				p.Match(SPLParserIDENTIFIER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -11966,7 +17083,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 				}
 			}
 
-			p.SetState(687)
+			p.SetState(1008)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -11977,14 +17094,14 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(688)
+			p.SetState(1009)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(691)
+		p.SetState(1012)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -11993,7 +17110,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 
 		if _la == SPLParserCOLON {
 			{
-				p.SetState(689)
+				p.SetState(1010)
 				p.Match(SPLParserCOLON)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -12001,7 +17118,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 				}
 			}
 			{
-				p.SetState(690)
+				p.SetState(1011)
 				p.Match(SPLParserIDENTIFIER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -12010,7 +17127,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 			}
 
 		}
-		p.SetState(697)
+		p.SetState(1018)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -12019,7 +17136,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 
 		for _la == SPLParserDOT {
 			{
-				p.SetState(693)
+				p.SetState(1014)
 				p.Match(SPLParserDOT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -12027,7 +17144,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 				}
 			}
 			{
-				p.SetState(694)
+				p.SetState(1015)
 				p.Match(SPLParserIDENTIFIER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -12035,7 +17152,7 @@ func (p *SPLParser) TstatsDatamodel() (localctx ITstatsDatamodelContext) {
 				}
 			}
 
-			p.SetState(699)
+			p.SetState(1020)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -12179,10 +17296,10 @@ func (s *TstatsPostOptionContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *SPLParser) TstatsPostOption() (localctx ITstatsPostOptionContext) {
 	localctx = NewTstatsPostOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, SPLParserRULE_tstatsPostOption)
+	p.EnterRule(localctx, 142, SPLParserRULE_tstatsPostOption)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(702)
+		p.SetState(1023)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -12190,23 +17307,23 @@ func (p *SPLParser) TstatsPostOption() (localctx ITstatsPostOptionContext) {
 		}
 	}
 	{
-		p.SetState(703)
+		p.SetState(1024)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(708)
+	p.SetState(1029)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 83, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 131, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(704)
+			p.SetState(1025)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12216,13 +17333,13 @@ func (p *SPLParser) TstatsPostOption() (localctx ITstatsPostOptionContext) {
 
 	case 2:
 		{
-			p.SetState(705)
+			p.SetState(1026)
 			p.FieldName()
 		}
 
 	case 3:
 		{
-			p.SetState(706)
+			p.SetState(1027)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12232,7 +17349,7 @@ func (p *SPLParser) TstatsPostOption() (localctx ITstatsPostOptionContext) {
 
 	case 4:
 		{
-			p.SetState(707)
+			p.SetState(1028)
 			p.Match(SPLParserTIME_SPAN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12553,48 +17670,48 @@ func (s *MstatsCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 	localctx = NewMstatsCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, SPLParserRULE_mstatsCommand)
+	p.EnterRule(localctx, 144, SPLParserRULE_mstatsCommand)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(710)
+		p.SetState(1031)
 		p.Match(SPLParserMSTATS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(714)
+	p.SetState(1035)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 84, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 132, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(711)
+				p.SetState(1032)
 				p.TstatsPreOption()
 			}
 
 		}
-		p.SetState(716)
+		p.SetState(1037)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 84, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 132, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
-	p.SetState(727)
+	p.SetState(1048)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12603,10 +17720,10 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 
 	if _la == SPLParserIDENTIFIER {
 		{
-			p.SetState(717)
+			p.SetState(1038)
 			p.StatsFunction()
 		}
-		p.SetState(724)
+		p.SetState(1045)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -12614,7 +17731,7 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SPLParserCOMMA || _la == SPLParserIDENTIFIER {
-			p.SetState(719)
+			p.SetState(1040)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -12623,7 +17740,7 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 
 			if _la == SPLParserCOMMA {
 				{
-					p.SetState(718)
+					p.SetState(1039)
 					p.Match(SPLParserCOMMA)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -12633,11 +17750,11 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 
 			}
 			{
-				p.SetState(721)
+				p.SetState(1042)
 				p.StatsFunction()
 			}
 
-			p.SetState(726)
+			p.SetState(1047)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -12646,7 +17763,7 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 		}
 
 	}
-	p.SetState(731)
+	p.SetState(1052)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12655,7 +17772,7 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 
 	if _la == SPLParserWHERE {
 		{
-			p.SetState(729)
+			p.SetState(1050)
 			p.Match(SPLParserWHERE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12663,12 +17780,12 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 			}
 		}
 		{
-			p.SetState(730)
+			p.SetState(1051)
 			p.SearchExpression()
 		}
 
 	}
-	p.SetState(740)
+	p.SetState(1061)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12677,7 +17794,7 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 
 	if _la == SPLParserBY || _la == SPLParserGROUPBY {
 		{
-			p.SetState(733)
+			p.SetState(1054)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SPLParserBY || _la == SPLParserGROUPBY) {
@@ -12687,30 +17804,30 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 				p.Consume()
 			}
 		}
-		p.SetState(736)
+		p.SetState(1057)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		for ok := true; ok; ok = ((int64((_la-40)) & ^0x3f) == 0 && ((int64(1)<<(_la-40))&54223962125) != 0) {
-			p.SetState(736)
+		for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&17173822373380352) != 0) || ((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&6531) != 0) {
+			p.SetState(1057)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 89, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 137, p.GetParserRuleContext()) {
 			case 1:
 				{
-					p.SetState(734)
+					p.SetState(1055)
 					p.TstatsPostOption()
 				}
 
 			case 2:
 				{
-					p.SetState(735)
+					p.SetState(1056)
 					p.FieldOrQuoted()
 				}
 
@@ -12718,7 +17835,7 @@ func (p *SPLParser) MstatsCommand() (localctx IMstatsCommandContext) {
 				goto errorExit
 			}
 
-			p.SetState(738)
+			p.SetState(1059)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -12898,49 +18015,49 @@ func (s *InputlookupCommandContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *SPLParser) InputlookupCommand() (localctx IInputlookupCommandContext) {
 	localctx = NewInputlookupCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, SPLParserRULE_inputlookupCommand)
+	p.EnterRule(localctx, 146, SPLParserRULE_inputlookupCommand)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(742)
+		p.SetState(1063)
 		p.Match(SPLParserINPUTLOOKUP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(746)
+	p.SetState(1067)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 92, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 140, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(743)
+				p.SetState(1064)
 				p.InputlookupOption()
 			}
 
 		}
-		p.SetState(748)
+		p.SetState(1069)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 92, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 140, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(749)
+		p.SetState(1070)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SPLParserQUOTED_STRING || _la == SPLParserIDENTIFIER) {
@@ -12950,7 +18067,7 @@ func (p *SPLParser) InputlookupCommand() (localctx IInputlookupCommandContext) {
 			p.Consume()
 		}
 	}
-	p.SetState(752)
+	p.SetState(1073)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12959,7 +18076,7 @@ func (p *SPLParser) InputlookupCommand() (localctx IInputlookupCommandContext) {
 
 	if _la == SPLParserWHERE {
 		{
-			p.SetState(750)
+			p.SetState(1071)
 			p.Match(SPLParserWHERE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12967,7 +18084,7 @@ func (p *SPLParser) InputlookupCommand() (localctx IInputlookupCommandContext) {
 			}
 		}
 		{
-			p.SetState(751)
+			p.SetState(1072)
 			p.Expression()
 		}
 
@@ -13100,10 +18217,10 @@ func (s *InputlookupOptionContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *SPLParser) InputlookupOption() (localctx IInputlookupOptionContext) {
 	localctx = NewInputlookupOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, SPLParserRULE_inputlookupOption)
+	p.EnterRule(localctx, 148, SPLParserRULE_inputlookupOption)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(754)
+		p.SetState(1075)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13111,23 +18228,23 @@ func (p *SPLParser) InputlookupOption() (localctx IInputlookupOptionContext) {
 		}
 	}
 	{
-		p.SetState(755)
+		p.SetState(1076)
 		p.Match(SPLParserEQ)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(759)
+	p.SetState(1080)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 94, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 142, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(756)
+			p.SetState(1077)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13137,13 +18254,13 @@ func (p *SPLParser) InputlookupOption() (localctx IInputlookupOptionContext) {
 
 	case 2:
 		{
-			p.SetState(757)
+			p.SetState(1078)
 			p.FieldName()
 		}
 
 	case 3:
 		{
-			p.SetState(758)
+			p.SetState(1079)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13293,32 +18410,32 @@ func (s *GenericCommandContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *SPLParser) GenericCommand() (localctx IGenericCommandContext) {
 	localctx = NewGenericCommandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, SPLParserRULE_genericCommand)
+	p.EnterRule(localctx, 150, SPLParserRULE_genericCommand)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(761)
+		p.SetState(1082)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(765)
+	p.SetState(1086)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for (int64((_la-56)) & ^0x3f) == 0 && ((int64(1)<<(_la-56))&648193) != 0 {
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&13514647676141600) != 0) || ((int64((_la-67)) & ^0x3f) == 0 && ((int64(1)<<(_la-67))&1166002177) != 0) {
 		{
-			p.SetState(762)
+			p.SetState(1083)
 			p.GenericArg()
 		}
 
-		p.SetState(767)
+		p.SetState(1088)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13356,6 +18473,7 @@ type IGenericArgContext interface {
 	RPAREN() antlr.TerminalNode
 	AllGenericArg() []IGenericArgContext
 	GenericArg(i int) IGenericArgContext
+	AS() antlr.TerminalNode
 
 	// IsGenericArgContext differentiates from other interfaces.
 	IsGenericArgContext()
@@ -13474,6 +18592,10 @@ func (s *GenericArgContext) GenericArg(i int) IGenericArgContext {
 	return t.(IGenericArgContext)
 }
 
+func (s *GenericArgContext) AS() antlr.TerminalNode {
+	return s.GetToken(SPLParserAS, 0)
+}
+
 func (s *GenericArgContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -13506,27 +18628,27 @@ func (s *GenericArgContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 	localctx = NewGenericArgContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, SPLParserRULE_genericArg)
+	p.EnterRule(localctx, 152, SPLParserRULE_genericArg)
 	var _la int
 
-	p.SetState(791)
+	p.SetState(1113)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 101, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 149, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(768)
+			p.SetState(1089)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(777)
+		p.SetState(1098)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13535,14 +18657,14 @@ func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 
 		if _la == SPLParserEQ {
 			{
-				p.SetState(769)
+				p.SetState(1090)
 				p.Match(SPLParserEQ)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-			p.SetState(771)
+			p.SetState(1092)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -13551,7 +18673,7 @@ func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 
 			if _la == SPLParserMINUS {
 				{
-					p.SetState(770)
+					p.SetState(1091)
 					p.Match(SPLParserMINUS)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -13560,22 +18682,22 @@ func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 				}
 
 			}
-			p.SetState(775)
+			p.SetState(1096)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 97, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 145, p.GetParserRuleContext()) {
 			case 1:
 				{
-					p.SetState(773)
+					p.SetState(1094)
 					p.Value()
 				}
 
 			case 2:
 				{
-					p.SetState(774)
+					p.SetState(1095)
 					p.Match(SPLParserIDENTIFIER)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -13591,7 +18713,7 @@ func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(780)
+		p.SetState(1101)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13600,7 +18722,7 @@ func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 
 		if _la == SPLParserMINUS {
 			{
-				p.SetState(779)
+				p.SetState(1100)
 				p.Match(SPLParserMINUS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -13610,34 +18732,34 @@ func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 
 		}
 		{
-			p.SetState(782)
+			p.SetState(1103)
 			p.Value()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(783)
+			p.SetState(1104)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(787)
+		p.SetState(1108)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		for (int64((_la-56)) & ^0x3f) == 0 && ((int64(1)<<(_la-56))&648193) != 0 {
+		for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&13514647676141600) != 0) || ((int64((_la-67)) & ^0x3f) == 0 && ((int64(1)<<(_la-67))&1166002177) != 0) {
 			{
-				p.SetState(784)
+				p.SetState(1105)
 				p.GenericArg()
 			}
 
-			p.SetState(789)
+			p.SetState(1110)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -13645,7 +18767,7 @@ func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(790)
+			p.SetState(1111)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13653,6 +18775,17 @@ func (p *SPLParser) GenericArg() (localctx IGenericArgContext) {
 			}
 		}
 
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1112)
+			p.Match(SPLParserAS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -13774,10 +18907,10 @@ func (s *SubsearchContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) Subsearch() (localctx ISubsearchContext) {
 	localctx = NewSubsearchContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, SPLParserRULE_subsearch)
+	p.EnterRule(localctx, 154, SPLParserRULE_subsearch)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(793)
+		p.SetState(1115)
 		p.Match(SPLParserLBRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13785,11 +18918,11 @@ func (p *SPLParser) Subsearch() (localctx ISubsearchContext) {
 		}
 	}
 	{
-		p.SetState(794)
+		p.SetState(1116)
 		p.Query()
 	}
 	{
-		p.SetState(795)
+		p.SetState(1117)
 		p.Match(SPLParserRBRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13973,23 +19106,23 @@ func (s *SearchExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *SPLParser) SearchExpression() (localctx ISearchExpressionContext) {
 	localctx = NewSearchExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, SPLParserRULE_searchExpression)
+	p.EnterRule(localctx, 156, SPLParserRULE_searchExpression)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(797)
+		p.SetState(1119)
 		p.SearchTerm()
 	}
-	p.SetState(804)
+	p.SetState(1126)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&395205160952922638) != 0) || ((int64((_la-69)) & ^0x3f) == 0 && ((int64(1)<<(_la-69))&621) != 0) {
-		p.SetState(799)
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2288669186840313074) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&5160960043) != 0) {
+		p.SetState(1121)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13998,17 +19131,17 @@ func (p *SPLParser) SearchExpression() (localctx ISearchExpressionContext) {
 
 		if _la == SPLParserAND || _la == SPLParserOR {
 			{
-				p.SetState(798)
+				p.SetState(1120)
 				p.LogicalOp()
 			}
 
 		}
 		{
-			p.SetState(801)
+			p.SetState(1123)
 			p.SearchTerm()
 		}
 
-		p.SetState(806)
+		p.SetState(1128)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -14211,18 +19344,18 @@ func (s *SearchTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) SearchTerm() (localctx ISearchTermContext) {
 	localctx = NewSearchTermContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, SPLParserRULE_searchTerm)
-	p.SetState(817)
+	p.EnterRule(localctx, 158, SPLParserRULE_searchTerm)
+	p.SetState(1141)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 104, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 152, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(807)
+			p.SetState(1129)
 			p.Match(SPLParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14230,14 +19363,14 @@ func (p *SPLParser) SearchTerm() (localctx ISearchTermContext) {
 			}
 		}
 		{
-			p.SetState(808)
+			p.SetState(1130)
 			p.SearchTerm()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(809)
+			p.SetState(1131)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14245,11 +19378,11 @@ func (p *SPLParser) SearchTerm() (localctx ISearchTermContext) {
 			}
 		}
 		{
-			p.SetState(810)
+			p.SetState(1132)
 			p.SearchExpression()
 		}
 		{
-			p.SetState(811)
+			p.SetState(1133)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14260,21 +19393,40 @@ func (p *SPLParser) SearchTerm() (localctx ISearchTermContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(813)
-			p.Condition()
+			p.SetState(1135)
+			p.Match(SPLParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1136)
+			p.Match(SPLParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(814)
-			p.Subsearch()
+			p.SetState(1137)
+			p.Condition()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(815)
+			p.SetState(1138)
+			p.Subsearch()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1139)
 			p.Match(SPLParserMACRO)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14282,10 +19434,10 @@ func (p *SPLParser) SearchTerm() (localctx ISearchTermContext) {
 			}
 		}
 
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(816)
+			p.SetState(1140)
 			p.BareWord()
 		}
 
@@ -14317,6 +19469,7 @@ type IConditionContext interface {
 	FieldName() IFieldNameContext
 	ComparisonOp() IComparisonOpContext
 	Value() IValueContext
+	DOUBLE_COLON() antlr.TerminalNode
 	IN() antlr.TerminalNode
 	LPAREN() antlr.TerminalNode
 	ValueList() IValueListContext
@@ -14408,6 +19561,10 @@ func (s *ConditionContext) Value() IValueContext {
 	return t.(IValueContext)
 }
 
+func (s *ConditionContext) DOUBLE_COLON() antlr.TerminalNode {
+	return s.GetToken(SPLParserDOUBLE_COLON, 0)
+}
+
 func (s *ConditionContext) IN() antlr.TerminalNode {
 	return s.GetToken(SPLParserIN, 0)
 }
@@ -14500,37 +19657,56 @@ func (s *ConditionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) Condition() (localctx IConditionContext) {
 	localctx = NewConditionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, SPLParserRULE_condition)
-	p.SetState(834)
+	p.EnterRule(localctx, 160, SPLParserRULE_condition)
+	p.SetState(1162)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 105, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 153, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(819)
+			p.SetState(1143)
 			p.FieldName()
 		}
 		{
-			p.SetState(820)
+			p.SetState(1144)
 			p.ComparisonOp()
 		}
 		{
-			p.SetState(821)
+			p.SetState(1145)
 			p.Value()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(823)
+			p.SetState(1147)
+			p.FieldName()
+		}
+		{
+			p.SetState(1148)
+			p.Match(SPLParserDOUBLE_COLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1149)
+			p.Value()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1151)
 			p.FieldName()
 		}
 		{
-			p.SetState(824)
+			p.SetState(1152)
 			p.Match(SPLParserIN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14538,7 +19714,7 @@ func (p *SPLParser) Condition() (localctx IConditionContext) {
 			}
 		}
 		{
-			p.SetState(825)
+			p.SetState(1153)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14546,11 +19722,11 @@ func (p *SPLParser) Condition() (localctx IConditionContext) {
 			}
 		}
 		{
-			p.SetState(826)
+			p.SetState(1154)
 			p.ValueList()
 		}
 		{
-			p.SetState(827)
+			p.SetState(1155)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14558,14 +19734,14 @@ func (p *SPLParser) Condition() (localctx IConditionContext) {
 			}
 		}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(829)
+			p.SetState(1157)
 			p.FieldName()
 		}
 		{
-			p.SetState(830)
+			p.SetState(1158)
 			p.Match(SPLParserIN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14573,14 +19749,14 @@ func (p *SPLParser) Condition() (localctx IConditionContext) {
 			}
 		}
 		{
-			p.SetState(831)
+			p.SetState(1159)
 			p.Subsearch()
 		}
 
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(833)
+			p.SetState(1161)
 			p.FunctionCall()
 		}
 
@@ -14610,6 +19786,7 @@ type IComparisonOpContext interface {
 
 	// Getter signatures
 	EQ() antlr.TerminalNode
+	EQEQ() antlr.TerminalNode
 	NEQ() antlr.TerminalNode
 	LT() antlr.TerminalNode
 	GT() antlr.TerminalNode
@@ -14656,6 +19833,10 @@ func (s *ComparisonOpContext) EQ() antlr.TerminalNode {
 	return s.GetToken(SPLParserEQ, 0)
 }
 
+func (s *ComparisonOpContext) EQEQ() antlr.TerminalNode {
+	return s.GetToken(SPLParserEQEQ, 0)
+}
+
 func (s *ComparisonOpContext) NEQ() antlr.TerminalNode {
 	return s.GetToken(SPLParserNEQ, 0)
 }
@@ -14708,15 +19889,15 @@ func (s *ComparisonOpContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) ComparisonOp() (localctx IComparisonOpContext) {
 	localctx = NewComparisonOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, SPLParserRULE_comparisonOp)
+	p.EnterRule(localctx, 162, SPLParserRULE_comparisonOp)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(836)
+		p.SetState(1164)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1108307720798208) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2287828610704211968) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -14824,12 +20005,12 @@ func (s *LogicalOpContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) LogicalOp() (localctx ILogicalOpContext) {
 	localctx = NewLogicalOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, SPLParserRULE_logicalOp)
+	p.EnterRule(localctx, 164, SPLParserRULE_logicalOp)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(838)
+		p.SetState(1166)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SPLParserAND || _la == SPLParserOR) {
@@ -14947,10 +20128,10 @@ func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) Expression() (localctx IExpressionContext) {
 	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, SPLParserRULE_expression)
+	p.EnterRule(localctx, 166, SPLParserRULE_expression)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(840)
+		p.SetState(1168)
 		p.OrExpression()
 	}
 
@@ -15097,15 +20278,15 @@ func (s *OrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) OrExpression() (localctx IOrExpressionContext) {
 	localctx = NewOrExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, SPLParserRULE_orExpression)
+	p.EnterRule(localctx, 168, SPLParserRULE_orExpression)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(842)
+		p.SetState(1170)
 		p.AndExpression()
 	}
-	p.SetState(847)
+	p.SetState(1175)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15114,7 +20295,7 @@ func (p *SPLParser) OrExpression() (localctx IOrExpressionContext) {
 
 	for _la == SPLParserOR {
 		{
-			p.SetState(843)
+			p.SetState(1171)
 			p.Match(SPLParserOR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15122,11 +20303,11 @@ func (p *SPLParser) OrExpression() (localctx IOrExpressionContext) {
 			}
 		}
 		{
-			p.SetState(844)
+			p.SetState(1172)
 			p.AndExpression()
 		}
 
-		p.SetState(849)
+		p.SetState(1177)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15277,23 +20458,23 @@ func (s *AndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) AndExpression() (localctx IAndExpressionContext) {
 	localctx = NewAndExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, SPLParserRULE_andExpression)
+	p.EnterRule(localctx, 170, SPLParserRULE_andExpression)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(850)
+		p.SetState(1178)
 		p.NotExpression()
 	}
-	p.SetState(857)
+	p.SetState(1185)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&395205160952922634) != 0) || ((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&889) != 0) {
-		p.SetState(852)
+	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2288669186840313078) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&824590379) != 0) {
+		p.SetState(1180)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15302,7 +20483,7 @@ func (p *SPLParser) AndExpression() (localctx IAndExpressionContext) {
 
 		if _la == SPLParserAND {
 			{
-				p.SetState(851)
+				p.SetState(1179)
 				p.Match(SPLParserAND)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15312,11 +20493,11 @@ func (p *SPLParser) AndExpression() (localctx IAndExpressionContext) {
 
 		}
 		{
-			p.SetState(854)
+			p.SetState(1182)
 			p.NotExpression()
 		}
 
-		p.SetState(859)
+		p.SetState(1187)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15453,8 +20634,8 @@ func (s *NotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) NotExpression() (localctx INotExpressionContext) {
 	localctx = NewNotExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, SPLParserRULE_notExpression)
-	p.SetState(863)
+	p.EnterRule(localctx, 172, SPLParserRULE_notExpression)
+	p.SetState(1191)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15464,7 +20645,7 @@ func (p *SPLParser) NotExpression() (localctx INotExpressionContext) {
 	case SPLParserNOT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(860)
+			p.SetState(1188)
 			p.Match(SPLParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15472,19 +20653,264 @@ func (p *SPLParser) NotExpression() (localctx INotExpressionContext) {
 			}
 		}
 		{
-			p.SetState(861)
-			p.NotExpression()
+			p.SetState(1189)
+			p.NotExpression()
+		}
+
+	case SPLParserSEARCH, SPLParserEVAL, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserLIKE, SPLParserMATCH, SPLParserCIDRMATCH, SPLParserISNOTNULL, SPLParserISNULL, SPLParserLPAREN, SPLParserLBRACKET, SPLParserMINUS, SPLParserQUOTED_STRING, SPLParserSINGLE_QUOTED, SPLParserTIME_SPAN, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1190)
+			p.ComparisonExpression()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IComparisonExpressionContext is an interface to support dynamic dispatch.
+type IComparisonExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllAdditiveExpression() []IAdditiveExpressionContext
+	AdditiveExpression(i int) IAdditiveExpressionContext
+	ComparisonOp() IComparisonOpContext
+	ProductExpression() IProductExpressionContext
+	Condition() IConditionContext
+
+	// IsComparisonExpressionContext differentiates from other interfaces.
+	IsComparisonExpressionContext()
+}
+
+type ComparisonExpressionContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyComparisonExpressionContext() *ComparisonExpressionContext {
+	var p = new(ComparisonExpressionContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_comparisonExpression
+	return p
+}
+
+func InitEmptyComparisonExpressionContext(p *ComparisonExpressionContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_comparisonExpression
+}
+
+func (*ComparisonExpressionContext) IsComparisonExpressionContext() {}
+
+func NewComparisonExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonExpressionContext {
+	var p = new(ComparisonExpressionContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_comparisonExpression
+
+	return p
+}
+
+func (s *ComparisonExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ComparisonExpressionContext) AllAdditiveExpression() []IAdditiveExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IAdditiveExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IAdditiveExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IAdditiveExpressionContext); ok {
+			tst[i] = t.(IAdditiveExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ComparisonExpressionContext) AdditiveExpression(i int) IAdditiveExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAdditiveExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAdditiveExpressionContext)
+}
+
+func (s *ComparisonExpressionContext) ComparisonOp() IComparisonOpContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IComparisonOpContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IComparisonOpContext)
+}
+
+func (s *ComparisonExpressionContext) ProductExpression() IProductExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IProductExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IProductExpressionContext)
+}
+
+func (s *ComparisonExpressionContext) Condition() IConditionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConditionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionContext)
+}
+
+func (s *ComparisonExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ComparisonExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ComparisonExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterComparisonExpression(s)
+	}
+}
+
+func (s *ComparisonExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitComparisonExpression(s)
+	}
+}
+
+func (s *ComparisonExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitComparisonExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) ComparisonExpression() (localctx IComparisonExpressionContext) {
+	localctx = NewComparisonExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 174, SPLParserRULE_comparisonExpression)
+	var _la int
+
+	p.SetState(1204)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 159, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1193)
+			p.AdditiveExpression()
+		}
+		{
+			p.SetState(1194)
+			p.ComparisonOp()
+		}
+		{
+			p.SetState(1195)
+			p.ProductExpression()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1197)
+			p.Condition()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1198)
+			p.AdditiveExpression()
+		}
+		p.SetState(1202)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2287828610704211968) != 0 {
+			{
+				p.SetState(1199)
+				p.ComparisonOp()
+			}
+			{
+				p.SetState(1200)
+				p.AdditiveExpression()
+			}
 
-	case SPLParserEVAL, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserLIKE, SPLParserMATCH, SPLParserCIDRMATCH, SPLParserISNOTNULL, SPLParserISNULL, SPLParserLPAREN, SPLParserLBRACKET, SPLParserMINUS, SPLParserQUOTED_STRING, SPLParserTIME_SPAN, SPLParserNUMBER, SPLParserWILDCARD, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(862)
-			p.ComparisonExpression()
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
 
@@ -15501,61 +20927,98 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IComparisonExpressionContext is an interface to support dynamic dispatch.
-type IComparisonExpressionContext interface {
+// IProductExpressionContext is an interface to support dynamic dispatch.
+type IProductExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Condition() IConditionContext
-	AllAdditiveExpression() []IAdditiveExpressionContext
-	AdditiveExpression(i int) IAdditiveExpressionContext
-	ComparisonOp() IComparisonOpContext
+	AllUnaryExpression() []IUnaryExpressionContext
+	UnaryExpression(i int) IUnaryExpressionContext
+	AllWILDCARD() []antlr.TerminalNode
+	WILDCARD(i int) antlr.TerminalNode
+	AllMultiplicativeExpression() []IMultiplicativeExpressionContext
+	MultiplicativeExpression(i int) IMultiplicativeExpressionContext
+	AllSLASH() []antlr.TerminalNode
+	SLASH(i int) antlr.TerminalNode
+	AllPERCENT() []antlr.TerminalNode
+	PERCENT(i int) antlr.TerminalNode
+	AllPLUS() []antlr.TerminalNode
+	PLUS(i int) antlr.TerminalNode
+	AllMINUS() []antlr.TerminalNode
+	MINUS(i int) antlr.TerminalNode
+	AllDOT() []antlr.TerminalNode
+	DOT(i int) antlr.TerminalNode
 
-	// IsComparisonExpressionContext differentiates from other interfaces.
-	IsComparisonExpressionContext()
+	// IsProductExpressionContext differentiates from other interfaces.
+	IsProductExpressionContext()
 }
 
-type ComparisonExpressionContext struct {
+type ProductExpressionContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyComparisonExpressionContext() *ComparisonExpressionContext {
-	var p = new(ComparisonExpressionContext)
+func NewEmptyProductExpressionContext() *ProductExpressionContext {
+	var p = new(ProductExpressionContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_comparisonExpression
+	p.RuleIndex = SPLParserRULE_productExpression
 	return p
 }
 
-func InitEmptyComparisonExpressionContext(p *ComparisonExpressionContext) {
+func InitEmptyProductExpressionContext(p *ProductExpressionContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SPLParserRULE_comparisonExpression
+	p.RuleIndex = SPLParserRULE_productExpression
 }
 
-func (*ComparisonExpressionContext) IsComparisonExpressionContext() {}
+func (*ProductExpressionContext) IsProductExpressionContext() {}
 
-func NewComparisonExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonExpressionContext {
-	var p = new(ComparisonExpressionContext)
+func NewProductExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ProductExpressionContext {
+	var p = new(ProductExpressionContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SPLParserRULE_comparisonExpression
+	p.RuleIndex = SPLParserRULE_productExpression
 
 	return p
 }
 
-func (s *ComparisonExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *ProductExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ComparisonExpressionContext) Condition() IConditionContext {
+func (s *ProductExpressionContext) AllUnaryExpression() []IUnaryExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IUnaryExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IUnaryExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IUnaryExpressionContext); ok {
+			tst[i] = t.(IUnaryExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ProductExpressionContext) UnaryExpression(i int) IUnaryExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConditionContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IUnaryExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -15563,23 +21026,31 @@ func (s *ComparisonExpressionContext) Condition() IConditionContext {
 		return nil
 	}
 
-	return t.(IConditionContext)
+	return t.(IUnaryExpressionContext)
 }
 
-func (s *ComparisonExpressionContext) AllAdditiveExpression() []IAdditiveExpressionContext {
+func (s *ProductExpressionContext) AllWILDCARD() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserWILDCARD)
+}
+
+func (s *ProductExpressionContext) WILDCARD(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserWILDCARD, i)
+}
+
+func (s *ProductExpressionContext) AllMultiplicativeExpression() []IMultiplicativeExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IAdditiveExpressionContext); ok {
+		if _, ok := ctx.(IMultiplicativeExpressionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IAdditiveExpressionContext, len)
+	tst := make([]IMultiplicativeExpressionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IAdditiveExpressionContext); ok {
-			tst[i] = t.(IAdditiveExpressionContext)
+		if t, ok := ctx.(IMultiplicativeExpressionContext); ok {
+			tst[i] = t.(IMultiplicativeExpressionContext)
 			i++
 		}
 	}
@@ -15587,11 +21058,11 @@ func (s *ComparisonExpressionContext) AllAdditiveExpression() []IAdditiveExpress
 	return tst
 }
 
-func (s *ComparisonExpressionContext) AdditiveExpression(i int) IAdditiveExpressionContext {
+func (s *ProductExpressionContext) MultiplicativeExpression(i int) IMultiplicativeExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAdditiveExpressionContext); ok {
+		if _, ok := ctx.(IMultiplicativeExpressionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -15604,101 +21075,171 @@ func (s *ComparisonExpressionContext) AdditiveExpression(i int) IAdditiveExpress
 		return nil
 	}
 
-	return t.(IAdditiveExpressionContext)
+	return t.(IMultiplicativeExpressionContext)
 }
 
-func (s *ComparisonExpressionContext) ComparisonOp() IComparisonOpContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IComparisonOpContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *ProductExpressionContext) AllSLASH() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserSLASH)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *ProductExpressionContext) SLASH(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserSLASH, i)
+}
 
-	return t.(IComparisonOpContext)
+func (s *ProductExpressionContext) AllPERCENT() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserPERCENT)
 }
 
-func (s *ComparisonExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ProductExpressionContext) PERCENT(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserPERCENT, i)
+}
+
+func (s *ProductExpressionContext) AllPLUS() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserPLUS)
+}
+
+func (s *ProductExpressionContext) PLUS(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserPLUS, i)
+}
+
+func (s *ProductExpressionContext) AllMINUS() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserMINUS)
+}
+
+func (s *ProductExpressionContext) MINUS(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserMINUS, i)
+}
+
+func (s *ProductExpressionContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(SPLParserDOT)
+}
+
+func (s *ProductExpressionContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(SPLParserDOT, i)
+}
+
+func (s *ProductExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ComparisonExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ProductExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ComparisonExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ProductExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.EnterComparisonExpression(s)
+		listenerT.EnterProductExpression(s)
 	}
 }
 
-func (s *ComparisonExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ProductExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SPLParserListener); ok {
-		listenerT.ExitComparisonExpression(s)
+		listenerT.ExitProductExpression(s)
 	}
 }
 
-func (s *ComparisonExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ProductExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SPLParserVisitor:
-		return t.VisitComparisonExpression(s)
+		return t.VisitProductExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SPLParser) ComparisonExpression() (localctx IComparisonExpressionContext) {
-	localctx = NewComparisonExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, SPLParserRULE_comparisonExpression)
+func (p *SPLParser) ProductExpression() (localctx IProductExpressionContext) {
+	localctx = NewProductExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 176, SPLParserRULE_productExpression)
 	var _la int
 
-	p.SetState(872)
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1206)
+		p.UnaryExpression()
+	}
+	{
+		p.SetState(1207)
+		p.Match(SPLParserWILDCARD)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1208)
+		p.UnaryExpression()
+	}
+	p.SetState(1213)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 111, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+	for (int64((_la-79)) & ^0x3f) == 0 && ((int64(1)<<(_la-79))&1027) != 0 {
 		{
-			p.SetState(865)
-			p.Condition()
-		}
+			p.SetState(1209)
+			_la = p.GetTokenStream().LA(1)
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+			if !((int64((_la-79)) & ^0x3f) == 0 && ((int64(1)<<(_la-79))&1027) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
 		{
-			p.SetState(866)
-			p.AdditiveExpression()
+			p.SetState(1210)
+			p.UnaryExpression()
 		}
-		p.SetState(870)
+
+		p.SetState(1215)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-
-		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1108307720798208) != 0 {
+	}
+	p.SetState(1220)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 161, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
 			{
-				p.SetState(867)
-				p.ComparisonOp()
+				p.SetState(1216)
+				_la = p.GetTokenStream().LA(1)
+
+				if !((int64((_la-77)) & ^0x3f) == 0 && ((int64(1)<<(_la-77))&131075) != 0) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
 			}
 			{
-				p.SetState(868)
-				p.AdditiveExpression()
+				p.SetState(1217)
+				p.MultiplicativeExpression()
 			}
 
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+		p.SetState(1222)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 161, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -15864,32 +21405,32 @@ func (s *AdditiveExpressionContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *SPLParser) AdditiveExpression() (localctx IAdditiveExpressionContext) {
 	localctx = NewAdditiveExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, SPLParserRULE_additiveExpression)
+	p.EnterRule(localctx, 178, SPLParserRULE_additiveExpression)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(874)
+		p.SetState(1223)
 		p.MultiplicativeExpression()
 	}
-	p.SetState(879)
+	p.SetState(1228)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 112, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 162, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(875)
+				p.SetState(1224)
 				_la = p.GetTokenStream().LA(1)
 
-				if !((int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&2051) != 0) {
+				if !((int64((_la-77)) & ^0x3f) == 0 && ((int64(1)<<(_la-77))&131075) != 0) {
 					p.GetErrorHandler().RecoverInline(p)
 				} else {
 					p.GetErrorHandler().ReportMatch(p)
@@ -15897,17 +21438,17 @@ func (p *SPLParser) AdditiveExpression() (localctx IAdditiveExpressionContext) {
 				}
 			}
 			{
-				p.SetState(876)
+				p.SetState(1225)
 				p.MultiplicativeExpression()
 			}
 
 		}
-		p.SetState(881)
+		p.SetState(1230)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 112, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 162, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -16076,53 +21617,44 @@ func (s *MultiplicativeExpressionContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *SPLParser) MultiplicativeExpression() (localctx IMultiplicativeExpressionContext) {
 	localctx = NewMultiplicativeExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, SPLParserRULE_multiplicativeExpression)
+	p.EnterRule(localctx, 180, SPLParserRULE_multiplicativeExpression)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(882)
+		p.SetState(1231)
 		p.UnaryExpression()
 	}
-	p.SetState(887)
+	p.SetState(1236)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 113, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(883)
-				_la = p.GetTokenStream().LA(1)
+	_la = p.GetTokenStream().LA(1)
 
-				if !((int64((_la-67)) & ^0x3f) == 0 && ((int64(1)<<(_la-67))&35) != 0) {
-					p.GetErrorHandler().RecoverInline(p)
-				} else {
-					p.GetErrorHandler().ReportMatch(p)
-					p.Consume()
-				}
-			}
-			{
-				p.SetState(884)
-				p.UnaryExpression()
-			}
+	for (int64((_la-79)) & ^0x3f) == 0 && ((int64(1)<<(_la-79))&1027) != 0 {
+		{
+			p.SetState(1232)
+			_la = p.GetTokenStream().LA(1)
 
+			if !((int64((_la-79)) & ^0x3f) == 0 && ((int64(1)<<(_la-79))&1027) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
-		p.SetState(889)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(1233)
+			p.UnaryExpression()
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 113, p.GetParserRuleContext())
+
+		p.SetState(1238)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -16254,8 +21786,8 @@ func (s *UnaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *SPLParser) UnaryExpression() (localctx IUnaryExpressionContext) {
 	localctx = NewUnaryExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, SPLParserRULE_unaryExpression)
-	p.SetState(893)
+	p.EnterRule(localctx, 182, SPLParserRULE_unaryExpression)
+	p.SetState(1242)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -16265,7 +21797,7 @@ func (p *SPLParser) UnaryExpression() (localctx IUnaryExpressionContext) {
 	case SPLParserMINUS:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(890)
+			p.SetState(1239)
 			p.Match(SPLParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16273,14 +21805,14 @@ func (p *SPLParser) UnaryExpression() (localctx IUnaryExpressionContext) {
 			}
 		}
 		{
-			p.SetState(891)
+			p.SetState(1240)
 			p.UnaryExpression()
 		}
 
-	case SPLParserEVAL, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserLIKE, SPLParserMATCH, SPLParserCIDRMATCH, SPLParserISNOTNULL, SPLParserISNULL, SPLParserLPAREN, SPLParserLBRACKET, SPLParserQUOTED_STRING, SPLParserTIME_SPAN, SPLParserNUMBER, SPLParserWILDCARD, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+	case SPLParserSEARCH, SPLParserEVAL, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserLIKE, SPLParserMATCH, SPLParserCIDRMATCH, SPLParserISNOTNULL, SPLParserISNULL, SPLParserLPAREN, SPLParserLBRACKET, SPLParserQUOTED_STRING, SPLParserSINGLE_QUOTED, SPLParserTIME_SPAN, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(892)
+			p.SetState(1241)
 			p.PrimaryExpression()
 		}
 
@@ -16315,7 +21847,10 @@ type IPrimaryExpressionContext interface {
 	RPAREN() antlr.TerminalNode
 	Subsearch() ISubsearchContext
 	FunctionCall() IFunctionCallContext
-	Value() IValueContext
+	QUOTED_STRING() antlr.TerminalNode
+	NUMBER() antlr.TerminalNode
+	TIME_SPAN() antlr.TerminalNode
+	ColonValue() IColonValueContext
 	FieldName() IFieldNameContext
 
 	// IsPrimaryExpressionContext differentiates from other interfaces.
@@ -16410,10 +21945,22 @@ func (s *PrimaryExpressionContext) FunctionCall() IFunctionCallContext {
 	return t.(IFunctionCallContext)
 }
 
-func (s *PrimaryExpressionContext) Value() IValueContext {
+func (s *PrimaryExpressionContext) QUOTED_STRING() antlr.TerminalNode {
+	return s.GetToken(SPLParserQUOTED_STRING, 0)
+}
+
+func (s *PrimaryExpressionContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
+func (s *PrimaryExpressionContext) TIME_SPAN() antlr.TerminalNode {
+	return s.GetToken(SPLParserTIME_SPAN, 0)
+}
+
+func (s *PrimaryExpressionContext) ColonValue() IColonValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IValueContext); ok {
+		if _, ok := ctx.(IColonValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -16423,7 +21970,7 @@ func (s *PrimaryExpressionContext) Value() IValueContext {
 		return nil
 	}
 
-	return t.(IValueContext)
+	return t.(IColonValueContext)
 }
 
 func (s *PrimaryExpressionContext) FieldName() IFieldNameContext {
@@ -16474,18 +22021,18 @@ func (s *PrimaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *SPLParser) PrimaryExpression() (localctx IPrimaryExpressionContext) {
 	localctx = NewPrimaryExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, SPLParserRULE_primaryExpression)
-	p.SetState(903)
+	p.EnterRule(localctx, 184, SPLParserRULE_primaryExpression)
+	p.SetState(1255)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 115, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 165, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(895)
+			p.SetState(1244)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16493,11 +22040,11 @@ func (p *SPLParser) PrimaryExpression() (localctx IPrimaryExpressionContext) {
 			}
 		}
 		{
-			p.SetState(896)
+			p.SetState(1245)
 			p.Expression()
 		}
 		{
-			p.SetState(897)
+			p.SetState(1246)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16508,28 +22055,61 @@ func (p *SPLParser) PrimaryExpression() (localctx IPrimaryExpressionContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(899)
+			p.SetState(1248)
 			p.Subsearch()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(900)
+			p.SetState(1249)
 			p.FunctionCall()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(901)
-			p.Value()
+			p.SetState(1250)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(902)
+			p.SetState(1251)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1252)
+			p.Match(SPLParserTIME_SPAN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(1253)
+			p.ColonValue()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(1254)
 			p.FieldName()
 		}
 
@@ -16689,10 +22269,10 @@ func (s *FunctionCallContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 	localctx = NewFunctionCallContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, SPLParserRULE_functionCall)
+	p.EnterRule(localctx, 186, SPLParserRULE_functionCall)
 	var _la int
 
-	p.SetState(942)
+	p.SetState(1294)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -16702,7 +22282,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 	case SPLParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(905)
+			p.SetState(1257)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16710,29 +22290,29 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(906)
+			p.SetState(1258)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(908)
+		p.SetState(1260)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&395205160952922632) != 0) || ((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&889) != 0) {
+		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2288669186840313080) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&824590379) != 0) {
 			{
-				p.SetState(907)
+				p.SetState(1259)
 				p.ArgumentList()
 			}
 
 		}
 		{
-			p.SetState(910)
+			p.SetState(1262)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16743,7 +22323,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 	case SPLParserEVAL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(911)
+			p.SetState(1263)
 			p.Match(SPLParserEVAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16751,29 +22331,29 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(912)
+			p.SetState(1264)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(914)
+		p.SetState(1266)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 
-		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&395205160952922632) != 0) || ((int64((_la-66)) & ^0x3f) == 0 && ((int64(1)<<(_la-66))&889) != 0) {
+		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2288669186840313080) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&824590379) != 0) {
 			{
-				p.SetState(913)
+				p.SetState(1265)
 				p.ArgumentList()
 			}
 
 		}
 		{
-			p.SetState(916)
+			p.SetState(1268)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16784,7 +22364,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 	case SPLParserMATCH:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(917)
+			p.SetState(1269)
 			p.Match(SPLParserMATCH)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16792,7 +22372,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(918)
+			p.SetState(1270)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16800,11 +22380,11 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(919)
+			p.SetState(1271)
 			p.ArgumentList()
 		}
 		{
-			p.SetState(920)
+			p.SetState(1272)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16815,7 +22395,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 	case SPLParserLIKE:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(922)
+			p.SetState(1274)
 			p.Match(SPLParserLIKE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16823,7 +22403,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(923)
+			p.SetState(1275)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16831,11 +22411,11 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(924)
+			p.SetState(1276)
 			p.ArgumentList()
 		}
 		{
-			p.SetState(925)
+			p.SetState(1277)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16846,7 +22426,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 	case SPLParserCIDRMATCH:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(927)
+			p.SetState(1279)
 			p.Match(SPLParserCIDRMATCH)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16854,7 +22434,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(928)
+			p.SetState(1280)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16862,11 +22442,11 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(929)
+			p.SetState(1281)
 			p.ArgumentList()
 		}
 		{
-			p.SetState(930)
+			p.SetState(1282)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16877,7 +22457,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 	case SPLParserISNOTNULL:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(932)
+			p.SetState(1284)
 			p.Match(SPLParserISNOTNULL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16885,7 +22465,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(933)
+			p.SetState(1285)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16893,11 +22473,11 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(934)
+			p.SetState(1286)
 			p.ArgumentList()
 		}
 		{
-			p.SetState(935)
+			p.SetState(1287)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16908,7 +22488,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 	case SPLParserISNULL:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(937)
+			p.SetState(1289)
 			p.Match(SPLParserISNULL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16916,7 +22496,7 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(938)
+			p.SetState(1290)
 			p.Match(SPLParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16924,11 +22504,11 @@ func (p *SPLParser) FunctionCall() (localctx IFunctionCallContext) {
 			}
 		}
 		{
-			p.SetState(939)
+			p.SetState(1291)
 			p.ArgumentList()
 		}
 		{
-			p.SetState(940)
+			p.SetState(1292)
 			p.Match(SPLParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17084,15 +22664,15 @@ func (s *ArgumentListContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SPLParser) ArgumentList() (localctx IArgumentListContext) {
 	localctx = NewArgumentListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, SPLParserRULE_argumentList)
+	p.EnterRule(localctx, 188, SPLParserRULE_argumentList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(944)
+		p.SetState(1296)
 		p.Expression()
 	}
-	p.SetState(949)
+	p.SetState(1301)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -17101,7 +22681,7 @@ func (p *SPLParser) ArgumentList() (localctx IArgumentListContext) {
 
 	for _la == SPLParserCOMMA {
 		{
-			p.SetState(945)
+			p.SetState(1297)
 			p.Match(SPLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17109,11 +22689,11 @@ func (p *SPLParser) ArgumentList() (localctx IArgumentListContext) {
 			}
 		}
 		{
-			p.SetState(946)
+			p.SetState(1298)
 			p.Expression()
 		}
 
-		p.SetState(951)
+		p.SetState(1303)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -17144,10 +22724,18 @@ type IValueContext interface {
 	// Getter signatures
 	QUOTED_STRING() antlr.TerminalNode
 	NUMBER() antlr.TerminalNode
+	IP_ADDRESS() antlr.TerminalNode
 	TIME_SPAN() antlr.TerminalNode
+	TIME_MODIFIER() antlr.TerminalNode
+	TIME_ABSOLUTE() antlr.TerminalNode
 	WildcardValue() IWildcardValueContext
 	ColonValue() IColonValueContext
 	IDENTIFIER() antlr.TerminalNode
+	TOKEN_VAR() antlr.TerminalNode
+	SINGLE_QUOTED() antlr.TerminalNode
+	TERM_DIRECTIVE() antlr.TerminalNode
+	CASE_DIRECTIVE() antlr.TerminalNode
+	KeywordAsValue() IKeywordAsValueContext
 
 	// IsValueContext differentiates from other interfaces.
 	IsValueContext()
@@ -17193,10 +22781,22 @@ func (s *ValueContext) NUMBER() antlr.TerminalNode {
 	return s.GetToken(SPLParserNUMBER, 0)
 }
 
+func (s *ValueContext) IP_ADDRESS() antlr.TerminalNode {
+	return s.GetToken(SPLParserIP_ADDRESS, 0)
+}
+
 func (s *ValueContext) TIME_SPAN() antlr.TerminalNode {
 	return s.GetToken(SPLParserTIME_SPAN, 0)
 }
 
+func (s *ValueContext) TIME_MODIFIER() antlr.TerminalNode {
+	return s.GetToken(SPLParserTIME_MODIFIER, 0)
+}
+
+func (s *ValueContext) TIME_ABSOLUTE() antlr.TerminalNode {
+	return s.GetToken(SPLParserTIME_ABSOLUTE, 0)
+}
+
 func (s *ValueContext) WildcardValue() IWildcardValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -17233,6 +22833,38 @@ func (s *ValueContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(SPLParserIDENTIFIER, 0)
 }
 
+func (s *ValueContext) TOKEN_VAR() antlr.TerminalNode {
+	return s.GetToken(SPLParserTOKEN_VAR, 0)
+}
+
+func (s *ValueContext) SINGLE_QUOTED() antlr.TerminalNode {
+	return s.GetToken(SPLParserSINGLE_QUOTED, 0)
+}
+
+func (s *ValueContext) TERM_DIRECTIVE() antlr.TerminalNode {
+	return s.GetToken(SPLParserTERM_DIRECTIVE, 0)
+}
+
+func (s *ValueContext) CASE_DIRECTIVE() antlr.TerminalNode {
+	return s.GetToken(SPLParserCASE_DIRECTIVE, 0)
+}
+
+func (s *ValueContext) KeywordAsValue() IKeywordAsValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IKeywordAsValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKeywordAsValueContext)
+}
+
 func (s *ValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -17265,18 +22897,18 @@ func (s *ValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) Value() (localctx IValueContext) {
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, SPLParserRULE_value)
-	p.SetState(958)
+	p.EnterRule(localctx, 190, SPLParserRULE_value)
+	p.SetState(1318)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 120, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 170, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(952)
+			p.SetState(1304)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17287,7 +22919,7 @@ func (p *SPLParser) Value() (localctx IValueContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(953)
+			p.SetState(1305)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17298,8 +22930,8 @@ func (p *SPLParser) Value() (localctx IValueContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(954)
-			p.Match(SPLParserTIME_SPAN)
+			p.SetState(1306)
+			p.Match(SPLParserIP_ADDRESS)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
@@ -17309,21 +22941,54 @@ func (p *SPLParser) Value() (localctx IValueContext) {
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(955)
-			p.WildcardValue()
+			p.SetState(1307)
+			p.Match(SPLParserTIME_SPAN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(956)
-			p.ColonValue()
+			p.SetState(1308)
+			p.Match(SPLParserTIME_MODIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(957)
+			p.SetState(1309)
+			p.Match(SPLParserTIME_ABSOLUTE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(1310)
+			p.WildcardValue()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(1311)
+			p.ColonValue()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(1312)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17331,6 +22996,57 @@ func (p *SPLParser) Value() (localctx IValueContext) {
 			}
 		}
 
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(1313)
+			p.Match(SPLParserTOKEN_VAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(1314)
+			p.Match(SPLParserSINGLE_QUOTED)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(1315)
+			p.Match(SPLParserTERM_DIRECTIVE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(1316)
+			p.Match(SPLParserCASE_DIRECTIVE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(1317)
+			p.KeywordAsValue()
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -17348,6 +23064,162 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// IKeywordAsValueContext is an interface to support dynamic dispatch.
+type IKeywordAsValueContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	OUTPUT() antlr.TerminalNode
+	OUTPUTNEW() antlr.TerminalNode
+	REGEX() antlr.TerminalNode
+	RETURN() antlr.TerminalNode
+	UNION() antlr.TerminalNode
+	APPENDCOLS() antlr.TerminalNode
+	APPENDPIPE() antlr.TerminalNode
+	MULTISEARCH() antlr.TerminalNode
+	MAP() antlr.TerminalNode
+	FOREACH() antlr.TerminalNode
+
+	// IsKeywordAsValueContext differentiates from other interfaces.
+	IsKeywordAsValueContext()
+}
+
+type KeywordAsValueContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyKeywordAsValueContext() *KeywordAsValueContext {
+	var p = new(KeywordAsValueContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_keywordAsValue
+	return p
+}
+
+func InitEmptyKeywordAsValueContext(p *KeywordAsValueContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SPLParserRULE_keywordAsValue
+}
+
+func (*KeywordAsValueContext) IsKeywordAsValueContext() {}
+
+func NewKeywordAsValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *KeywordAsValueContext {
+	var p = new(KeywordAsValueContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SPLParserRULE_keywordAsValue
+
+	return p
+}
+
+func (s *KeywordAsValueContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *KeywordAsValueContext) OUTPUT() antlr.TerminalNode {
+	return s.GetToken(SPLParserOUTPUT, 0)
+}
+
+func (s *KeywordAsValueContext) OUTPUTNEW() antlr.TerminalNode {
+	return s.GetToken(SPLParserOUTPUTNEW, 0)
+}
+
+func (s *KeywordAsValueContext) REGEX() antlr.TerminalNode {
+	return s.GetToken(SPLParserREGEX, 0)
+}
+
+func (s *KeywordAsValueContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(SPLParserRETURN, 0)
+}
+
+func (s *KeywordAsValueContext) UNION() antlr.TerminalNode {
+	return s.GetToken(SPLParserUNION, 0)
+}
+
+func (s *KeywordAsValueContext) APPENDCOLS() antlr.TerminalNode {
+	return s.GetToken(SPLParserAPPENDCOLS, 0)
+}
+
+func (s *KeywordAsValueContext) APPENDPIPE() antlr.TerminalNode {
+	return s.GetToken(SPLParserAPPENDPIPE, 0)
+}
+
+func (s *KeywordAsValueContext) MULTISEARCH() antlr.TerminalNode {
+	return s.GetToken(SPLParserMULTISEARCH, 0)
+}
+
+func (s *KeywordAsValueContext) MAP() antlr.TerminalNode {
+	return s.GetToken(SPLParserMAP, 0)
+}
+
+func (s *KeywordAsValueContext) FOREACH() antlr.TerminalNode {
+	return s.GetToken(SPLParserFOREACH, 0)
+}
+
+func (s *KeywordAsValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *KeywordAsValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *KeywordAsValueContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.EnterKeywordAsValue(s)
+	}
+}
+
+func (s *KeywordAsValueContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SPLParserListener); ok {
+		listenerT.ExitKeywordAsValue(s)
+	}
+}
+
+func (s *KeywordAsValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SPLParserVisitor:
+		return t.VisitKeywordAsValue(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SPLParser) KeywordAsValue() (localctx IKeywordAsValueContext) {
+	localctx = NewKeywordAsValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 192, SPLParserRULE_keywordAsValue)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1320)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&13514647676141568) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 // IColonValueContext is an interface to support dynamic dispatch.
 type IColonValueContext interface {
 	antlr.ParserRuleContext
@@ -17478,15 +23350,15 @@ func (s *ColonValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) ColonValue() (localctx IColonValueContext) {
 	localctx = NewColonValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, SPLParserRULE_colonValue)
+	p.EnterRule(localctx, 194, SPLParserRULE_colonValue)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(960)
+		p.SetState(1322)
 		p.ExtendedIdentifier()
 	}
-	p.SetState(963)
+	p.SetState(1325)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -17495,7 +23367,7 @@ func (p *SPLParser) ColonValue() (localctx IColonValueContext) {
 
 	for ok := true; ok; ok = _la == SPLParserCOLON {
 		{
-			p.SetState(961)
+			p.SetState(1323)
 			p.Match(SPLParserCOLON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17503,11 +23375,11 @@ func (p *SPLParser) ColonValue() (localctx IColonValueContext) {
 			}
 		}
 		{
-			p.SetState(962)
+			p.SetState(1324)
 			p.ExtendedIdentifier()
 		}
 
-		p.SetState(965)
+		p.SetState(1327)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -17635,33 +23507,33 @@ func (s *ExtendedIdentifierContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *SPLParser) ExtendedIdentifier() (localctx IExtendedIdentifierContext) {
 	localctx = NewExtendedIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, SPLParserRULE_extendedIdentifier)
+	p.EnterRule(localctx, 196, SPLParserRULE_extendedIdentifier)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(967)
+		p.SetState(1329)
 		p.Match(SPLParserIDENTIFIER)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(972)
+	p.SetState(1334)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 122, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 172, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(968)
+				p.SetState(1330)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == SPLParserMINUS || _la == SPLParserSLASH) {
@@ -17672,7 +23544,7 @@ func (p *SPLParser) ExtendedIdentifier() (localctx IExtendedIdentifierContext) {
 				}
 			}
 			{
-				p.SetState(969)
+				p.SetState(1331)
 				p.Match(SPLParserIDENTIFIER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -17681,12 +23553,12 @@ func (p *SPLParser) ExtendedIdentifier() (localctx IExtendedIdentifierContext) {
 			}
 
 		}
-		p.SetState(974)
+		p.SetState(1336)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 122, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 172, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -17807,18 +23679,18 @@ func (s *WildcardValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 	localctx = NewWildcardValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, SPLParserRULE_wildcardValue)
-	p.SetState(991)
+	p.EnterRule(localctx, 198, SPLParserRULE_wildcardValue)
+	p.SetState(1353)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 123, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 173, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(975)
+			p.SetState(1337)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17826,7 +23698,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(976)
+			p.SetState(1338)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17834,7 +23706,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(977)
+			p.SetState(1339)
 			p.Match(SPLParserDOLLAR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17845,7 +23717,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(978)
+			p.SetState(1340)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17853,7 +23725,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(979)
+			p.SetState(1341)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17864,7 +23736,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(980)
+			p.SetState(1342)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17872,7 +23744,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(981)
+			p.SetState(1343)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17880,7 +23752,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(982)
+			p.SetState(1344)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17891,7 +23763,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(983)
+			p.SetState(1345)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17899,7 +23771,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(984)
+			p.SetState(1346)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17910,7 +23782,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(985)
+			p.SetState(1347)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17918,7 +23790,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(986)
+			p.SetState(1348)
 			p.Match(SPLParserDOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17926,7 +23798,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(987)
+			p.SetState(1349)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17937,7 +23809,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(988)
+			p.SetState(1350)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17945,7 +23817,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 			}
 		}
 		{
-			p.SetState(989)
+			p.SetState(1351)
 			p.Match(SPLParserDOLLAR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17956,7 +23828,7 @@ func (p *SPLParser) WildcardValue() (localctx IWildcardValueContext) {
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(990)
+			p.SetState(1352)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17991,8 +23863,12 @@ type IBareWordContext interface {
 	// Getter signatures
 	IDENTIFIER() antlr.TerminalNode
 	NUMBER() antlr.TerminalNode
+	IP_ADDRESS() antlr.TerminalNode
 	QUOTED_STRING() antlr.TerminalNode
+	SINGLE_QUOTED() antlr.TerminalNode
 	WildcardValue() IWildcardValueContext
+	TERM_DIRECTIVE() antlr.TerminalNode
+	CASE_DIRECTIVE() antlr.TerminalNode
 
 	// IsBareWordContext differentiates from other interfaces.
 	IsBareWordContext()
@@ -18038,10 +23914,18 @@ func (s *BareWordContext) NUMBER() antlr.TerminalNode {
 	return s.GetToken(SPLParserNUMBER, 0)
 }
 
+func (s *BareWordContext) IP_ADDRESS() antlr.TerminalNode {
+	return s.GetToken(SPLParserIP_ADDRESS, 0)
+}
+
 func (s *BareWordContext) QUOTED_STRING() antlr.TerminalNode {
 	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
+func (s *BareWordContext) SINGLE_QUOTED() antlr.TerminalNode {
+	return s.GetToken(SPLParserSINGLE_QUOTED, 0)
+}
+
 func (s *BareWordContext) WildcardValue() IWildcardValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -18058,6 +23942,14 @@ func (s *BareWordContext) WildcardValue() IWildcardValueContext {
 	return t.(IWildcardValueContext)
 }
 
+func (s *BareWordContext) TERM_DIRECTIVE() antlr.TerminalNode {
+	return s.GetToken(SPLParserTERM_DIRECTIVE, 0)
+}
+
+func (s *BareWordContext) CASE_DIRECTIVE() antlr.TerminalNode {
+	return s.GetToken(SPLParserCASE_DIRECTIVE, 0)
+}
+
 func (s *BareWordContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -18090,18 +23982,18 @@ func (s *BareWordContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) BareWord() (localctx IBareWordContext) {
 	localctx = NewBareWordContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, SPLParserRULE_bareWord)
-	p.SetState(997)
+	p.EnterRule(localctx, 200, SPLParserRULE_bareWord)
+	p.SetState(1363)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 124, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 174, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(993)
+			p.SetState(1355)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18112,7 +24004,7 @@ func (p *SPLParser) BareWord() (localctx IBareWordContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(994)
+			p.SetState(1356)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18123,8 +24015,8 @@ func (p *SPLParser) BareWord() (localctx IBareWordContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(995)
-			p.Match(SPLParserQUOTED_STRING)
+			p.SetState(1357)
+			p.Match(SPLParserIP_ADDRESS)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
@@ -18134,10 +24026,54 @@ func (p *SPLParser) BareWord() (localctx IBareWordContext) {
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(996)
+			p.SetState(1358)
+			p.Match(SPLParserQUOTED_STRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(1359)
+			p.Match(SPLParserSINGLE_QUOTED)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1360)
 			p.WildcardValue()
 		}
 
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(1361)
+			p.Match(SPLParserTERM_DIRECTIVE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(1362)
+			p.Match(SPLParserCASE_DIRECTIVE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -18172,6 +24108,7 @@ type IFieldNameContext interface {
 	NUMBER() antlr.TerminalNode
 	TEMPLATE_VAR() antlr.TerminalNode
 	IDENTIFIER() antlr.TerminalNode
+	SINGLE_QUOTED() antlr.TerminalNode
 
 	// IsFieldNameContext differentiates from other interfaces.
 	IsFieldNameContext()
@@ -18311,6 +24248,10 @@ func (s *FieldNameContext) IDENTIFIER() antlr.TerminalNode {
 	return s.GetToken(SPLParserIDENTIFIER, 0)
 }
 
+func (s *FieldNameContext) SINGLE_QUOTED() antlr.TerminalNode {
+	return s.GetToken(SPLParserSINGLE_QUOTED, 0)
+}
+
 func (s *FieldNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -18343,43 +24284,43 @@ func (s *FieldNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) FieldName() (localctx IFieldNameContext) {
 	localctx = NewFieldNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, SPLParserRULE_fieldName)
+	p.EnterRule(localctx, 202, SPLParserRULE_fieldName)
 	var _alt int
 
-	p.SetState(1018)
+	p.SetState(1385)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserIDENTIFIER:
+	case SPLParserSEARCH, SPLParserREGEX, SPLParserAPPENDCOLS, SPLParserAPPENDPIPE, SPLParserUNION, SPLParserMULTISEARCH, SPLParserRETURN, SPLParserFOREACH, SPLParserMAP, SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserOUTPUT, SPLParserOUTPUTNEW, SPLParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(999)
+			p.SetState(1365)
 			p.FieldNameBase()
 		}
-		p.SetState(1011)
+		p.SetState(1377)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 127, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 177, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1000)
+				p.SetState(1366)
 				p.FieldNameSuffix()
 			}
-			p.SetState(1008)
+			p.SetState(1374)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 126, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 176, p.GetParserRuleContext())
 			if p.HasError() {
 				goto errorExit
 			}
 			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 				if _alt == 1 {
 					{
-						p.SetState(1001)
+						p.SetState(1367)
 						p.Match(SPLParserDOT)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18387,15 +24328,15 @@ func (p *SPLParser) FieldName() (localctx IFieldNameContext) {
 						}
 					}
 					{
-						p.SetState(1002)
+						p.SetState(1368)
 						p.FieldNameBase()
 					}
-					p.SetState(1004)
+					p.SetState(1370)
 					p.GetErrorHandler().Sync(p)
 
-					if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 125, p.GetParserRuleContext()) == 1 {
+					if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 175, p.GetParserRuleContext()) == 1 {
 						{
-							p.SetState(1003)
+							p.SetState(1369)
 							p.FieldNameSuffix()
 						}
 
@@ -18404,12 +24345,12 @@ func (p *SPLParser) FieldName() (localctx IFieldNameContext) {
 					}
 
 				}
-				p.SetState(1010)
+				p.SetState(1376)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
 				}
-				_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 126, p.GetParserRuleContext())
+				_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 176, p.GetParserRuleContext())
 				if p.HasError() {
 					goto errorExit
 				}
@@ -18422,7 +24363,7 @@ func (p *SPLParser) FieldName() (localctx IFieldNameContext) {
 	case SPLParserNUMBER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1013)
+			p.SetState(1379)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18433,19 +24374,19 @@ func (p *SPLParser) FieldName() (localctx IFieldNameContext) {
 	case SPLParserTEMPLATE_VAR:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1014)
+			p.SetState(1380)
 			p.Match(SPLParserTEMPLATE_VAR)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1016)
+		p.SetState(1382)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 128, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 178, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1015)
+				p.SetState(1381)
 				p.Match(SPLParserIDENTIFIER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -18457,6 +24398,17 @@ func (p *SPLParser) FieldName() (localctx IFieldNameContext) {
 			goto errorExit
 		}
 
+	case SPLParserSINGLE_QUOTED:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1384)
+			p.Match(SPLParserSINGLE_QUOTED)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
 	default:
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
@@ -18485,10 +24437,10 @@ type IFieldNameSuffixContext interface {
 	// Getter signatures
 	LBRACE() antlr.TerminalNode
 	RBRACE() antlr.TerminalNode
+	NUMBER() antlr.TerminalNode
 	LBRACKET() antlr.TerminalNode
 	WILDCARD() antlr.TerminalNode
 	RBRACKET() antlr.TerminalNode
-	NUMBER() antlr.TerminalNode
 
 	// IsFieldNameSuffixContext differentiates from other interfaces.
 	IsFieldNameSuffixContext()
@@ -18534,6 +24486,10 @@ func (s *FieldNameSuffixContext) RBRACE() antlr.TerminalNode {
 	return s.GetToken(SPLParserRBRACE, 0)
 }
 
+func (s *FieldNameSuffixContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SPLParserNUMBER, 0)
+}
+
 func (s *FieldNameSuffixContext) LBRACKET() antlr.TerminalNode {
 	return s.GetToken(SPLParserLBRACKET, 0)
 }
@@ -18546,10 +24502,6 @@ func (s *FieldNameSuffixContext) RBRACKET() antlr.TerminalNode {
 	return s.GetToken(SPLParserRBRACKET, 0)
 }
 
-func (s *FieldNameSuffixContext) NUMBER() antlr.TerminalNode {
-	return s.GetToken(SPLParserNUMBER, 0)
-}
-
 func (s *FieldNameSuffixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -18582,18 +24534,18 @@ func (s *FieldNameSuffixContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *SPLParser) FieldNameSuffix() (localctx IFieldNameSuffixContext) {
 	localctx = NewFieldNameSuffixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, SPLParserRULE_fieldNameSuffix)
-	p.SetState(1028)
+	p.EnterRule(localctx, 204, SPLParserRULE_fieldNameSuffix)
+	p.SetState(1398)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 130, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 180, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1020)
+			p.SetState(1387)
 			p.Match(SPLParserLBRACE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18601,7 +24553,7 @@ func (p *SPLParser) FieldNameSuffix() (localctx IFieldNameSuffixContext) {
 			}
 		}
 		{
-			p.SetState(1021)
+			p.SetState(1388)
 			p.Match(SPLParserRBRACE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18612,7 +24564,34 @@ func (p *SPLParser) FieldNameSuffix() (localctx IFieldNameSuffixContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1022)
+			p.SetState(1389)
+			p.Match(SPLParserLBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1390)
+			p.Match(SPLParserNUMBER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1391)
+			p.Match(SPLParserRBRACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1392)
 			p.Match(SPLParserLBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18620,7 +24599,7 @@ func (p *SPLParser) FieldNameSuffix() (localctx IFieldNameSuffixContext) {
 			}
 		}
 		{
-			p.SetState(1023)
+			p.SetState(1393)
 			p.Match(SPLParserWILDCARD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18628,7 +24607,7 @@ func (p *SPLParser) FieldNameSuffix() (localctx IFieldNameSuffixContext) {
 			}
 		}
 		{
-			p.SetState(1024)
+			p.SetState(1394)
 			p.Match(SPLParserRBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18636,10 +24615,10 @@ func (p *SPLParser) FieldNameSuffix() (localctx IFieldNameSuffixContext) {
 			}
 		}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1025)
+			p.SetState(1395)
 			p.Match(SPLParserLBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18647,7 +24626,7 @@ func (p *SPLParser) FieldNameSuffix() (localctx IFieldNameSuffixContext) {
 			}
 		}
 		{
-			p.SetState(1026)
+			p.SetState(1396)
 			p.Match(SPLParserNUMBER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18655,7 +24634,7 @@ func (p *SPLParser) FieldNameSuffix() (localctx IFieldNameSuffixContext) {
 			}
 		}
 		{
-			p.SetState(1027)
+			p.SetState(1397)
 			p.Match(SPLParserRBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18695,6 +24674,17 @@ type IFieldNameBaseContext interface {
 	FROM() antlr.TerminalNode
 	MSTATS() antlr.TerminalNode
 	INPUTLOOKUP() antlr.TerminalNode
+	OUTPUT() antlr.TerminalNode
+	OUTPUTNEW() antlr.TerminalNode
+	REGEX() antlr.TerminalNode
+	RETURN() antlr.TerminalNode
+	FOREACH() antlr.TerminalNode
+	MAP() antlr.TerminalNode
+	SEARCH() antlr.TerminalNode
+	UNION() antlr.TerminalNode
+	APPENDCOLS() antlr.TerminalNode
+	APPENDPIPE() antlr.TerminalNode
+	MULTISEARCH() antlr.TerminalNode
 
 	// IsFieldNameBaseContext differentiates from other interfaces.
 	IsFieldNameBaseContext()
@@ -18760,6 +24750,50 @@ func (s *FieldNameBaseContext) INPUTLOOKUP() antlr.TerminalNode {
 	return s.GetToken(SPLParserINPUTLOOKUP, 0)
 }
 
+func (s *FieldNameBaseContext) OUTPUT() antlr.TerminalNode {
+	return s.GetToken(SPLParserOUTPUT, 0)
+}
+
+func (s *FieldNameBaseContext) OUTPUTNEW() antlr.TerminalNode {
+	return s.GetToken(SPLParserOUTPUTNEW, 0)
+}
+
+func (s *FieldNameBaseContext) REGEX() antlr.TerminalNode {
+	return s.GetToken(SPLParserREGEX, 0)
+}
+
+func (s *FieldNameBaseContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(SPLParserRETURN, 0)
+}
+
+func (s *FieldNameBaseContext) FOREACH() antlr.TerminalNode {
+	return s.GetToken(SPLParserFOREACH, 0)
+}
+
+func (s *FieldNameBaseContext) MAP() antlr.TerminalNode {
+	return s.GetToken(SPLParserMAP, 0)
+}
+
+func (s *FieldNameBaseContext) SEARCH() antlr.TerminalNode {
+	return s.GetToken(SPLParserSEARCH, 0)
+}
+
+func (s *FieldNameBaseContext) UNION() antlr.TerminalNode {
+	return s.GetToken(SPLParserUNION, 0)
+}
+
+func (s *FieldNameBaseContext) APPENDCOLS() antlr.TerminalNode {
+	return s.GetToken(SPLParserAPPENDCOLS, 0)
+}
+
+func (s *FieldNameBaseContext) APPENDPIPE() antlr.TerminalNode {
+	return s.GetToken(SPLParserAPPENDPIPE, 0)
+}
+
+func (s *FieldNameBaseContext) MULTISEARCH() antlr.TerminalNode {
+	return s.GetToken(SPLParserMULTISEARCH, 0)
+}
+
 func (s *FieldNameBaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -18792,10 +24826,10 @@ func (s *FieldNameBaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) FieldNameBase() (localctx IFieldNameBaseContext) {
 	localctx = NewFieldNameBaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, SPLParserRULE_fieldNameBase)
+	p.EnterRule(localctx, 206, SPLParserRULE_fieldNameBase)
 	var _alt int
 
-	p.SetState(1041)
+	p.SetState(1422)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -18805,26 +24839,26 @@ func (p *SPLParser) FieldNameBase() (localctx IFieldNameBaseContext) {
 	case SPLParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1030)
+			p.SetState(1400)
 			p.Match(SPLParserIDENTIFIER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1035)
+		p.SetState(1405)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 131, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 181, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(1031)
+					p.SetState(1401)
 					p.Match(SPLParserMINUS)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18832,7 +24866,7 @@ func (p *SPLParser) FieldNameBase() (localctx IFieldNameBaseContext) {
 					}
 				}
 				{
-					p.SetState(1032)
+					p.SetState(1402)
 					p.Match(SPLParserIDENTIFIER)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18841,12 +24875,12 @@ func (p *SPLParser) FieldNameBase() (localctx IFieldNameBaseContext) {
 				}
 
 			}
-			p.SetState(1037)
+			p.SetState(1407)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 131, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 181, p.GetParserRuleContext())
 			if p.HasError() {
 				goto errorExit
 			}
@@ -18855,7 +24889,7 @@ func (p *SPLParser) FieldNameBase() (localctx IFieldNameBaseContext) {
 	case SPLParserFROM:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1038)
+			p.SetState(1408)
 			p.Match(SPLParserFROM)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18866,7 +24900,7 @@ func (p *SPLParser) FieldNameBase() (localctx IFieldNameBaseContext) {
 	case SPLParserMSTATS:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1039)
+			p.SetState(1409)
 			p.Match(SPLParserMSTATS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18877,7 +24911,7 @@ func (p *SPLParser) FieldNameBase() (localctx IFieldNameBaseContext) {
 	case SPLParserINPUTLOOKUP:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1040)
+			p.SetState(1410)
 			p.Match(SPLParserINPUTLOOKUP)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18885,6 +24919,127 @@ func (p *SPLParser) FieldNameBase() (localctx IFieldNameBaseContext) {
 			}
 		}
 
+	case SPLParserOUTPUT:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(1411)
+			p.Match(SPLParserOUTPUT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserOUTPUTNEW:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1412)
+			p.Match(SPLParserOUTPUTNEW)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserREGEX:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(1413)
+			p.Match(SPLParserREGEX)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserRETURN:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(1414)
+			p.Match(SPLParserRETURN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserFOREACH:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(1415)
+			p.Match(SPLParserFOREACH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserMAP:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(1416)
+			p.Match(SPLParserMAP)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserSEARCH:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(1417)
+			p.Match(SPLParserSEARCH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserUNION:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(1418)
+			p.Match(SPLParserUNION)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserAPPENDCOLS:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(1419)
+			p.Match(SPLParserAPPENDCOLS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserAPPENDPIPE:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(1420)
+			p.Match(SPLParserAPPENDPIPE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SPLParserMULTISEARCH:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(1421)
+			p.Match(SPLParserMULTISEARCH)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
 	default:
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
@@ -19033,28 +25188,28 @@ func (s *FieldListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) FieldList() (localctx IFieldListContext) {
 	localctx = NewFieldListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, SPLParserRULE_fieldList)
+	p.EnterRule(localctx, 208, SPLParserRULE_fieldList)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1043)
+		p.SetState(1424)
 		p.FieldOrQuoted()
 	}
-	p.SetState(1050)
+	p.SetState(1431)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 134, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 184, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(1045)
+			p.SetState(1426)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -19063,7 +25218,7 @@ func (p *SPLParser) FieldList() (localctx IFieldListContext) {
 
 			if _la == SPLParserCOMMA {
 				{
-					p.SetState(1044)
+					p.SetState(1425)
 					p.Match(SPLParserCOMMA)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -19073,17 +25228,17 @@ func (p *SPLParser) FieldList() (localctx IFieldListContext) {
 
 			}
 			{
-				p.SetState(1047)
+				p.SetState(1428)
 				p.FieldOrQuoted()
 			}
 
 		}
-		p.SetState(1052)
+		p.SetState(1433)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 134, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 184, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -19112,6 +25267,7 @@ type IFieldOrQuotedContext interface {
 	// Getter signatures
 	FieldName() IFieldNameContext
 	QUOTED_STRING() antlr.TerminalNode
+	WildcardValue() IWildcardValueContext
 
 	// IsFieldOrQuotedContext differentiates from other interfaces.
 	IsFieldOrQuotedContext()
@@ -19169,6 +25325,22 @@ func (s *FieldOrQuotedContext) QUOTED_STRING() antlr.TerminalNode {
 	return s.GetToken(SPLParserQUOTED_STRING, 0)
 }
 
+func (s *FieldOrQuotedContext) WildcardValue() IWildcardValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWildcardValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWildcardValueContext)
+}
+
 func (s *FieldOrQuotedContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -19201,25 +25373,25 @@ func (s *FieldOrQuotedContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SPLParser) FieldOrQuoted() (localctx IFieldOrQuotedContext) {
 	localctx = NewFieldOrQuotedContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, SPLParserRULE_fieldOrQuoted)
-	p.SetState(1055)
+	p.EnterRule(localctx, 210, SPLParserRULE_fieldOrQuoted)
+	p.SetState(1437)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetTokenStream().LA(1) {
-	case SPLParserFROM, SPLParserMSTATS, SPLParserINPUTLOOKUP, SPLParserNUMBER, SPLParserTEMPLATE_VAR, SPLParserIDENTIFIER:
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 185, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1053)
+			p.SetState(1434)
 			p.FieldName()
 		}
 
-	case SPLParserQUOTED_STRING:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1054)
+			p.SetState(1435)
 			p.Match(SPLParserQUOTED_STRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -19227,8 +25399,14 @@ func (p *SPLParser) FieldOrQuoted() (localctx IFieldOrQuotedContext) {
 			}
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1436)
+			p.WildcardValue()
+		}
+
+	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
 
@@ -19375,15 +25553,15 @@ func (s *ValueListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SPLParser) ValueList() (localctx IValueListContext) {
 	localctx = NewValueListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, SPLParserRULE_valueList)
+	p.EnterRule(localctx, 212, SPLParserRULE_valueList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1057)
+		p.SetState(1439)
 		p.Value()
 	}
-	p.SetState(1062)
+	p.SetState(1444)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -19392,7 +25570,7 @@ func (p *SPLParser) ValueList() (localctx IValueListContext) {
 
 	for _la == SPLParserCOMMA {
 		{
-			p.SetState(1058)
+			p.SetState(1440)
 			p.Match(SPLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -19400,11 +25578,11 @@ func (p *SPLParser) ValueList() (localctx IValueListContext) {
 			}
 		}
 		{
-			p.SetState(1059)
+			p.SetState(1441)
 			p.Value()
 		}
 
-		p.SetState(1064)
+		p.SetState(1446)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
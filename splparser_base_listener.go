@@ -104,6 +104,12 @@ func (s *BaseSPLParserListener) EnterRexOption(ctx *RexOptionContext) {}
 // ExitRexOption is called when production rexOption is exited.
 func (s *BaseSPLParserListener) ExitRexOption(ctx *RexOptionContext) {}
 
+// EnterRegexCommand is called when production regexCommand is entered.
+func (s *BaseSPLParserListener) EnterRegexCommand(ctx *RegexCommandContext) {}
+
+// ExitRegexCommand is called when production regexCommand is exited.
+func (s *BaseSPLParserListener) ExitRegexCommand(ctx *RegexCommandContext) {}
+
 // EnterDedupCommand is called when production dedupCommand is entered.
 func (s *BaseSPLParserListener) EnterDedupCommand(ctx *DedupCommandContext) {}
 
@@ -146,6 +152,12 @@ func (s *BaseSPLParserListener) EnterTopCommand(ctx *TopCommandContext) {}
 // ExitTopCommand is called when production topCommand is exited.
 func (s *BaseSPLParserListener) ExitTopCommand(ctx *TopCommandContext) {}
 
+// EnterTopOption is called when production topOption is entered.
+func (s *BaseSPLParserListener) EnterTopOption(ctx *TopOptionContext) {}
+
+// ExitTopOption is called when production topOption is exited.
+func (s *BaseSPLParserListener) ExitTopOption(ctx *TopOptionContext) {}
+
 // EnterRareCommand is called when production rareCommand is entered.
 func (s *BaseSPLParserListener) EnterRareCommand(ctx *RareCommandContext) {}
 
@@ -158,6 +170,24 @@ func (s *BaseSPLParserListener) EnterLookupCommand(ctx *LookupCommandContext) {}
 // ExitLookupCommand is called when production lookupCommand is exited.
 func (s *BaseSPLParserListener) ExitLookupCommand(ctx *LookupCommandContext) {}
 
+// EnterLookupOutput is called when production lookupOutput is entered.
+func (s *BaseSPLParserListener) EnterLookupOutput(ctx *LookupOutputContext) {}
+
+// ExitLookupOutput is called when production lookupOutput is exited.
+func (s *BaseSPLParserListener) ExitLookupOutput(ctx *LookupOutputContext) {}
+
+// EnterLookupFieldMapping is called when production lookupFieldMapping is entered.
+func (s *BaseSPLParserListener) EnterLookupFieldMapping(ctx *LookupFieldMappingContext) {}
+
+// ExitLookupFieldMapping is called when production lookupFieldMapping is exited.
+func (s *BaseSPLParserListener) ExitLookupFieldMapping(ctx *LookupFieldMappingContext) {}
+
+// EnterLookupField is called when production lookupField is entered.
+func (s *BaseSPLParserListener) EnterLookupField(ctx *LookupFieldContext) {}
+
+// ExitLookupField is called when production lookupField is exited.
+func (s *BaseSPLParserListener) ExitLookupField(ctx *LookupFieldContext) {}
+
 // EnterLookupOption is called when production lookupOption is entered.
 func (s *BaseSPLParserListener) EnterLookupOption(ctx *LookupOptionContext) {}
 
@@ -182,6 +212,30 @@ func (s *BaseSPLParserListener) EnterAppendCommand(ctx *AppendCommandContext) {}
 // ExitAppendCommand is called when production appendCommand is exited.
 func (s *BaseSPLParserListener) ExitAppendCommand(ctx *AppendCommandContext) {}
 
+// EnterAppendcolsCommand is called when production appendcolsCommand is entered.
+func (s *BaseSPLParserListener) EnterAppendcolsCommand(ctx *AppendcolsCommandContext) {}
+
+// ExitAppendcolsCommand is called when production appendcolsCommand is exited.
+func (s *BaseSPLParserListener) ExitAppendcolsCommand(ctx *AppendcolsCommandContext) {}
+
+// EnterAppendpipeCommand is called when production appendpipeCommand is entered.
+func (s *BaseSPLParserListener) EnterAppendpipeCommand(ctx *AppendpipeCommandContext) {}
+
+// ExitAppendpipeCommand is called when production appendpipeCommand is exited.
+func (s *BaseSPLParserListener) ExitAppendpipeCommand(ctx *AppendpipeCommandContext) {}
+
+// EnterUnionCommand is called when production unionCommand is entered.
+func (s *BaseSPLParserListener) EnterUnionCommand(ctx *UnionCommandContext) {}
+
+// ExitUnionCommand is called when production unionCommand is exited.
+func (s *BaseSPLParserListener) ExitUnionCommand(ctx *UnionCommandContext) {}
+
+// EnterMultisearchCommand is called when production multisearchCommand is entered.
+func (s *BaseSPLParserListener) EnterMultisearchCommand(ctx *MultisearchCommandContext) {}
+
+// ExitMultisearchCommand is called when production multisearchCommand is exited.
+func (s *BaseSPLParserListener) ExitMultisearchCommand(ctx *MultisearchCommandContext) {}
+
 // EnterTransactionCommand is called when production transactionCommand is entered.
 func (s *BaseSPLParserListener) EnterTransactionCommand(ctx *TransactionCommandContext) {}
 
@@ -200,6 +254,12 @@ func (s *BaseSPLParserListener) EnterSpathCommand(ctx *SpathCommandContext) {}
 // ExitSpathCommand is called when production spathCommand is exited.
 func (s *BaseSPLParserListener) ExitSpathCommand(ctx *SpathCommandContext) {}
 
+// EnterSpathPath is called when production spathPath is entered.
+func (s *BaseSPLParserListener) EnterSpathPath(ctx *SpathPathContext) {}
+
+// ExitSpathPath is called when production spathPath is exited.
+func (s *BaseSPLParserListener) ExitSpathPath(ctx *SpathPathContext) {}
+
 // EnterSpathOption is called when production spathOption is entered.
 func (s *BaseSPLParserListener) EnterSpathOption(ctx *SpathOptionContext) {}
 
@@ -218,6 +278,12 @@ func (s *BaseSPLParserListener) EnterStreamstatsCommand(ctx *StreamstatsCommandC
 // ExitStreamstatsCommand is called when production streamstatsCommand is exited.
 func (s *BaseSPLParserListener) ExitStreamstatsCommand(ctx *StreamstatsCommandContext) {}
 
+// EnterStatsOption is called when production statsOption is entered.
+func (s *BaseSPLParserListener) EnterStatsOption(ctx *StatsOptionContext) {}
+
+// ExitStatsOption is called when production statsOption is exited.
+func (s *BaseSPLParserListener) ExitStatsOption(ctx *StatsOptionContext) {}
+
 // EnterTimechartCommand is called when production timechartCommand is entered.
 func (s *BaseSPLParserListener) EnterTimechartCommand(ctx *TimechartCommandContext) {}
 
@@ -272,12 +338,54 @@ func (s *BaseSPLParserListener) EnterFormatCommand(ctx *FormatCommandContext) {}
 // ExitFormatCommand is called when production formatCommand is exited.
 func (s *BaseSPLParserListener) ExitFormatCommand(ctx *FormatCommandContext) {}
 
+// EnterFormatArg is called when production formatArg is entered.
+func (s *BaseSPLParserListener) EnterFormatArg(ctx *FormatArgContext) {}
+
+// ExitFormatArg is called when production formatArg is exited.
+func (s *BaseSPLParserListener) ExitFormatArg(ctx *FormatArgContext) {}
+
 // EnterFormatOption is called when production formatOption is entered.
 func (s *BaseSPLParserListener) EnterFormatOption(ctx *FormatOptionContext) {}
 
 // ExitFormatOption is called when production formatOption is exited.
 func (s *BaseSPLParserListener) ExitFormatOption(ctx *FormatOptionContext) {}
 
+// EnterReturnCommand is called when production returnCommand is entered.
+func (s *BaseSPLParserListener) EnterReturnCommand(ctx *ReturnCommandContext) {}
+
+// ExitReturnCommand is called when production returnCommand is exited.
+func (s *BaseSPLParserListener) ExitReturnCommand(ctx *ReturnCommandContext) {}
+
+// EnterReturnItem is called when production returnItem is entered.
+func (s *BaseSPLParserListener) EnterReturnItem(ctx *ReturnItemContext) {}
+
+// ExitReturnItem is called when production returnItem is exited.
+func (s *BaseSPLParserListener) ExitReturnItem(ctx *ReturnItemContext) {}
+
+// EnterForeachCommand is called when production foreachCommand is entered.
+func (s *BaseSPLParserListener) EnterForeachCommand(ctx *ForeachCommandContext) {}
+
+// ExitForeachCommand is called when production foreachCommand is exited.
+func (s *BaseSPLParserListener) ExitForeachCommand(ctx *ForeachCommandContext) {}
+
+// EnterForeachArg is called when production foreachArg is entered.
+func (s *BaseSPLParserListener) EnterForeachArg(ctx *ForeachArgContext) {}
+
+// ExitForeachArg is called when production foreachArg is exited.
+func (s *BaseSPLParserListener) ExitForeachArg(ctx *ForeachArgContext) {}
+
+// EnterMapCommand is called when production mapCommand is entered.
+func (s *BaseSPLParserListener) EnterMapCommand(ctx *MapCommandContext) {}
+
+// ExitMapCommand is called when production mapCommand is exited.
+func (s *BaseSPLParserListener) ExitMapCommand(ctx *MapCommandContext) {}
+
+// EnterMapOption is called when production mapOption is entered.
+func (s *BaseSPLParserListener) EnterMapOption(ctx *MapOptionContext) {}
+
+// ExitMapOption is called when production mapOption is exited.
+func (s *BaseSPLParserListener) ExitMapOption(ctx *MapOptionContext) {}
+
 // EnterConvertCommand is called when production convertCommand is entered.
 func (s *BaseSPLParserListener) EnterConvertCommand(ctx *ConvertCommandContext) {}
 
@@ -440,6 +548,12 @@ func (s *BaseSPLParserListener) EnterComparisonExpression(ctx *ComparisonExpress
 // ExitComparisonExpression is called when production comparisonExpression is exited.
 func (s *BaseSPLParserListener) ExitComparisonExpression(ctx *ComparisonExpressionContext) {}
 
+// EnterProductExpression is called when production productExpression is entered.
+func (s *BaseSPLParserListener) EnterProductExpression(ctx *ProductExpressionContext) {}
+
+// ExitProductExpression is called when production productExpression is exited.
+func (s *BaseSPLParserListener) ExitProductExpression(ctx *ProductExpressionContext) {}
+
 // EnterAdditiveExpression is called when production additiveExpression is entered.
 func (s *BaseSPLParserListener) EnterAdditiveExpression(ctx *AdditiveExpressionContext) {}
 
@@ -482,6 +596,12 @@ func (s *BaseSPLParserListener) EnterValue(ctx *ValueContext) {}
 // ExitValue is called when production value is exited.
 func (s *BaseSPLParserListener) ExitValue(ctx *ValueContext) {}
 
+// EnterKeywordAsValue is called when production keywordAsValue is entered.
+func (s *BaseSPLParserListener) EnterKeywordAsValue(ctx *KeywordAsValueContext) {}
+
+// ExitKeywordAsValue is called when production keywordAsValue is exited.
+func (s *BaseSPLParserListener) ExitKeywordAsValue(ctx *KeywordAsValueContext) {}
+
 // EnterColonValue is called when production colonValue is entered.
 func (s *BaseSPLParserListener) EnterColonValue(ctx *ColonValueContext) {}
 
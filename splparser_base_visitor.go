@@ -63,6 +63,10 @@ func (v *BaseSPLParserVisitor) VisitRexOption(ctx *RexOptionContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitRegexCommand(ctx *RegexCommandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitDedupCommand(ctx *DedupCommandContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -91,6 +95,10 @@ func (v *BaseSPLParserVisitor) VisitTopCommand(ctx *TopCommandContext) interface
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitTopOption(ctx *TopOptionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitRareCommand(ctx *RareCommandContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -99,6 +107,18 @@ func (v *BaseSPLParserVisitor) VisitLookupCommand(ctx *LookupCommandContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitLookupOutput(ctx *LookupOutputContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitLookupFieldMapping(ctx *LookupFieldMappingContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitLookupField(ctx *LookupFieldContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitLookupOption(ctx *LookupOptionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -115,6 +135,22 @@ func (v *BaseSPLParserVisitor) VisitAppendCommand(ctx *AppendCommandContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitAppendcolsCommand(ctx *AppendcolsCommandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitAppendpipeCommand(ctx *AppendpipeCommandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitUnionCommand(ctx *UnionCommandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitMultisearchCommand(ctx *MultisearchCommandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitTransactionCommand(ctx *TransactionCommandContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -127,6 +163,10 @@ func (v *BaseSPLParserVisitor) VisitSpathCommand(ctx *SpathCommandContext) inter
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitSpathPath(ctx *SpathPathContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitSpathOption(ctx *SpathOptionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -139,6 +179,10 @@ func (v *BaseSPLParserVisitor) VisitStreamstatsCommand(ctx *StreamstatsCommandCo
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitStatsOption(ctx *StatsOptionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitTimechartCommand(ctx *TimechartCommandContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -175,10 +219,38 @@ func (v *BaseSPLParserVisitor) VisitFormatCommand(ctx *FormatCommandContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitFormatArg(ctx *FormatArgContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitFormatOption(ctx *FormatOptionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitReturnCommand(ctx *ReturnCommandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitReturnItem(ctx *ReturnItemContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitForeachCommand(ctx *ForeachCommandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitForeachArg(ctx *ForeachArgContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitMapCommand(ctx *MapCommandContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSPLParserVisitor) VisitMapOption(ctx *MapOptionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitConvertCommand(ctx *ConvertCommandContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -287,6 +359,10 @@ func (v *BaseSPLParserVisitor) VisitComparisonExpression(ctx *ComparisonExpressi
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitProductExpression(ctx *ProductExpressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitAdditiveExpression(ctx *AdditiveExpressionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -315,6 +391,10 @@ func (v *BaseSPLParserVisitor) VisitValue(ctx *ValueContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSPLParserVisitor) VisitKeywordAsValue(ctx *KeywordAsValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSPLParserVisitor) VisitColonValue(ctx *ColonValueContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -49,6 +49,9 @@ type SPLParserListener interface {
 	// EnterRexOption is called when entering the rexOption production.
 	EnterRexOption(c *RexOptionContext)
 
+	// EnterRegexCommand is called when entering the regexCommand production.
+	EnterRegexCommand(c *RegexCommandContext)
+
 	// EnterDedupCommand is called when entering the dedupCommand production.
 	EnterDedupCommand(c *DedupCommandContext)
 
@@ -70,12 +73,24 @@ type SPLParserListener interface {
 	// EnterTopCommand is called when entering the topCommand production.
 	EnterTopCommand(c *TopCommandContext)
 
+	// EnterTopOption is called when entering the topOption production.
+	EnterTopOption(c *TopOptionContext)
+
 	// EnterRareCommand is called when entering the rareCommand production.
 	EnterRareCommand(c *RareCommandContext)
 
 	// EnterLookupCommand is called when entering the lookupCommand production.
 	EnterLookupCommand(c *LookupCommandContext)
 
+	// EnterLookupOutput is called when entering the lookupOutput production.
+	EnterLookupOutput(c *LookupOutputContext)
+
+	// EnterLookupFieldMapping is called when entering the lookupFieldMapping production.
+	EnterLookupFieldMapping(c *LookupFieldMappingContext)
+
+	// EnterLookupField is called when entering the lookupField production.
+	EnterLookupField(c *LookupFieldContext)
+
 	// EnterLookupOption is called when entering the lookupOption production.
 	EnterLookupOption(c *LookupOptionContext)
 
@@ -88,6 +103,18 @@ type SPLParserListener interface {
 	// EnterAppendCommand is called when entering the appendCommand production.
 	EnterAppendCommand(c *AppendCommandContext)
 
+	// EnterAppendcolsCommand is called when entering the appendcolsCommand production.
+	EnterAppendcolsCommand(c *AppendcolsCommandContext)
+
+	// EnterAppendpipeCommand is called when entering the appendpipeCommand production.
+	EnterAppendpipeCommand(c *AppendpipeCommandContext)
+
+	// EnterUnionCommand is called when entering the unionCommand production.
+	EnterUnionCommand(c *UnionCommandContext)
+
+	// EnterMultisearchCommand is called when entering the multisearchCommand production.
+	EnterMultisearchCommand(c *MultisearchCommandContext)
+
 	// EnterTransactionCommand is called when entering the transactionCommand production.
 	EnterTransactionCommand(c *TransactionCommandContext)
 
@@ -97,6 +124,9 @@ type SPLParserListener interface {
 	// EnterSpathCommand is called when entering the spathCommand production.
 	EnterSpathCommand(c *SpathCommandContext)
 
+	// EnterSpathPath is called when entering the spathPath production.
+	EnterSpathPath(c *SpathPathContext)
+
 	// EnterSpathOption is called when entering the spathOption production.
 	EnterSpathOption(c *SpathOptionContext)
 
@@ -106,6 +136,9 @@ type SPLParserListener interface {
 	// EnterStreamstatsCommand is called when entering the streamstatsCommand production.
 	EnterStreamstatsCommand(c *StreamstatsCommandContext)
 
+	// EnterStatsOption is called when entering the statsOption production.
+	EnterStatsOption(c *StatsOptionContext)
+
 	// EnterTimechartCommand is called when entering the timechartCommand production.
 	EnterTimechartCommand(c *TimechartCommandContext)
 
@@ -133,9 +166,30 @@ type SPLParserListener interface {
 	// EnterFormatCommand is called when entering the formatCommand production.
 	EnterFormatCommand(c *FormatCommandContext)
 
+	// EnterFormatArg is called when entering the formatArg production.
+	EnterFormatArg(c *FormatArgContext)
+
 	// EnterFormatOption is called when entering the formatOption production.
 	EnterFormatOption(c *FormatOptionContext)
 
+	// EnterReturnCommand is called when entering the returnCommand production.
+	EnterReturnCommand(c *ReturnCommandContext)
+
+	// EnterReturnItem is called when entering the returnItem production.
+	EnterReturnItem(c *ReturnItemContext)
+
+	// EnterForeachCommand is called when entering the foreachCommand production.
+	EnterForeachCommand(c *ForeachCommandContext)
+
+	// EnterForeachArg is called when entering the foreachArg production.
+	EnterForeachArg(c *ForeachArgContext)
+
+	// EnterMapCommand is called when entering the mapCommand production.
+	EnterMapCommand(c *MapCommandContext)
+
+	// EnterMapOption is called when entering the mapOption production.
+	EnterMapOption(c *MapOptionContext)
+
 	// EnterConvertCommand is called when entering the convertCommand production.
 	EnterConvertCommand(c *ConvertCommandContext)
 
@@ -217,6 +271,9 @@ type SPLParserListener interface {
 	// EnterComparisonExpression is called when entering the comparisonExpression production.
 	EnterComparisonExpression(c *ComparisonExpressionContext)
 
+	// EnterProductExpression is called when entering the productExpression production.
+	EnterProductExpression(c *ProductExpressionContext)
+
 	// EnterAdditiveExpression is called when entering the additiveExpression production.
 	EnterAdditiveExpression(c *AdditiveExpressionContext)
 
@@ -238,6 +295,9 @@ type SPLParserListener interface {
 	// EnterValue is called when entering the value production.
 	EnterValue(c *ValueContext)
 
+	// EnterKeywordAsValue is called when entering the keywordAsValue production.
+	EnterKeywordAsValue(c *KeywordAsValueContext)
+
 	// EnterColonValue is called when entering the colonValue production.
 	EnterColonValue(c *ColonValueContext)
 
@@ -310,6 +370,9 @@ type SPLParserListener interface {
 	// ExitRexOption is called when exiting the rexOption production.
 	ExitRexOption(c *RexOptionContext)
 
+	// ExitRegexCommand is called when exiting the regexCommand production.
+	ExitRegexCommand(c *RegexCommandContext)
+
 	// ExitDedupCommand is called when exiting the dedupCommand production.
 	ExitDedupCommand(c *DedupCommandContext)
 
@@ -331,12 +394,24 @@ type SPLParserListener interface {
 	// ExitTopCommand is called when exiting the topCommand production.
 	ExitTopCommand(c *TopCommandContext)
 
+	// ExitTopOption is called when exiting the topOption production.
+	ExitTopOption(c *TopOptionContext)
+
 	// ExitRareCommand is called when exiting the rareCommand production.
 	ExitRareCommand(c *RareCommandContext)
 
 	// ExitLookupCommand is called when exiting the lookupCommand production.
 	ExitLookupCommand(c *LookupCommandContext)
 
+	// ExitLookupOutput is called when exiting the lookupOutput production.
+	ExitLookupOutput(c *LookupOutputContext)
+
+	// ExitLookupFieldMapping is called when exiting the lookupFieldMapping production.
+	ExitLookupFieldMapping(c *LookupFieldMappingContext)
+
+	// ExitLookupField is called when exiting the lookupField production.
+	ExitLookupField(c *LookupFieldContext)
+
 	// ExitLookupOption is called when exiting the lookupOption production.
 	ExitLookupOption(c *LookupOptionContext)
 
@@ -349,6 +424,18 @@ type SPLParserListener interface {
 	// ExitAppendCommand is called when exiting the appendCommand production.
 	ExitAppendCommand(c *AppendCommandContext)
 
+	// ExitAppendcolsCommand is called when exiting the appendcolsCommand production.
+	ExitAppendcolsCommand(c *AppendcolsCommandContext)
+
+	// ExitAppendpipeCommand is called when exiting the appendpipeCommand production.
+	ExitAppendpipeCommand(c *AppendpipeCommandContext)
+
+	// ExitUnionCommand is called when exiting the unionCommand production.
+	ExitUnionCommand(c *UnionCommandContext)
+
+	// ExitMultisearchCommand is called when exiting the multisearchCommand production.
+	ExitMultisearchCommand(c *MultisearchCommandContext)
+
 	// ExitTransactionCommand is called when exiting the transactionCommand production.
 	ExitTransactionCommand(c *TransactionCommandContext)
 
@@ -358,6 +445,9 @@ type SPLParserListener interface {
 	// ExitSpathCommand is called when exiting the spathCommand production.
 	ExitSpathCommand(c *SpathCommandContext)
 
+	// ExitSpathPath is called when exiting the spathPath production.
+	ExitSpathPath(c *SpathPathContext)
+
 	// ExitSpathOption is called when exiting the spathOption production.
 	ExitSpathOption(c *SpathOptionContext)
 
@@ -367,6 +457,9 @@ type SPLParserListener interface {
 	// ExitStreamstatsCommand is called when exiting the streamstatsCommand production.
 	ExitStreamstatsCommand(c *StreamstatsCommandContext)
 
+	// ExitStatsOption is called when exiting the statsOption production.
+	ExitStatsOption(c *StatsOptionContext)
+
 	// ExitTimechartCommand is called when exiting the timechartCommand production.
 	ExitTimechartCommand(c *TimechartCommandContext)
 
@@ -394,9 +487,30 @@ type SPLParserListener interface {
 	// ExitFormatCommand is called when exiting the formatCommand production.
 	ExitFormatCommand(c *FormatCommandContext)
 
+	// ExitFormatArg is called when exiting the formatArg production.
+	ExitFormatArg(c *FormatArgContext)
+
 	// ExitFormatOption is called when exiting the formatOption production.
 	ExitFormatOption(c *FormatOptionContext)
 
+	// ExitReturnCommand is called when exiting the returnCommand production.
+	ExitReturnCommand(c *ReturnCommandContext)
+
+	// ExitReturnItem is called when exiting the returnItem production.
+	ExitReturnItem(c *ReturnItemContext)
+
+	// ExitForeachCommand is called when exiting the foreachCommand production.
+	ExitForeachCommand(c *ForeachCommandContext)
+
+	// ExitForeachArg is called when exiting the foreachArg production.
+	ExitForeachArg(c *ForeachArgContext)
+
+	// ExitMapCommand is called when exiting the mapCommand production.
+	ExitMapCommand(c *MapCommandContext)
+
+	// ExitMapOption is called when exiting the mapOption production.
+	ExitMapOption(c *MapOptionContext)
+
 	// ExitConvertCommand is called when exiting the convertCommand production.
 	ExitConvertCommand(c *ConvertCommandContext)
 
@@ -478,6 +592,9 @@ type SPLParserListener interface {
 	// ExitComparisonExpression is called when exiting the comparisonExpression production.
 	ExitComparisonExpression(c *ComparisonExpressionContext)
 
+	// ExitProductExpression is called when exiting the productExpression production.
+	ExitProductExpression(c *ProductExpressionContext)
+
 	// ExitAdditiveExpression is called when exiting the additiveExpression production.
 	ExitAdditiveExpression(c *AdditiveExpressionContext)
 
@@ -499,6 +616,9 @@ type SPLParserListener interface {
 	// ExitValue is called when exiting the value production.
 	ExitValue(c *ValueContext)
 
+	// ExitKeywordAsValue is called when exiting the keywordAsValue production.
+	ExitKeywordAsValue(c *KeywordAsValueContext)
+
 	// ExitColonValue is called when exiting the colonValue production.
 	ExitColonValue(c *ColonValueContext)
 
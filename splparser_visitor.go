@@ -49,6 +49,9 @@ type SPLParserVisitor interface {
 	// Visit a parse tree produced by SPLParser#rexOption.
 	VisitRexOption(ctx *RexOptionContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#regexCommand.
+	VisitRegexCommand(ctx *RegexCommandContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#dedupCommand.
 	VisitDedupCommand(ctx *DedupCommandContext) interface{}
 
@@ -70,12 +73,24 @@ type SPLParserVisitor interface {
 	// Visit a parse tree produced by SPLParser#topCommand.
 	VisitTopCommand(ctx *TopCommandContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#topOption.
+	VisitTopOption(ctx *TopOptionContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#rareCommand.
 	VisitRareCommand(ctx *RareCommandContext) interface{}
 
 	// Visit a parse tree produced by SPLParser#lookupCommand.
 	VisitLookupCommand(ctx *LookupCommandContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#lookupOutput.
+	VisitLookupOutput(ctx *LookupOutputContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#lookupFieldMapping.
+	VisitLookupFieldMapping(ctx *LookupFieldMappingContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#lookupField.
+	VisitLookupField(ctx *LookupFieldContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#lookupOption.
 	VisitLookupOption(ctx *LookupOptionContext) interface{}
 
@@ -88,6 +103,18 @@ type SPLParserVisitor interface {
 	// Visit a parse tree produced by SPLParser#appendCommand.
 	VisitAppendCommand(ctx *AppendCommandContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#appendcolsCommand.
+	VisitAppendcolsCommand(ctx *AppendcolsCommandContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#appendpipeCommand.
+	VisitAppendpipeCommand(ctx *AppendpipeCommandContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#unionCommand.
+	VisitUnionCommand(ctx *UnionCommandContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#multisearchCommand.
+	VisitMultisearchCommand(ctx *MultisearchCommandContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#transactionCommand.
 	VisitTransactionCommand(ctx *TransactionCommandContext) interface{}
 
@@ -97,6 +124,9 @@ type SPLParserVisitor interface {
 	// Visit a parse tree produced by SPLParser#spathCommand.
 	VisitSpathCommand(ctx *SpathCommandContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#spathPath.
+	VisitSpathPath(ctx *SpathPathContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#spathOption.
 	VisitSpathOption(ctx *SpathOptionContext) interface{}
 
@@ -106,6 +136,9 @@ type SPLParserVisitor interface {
 	// Visit a parse tree produced by SPLParser#streamstatsCommand.
 	VisitStreamstatsCommand(ctx *StreamstatsCommandContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#statsOption.
+	VisitStatsOption(ctx *StatsOptionContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#timechartCommand.
 	VisitTimechartCommand(ctx *TimechartCommandContext) interface{}
 
@@ -133,9 +166,30 @@ type SPLParserVisitor interface {
 	// Visit a parse tree produced by SPLParser#formatCommand.
 	VisitFormatCommand(ctx *FormatCommandContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#formatArg.
+	VisitFormatArg(ctx *FormatArgContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#formatOption.
 	VisitFormatOption(ctx *FormatOptionContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#returnCommand.
+	VisitReturnCommand(ctx *ReturnCommandContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#returnItem.
+	VisitReturnItem(ctx *ReturnItemContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#foreachCommand.
+	VisitForeachCommand(ctx *ForeachCommandContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#foreachArg.
+	VisitForeachArg(ctx *ForeachArgContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#mapCommand.
+	VisitMapCommand(ctx *MapCommandContext) interface{}
+
+	// Visit a parse tree produced by SPLParser#mapOption.
+	VisitMapOption(ctx *MapOptionContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#convertCommand.
 	VisitConvertCommand(ctx *ConvertCommandContext) interface{}
 
@@ -217,6 +271,9 @@ type SPLParserVisitor interface {
 	// Visit a parse tree produced by SPLParser#comparisonExpression.
 	VisitComparisonExpression(ctx *ComparisonExpressionContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#productExpression.
+	VisitProductExpression(ctx *ProductExpressionContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#additiveExpression.
 	VisitAdditiveExpression(ctx *AdditiveExpressionContext) interface{}
 
@@ -238,6 +295,9 @@ type SPLParserVisitor interface {
 	// Visit a parse tree produced by SPLParser#value.
 	VisitValue(ctx *ValueContext) interface{}
 
+	// Visit a parse tree produced by SPLParser#keywordAsValue.
+	VisitKeywordAsValue(ctx *KeywordAsValueContext) interface{}
+
 	// Visit a parse tree produced by SPLParser#colonValue.
 	VisitColonValue(ctx *ColonValueContext) interface{}
 
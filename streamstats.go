@@ -0,0 +1,130 @@
+package spl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StreamstatsInfo describes a streamstats or eventstats command: its
+// aggregates, BY fields and window options. Options not given hold their
+// Splunk defaults, so Current and Global are true unless turned off.
+type StreamstatsInfo struct {
+	Command       string            `json:"command"`                   // "streamstats" or "eventstats"
+	Functions     []string          `json:"functions"`                 // Output fields, e.g. count, avg(bytes), failures
+	GroupBy       []string          `json:"group_by,omitempty"`        // BY fields
+	Window        int               `json:"window,omitempty"`          // window=N: events in the window (0 = unbounded)
+	TimeWindow    string            `json:"time_window,omitempty"`     // time_window=<span>, e.g. 1h
+	Current       bool              `json:"current"`                   // current=t: the event is included in its own stats
+	Global        bool              `json:"global"`                    // global=t: one window shared by all BY groups
+	ResetOnChange bool              `json:"reset_on_change,omitempty"` // Reset when the BY field values change
+	ResetBefore   string            `json:"reset_before,omitempty"`    // Eval expression checked before each event
+	ResetAfter    string            `json:"reset_after,omitempty"`     // Eval expression checked after each event
+	AllNum        bool              `json:"allnum,omitempty"`          // Numeric stats only when all values are numeric
+	Options       map[string]string `json:"options,omitempty"`         // Options as written
+	PipeStage     int               `json:"pipe_stage"`
+}
+
+// streamstatsOptions are the options streamstats accepts; eventstats only
+// takes allnum
+var streamstatsOptions = map[string]bool{
+	"window": true, "time_window": true, "current": true, "global": true,
+	"reset_before": true, "reset_after": true, "reset_on_change": true, "allnum": true,
+}
+
+// newStreamstatsInfo reads the options of a streamstats or eventstats
+// command. The returned info is filled in as far as possible even when an
+// option is invalid.
+func newStreamstatsInfo(command string, opts []IStatsOptionContext, fns []IStatsFunctionContext, by IFieldListContext) (StreamstatsInfo, error) {
+	info := StreamstatsInfo{
+		Command: command,
+		GroupBy: fieldListNames(by),
+		Options: commandOptions(opts),
+		Current: true,
+		Global:  true,
+	}
+	if specs, err := parseStatsSpecs(fns); err == nil {
+		for _, spec := range specs {
+			info.Functions = append(info.Functions, spec.name)
+		}
+	} else {
+		for _, f := range fns {
+			info.Functions = append(info.Functions, f.GetText())
+		}
+	}
+
+	keys := make([]string, 0, len(info.Options))
+	for key := range info.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, key := range keys {
+		value := info.Options[key]
+		if !streamstatsOptions[key] || (command == "eventstats" && key != "allnum") {
+			errs = append(errs, fmt.Sprintf("unknown %s option %s", command, key))
+			continue
+		}
+		switch key {
+		case "window":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				errs = append(errs, fmt.Sprintf("%s window must be a non-negative integer, got %q", command, value))
+				continue
+			}
+			info.Window = n
+		case "time_window":
+			info.TimeWindow = value
+			if _, ok := info.TimeWindowDuration(); !ok {
+				errs = append(errs, fmt.Sprintf("%s time_window %q is not a valid time span", command, value))
+			}
+		case "reset_before":
+			info.ResetBefore = value
+		case "reset_after":
+			info.ResetAfter = value
+		default:
+			b, ok := parseBoolOption(value)
+			if !ok {
+				errs = append(errs, fmt.Sprintf("%s %s must be a boolean, got %q", command, key, value))
+				continue
+			}
+			switch key {
+			case "current":
+				info.Current = b
+			case "global":
+				info.Global = b
+			case "reset_on_change":
+				info.ResetOnChange = b
+			case "allnum":
+				info.AllNum = b
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return info, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return info, nil
+}
+
+// TimeWindowDuration returns the time_window span as a duration; false when
+// there is no time window or it cannot be expressed as a fixed duration
+func (s StreamstatsInfo) TimeWindowDuration() (time.Duration, bool) {
+	if s.TimeWindow == "" {
+		return 0, false
+	}
+	return TimeSpan{Value: s.TimeWindow}.Duration()
+}
+
+// parseBoolOption parses a Splunk boolean option value
+func parseBoolOption(v string) (value, ok bool) {
+	switch strings.ToLower(v) {
+	case "t", "true", "1", "yes", "y":
+		return true, true
+	case "f", "false", "0", "no", "n":
+		return false, true
+	}
+	return false, false
+}
@@ -0,0 +1,109 @@
+package spl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStreamstatsInfo(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  StreamstatsInfo
+	}{
+		{
+			name:  "defaults",
+			query: `index=main | streamstats count by user`,
+			want:  StreamstatsInfo{Command: "streamstats", Functions: []string{"count"}, GroupBy: []string{"user"}, Current: true, Global: true, Options: map[string]string{}, PipeStage: 1},
+		},
+		{
+			name:  "sliding window",
+			query: `index=auth action=failure | streamstats window=5 current=f time_window=1h global=f reset_on_change=t count by user`,
+			want: StreamstatsInfo{
+				Command: "streamstats", Functions: []string{"count"}, GroupBy: []string{"user"},
+				Window: 5, TimeWindow: "1h", ResetOnChange: true,
+				Options:   map[string]string{"window": "5", "current": "f", "time_window": "1h", "global": "f", "reset_on_change": "t"},
+				PipeStage: 1,
+			},
+		},
+		{
+			name:  "reset expressions",
+			query: `index=main | streamstats reset_before="(action=\"login\")" reset_after="(failures>3)" allnum=t count AS failures, avg(bytes)`,
+			want: StreamstatsInfo{
+				Command: "streamstats", Functions: []string{"failures", "avg(bytes)"},
+				Current: true, Global: true, ResetBefore: `(action="login")`, ResetAfter: "(failures>3)", AllNum: true,
+				Options:   map[string]string{"reset_before": `(action="login")`, "reset_after": "(failures>3)", "allnum": "t"},
+				PipeStage: 1,
+			},
+		},
+		{
+			name:  "options after the functions",
+			query: `index=main | streamstats window=10 avg(value) AS avg_value current=f by host`,
+			want: StreamstatsInfo{
+				Command: "streamstats", Functions: []string{"avg_value"}, GroupBy: []string{"host"},
+				Window: 10, Global: true, Options: map[string]string{"window": "10", "current": "f"}, PipeStage: 1,
+			},
+		},
+		{
+			name:  "eventstats",
+			query: `index=main | eventstats allnum=true avg(bytes) AS avg_bytes by host`,
+			want: StreamstatsInfo{
+				Command: "eventstats", Functions: []string{"avg_bytes"}, GroupBy: []string{"host"},
+				Current: true, Global: true, AllNum: true, Options: map[string]string{"allnum": "true"}, PipeStage: 1,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ExtractConditions(tc.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("unexpected errors: %v", result.Errors)
+			}
+			if len(result.Streamstats) != 1 {
+				t.Fatalf("expected 1 streamstats, got %d", len(result.Streamstats))
+			}
+			if got := result.Streamstats[0]; !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v\nwant %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStreamstatsInfo_TimeWindow(t *testing.T) {
+	result := ExtractConditions(`index=auth | streamstats time_window=30m count by src | where count > 20`)
+	if len(result.Streamstats) != 1 {
+		t.Fatalf("expected 1 streamstats, got %d", len(result.Streamstats))
+	}
+	if d, ok := result.Streamstats[0].TimeWindowDuration(); !ok || d != 30*time.Minute {
+		t.Errorf("TimeWindowDuration() = %v, %v; want 30m", d, ok)
+	}
+	if result.TimeRange == nil || len(result.TimeRange.Spans) != 1 {
+		t.Fatalf("expected the time_window span in TimeRange, got %+v", result.TimeRange)
+	}
+	want := TimeSpan{Command: "streamstats", Option: "time_window", Value: "30m", PipeStage: 1}
+	if got := result.TimeRange.Spans[0]; got != want {
+		t.Errorf("span = %+v, want %+v", got, want)
+	}
+}
+
+func TestStreamstatsInfo_Errors(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`index=main | streamstats window=five count`, "window must be a non-negative integer"},
+		{`index=main | streamstats time_window=1mon count`, "not a valid time span"},
+		{`index=main | streamstats current=maybe count`, "current must be a boolean"},
+		{`index=main | streamstats windows=5 count`, "unknown streamstats option windows"},
+		{`index=main | eventstats window=5 count`, "unknown eventstats option window"},
+	}
+	for _, tc := range tests {
+		result := ExtractConditions(tc.query)
+		if !strings.Contains(strings.Join(result.Errors, "\n"), tc.want) {
+			t.Errorf("%s: errors %v, want one containing %q", tc.query, result.Errors, tc.want)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package spl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SubsearchFormat is the shape of the search string a subsearch emits to
+// the outer search. Subsearches ending in fields or table are formatted
+// with the format command's defaults.
+type SubsearchFormat struct {
+	MaxResults      int          `json:"max_results,omitempty"` // Rows rendered: return <count> (default 1) or format maxresults=; 0 is unlimited
+	Returns         []ReturnItem `json:"returns,omitempty"`     // return arguments; empty for format
+	RowPrefix       string       `json:"row_prefix"`
+	ColumnPrefix    string       `json:"column_prefix"`
+	ColumnSeparator string       `json:"column_separator"`
+	ColumnEnd       string       `json:"column_end"`
+	RowSeparator    string       `json:"row_separator"`
+	RowEnd          string       `json:"row_end"`
+	MVSeparator     string       `json:"mv_separator"` // Joins the values of a multivalue field
+	EmptyString     string       `json:"empty_string"` // Emitted when there are no results
+}
+
+// ReturnItem is one return argument: field, alias=field or $field
+type ReturnItem struct {
+	Field     string `json:"field"`
+	Alias     string `json:"alias,omitempty"`      // alias=field: searched as alias="value"
+	ValueOnly bool   `json:"value_only,omitempty"` // $field: the value alone, searched as a keyword
+}
+
+// DefaultSubsearchFormat is the output of format without arguments:
+// ( ( a="1" AND b="2" ) OR ( a="3" AND b="4" ) ), or NOT ( ) without results
+func DefaultSubsearchFormat() SubsearchFormat {
+	return SubsearchFormat{
+		RowPrefix:       "(",
+		ColumnPrefix:    "(",
+		ColumnSeparator: "AND",
+		ColumnEnd:       ")",
+		RowSeparator:    "OR",
+		RowEnd:          ")",
+		MVSeparator:     "OR",
+		EmptyString:     "NOT ( )",
+	}
+}
+
+// newFormatCommandFormat reads the options and positional separators of a
+// format command
+func newFormatCommandFormat(ctx IFormatCommandContext) (SubsearchFormat, error) {
+	f := DefaultSubsearchFormat()
+	var options []IFormatOptionContext
+	var positional []string
+	for _, arg := range ctx.AllFormatArg() {
+		if arg.FormatOption() != nil {
+			options = append(options, arg.FormatOption())
+		} else {
+			positional = append(positional, unquoteSPLString(arg.QUOTED_STRING().GetText()))
+		}
+	}
+
+	switch len(positional) {
+	case 0:
+	case 6:
+		f.RowPrefix, f.ColumnPrefix, f.ColumnSeparator = positional[0], positional[1], positional[2]
+		f.ColumnEnd, f.RowSeparator, f.RowEnd = positional[3], positional[4], positional[5]
+	default:
+		return f, fmt.Errorf("format takes six positional separators, got %d", len(positional))
+	}
+
+	opts := commandOptions(options)
+	if v, ok := opts["mvsep"]; ok {
+		f.MVSeparator = v
+	}
+	if v, ok := opts["emptystr"]; ok {
+		f.EmptyString = v
+	}
+	if v, ok := opts["maxresults"]; ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return f, fmt.Errorf("format maxresults=%s is not a count", v)
+		}
+		f.MaxResults = n
+	}
+	return f, nil
+}
+
+// newReturnCommandFormat reads the count and fields of a return command
+func newReturnCommandFormat(ctx IReturnCommandContext) SubsearchFormat {
+	f := DefaultSubsearchFormat()
+	f.MaxResults = countArg(ctx.NUMBER(), 1)
+	for _, item := range ctx.AllReturnItem() {
+		names := item.AllFieldName()
+		switch {
+		case item.DOLLAR() != nil:
+			f.Returns = append(f.Returns, ReturnItem{Field: names[0].GetText(), ValueOnly: true})
+		case len(names) == 2:
+			f.Returns = append(f.Returns, ReturnItem{Field: names[1].GetText(), Alias: names[0].GetText()})
+		default:
+			f.Returns = append(f.Returns, ReturnItem{Field: names[0].GetText()})
+		}
+	}
+	return f
+}
+
+// SearchFields lists the fields the rendered search filters on, or nil if
+// they depend on the result rows (format without a field selection). Values
+// returned with $field are keyword searches and reported as _raw.
+func (f SubsearchFormat) SearchFields() []string {
+	var fields []string
+	for _, item := range f.Returns {
+		switch {
+		case item.ValueOnly:
+			fields = append(fields, "_raw")
+		case item.Alias != "":
+			fields = append(fields, item.Alias)
+		default:
+			fields = append(fields, item.Field)
+		}
+	}
+	return fields
+}
+
+// Render returns the search string rows expand to. format renders every
+// non-internal field in name order; return renders its arguments, wrapping
+// rows in parentheses only when there is more than one term.
+func (f SubsearchFormat) Render(rows []Event) string {
+	if f.MaxResults > 0 && len(rows) > f.MaxResults {
+		rows = rows[:f.MaxResults]
+	}
+	if len(f.Returns) > 0 {
+		return f.renderReturn(rows)
+	}
+
+	var rendered []string
+	for _, row := range rows {
+		var names []string
+		for name, v := range row {
+			if !strings.HasPrefix(name, "_") && !EvalValueOf(v).IsNull() {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		sort.Strings(names)
+		terms := make([]string, len(names))
+		for i, name := range names {
+			terms[i] = f.renderTerm(name, EvalValueOf(row[name]))
+		}
+		rendered = append(rendered, f.ColumnPrefix+" "+strings.Join(terms, " "+f.ColumnSeparator+" ")+" "+f.ColumnEnd)
+	}
+	if len(rendered) == 0 {
+		return f.EmptyString
+	}
+	return f.RowPrefix + " " + strings.Join(rendered, " "+f.RowSeparator+" ") + " " + f.RowEnd
+}
+
+// renderReturn renders rows for return. Without results the search string
+// is empty, so the outer search is not filtered at all.
+func (f SubsearchFormat) renderReturn(rows []Event) string {
+	var rendered []string
+	multiple := len(rows) > 1
+	for _, row := range rows {
+		var terms []string
+		for _, item := range f.Returns {
+			v := EvalValueOf(row[item.Field])
+			switch {
+			case v.IsNull():
+			case item.ValueOnly:
+				terms = append(terms, v.scalar().String())
+			case item.Alias != "":
+				terms = append(terms, f.renderTerm(item.Alias, v))
+			default:
+				terms = append(terms, f.renderTerm(item.Field, v))
+			}
+		}
+		if len(terms) > 1 {
+			multiple = true
+		}
+		if len(terms) > 0 {
+			rendered = append(rendered, strings.Join(terms, " "))
+		}
+	}
+	if !multiple {
+		return strings.Join(rendered, "")
+	}
+	for i, r := range rendered {
+		rendered[i] = "(" + r + ")"
+	}
+	return strings.Join(rendered, " OR ")
+}
+
+// renderTerm renders field="value", or ( field="a" OR field="b" ) for a
+// multivalue field
+func (f SubsearchFormat) renderTerm(field string, v EvalValue) string {
+	values := v.Values()
+	terms := make([]string, len(values))
+	for i, value := range values {
+		terms[i] = field + "=" + quoteSearchValue(value.String())
+	}
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return "( " + strings.Join(terms, " "+f.MVSeparator+" ") + " )"
+}
+
+// quoteSearchValue double-quotes a search value, escaping quotes and backslashes
+func quoteSearchValue(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
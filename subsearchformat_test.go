@@ -0,0 +1,81 @@
+package spl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+func TestSubsearchFormat_Render(t *testing.T) {
+	rows := []Event{
+		{"user": "alice", "src": "10.0.0.1", "_time": 1000.0},
+		{"user": `bob "b"`, "src": []any{"10.0.0.2", "10.0.0.3"}},
+	}
+	tests := []struct {
+		command string
+		rows    []Event
+		want    string
+	}{
+		{`format`, rows, `( ( src="10.0.0.1" AND user="alice" ) OR ( ( src="10.0.0.2" OR src="10.0.0.3" ) AND user="bob \"b\"" ) )`},
+		{`format "[" "[" "&&" "]" "||" "]" mvsep="||" maxresults=2`, rows[1:], `[ [ ( src="10.0.0.2" || src="10.0.0.3" ) && user="bob \"b\"" ] ]`},
+		{`format maxresults=1`, rows, `( ( src="10.0.0.1" AND user="alice" ) )`},
+		{`format`, nil, `NOT ( )`},
+		{`format emptystr="*"`, nil, `*`},
+		{`return user`, rows, `user="alice"`},
+		{`return 2 account=user`, rows, `(account="alice") OR (account="bob \"b\"")`},
+		{`return user src`, rows, `(user="alice" src="10.0.0.1")`},
+		{`return 10 $user`, rows[:1], `alice`},
+		{`return user`, nil, ``},
+	}
+	for _, tt := range tests {
+		if got := parseTestSubsearchFormat(t, tt.command).Render(tt.rows); got != tt.want {
+			t.Errorf("%s:\n got: %s\nwant: %s", tt.command, got, tt.want)
+		}
+	}
+}
+
+func parseTestSubsearchFormat(t *testing.T, command string) SubsearchFormat {
+	t.Helper()
+	tree, err := parseSPLRule(command, func(p *SPLParser) antlr.ParserRuleContext { return p.PipelineStage() })
+	if err != nil {
+		t.Fatalf("%s: %v", command, err)
+	}
+	stage := tree.(IPipelineStageContext)
+	if stage.ReturnCommand() != nil {
+		return newReturnCommandFormat(stage.ReturnCommand())
+	}
+	f, err := newFormatCommandFormat(stage.FormatCommand())
+	if err != nil {
+		t.Fatalf("%s: %v", command, err)
+	}
+	return f
+}
+
+func TestSubsearchFormat_Errors(t *testing.T) {
+	for _, query := range []string{`format "(" "("`, `format maxresults=many`} {
+		if result := ExtractConditions(`index=main | ` + query); len(result.Errors) == 0 {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestSubsearchFilter_Format(t *testing.T) {
+	result := ExtractConditions(`index=auth [search index=hr | return 5 user=employee_id $badge]`)
+	var filter *SubsearchFilter
+	for _, c := range result.Conditions {
+		if c.Subsearch != nil {
+			filter = c.Subsearch
+		}
+	}
+	if filter == nil {
+		t.Fatal("Expected a subsearch filter")
+	}
+	want := []ReturnItem{{Field: "employee_id", Alias: "user"}, {Field: "badge", ValueOnly: true}}
+	if fmt.Sprint(filter.Format.Returns) != fmt.Sprint(want) || filter.Format.MaxResults != 5 {
+		t.Errorf("Format = %+v", filter.Format)
+	}
+	if fmt.Sprint(filter.Fields) != "[user _raw]" {
+		t.Errorf("Fields = %v", filter.Fields)
+	}
+}
@@ -0,0 +1,163 @@
+package spl
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TemplateSearch is the templated search of a foreach or map command.
+// foreach runs it once per matching field with <<FIELD>> style tokens
+// replaced; map runs it once per result row with $field$ tokens replaced.
+type TemplateSearch struct {
+	Command     string              `json:"command"`                // "foreach" or "map"
+	Template    string              `json:"template"`               // Search as written
+	Fields      []string            `json:"fields,omitempty"`       // foreach: field patterns iterated; map: fields of the $field$ tokens
+	Options     map[string]string   `json:"options,omitempty"`      // mode=, fieldstr=, maxsearches=, ...
+	MaxSearches int                 `json:"max_searches,omitempty"` // map: rows searched (default 10)
+	Expansions  []TemplateExpansion `json:"expansions,omitempty"`   // Expansions known statically (see Expand for run-time ones)
+	PipeStage   int                 `json:"pipe_stage"`
+}
+
+// TemplateExpansion is one iteration of a templated search
+type TemplateExpansion struct {
+	Substitutions map[string]string `json:"substitutions,omitempty"` // Token -> replacement, e.g. <<FIELD>> -> p95_bytes
+	Search        string            `json:"search"`                  // Search with the tokens replaced
+	Result        *ParseResult      `json:"result"`                  // Expanded search analyzed like a normal pipeline
+}
+
+// mapToken matches a $field$ token in a map search
+var mapToken = regexp.MustCompile(`\$([A-Za-z_][\w.]*)\$`)
+
+// newForeachTemplate reads a foreach command. Field patterns may be split
+// across several arguments by the lexer (p*_bytes), so adjacent arguments
+// are joined back together.
+func newForeachTemplate(ctx IForeachCommandContext, template string) TemplateSearch {
+	t := TemplateSearch{Command: "foreach", Template: template}
+	var options []IForeachArgContext
+	lastStop := -2
+	for _, arg := range ctx.AllForeachArg() {
+		if arg.EQ() != nil {
+			options = append(options, arg)
+			lastStop = -2
+			continue
+		}
+		text := arg.GetText()
+		if arg.QUOTED_STRING() != nil {
+			text = unquoteSPLString(text)
+		}
+		if arg.GetStart().GetStart() == lastStop+1 && len(t.Fields) > 0 {
+			t.Fields[len(t.Fields)-1] += text
+		} else {
+			t.Fields = append(t.Fields, text)
+		}
+		lastStop = arg.GetStop().GetStop()
+	}
+	t.Options = commandOptions(options)
+	return t
+}
+
+// newMapTemplate reads a map command with a search= option or a subsearch
+func newMapTemplate(ctx IMapCommandContext, subsearchText string) TemplateSearch {
+	t := TemplateSearch{Command: "map", Template: subsearchText, Options: commandOptions(ctx.AllMapOption()), MaxSearches: 10}
+	if search, ok := t.Options["search"]; ok && t.Template == "" {
+		t.Template = search
+	}
+	if n, err := strconv.Atoi(t.Options["maxsearches"]); err == nil && n > 0 {
+		t.MaxSearches = n
+	}
+	seen := make(map[string]bool)
+	for _, m := range mapToken.FindAllStringSubmatch(t.Template, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			t.Fields = append(t.Fields, m[1])
+		}
+	}
+	return t
+}
+
+// Expand returns the expansions for a result row: one per field of the row
+// matching a foreach pattern, or the map search with $field$ tokens
+// replaced by the row's values. Tokens of missing fields are left in place.
+func (t TemplateSearch) Expand(row Event) []TemplateExpansion {
+	if t.Command == "foreach" {
+		fields := make([]string, 0, len(row))
+		for f := range row {
+			fields = append(fields, f)
+		}
+		sort.Strings(fields)
+		return t.expandFields(fields)
+	}
+
+	subs := make(map[string]string)
+	search := mapToken.ReplaceAllStringFunc(t.Template, func(token string) string {
+		v := EvalValueOf(row[token[1:len(token)-1]])
+		if v.IsNull() {
+			return token
+		}
+		subs[token] = v.scalar().String()
+		return subs[token]
+	})
+	return []TemplateExpansion{{Substitutions: subs, Search: search, Result: ExtractConditions(search)}}
+}
+
+// expandFields expands a foreach template for each of fields that matches
+// one of its patterns. Only mode=multifield (the default) iterates fields.
+func (t TemplateSearch) expandFields(fields []string) []TemplateExpansion {
+	if mode := strings.ToLower(t.Options["mode"]); mode != "" && mode != "multifield" && mode != "auto" {
+		return nil
+	}
+	tokens := []string{"<<FIELD>>", "<<MATCHSTR>>", "<<MATCHSEG1>>", "<<MATCHSEG2>>", "<<MATCHSEG3>>"}
+	for i, opt := range []string{"fieldstr", "matchstr", "matchseg1", "matchseg2", "matchseg3"} {
+		if v := t.Options[opt]; v != "" {
+			tokens[i] = v
+		}
+	}
+
+	var out []TemplateExpansion
+	seen := make(map[string]bool)
+	for _, pattern := range t.Fields {
+		re := foreachPattern(pattern)
+		for _, field := range fields {
+			m := re.FindStringSubmatch(field)
+			if m == nil || seen[strings.ToLower(field)] {
+				continue
+			}
+			seen[strings.ToLower(field)] = true
+
+			subs := map[string]string{tokens[0]: field, tokens[1]: strings.Join(m[1:], "")}
+			for i, seg := range m[1:] {
+				if i < 3 {
+					subs[tokens[2+i]] = seg
+				}
+			}
+			search := t.Template
+			for token, value := range subs {
+				search = strings.ReplaceAll(search, token, value)
+			}
+			out = append(out, TemplateExpansion{Substitutions: subs, Search: search, Result: ExtractConditions(search)})
+		}
+	}
+	return out
+}
+
+// foreachPattern compiles a foreach field pattern; each * captures a segment
+func foreachPattern(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	re, _ := cachedRegexp(`(?i)^` + strings.Join(parts, "(.*)") + `$`) // Quoted literals always compile
+	return re
+}
+
+// subsearchQueryText returns the text inside a subsearch's brackets as
+// written, whitespace included
+func subsearchQueryText(ctx ISubsearchContext) string {
+	q := ctx.Query()
+	if q == nil || q.GetStart() == nil || q.GetStop() == nil {
+		return ""
+	}
+	return q.GetStart().GetInputStream().GetText(q.GetStart().GetStart(), q.GetStop().GetStop())
+}
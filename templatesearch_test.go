@@ -0,0 +1,90 @@
+package spl
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestForeachTemplate(t *testing.T) {
+	query := `index=web | stats p50(bytes) AS p50_bytes p95(bytes) AS p95_bytes count by host | foreach p*_bytes [eval <<FIELD>>_kb=round(<<FIELD>>/1024, 2), <<MATCHSTR>>_label="p<<MATCHSEG1>>"] | where p95_bytes_kb > 100`
+	result := ExtractConditions(query)
+	if len(result.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", result.Errors)
+	}
+	if len(result.Templates) != 1 {
+		t.Fatalf("Expected 1 template, got %+v", result.Templates)
+	}
+	tmpl := result.Templates[0]
+	if tmpl.Command != "foreach" || fmt.Sprint(tmpl.Fields) != "[p*_bytes]" || tmpl.PipeStage != 2 {
+		t.Errorf("Unexpected template %+v", tmpl)
+	}
+
+	var searches []string
+	for _, x := range tmpl.Expansions {
+		searches = append(searches, x.Search)
+	}
+	want := []string{
+		`eval p50_bytes_kb=round(p50_bytes/1024, 2), 50_label="p50"`,
+		`eval p95_bytes_kb=round(p95_bytes/1024, 2), 95_label="p95"`,
+	}
+	if fmt.Sprint(searches) != fmt.Sprint(want) {
+		t.Fatalf("Expansions = %q, want %q", searches, want)
+	}
+
+	if got := result.ComputedFields["p95_bytes_kb"]; got != "p95_bytes" {
+		t.Errorf("ComputedFields[p95_bytes_kb] = %q, want p95_bytes", got)
+	}
+	for _, c := range result.Conditions {
+		if c.Field == "p95_bytes_kb" && !c.IsComputed {
+			t.Errorf("Expected the foreach output to be computed, got %+v", c)
+		}
+	}
+}
+
+func TestForeachTemplate_Options(t *testing.T) {
+	result := ExtractConditions(`| stats count AS count_a count AS count_b | foreach count_* fieldstr="#F#" matchstr="#M#" [eval pct_#M#=#F#/2]`)
+	if len(result.Templates) != 1 || len(result.Templates[0].Expansions) != 2 {
+		t.Fatalf("Expected 2 expansions, got %+v", result.Templates)
+	}
+	if got := result.Templates[0].Expansions[1].Search; got != "eval pct_b=count_b/2" {
+		t.Errorf("Expansion = %q", got)
+	}
+
+	result = ExtractConditions(`| stats values(x) AS x | foreach mode=multivalue x [eval y=<<ITEM>>]`)
+	if len(result.Templates) != 1 || len(result.Templates[0].Expansions) != 0 {
+		t.Errorf("Expected no field expansions for mode=multivalue, got %+v", result.Templates)
+	}
+}
+
+func TestMapTemplate(t *testing.T) {
+	result := ExtractConditions(`| inputlookup users.csv | map maxsearches=5 search="search index=auth user=$user$ src=$src_ip$ | stats count"`)
+	if len(result.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", result.Errors)
+	}
+	if len(result.Templates) != 1 {
+		t.Fatalf("Expected 1 template, got %+v", result.Templates)
+	}
+	tmpl := result.Templates[0]
+	if tmpl.Command != "map" || tmpl.MaxSearches != 5 || fmt.Sprint(tmpl.Fields) != "[user src_ip]" {
+		t.Errorf("Unexpected template %+v", tmpl)
+	}
+	if len(tmpl.Expansions) != 1 || len(tmpl.Expansions[0].Result.Conditions) != 3 {
+		t.Fatalf("Expected the template to be analyzed, got %+v", tmpl.Expansions)
+	}
+	if c := tmpl.Expansions[0].Result.Conditions[1]; c.Field != "user" || c.Value != "$user$" {
+		t.Errorf("Expected user=$user$, got %+v", c)
+	}
+
+	x := tmpl.Expand(Event{"user": "alice"})
+	if len(x) != 1 || x[0].Search != "search index=auth user=alice src=$src_ip$ | stats count" {
+		t.Fatalf("Expand = %+v", x)
+	}
+	if x[0].Substitutions["$user$"] != "alice" || x[0].Result.Conditions[1].Value != "alice" {
+		t.Errorf("Unexpected expansion %+v", x[0])
+	}
+
+	result = ExtractConditions(`| makeresults | map [search index=auth user=$user$]`)
+	if len(result.Templates) != 1 || result.Templates[0].Template != "search index=auth user=$user$" || result.Templates[0].MaxSearches != 10 {
+		t.Errorf("Unexpected subsearch map template %+v", result.Templates)
+	}
+}
@@ -0,0 +1,77 @@
+package spl
+
+import "strings"
+
+// Threshold is a comparison on an aggregated field after the aggregation,
+// such as | where Failed>=4 after stats count(eval(...)) AS Failed by user
+type Threshold struct {
+	Field            string               `json:"field"`              // Compared field as written
+	Operator         string               `json:"operator"`           // =, !=, <, <=, >, >=
+	Value            string               `json:"value"`              // Threshold value (or the field compared with)
+	Negated          bool                 `json:"negated,omitempty"`  // Comparison is under NOT
+	PipeStage        int                  `json:"pipe_stage"`         // Stage of the comparison
+	Command          string               `json:"command"`            // Aggregating command: stats, timechart, top, transaction, ...
+	AggregationStage int                  `json:"aggregation_stage"`  // Stage of the aggregating command
+	Function         *AggregationFunction `json:"function,omitempty"` // Aggregate producing the field (nil for top, rare and transaction outputs)
+	GroupBy          []string             `json:"group_by,omitempty"` // BY keys of the aggregation
+}
+
+// thresholdOperators are the comparisons recorded as thresholds
+var thresholdOperators = map[string]bool{
+	"=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+}
+
+// implicitAggregateFields are the fields aggregating commands without
+// functions add to their results
+var implicitAggregateFields = map[string][]string{
+	"top":         {"count", "percent"},
+	"rare":        {"count", "percent"},
+	"transaction": {"duration", "eventcount"},
+}
+
+// fieldPreservingCommands keep the fields of their input events, so a field
+// not produced by them may come from an earlier aggregation
+var fieldPreservingCommands = map[string]bool{
+	"eventstats": true, "streamstats": true, "transaction": true, "dedup": true,
+}
+
+// aggregationStage is a pipeline stage whose command aggregates events
+// (see PipelineStageInfo.IsAggregation)
+type aggregationStage struct {
+	command string
+	stage   int
+}
+
+// linkThreshold finds the aggregation producing field, searching back from
+// the last aggregation stage before stage. aggregations are the recorded
+// stats-family stages.
+func linkThreshold(stages []aggregationStage, aggregations []Aggregation, field string, stage int) (Threshold, bool) {
+	for i := len(stages) - 1; i >= 0; i-- {
+		st := stages[i]
+		if st.stage >= stage {
+			continue
+		}
+		th := Threshold{Command: st.command, AggregationStage: st.stage}
+		for _, agg := range aggregations {
+			if agg.PipeStage != st.stage || agg.Command != st.command {
+				continue
+			}
+			for _, fn := range agg.Functions {
+				if strings.EqualFold(fn.Name, field) {
+					fn := fn
+					th.Function, th.GroupBy = &fn, agg.GroupBy
+					return th, true
+				}
+			}
+		}
+		for _, name := range implicitAggregateFields[st.command] {
+			if strings.EqualFold(name, field) {
+				return th, true
+			}
+		}
+		if !fieldPreservingCommands[st.command] {
+			return Threshold{}, false
+		}
+	}
+	return Threshold{}, false
+}
@@ -0,0 +1,118 @@
+package spl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestThresholds(t *testing.T) {
+	failed := AggregationFunction{
+		Function: "count", Argument: `eval(match(Keywords,"Audit Failure"))`, Condition: `match(Keywords,"Audit Failure")`,
+		ConditionFields: []string{"Keywords"}, Alias: "Failed", Name: "Failed",
+	}
+	count := AggregationFunction{Function: "count", Name: "count"}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []Threshold
+	}{
+		{
+			name:  "aliased count eval",
+			query: `index=wineventlog | bin _time span=1m | rename _time AS minute | stats count(eval(match(Keywords,"Audit Failure"))) AS Failed by minute username | where Failed>=4`,
+			want: []Threshold{{
+				Field: "Failed", Operator: ">=", Value: "4", PipeStage: 4,
+				Command: "stats", AggregationStage: 3, Function: &failed, GroupBy: []string{"minute", "username"},
+			}},
+		},
+		{
+			name:  "count keyword in where",
+			query: `index=auth action=failure | stats count by user src | where count > 10`,
+			want: []Threshold{{
+				Field: "count", Operator: ">", Value: "10", PipeStage: 2,
+				Command: "stats", AggregationStage: 1, Function: &count, GroupBy: []string{"user", "src"},
+			}},
+		},
+		{
+			name:  "search after stats",
+			query: `index=auth | stats dc(src) AS Total by user | search Total>5`,
+			want: []Threshold{{
+				Field: "Total", Operator: ">", Value: "5", PipeStage: 2,
+				Command: "stats", AggregationStage: 1, GroupBy: []string{"user"},
+				Function: &AggregationFunction{Function: "dc", Field: "src", Argument: "src", Alias: "Total", Name: "Total"},
+			}},
+		},
+		{
+			name:  "renamed aggregate",
+			query: `index=auth | stats count by user | rename count AS attempts | where attempts>=20`,
+			want: []Threshold{{
+				Field: "attempts", Operator: ">=", Value: "20", PipeStage: 3,
+				Command: "stats", AggregationStage: 1, Function: &count, GroupBy: []string{"user"},
+			}},
+		},
+		{
+			name:  "through eventstats",
+			query: `index=auth | stats count by user | eventstats avg(count) AS avg_count | where count > 100`,
+			want: []Threshold{{
+				Field: "count", Operator: ">", Value: "100", PipeStage: 3,
+				Command: "stats", AggregationStage: 1, Function: &count, GroupBy: []string{"user"},
+			}},
+		},
+		{
+			name:  "compared with a field",
+			query: `index=auth | stats count by user | eventstats avg(count) AS a | where count > a`,
+			want: []Threshold{{
+				Field: "count", Operator: ">", Value: "a", PipeStage: 3,
+				Command: "stats", AggregationStage: 1, Function: &count, GroupBy: []string{"user"},
+			}},
+		},
+		{
+			name:  "compared with an expression",
+			query: `index=auth | stats count by user | eventstats avg(count) AS avg | where count > avg*3`,
+			want: []Threshold{{
+				Field: "count", Operator: ">", Value: "avg*3", PipeStage: 3,
+				Command: "stats", AggregationStage: 1, Function: &count, GroupBy: []string{"user"},
+			}},
+		},
+		{
+			name:  "transaction output",
+			query: `index=auth | transaction user maxspan=5m | where eventcount > 3`,
+			want: []Threshold{{
+				Field: "eventcount", Operator: ">", Value: "3", PipeStage: 2,
+				Command: "transaction", AggregationStage: 1,
+			}},
+		},
+		{
+			name:  "group-by field is not a threshold",
+			query: `index=auth | stats count by user | where user!="admin"`,
+			want:  nil,
+		},
+		{
+			name:  "comparison before aggregation is not a threshold",
+			query: `index=auth | where bytes > 100 | stats sum(bytes) AS bytes by user`,
+			want:  nil,
+		},
+		{
+			name:  "fields dropped by a later stats",
+			query: `index=auth | stats count AS n by user | stats count by n | where n > 2`,
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ExtractConditions(tc.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("unexpected errors: %v", result.Errors)
+			}
+			if !reflect.DeepEqual(result.Thresholds, tc.want) {
+				t.Errorf("Thresholds =\n%+v\nwant\n%+v", result.Thresholds, tc.want)
+				for _, th := range result.Thresholds {
+					if th.Function != nil {
+						t.Logf("function: %+v", *th.Function)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,394 @@
+package spl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeUnitAliases maps every spelling Splunk accepts in relative time
+// modifiers to a canonical unit.
+var timeUnitAliases = map[string]string{
+	"s": "s", "sec": "s", "secs": "s", "second": "s", "seconds": "s",
+	"m": "m", "min": "m", "mins": "m", "minute": "m", "minutes": "m",
+	"h": "h", "hr": "h", "hrs": "h", "hour": "h", "hours": "h",
+	"d": "d", "day": "d", "days": "d",
+	"w": "w", "week": "w", "weeks": "w",
+	"mon": "mon", "month": "mon", "months": "mon",
+	"q": "q", "qtr": "q", "qtrs": "q", "quarter": "q", "quarters": "q",
+	"y": "y", "yr": "y", "yrs": "y", "year": "y", "years": "y",
+}
+
+// timeModifierStep is one step of a relative time modifier: either an offset
+// (amount and canonical unit) or a snap to the start of a unit.
+type timeModifierStep struct {
+	snap   string
+	amount int
+	unit   string
+}
+
+// parseTimeModifier splits a relative time modifier such as "-7d@d", "@w1",
+// "+3h" or "-1d@d+6h" into its steps. An empty modifier or "now" has none.
+func parseTimeModifier(modifier string) ([]timeModifierStep, error) {
+	s := strings.TrimSpace(modifier)
+	if s == "" || strings.EqualFold(s, "now") {
+		return nil, nil
+	}
+
+	var steps []timeModifierStep
+	i := 0
+	for i < len(s) {
+		switch c := s[i]; {
+		case c == '@':
+			j := i + 1
+			for j < len(s) && isASCIILetter(s[j]) {
+				j++
+			}
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			if _, err := snapTime(time.Time{}, s[i+1:j]); err != nil {
+				return nil, fmt.Errorf("invalid time modifier %q: %w", modifier, err)
+			}
+			steps = append(steps, timeModifierStep{snap: strings.ToLower(s[i+1 : j])})
+			i = j
+		case c == '+' || c == '-' || (c >= '0' && c <= '9'):
+			sign := 1
+			if c == '+' || c == '-' {
+				if c == '-' {
+					sign = -1
+				}
+				i++
+			}
+			j := i
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			amount := 1
+			if j > i {
+				amount, _ = strconv.Atoi(s[i:j])
+			}
+			k := j
+			for k < len(s) && isASCIILetter(s[k]) {
+				k++
+			}
+			unit, ok := timeUnitAliases[strings.ToLower(s[j:k])]
+			if !ok {
+				return nil, fmt.Errorf("invalid time modifier %q: unknown unit %q", modifier, s[j:k])
+			}
+			steps = append(steps, timeModifierStep{amount: sign * amount, unit: unit})
+			i = k
+		default:
+			return nil, fmt.Errorf("invalid time modifier %q: unexpected %q", modifier, string(c))
+		}
+	}
+	return steps, nil
+}
+
+// applyTimeModifier resolves a Splunk relative time modifier such as "-7d@d",
+// "@w1", "+3h" or "-1d@d+6h" against ref. An empty modifier or "now" returns ref.
+func applyTimeModifier(ref time.Time, modifier string) (time.Time, error) {
+	steps, err := parseTimeModifier(modifier)
+	if err != nil {
+		return ref, err
+	}
+	t := ref
+	for _, step := range steps {
+		if step.snap != "" {
+			if t, err = snapTime(t, step.snap); err != nil {
+				return ref, err
+			}
+			continue
+		}
+		t = shiftTime(t, step.amount, step.unit)
+	}
+	return t, nil
+}
+
+// shiftTime moves t by n canonical units.
+func shiftTime(t time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "s":
+		return t.Add(time.Duration(n) * time.Second)
+	case "m":
+		return t.Add(time.Duration(n) * time.Minute)
+	case "h":
+		return t.Add(time.Duration(n) * time.Hour)
+	case "d":
+		return t.AddDate(0, 0, n)
+	case "w":
+		return t.AddDate(0, 0, 7*n)
+	case "mon":
+		return t.AddDate(0, n, 0)
+	case "q":
+		return t.AddDate(0, 3*n, 0)
+	case "y":
+		return t.AddDate(n, 0, 0)
+	}
+	return t
+}
+
+// snapTime rounds t down to the start of the given unit. Week snaps accept a
+// day suffix (w0-w7, where w0 and w7 are Sunday and plain w is Sunday).
+func snapTime(t time.Time, unit string) (time.Time, error) {
+	lower := strings.ToLower(unit)
+	if len(lower) == 2 && lower[0] == 'w' && lower[1] >= '0' && lower[1] <= '7' {
+		return snapWeekday(t, time.Weekday(int(lower[1]-'0')%7)), nil
+	}
+	canonical, ok := timeUnitAliases[lower]
+	if !ok {
+		return t, fmt.Errorf("unknown snap unit %q", unit)
+	}
+	y, mo, d := t.Date()
+	loc := t.Location()
+	switch canonical {
+	case "s":
+		return t.Truncate(time.Second), nil
+	case "m":
+		return time.Date(y, mo, d, t.Hour(), t.Minute(), 0, 0, loc), nil
+	case "h":
+		return time.Date(y, mo, d, t.Hour(), 0, 0, 0, loc), nil
+	case "d":
+		return time.Date(y, mo, d, 0, 0, 0, 0, loc), nil
+	case "w":
+		return snapWeekday(t, time.Sunday), nil
+	case "mon":
+		return time.Date(y, mo, 1, 0, 0, 0, 0, loc), nil
+	case "q":
+		return time.Date(y, mo-(mo-1)%3, 1, 0, 0, 0, 0, loc), nil
+	case "y":
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, loc), nil
+	}
+	return t, fmt.Errorf("unknown snap unit %q", unit)
+}
+
+// snapWeekday snaps t back to midnight of the most recent given weekday.
+func snapWeekday(t time.Time, day time.Weekday) time.Time {
+	y, mo, d := t.Date()
+	back := (int(t.Weekday()) - int(day) + 7) % 7
+	return time.Date(y, mo, d-back, 0, 0, 0, 0, t.Location())
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// strftimeFormat formats t using the strftime-style directives Splunk supports.
+func strftimeFormat(t time.Time, format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' || i+1 >= len(format) {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		// Subsecond directives: %N, %3N, %6N, %9N (also %Q as milliseconds)
+		digits := 0
+		if format[i] >= '1' && format[i] <= '9' && i+1 < len(format) && format[i+1] == 'N' {
+			digits = int(format[i] - '0')
+			i++
+		}
+		switch format[i] {
+		case 'N':
+			if digits == 0 {
+				digits = 9
+			}
+			b.WriteString(fmt.Sprintf("%09d", t.Nanosecond())[:digits])
+		case 'Q':
+			b.WriteString(fmt.Sprintf("%03d", t.Nanosecond()/int(time.Millisecond)))
+		case 'a':
+			b.WriteString(t.Format("Mon"))
+		case 'A':
+			b.WriteString(t.Format("Monday"))
+		case 'b', 'h':
+			b.WriteString(t.Format("Jan"))
+		case 'B':
+			b.WriteString(t.Format("January"))
+		case 'c':
+			b.WriteString(t.Format("Mon Jan _2 15:04:05 2006"))
+		case 'd':
+			b.WriteString(t.Format("02"))
+		case 'e':
+			b.WriteString(t.Format("_2"))
+		case 'D':
+			b.WriteString(t.Format("01/02/06"))
+		case 'F':
+			b.WriteString(t.Format("2006-01-02"))
+		case 'H':
+			b.WriteString(t.Format("15"))
+		case 'I':
+			b.WriteString(t.Format("03"))
+		case 'j':
+			b.WriteString(fmt.Sprintf("%03d", t.YearDay()))
+		case 'k':
+			b.WriteString(fmt.Sprintf("%2d", t.Hour()))
+		case 'm':
+			b.WriteString(t.Format("01"))
+		case 'M':
+			b.WriteString(t.Format("04"))
+		case 'p':
+			b.WriteString(t.Format("PM"))
+		case 'R':
+			b.WriteString(t.Format("15:04"))
+		case 's':
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'S':
+			b.WriteString(t.Format("05"))
+		case 'T':
+			b.WriteString(t.Format("15:04:05"))
+		case 'u':
+			wd := int(t.Weekday())
+			if wd == 0 {
+				wd = 7
+			}
+			b.WriteString(strconv.Itoa(wd))
+		case 'w':
+			b.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'y':
+			b.WriteString(t.Format("06"))
+		case 'Y':
+			b.WriteString(t.Format("2006"))
+		case 'z':
+			b.WriteString(t.Format("-0700"))
+		case 'Z':
+			b.WriteString(t.Format("MST"))
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case '%':
+			b.WriteByte('%')
+		default:
+			b.WriteByte('%')
+			b.WriteByte(format[i])
+		}
+	}
+	return b.String()
+}
+
+// strptimeLayouts maps strftime directives to Go reference-time layouts.
+var strptimeLayouts = map[byte]string{
+	'a': "Mon", 'A': "Monday", 'b': "Jan", 'h': "Jan", 'B': "January",
+	'd': "02", 'e': "_2", 'D': "01/02/06", 'F': "2006-01-02",
+	'H': "15", 'I': "03", 'j': "002", 'm': "01", 'M': "04", 'p': "PM",
+	'R': "15:04", 'S': "05", 'T': "15:04:05", 'y': "06", 'Y': "2006",
+	'z': "-0700", 'Z': "MST",
+}
+
+// strptimeParse parses value according to a strftime-style format in loc.
+func strptimeParse(value, format string, loc *time.Location) (time.Time, error) {
+	if format == "%s" {
+		secs, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return epochToTime(secs, loc), nil
+	}
+
+	var layout strings.Builder
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c != '%' || i+1 >= len(format) {
+			layout.WriteByte(c)
+			continue
+		}
+		i++
+		if format[i] >= '1' && format[i] <= '9' && i+1 < len(format) && format[i+1] == 'N' {
+			layout.WriteString(strings.Repeat("0", int(format[i]-'0')))
+			i++
+			continue
+		}
+		switch format[i] {
+		case 'N':
+			layout.WriteString("000000000")
+		case 'Q':
+			layout.WriteString("000")
+		case '%':
+			layout.WriteByte('%')
+		default:
+			l, ok := strptimeLayouts[format[i]]
+			if !ok {
+				return time.Time{}, fmt.Errorf("unsupported strptime directive %%%c", format[i])
+			}
+			layout.WriteString(l)
+		}
+	}
+	return time.ParseInLocation(layout.String(), value, loc)
+}
+
+// epochToTime converts fractional epoch seconds to a time in loc.
+func epochToTime(secs float64, loc *time.Location) time.Time {
+	whole := int64(secs)
+	nanos := int64((secs - float64(whole)) * 1e9)
+	return time.Unix(whole, nanos).In(loc)
+}
+
+// timeToEpoch converts t to fractional epoch seconds.
+func timeToEpoch(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/1e9
+}
+
+// parseSpan parses a span such as "1h", "30m", "7d" or "1mon" into an amount
+// and canonical unit. A bare number is a span in seconds.
+func parseSpan(span string) (int, string, error) {
+	s := strings.TrimSpace(span)
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	amount := 1
+	if i > 0 {
+		amount, _ = strconv.Atoi(s[:i])
+	}
+	if i == len(s) {
+		if i == 0 {
+			return 0, "", fmt.Errorf("empty span")
+		}
+		return amount, "s", nil
+	}
+	unitText := s[i:]
+	if unitText == "M" {
+		unitText = "mon" // TIME_SPAN allows M for months
+	}
+	unit, ok := timeUnitAliases[strings.ToLower(unitText)]
+	if !ok || amount <= 0 {
+		return 0, "", fmt.Errorf("invalid span %q", span)
+	}
+	return amount, unit, nil
+}
+
+// spanDuration converts a fixed-length span unit to a duration. Months,
+// quarters and years have no fixed length and report false.
+func spanDuration(amount int, unit string) (time.Duration, bool) {
+	switch unit {
+	case "s":
+		return time.Duration(amount) * time.Second, true
+	case "m":
+		return time.Duration(amount) * time.Minute, true
+	case "h":
+		return time.Duration(amount) * time.Hour, true
+	case "d":
+		return time.Duration(amount) * 24 * time.Hour, true
+	case "w":
+		return time.Duration(amount) * 7 * 24 * time.Hour, true
+	}
+	return 0, false
+}
+
+// splunkAbsoluteTimeFormat is the default absolute time format for earliest/latest
+const splunkAbsoluteTimeFormat = "%m/%d/%Y:%H:%M:%S"
+
+// resolveTimeBound resolves an earliest/latest value: epoch seconds, an
+// absolute %m/%d/%Y:%H:%M:%S timestamp, or a relative time modifier.
+func resolveTimeBound(value string, now time.Time) (time.Time, error) {
+	v := strings.TrimSpace(value)
+	if secs, ok := parseNumber(v); ok && !strings.ContainsAny(v, "+-") {
+		return epochToTime(secs, now.Location()), nil
+	}
+	if strings.Contains(v, "/") {
+		return strptimeParse(v, splunkAbsoluteTimeFormat, now.Location())
+	}
+	return applyTimeModifier(now, v)
+}
@@ -0,0 +1,149 @@
+package spl
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimeBoundKind classifies an earliest/latest value
+type TimeBoundKind string
+
+const (
+	TimeBoundRelative TimeBoundKind = "relative" // -7d@d, @w1, +3h
+	TimeBoundAbsolute TimeBoundKind = "absolute" // 03/14/2024:00:00:00
+	TimeBoundEpoch    TimeBoundKind = "epoch"    // 1710374400
+	TimeBoundNow      TimeBoundKind = "now"      // now
+	TimeBoundInvalid  TimeBoundKind = "invalid"  // Unparseable, e.g. $token$ or rt-5m
+)
+
+// TimeOffset is a signed relative offset in a canonical unit
+// (s, m, h, d, w, mon, q or y)
+type TimeOffset struct {
+	Amount int    `json:"amount"`
+	Unit   string `json:"unit"`
+}
+
+// TimeBound is a parsed earliest/latest value. A relative modifier such as
+// -1d@d+6h applies Offsets (-1d), then Snap (d), then SnapOffsets (+6h).
+type TimeBound struct {
+	Raw         string        `json:"raw"`
+	Kind        TimeBoundKind `json:"kind"`
+	Offsets     []TimeOffset  `json:"offsets,omitempty"`
+	Snap        string        `json:"snap,omitempty"` // Snap-to unit, e.g. "d", "w1", "mon"
+	SnapOffsets []TimeOffset  `json:"snap_offsets,omitempty"`
+}
+
+// TimeSpan is a span option of a time-bucketing command
+type TimeSpan struct {
+	Command   string `json:"command"`    // bin, bucket, timechart, tstats, transaction or streamstats
+	Option    string `json:"option"`     // span, maxspan or time_window
+	Value     string `json:"value"`      // e.g. 1h, 5m, 1mon
+	PipeStage int    `json:"pipe_stage"` // Pipeline stage where the span appears
+}
+
+// TimeRange is the search window a query declares with earliest/latest and
+// _index_earliest/_index_latest, plus the spans of its time-bucketing commands
+type TimeRange struct {
+	Earliest      *TimeBound `json:"earliest,omitempty"`
+	Latest        *TimeBound `json:"latest,omitempty"`
+	IndexEarliest *TimeBound `json:"index_earliest,omitempty"`
+	IndexLatest   *TimeBound `json:"index_latest,omitempty"`
+	Spans         []TimeSpan `json:"spans,omitempty"`
+}
+
+// ResolvedTimeRange is a TimeRange resolved against a reference clock.
+// A zero Earliest (or IndexEarliest/IndexLatest) means unbounded.
+type ResolvedTimeRange struct {
+	Earliest      time.Time `json:"earliest"`
+	Latest        time.Time `json:"latest"`
+	IndexEarliest time.Time `json:"index_earliest"`
+	IndexLatest   time.Time `json:"index_latest"`
+}
+
+// ParseTimeBound parses an earliest/latest value: "now", epoch seconds, an
+// absolute %m/%d/%Y:%H:%M:%S time, or a relative time modifier.
+func ParseTimeBound(raw string) *TimeBound {
+	v := strings.TrimSpace(raw)
+	bound := &TimeBound{Raw: v}
+	if v == "" || strings.EqualFold(v, "now") {
+		bound.Kind = TimeBoundNow
+		return bound
+	}
+	if _, ok := parseNumber(v); ok && !strings.ContainsAny(v, "+-") {
+		bound.Kind = TimeBoundEpoch
+		return bound
+	}
+	if strings.Contains(v, "/") {
+		bound.Kind = TimeBoundAbsolute
+		if _, err := strptimeParse(v, splunkAbsoluteTimeFormat, time.UTC); err != nil {
+			bound.Kind = TimeBoundInvalid
+		}
+		return bound
+	}
+
+	steps, err := parseTimeModifier(v)
+	if err != nil {
+		bound.Kind = TimeBoundInvalid
+		return bound
+	}
+	bound.Kind = TimeBoundRelative
+	for _, step := range steps {
+		switch {
+		case step.snap != "" && bound.Snap == "":
+			bound.Snap = step.snap
+		case step.snap != "":
+			// A second snap cannot be represented; Raw still resolves correctly
+		case bound.Snap == "":
+			bound.Offsets = append(bound.Offsets, TimeOffset{Amount: step.amount, Unit: step.unit})
+		default:
+			bound.SnapOffsets = append(bound.SnapOffsets, TimeOffset{Amount: step.amount, Unit: step.unit})
+		}
+	}
+	return bound
+}
+
+// Resolve returns the concrete time of the bound relative to now. Absolute
+// times are interpreted in now's location.
+func (b *TimeBound) Resolve(now time.Time) (time.Time, error) {
+	if b.Kind == TimeBoundInvalid {
+		return time.Time{}, fmt.Errorf("invalid time bound %q", b.Raw)
+	}
+	return resolveTimeBound(b.Raw, now)
+}
+
+// Resolve computes the concrete search window relative to now. Latest
+// defaults to now, as it does in Splunk; an unset earliest is unbounded.
+func (r *TimeRange) Resolve(now time.Time) (ResolvedTimeRange, error) {
+	resolved := ResolvedTimeRange{Latest: now}
+	bounds := []struct {
+		bound *TimeBound
+		dest  *time.Time
+	}{
+		{r.Earliest, &resolved.Earliest},
+		{r.Latest, &resolved.Latest},
+		{r.IndexEarliest, &resolved.IndexEarliest},
+		{r.IndexLatest, &resolved.IndexLatest},
+	}
+	for _, b := range bounds {
+		if b.bound == nil {
+			continue
+		}
+		t, err := b.bound.Resolve(now)
+		if err != nil {
+			return resolved, err
+		}
+		*b.dest = t
+	}
+	return resolved, nil
+}
+
+// Duration returns the span as a fixed duration. Month, quarter and year
+// spans have no fixed length and report false.
+func (s TimeSpan) Duration() (time.Duration, bool) {
+	amount, unit, err := parseSpan(strings.TrimPrefix(s.Value, "+"))
+	if err != nil {
+		return 0, false
+	}
+	return spanDuration(amount, unit)
+}
@@ -0,0 +1,162 @@
+package spl
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseTimeBound(t *testing.T) {
+	tests := []struct {
+		raw         string
+		kind        TimeBoundKind
+		offsets     []TimeOffset
+		snap        string
+		snapOffsets []TimeOffset
+	}{
+		{raw: "now", kind: TimeBoundNow},
+		{raw: "1710374400", kind: TimeBoundEpoch},
+		{raw: "03/14/2024:00:00:00", kind: TimeBoundAbsolute},
+		{raw: "-24h", kind: TimeBoundRelative, offsets: []TimeOffset{{-24, "h"}}},
+		{raw: "-7d@d", kind: TimeBoundRelative, offsets: []TimeOffset{{-7, "d"}}, snap: "d"},
+		{raw: "@w1", kind: TimeBoundRelative, snap: "w1"},
+		{raw: "-1mon@mon", kind: TimeBoundRelative, offsets: []TimeOffset{{-1, "mon"}}, snap: "mon"},
+		{raw: "-24h@h+6h", kind: TimeBoundRelative, offsets: []TimeOffset{{-24, "h"}}, snap: "h", snapOffsets: []TimeOffset{{6, "h"}}},
+		{raw: "+0s", kind: TimeBoundRelative, offsets: []TimeOffset{{0, "s"}}},
+		{raw: "$earliest$", kind: TimeBoundInvalid},
+		{raw: "rt-5m", kind: TimeBoundInvalid},
+		{raw: "13/45/2024:00:00:00", kind: TimeBoundInvalid},
+	}
+
+	for _, tt := range tests {
+		b := ParseTimeBound(tt.raw)
+		if b.Kind != tt.kind {
+			t.Errorf("ParseTimeBound(%q).Kind = %q, want %q", tt.raw, b.Kind, tt.kind)
+		}
+		if fmt.Sprint(b.Offsets) != fmt.Sprint(tt.offsets) || b.Snap != tt.snap || fmt.Sprint(b.SnapOffsets) != fmt.Sprint(tt.snapOffsets) {
+			t.Errorf("ParseTimeBound(%q) = offsets %v snap %q snapOffsets %v, want %v %q %v",
+				tt.raw, b.Offsets, b.Snap, b.SnapOffsets, tt.offsets, tt.snap, tt.snapOffsets)
+		}
+	}
+}
+
+func TestExtractConditions_TimeRange(t *testing.T) {
+	now := time.Date(2024, time.March, 14, 15, 9, 26, 0, time.UTC) // a Thursday
+	tests := []struct {
+		query    string
+		earliest time.Time
+		latest   time.Time
+	}{
+		{`index=main earliest=-7d@d latest=@d`, time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{`index=main earliest=@w1 latest=now`, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), now},
+		{`index=main earliest=-24h@h+6h`, time.Date(2024, 3, 13, 21, 0, 0, 0, time.UTC), now},
+		{`index=main earliest=-1mon@mon latest=@mon`, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{`index=main earliest="03/14/2024:00:00:00" latest=03/14/2024:12:00:00`, time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 14, 12, 0, 0, 0, time.UTC)},
+		{`index=main earliest=1710374400 user=admin`, time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC), now},
+		{`| tstats count where index=main earliest=-15m by host`, time.Date(2024, 3, 14, 14, 54, 26, 0, time.UTC), now},
+	}
+
+	for _, tt := range tests {
+		result := ExtractConditions(tt.query)
+		if len(result.Errors) > 0 {
+			t.Errorf("%s: unexpected errors: %v", tt.query, result.Errors)
+			continue
+		}
+		if result.TimeRange == nil {
+			t.Errorf("%s: expected a TimeRange", tt.query)
+			continue
+		}
+		resolved, err := result.TimeRange.Resolve(now)
+		if err != nil {
+			t.Errorf("%s: Resolve error: %v", tt.query, err)
+			continue
+		}
+		if !resolved.Earliest.Equal(tt.earliest) || !resolved.Latest.Equal(tt.latest) {
+			t.Errorf("%s: resolved to [%v, %v], want [%v, %v]", tt.query, resolved.Earliest, resolved.Latest, tt.earliest, tt.latest)
+		}
+	}
+}
+
+func TestExtractConditions_TimeRangeIndexTimeAndSubsearch(t *testing.T) {
+	query := `index=main _index_earliest=-1h _index_latest=now | join user [search index=ad earliest=-30d]`
+	result := ExtractConditions(query)
+	if result.TimeRange == nil {
+		t.Fatal("Expected a TimeRange")
+	}
+	if result.TimeRange.Earliest != nil {
+		t.Errorf("Subsearch earliest should not set the main search window, got %+v", result.TimeRange.Earliest)
+	}
+	if b := result.TimeRange.IndexEarliest; b == nil || b.Raw != "-1h" {
+		t.Errorf("IndexEarliest = %+v, want -1h", b)
+	}
+	if b := result.TimeRange.IndexLatest; b == nil || b.Kind != TimeBoundNow {
+		t.Errorf("IndexLatest = %+v, want now", b)
+	}
+	for _, c := range result.Conditions {
+		if c.Field == "_index_earliest" || c.Field == "_index_latest" {
+			t.Errorf("Index time modifier extracted as a condition: %+v", c)
+		}
+	}
+
+	if ExtractConditions(`index=main user=admin`).TimeRange != nil {
+		t.Error("Expected no TimeRange for a query without time modifiers")
+	}
+}
+
+func TestExtractConditions_TimeRangeSkipsNotAndOr(t *testing.T) {
+	tests := []struct {
+		query    string
+		earliest string
+	}{
+		{`index=main NOT earliest=-24h`, ""},
+		{`index=main (earliest=-1h OR earliest=-7d)`, ""},
+		{`index=main earliest=-1h OR user=admin`, ""},
+		{`index=main NOT (user=a OR user=b) earliest=-4h`, "-4h"},
+		{`index=main (user=a OR user=b) earliest=-4h`, "-4h"},
+		{`index=main (earliest=-2h user=a) OR user=b`, ""},
+	}
+	for _, tt := range tests {
+		result := ExtractConditions(tt.query)
+		if len(result.Errors) > 0 {
+			t.Errorf("%s: unexpected errors: %v", tt.query, result.Errors)
+			continue
+		}
+		got := ""
+		if result.TimeRange != nil && result.TimeRange.Earliest != nil {
+			got = result.TimeRange.Earliest.Raw
+		}
+		if got != tt.earliest {
+			t.Errorf("%s: Earliest = %q, want %q", tt.query, got, tt.earliest)
+		}
+	}
+}
+
+func TestExtractConditions_TimeSpans(t *testing.T) {
+	query := `index=main earliest=-1d | bin _time span=5min | timechart span=1h count | transaction user maxspan=30m maxpause=5m`
+	result := ExtractConditions(query)
+	if len(result.Errors) > 0 {
+		t.Fatalf("Unexpected errors: %v", result.Errors)
+	}
+	if result.TimeRange == nil {
+		t.Fatal("Expected a TimeRange")
+	}
+	want := []TimeSpan{
+		{Command: "bin", Option: "span", Value: "5min", PipeStage: 1},
+		{Command: "timechart", Option: "span", Value: "1h", PipeStage: 2},
+		{Command: "transaction", Option: "maxspan", Value: "30m", PipeStage: 3},
+	}
+	if fmt.Sprint(result.TimeRange.Spans) != fmt.Sprint(want) {
+		t.Errorf("Spans = %+v, want %+v", result.TimeRange.Spans, want)
+	}
+	if d, ok := result.TimeRange.Spans[0].Duration(); !ok || d != 5*time.Minute {
+		t.Errorf("bin span duration = %v, %v", d, ok)
+	}
+
+	tstats := ExtractConditions(`| tstats count where index=main by _time span=1mon`)
+	if tstats.TimeRange == nil || len(tstats.TimeRange.Spans) != 1 || tstats.TimeRange.Spans[0].Value != "1mon" {
+		t.Fatalf("tstats spans = %+v", tstats.TimeRange)
+	}
+	if _, ok := tstats.TimeRange.Spans[0].Duration(); ok {
+		t.Error("A month span has no fixed duration")
+	}
+}
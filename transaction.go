@@ -0,0 +1,157 @@
+package spl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// TransactionInfo describes a transaction command: its grouping fields,
+// span limits and the startswith/endswith boundaries
+type TransactionInfo struct {
+	Fields      []string             `json:"fields,omitempty"`      // Fields shared by the events of a transaction
+	StartsWith  *TransactionBoundary `json:"startswith,omitempty"`  // Event opening a transaction
+	EndsWith    *TransactionBoundary `json:"endswith,omitempty"`    // Event closing a transaction
+	MaxSpan     time.Duration        `json:"maxspan,omitempty"`     // Longest transaction (0 = unlimited)
+	MaxPause    time.Duration        `json:"maxpause,omitempty"`    // Longest gap between events (0 = unlimited)
+	MaxEvents   int                  `json:"maxevents,omitempty"`   // Most events per transaction (default 1000)
+	KeepEvicted bool                 `json:"keepevicted,omitempty"` // Keep transactions that were not closed
+	Options     map[string]string    `json:"options,omitempty"`     // Options as written
+	PipeStage   int                  `json:"pipe_stage"`
+}
+
+// TransactionBoundary is a startswith or endswith condition, written as a
+// quoted search ("EventCode=4625"), a parenthesized search
+// ((EventCode=4624)) or an eval expression (eval(action="logout"))
+type TransactionBoundary struct {
+	Kind       string      `json:"kind"`       // "search" or "eval"
+	Text       string      `json:"text"`       // Search or expression without quotes, parentheses or eval()
+	Conditions []Condition `json:"conditions"` // Conditions extracted from Text
+
+	search ISearchExpressionContext
+	eval   IExpressionContext
+}
+
+// newTransactionInfo reads a transaction command's options. The returned
+// info is filled in as far as possible even when an option is invalid.
+func newTransactionInfo(ctx ITransactionCommandContext) (TransactionInfo, error) {
+	info := TransactionInfo{
+		Fields:    fieldListNames(ctx.FieldList()),
+		Options:   commandOptions(ctx.AllTransactionOption()),
+		MaxEvents: 1000,
+	}
+
+	var errs []string
+	for _, opt := range ctx.AllTransactionOption() {
+		key := strings.ToLower(opt.IDENTIFIER().GetText())
+		if key != "startswith" && key != "endswith" {
+			continue
+		}
+		if opt.SearchExpression() != nil || opt.EVAL() != nil || opt.Condition() != nil {
+			// Keep the value as written; joined token text loses its spaces
+			eq := opt.EQ().GetSymbol()
+			info.Options[key] = strings.TrimSpace(eq.GetInputStream().GetText(eq.GetStop()+1, opt.GetStop().GetStop()))
+		}
+		b, err := newTransactionBoundary(opt)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("transaction %s: %v", key, err))
+			continue
+		}
+		if key == "startswith" {
+			info.StartsWith = b
+		} else {
+			info.EndsWith = b
+		}
+	}
+
+	keys := make([]string, 0, len(info.Options))
+	for key := range info.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := info.Options[key]
+		switch key {
+		case "maxspan", "maxpause":
+			d, err := transactionSpan(value)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("transaction %s: %v", key, err))
+				continue
+			}
+			if key == "maxspan" {
+				info.MaxSpan = d
+			} else {
+				info.MaxPause = d
+			}
+		case "maxevents":
+			if n, err := strconv.Atoi(value); err == nil && n > 0 {
+				info.MaxEvents = n
+			} else if value != "-1" {
+				errs = append(errs, fmt.Sprintf("transaction maxevents must be a positive integer, got %q", value))
+			}
+		case "keepevicted":
+			info.KeepEvicted = isTrueOption(value)
+		}
+	}
+	if len(errs) > 0 {
+		return info, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return info, nil
+}
+
+// newTransactionBoundary parses a startswith/endswith option
+func newTransactionBoundary(opt ITransactionOptionContext) (*TransactionBoundary, error) {
+	b := &TransactionBoundary{Kind: "search"}
+	switch {
+	case opt.SearchExpression() != nil:
+		b.search = opt.SearchExpression()
+		b.Text = ruleText(b.search)
+	case opt.EVAL() != nil:
+		b.Kind, b.eval = "eval", opt.Expression()
+		b.Text = ruleText(b.eval)
+	default:
+		text := commandOptions([]ITransactionOptionContext{opt})[strings.ToLower(opt.IDENTIFIER().GetText())]
+		if lower := strings.ToLower(text); strings.HasPrefix(lower, "eval(") && strings.HasSuffix(lower, ")") {
+			b.Kind, b.Text = "eval", strings.TrimSpace(text[5:len(text)-1])
+			tree, err := parseSPLRule(b.Text, func(p *SPLParser) antlr.ParserRuleContext { return p.Expression() })
+			if err != nil {
+				return nil, err
+			}
+			b.eval = tree.(IExpressionContext)
+		} else {
+			b.Text = text
+			tree, err := parseSPLRule(text, func(p *SPLParser) antlr.ParserRuleContext { return p.SearchExpression() })
+			if err != nil {
+				return nil, err
+			}
+			b.search = tree.(ISearchExpressionContext)
+		}
+	}
+
+	query := b.Text
+	if b.Kind == "eval" {
+		query = "| where " + b.Text
+	}
+	b.Conditions = ExtractConditions(query).Conditions
+	return b, nil
+}
+
+// transactionSpan parses maxspan/maxpause (empty or -1 means unlimited)
+func transactionSpan(text string) (time.Duration, error) {
+	if text == "" || text == "-1" {
+		return 0, nil
+	}
+	amount, unit, err := parseSpan(text)
+	if err != nil {
+		return 0, err
+	}
+	d, ok := spanDuration(amount, unit)
+	if !ok {
+		return 0, fmt.Errorf("unsupported transaction span %q", text)
+	}
+	return d, nil
+}
@@ -0,0 +1,111 @@
+package spl
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTransactionInfo(t *testing.T) {
+	result := ExtractConditions(`index=wineventlog | transaction user startswith="EventCode=4625" endswith=(EventCode=4624 OR EventCode=4634) maxspan=5m maxpause=30s keepevicted=true`)
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	if len(result.Transactions) != 1 {
+		t.Fatalf("expected 1 transaction, got %d", len(result.Transactions))
+	}
+	txn := result.Transactions[0]
+	if txn.PipeStage != 1 || len(txn.Fields) != 1 || txn.Fields[0] != "user" {
+		t.Errorf("stage/fields = %d %v, want 1 [user]", txn.PipeStage, txn.Fields)
+	}
+	if txn.MaxSpan != 5*time.Minute || txn.MaxPause != 30*time.Second || txn.MaxEvents != 1000 || !txn.KeepEvicted {
+		t.Errorf("maxspan=%v maxpause=%v maxevents=%d keepevicted=%v", txn.MaxSpan, txn.MaxPause, txn.MaxEvents, txn.KeepEvicted)
+	}
+	if got := txn.Options["endswith"]; got != "(EventCode=4624 OR EventCode=4634)" {
+		t.Errorf("Options[endswith] = %q", got)
+	}
+
+	if txn.StartsWith == nil || txn.StartsWith.Kind != "search" || txn.StartsWith.Text != "EventCode=4625" {
+		t.Fatalf("StartsWith = %+v", txn.StartsWith)
+	}
+	if c := txn.StartsWith.Conditions; len(c) != 1 || c[0].Field != "EventCode" || c[0].Value != "4625" {
+		t.Errorf("StartsWith.Conditions = %+v", c)
+	}
+	if txn.EndsWith == nil || txn.EndsWith.Text != "EventCode=4624 OR EventCode=4634" {
+		t.Fatalf("EndsWith = %+v", txn.EndsWith)
+	}
+	if c := txn.EndsWith.Conditions; len(c) != 1 || strings.Join(c[0].Alternatives, ",") != "4624,4634" {
+		t.Errorf("EndsWith.Conditions = %+v", c)
+	}
+
+	// Boundary conditions are not filters of the search itself
+	for _, c := range result.Conditions {
+		if strings.EqualFold(c.Field, "EventCode") {
+			t.Errorf("boundary condition leaked into Conditions: %+v", c)
+		}
+	}
+}
+
+func TestTransactionInfo_BoundaryOrDoesNotLeak(t *testing.T) {
+	result := ExtractConditions(`index=a user=bob | transaction user startswith=(x=1 OR x=2) | search user=alice`)
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	var users []Condition
+	for _, c := range result.Conditions {
+		if c.Field == "user" {
+			users = append(users, c)
+		}
+	}
+	if len(users) != 2 || users[1].LogicalOp != "AND" || len(users[0].Alternatives) > 1 {
+		t.Errorf("user conditions = %+v, want bob and alice as separate ANDed conditions", users)
+	}
+}
+
+func TestTransactionInfo_EvalBoundaries(t *testing.T) {
+	tests := []struct {
+		query     string
+		kind      string
+		text      string
+		condField string
+	}{
+		{`| transaction host endswith=eval(action=="logout")`, "eval", `action=="logout"`, "action"},
+		{`| transaction host endswith="eval(status >= 500)"`, "eval", "status >= 500", "status"},
+		{`| transaction host endswith="action=logout"`, "search", "action=logout", "action"},
+		{`| transaction host endswith=EventCode=4801`, "search", "EventCode=4801", "EventCode"},
+	}
+	for _, tc := range tests {
+		result := ExtractConditions(tc.query)
+		if len(result.Errors) > 0 || len(result.Transactions) != 1 {
+			t.Fatalf("%s: errors %v, %d transactions", tc.query, result.Errors, len(result.Transactions))
+		}
+		b := result.Transactions[0].EndsWith
+		if b == nil || b.Kind != tc.kind || b.Text != tc.text {
+			t.Errorf("%s: EndsWith = %+v, want kind %s text %q", tc.query, b, tc.kind, tc.text)
+			continue
+		}
+		if len(b.Conditions) == 0 || b.Conditions[0].Field != tc.condField {
+			t.Errorf("%s: Conditions = %+v, want a condition on %s", tc.query, b.Conditions, tc.condField)
+		}
+		if len(result.Conditions) != 0 {
+			t.Errorf("%s: boundary condition leaked into Conditions: %+v", tc.query, result.Conditions)
+		}
+	}
+}
+
+func TestTransactionInfo_Errors(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`| transaction user maxspan=1mon`, "transaction maxspan"},
+		{`| transaction user maxevents=many`, "maxevents must be a positive integer"},
+		{`| transaction user startswith="action=("`, "transaction startswith"},
+	}
+	for _, tc := range tests {
+		result := ExtractConditions(tc.query)
+		if !strings.Contains(strings.Join(result.Errors, "\n"), tc.want) {
+			t.Errorf("%s: errors %v, want one containing %q", tc.query, result.Errors, tc.want)
+		}
+	}
+}
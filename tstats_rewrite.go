@@ -0,0 +1,455 @@
+package spl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// TstatsRewriteOptions controls RewriteAsTstats
+type TstatsRewriteOptions struct {
+	Target        string // Data model object to use, e.g. "Endpoint.Processes"; empty picks the one the fields map onto
+	SummariesOnly bool   // Emit summariesonly=t (accelerated summaries only) instead of summariesonly=f
+}
+
+// TstatsRewrite is the result of RewriteAsTstats: either a tstats query or the
+// reasons the search cannot be rewritten faithfully
+type TstatsRewrite struct {
+	Query     string   `json:"query,omitempty"`      // Equivalent tstats query, empty if Reasons is set
+	DataModel string   `json:"data_model,omitempty"` // Data model object used, e.g. Endpoint.Processes
+	Reasons   []string `json:"reasons,omitempty"`    // Why the search cannot be rewritten
+}
+
+// tstatsFunctions are the stats functions tstats supports
+var tstatsFunctions = map[string]bool{
+	"count": true, "c": true, "dc": true, "distinct_count": true, "estdc": true,
+	"sum": true, "sumsq": true, "avg": true, "mean": true, "min": true, "max": true,
+	"range": true, "median": true, "mode": true, "stdev": true, "stdevp": true,
+	"var": true, "varp": true, "values": true, "earliest": true, "latest": true,
+	"earliest_time": true, "latest_time": true, "rate": true,
+}
+
+// tstatsUnprefixedFields keep their name in a datamodel tstats query
+var tstatsUnprefixedFields = map[string]bool{
+	"_time": true, "host": true, "source": true, "sourcetype": true, "index": true,
+	"earliest": true, "latest": true, "splunk_server": true,
+}
+
+// RewriteAsTstats converts a raw-event search of the form
+//
+//	<search filters> [| search ...] | stats <functions> [by <fields>] [| ...]
+//
+// into a tstats query over the data model object that defines every filter
+// and output field. Filters that repeat the object's constraints (tag=process)
+// are dropped, fields are prefixed with the object's lineage, and the BY
+// fields are renamed back so later stages keep working. The rewrite assumes the raw
+// events are covered by the data model; anything tstats cannot express, such
+// as rex, eval, macros or _raw keywords, is reported in Reasons instead.
+func (c *DataModelCatalog) RewriteAsTstats(query string, opts TstatsRewriteOptions) TstatsRewrite {
+	tree, err := parseSPLRule(query, func(p *SPLParser) antlr.ParserRuleContext { return p.Query() })
+	if err != nil {
+		return TstatsRewrite{Reasons: []string{err.Error()}}
+	}
+	w := &tstatsRewriter{query: []rune(query)}
+	w.split(tree.(IQueryContext).AllPipelineStage())
+	if len(w.reasons) > 0 {
+		return TstatsRewrite{Reasons: w.reasons}
+	}
+
+	for _, expr := range w.filters {
+		w.render(expr, nil, true)
+	}
+	obj := c.chooseObject(w, opts.Target)
+	if len(w.reasons) > 0 {
+		return TstatsRewrite{Reasons: w.reasons}
+	}
+	return TstatsRewrite{
+		Query:     w.build(obj, opts.SummariesOnly),
+		DataModel: obj.model.Name + "." + obj.Name,
+	}
+}
+
+// tstatsRewriter holds the pieces of the search being rewritten
+type tstatsRewriter struct {
+	query   []rune
+	filters []ISearchExpressionContext // search stages before stats
+	stats   *StatsCommandContext
+	rest    []string // text of the stages after stats
+	conds   []tstatsCondition
+	reasons []string
+}
+
+// tstatsCondition is a field condition of the filters. Required conditions
+// are ANDed with the rest of the search and can be dropped when the data
+// model constraint already implies them.
+type tstatsCondition struct {
+	field, value string
+	required     bool
+}
+
+func (w *tstatsRewriter) reason(format string, args ...any) {
+	w.reasons = append(w.reasons, fmt.Sprintf(format, args...))
+}
+
+// text returns the original query text of a rule, whitespace included
+func (w *tstatsRewriter) text(ctx antlr.ParserRuleContext) string {
+	start, stop := ctx.GetStart().GetStart(), ctx.GetStop().GetStop()
+	if start < 0 || stop < start || stop >= len(w.query) {
+		return ctx.GetText()
+	}
+	return string(w.query[start : stop+1])
+}
+
+// split sorts the stages into filters, the stats command and the rest
+func (w *tstatsRewriter) split(stages []IPipelineStageContext) {
+	hasStats := false
+	for _, stage := range stages {
+		hasStats = hasStats || stage.StatsCommand() != nil
+	}
+	if !hasStats {
+		w.reason("search has no stats command; tstats only returns aggregates")
+		return
+	}
+
+	for i, stage := range stages {
+		if w.stats != nil {
+			w.rest = append(w.rest, w.text(stage))
+			continue
+		}
+		switch cmd := stage.GetChild(0).(type) {
+		case *SearchCommandContext:
+			w.filters = append(w.filters, cmd.SearchExpression())
+		case *StatsCommandContext:
+			if i == 0 {
+				w.reason("stats must follow a search")
+			}
+			w.stats = cmd
+		case *RexCommandContext:
+			w.reason("rex extracts fields at search time; tstats only sees indexed data model fields")
+		default:
+			w.reason("%s before stats cannot be expressed in tstats", classifyStage(stage))
+		}
+	}
+	for _, fn := range w.stats.AllStatsFunction() {
+		name := strings.ToLower(fn.IDENTIFIER().GetText())
+		if !tstatsFunctions[name] && !percentilePattern.MatchString(name) {
+			w.reason("stats function %s is not supported by tstats", name)
+		}
+		if expr := fn.Expression(); expr != nil && !tstatsFieldPattern.MatchString(expr.GetText()) {
+			w.reason("stats argument %s is an expression; tstats only aggregates fields", expr.GetText())
+		}
+	}
+}
+
+// outputFields returns the fields the stats command reads
+func (w *tstatsRewriter) outputFields() []string {
+	var fields []string
+	for _, fn := range w.stats.AllStatsFunction() {
+		if fn.Expression() != nil {
+			fields = append(fields, fn.Expression().GetText())
+		}
+	}
+	return append(fields, fieldListNames(w.stats.FieldList())...)
+}
+
+// render writes a search expression in tstats form for obj. With a nil obj it
+// only collects conditions and reasons. OR binds tighter than AND in search,
+// so only terms outside OR groups (and NOT) are required.
+func (w *tstatsRewriter) render(ctx ISearchExpressionContext, obj *DataModelObject, required bool) string {
+	var groups [][]ISearchTermContext
+	pendingOR := false
+	for _, child := range ctx.GetChildren() {
+		switch c := child.(type) {
+		case ILogicalOpContext:
+			pendingOR = c.OR() != nil
+		case ISearchTermContext:
+			if pendingOR && len(groups) > 0 {
+				groups[len(groups)-1] = append(groups[len(groups)-1], c)
+			} else {
+				groups = append(groups, []ISearchTermContext{c})
+			}
+			pendingOR = false
+		}
+	}
+
+	var parts []string
+	for _, group := range groups {
+		terms := make([]string, 0, len(group))
+		for _, term := range group {
+			if t := w.renderTerm(term, obj, required && len(group) == 1); t != "" {
+				terms = append(terms, t)
+			}
+		}
+		if len(terms) > 0 {
+			parts = append(parts, strings.Join(terms, " OR "))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// renderTerm renders one search term; a required condition implied by the
+// object's constraint renders as ""
+func (w *tstatsRewriter) renderTerm(ctx ISearchTermContext, obj *DataModelObject, required bool) string {
+	switch {
+	case ctx.NOT() != nil:
+		return "NOT " + w.renderTerm(ctx.SearchTerm(), obj, false)
+	case ctx.SearchExpression() != nil:
+		if inner := w.render(ctx.SearchExpression(), obj, required); inner != "" {
+			return "(" + inner + ")"
+		}
+	case ctx.Condition() != nil:
+		return w.renderCondition(ctx.Condition(), obj, required)
+	case ctx.MACRO() != nil:
+		if obj == nil {
+			w.reason("macro %s must be expanded before rewriting", ctx.MACRO().GetText())
+		}
+	case ctx.BareWord() != nil:
+		if word := ctx.BareWord().GetText(); word == "*" {
+			return "" // Matches every event
+		} else if obj == nil {
+			w.reason("keyword %s searches _raw, which tstats cannot", word)
+		}
+	case ctx.Subsearch() != nil:
+		if obj == nil {
+			w.reason("subsearches cannot be expressed in tstats")
+		}
+	}
+	return ""
+}
+
+func (w *tstatsRewriter) renderCondition(ctx IConditionContext, obj *DataModelObject, required bool) string {
+	if ctx.FunctionCall() != nil || ctx.Subsearch() != nil {
+		if obj == nil {
+			w.reason("condition %s cannot be expressed in tstats", w.text(ctx))
+		}
+		return ""
+	}
+	field := ctx.FieldName().GetText()
+	if obj == nil {
+		cond := tstatsCondition{field: field}
+		if ctx.DOUBLE_COLON() != nil || ctx.ComparisonOp() != nil && ctx.ComparisonOp().GetText() == "=" {
+			cond.value, cond.required = extractValue(ctx.Value()), required
+		}
+		w.conds = append(w.conds, cond)
+		return ""
+	}
+	if required && impliedBy(obj, field, extractValue(ctx.Value())) {
+		return ""
+	}
+	rest := strings.TrimSpace(w.text(ctx)[len(w.text(ctx.FieldName())):])
+	if ctx.ComparisonOp() != nil || ctx.DOUBLE_COLON() != nil {
+		return tstatsFieldName(obj, field) + rest
+	}
+	return tstatsFieldName(obj, field) + " " + rest // IN (...)
+}
+
+// impliedBy reports whether field=value is one of obj's constraint conditions
+func impliedBy(obj *DataModelObject, field, value string) bool {
+	search := obj.ConstraintSearch()
+	if search == "" || value == "" {
+		return false
+	}
+	for _, c := range ExtractConditions(search).Conditions {
+		if strings.EqualFold(c.Field, field) && c.Operator == "=" && !c.Negated &&
+			len(c.Alternatives) == 0 && strings.EqualFold(c.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// tstatsFieldName prefixes a data model field with the lineage of the object
+// that defines it, e.g. Authentication.src for Failed_Authentication
+func tstatsFieldName(obj *DataModelObject, field string) string {
+	if tstatsUnprefixedFields[strings.ToLower(field)] {
+		return field
+	}
+	lineage := obj.Lineage()
+	for i, name := range lineage {
+		if containsString(obj.model.Object(name).Fields, field) {
+			return strings.Join(lineage[:i+1], ".") + "." + field
+		}
+	}
+	return lineage[0] + "." + field
+}
+
+// missingFields returns the fields of the search obj does not define. Scope
+// fields are always available and required conditions obj's constraint
+// implies need no field.
+func (w *tstatsRewriter) missingFields(obj *DataModelObject) (missing []string, implied int) {
+	check := func(field string) {
+		if !tstatsUnprefixedFields[strings.ToLower(field)] && !obj.HasField(field) && !containsString(missing, field) {
+			missing = append(missing, field)
+		}
+	}
+	for _, c := range w.conds {
+		if c.required && impliedBy(obj, c.field, c.value) {
+			implied++
+			continue
+		}
+		check(c.field)
+	}
+	for _, f := range w.outputFields() {
+		check(f)
+	}
+	return missing, implied
+}
+
+// chooseObject picks the target object, or the object defining every field.
+// When several do, the one whose constraints the search repeats wins.
+func (c *DataModelCatalog) chooseObject(w *tstatsRewriter, target string) *DataModelObject {
+	if target != "" {
+		model, object, _ := strings.Cut(target, ".")
+		var obj *DataModelObject
+		for name, m := range c.Models {
+			if strings.EqualFold(name, model) {
+				obj = m.Object(object)
+			}
+		}
+		if obj == nil {
+			w.reason("unknown data model object %s", target)
+			return nil
+		}
+		missing, _ := w.missingFields(obj)
+		for _, f := range missing {
+			w.reason("field %s is not in data model object %s", f, target)
+		}
+		return obj
+	}
+
+	var best []*DataModelObject
+	bestImplied := -1
+	for _, name := range c.modelNames() {
+		for _, obj := range c.Models[name].Objects {
+			missing, implied := w.missingFields(obj)
+			switch {
+			case len(missing) > 0 || implied < bestImplied:
+			case implied > bestImplied:
+				best, bestImplied = []*DataModelObject{obj}, implied
+			default:
+				best = append(best, obj)
+			}
+		}
+	}
+	best = withoutDescendants(best)
+	switch len(best) {
+	case 0:
+		w.reason("no data model object defines all of the fields %s", strings.Join(w.searchFields(), ", "))
+	case 1:
+		return best[0]
+	default:
+		names := make([]string, len(best))
+		for i, obj := range best {
+			names[i] = obj.model.Name + "." + obj.Name
+		}
+		w.reason("fields map onto several data model objects (%s); set a target", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// withoutDescendants drops objects whose ancestor is also a candidate: the
+// ancestor does not add the child's constraints, so it matches the search
+func withoutDescendants(objs []*DataModelObject) []*DataModelObject {
+	var out []*DataModelObject
+	for _, obj := range objs {
+		descendant := false
+		for _, other := range objs {
+			lineage := obj.Lineage()
+			if other != obj && other.model == obj.model && containsString(lineage[:len(lineage)-1], other.Name) {
+				descendant = true
+			}
+		}
+		if !descendant {
+			out = append(out, obj)
+		}
+	}
+	return out
+}
+
+// modelNames returns the catalog's model names in order, so object choice is stable
+func (c *DataModelCatalog) modelNames() []string {
+	names := make([]string, 0, len(c.Models))
+	for name := range c.Models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// searchFields lists the data fields of the search for error messages
+func (w *tstatsRewriter) searchFields() []string {
+	var fields []string
+	for _, c := range w.conds {
+		if !tstatsUnprefixedFields[strings.ToLower(c.field)] && !containsString(fields, c.field) {
+			fields = append(fields, c.field)
+		}
+	}
+	for _, f := range w.outputFields() {
+		if !tstatsUnprefixedFields[strings.ToLower(f)] && !containsString(fields, f) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// build assembles the tstats query
+func (w *tstatsRewriter) build(obj *DataModelObject, summariesOnly bool) string {
+	var b strings.Builder
+	b.WriteString("| tstats summariesonly=")
+	if summariesOnly {
+		b.WriteString("t")
+	} else {
+		b.WriteString("f")
+	}
+
+	for _, fn := range w.stats.AllStatsFunction() {
+		name := fn.IDENTIFIER().GetText()
+		b.WriteString(" ")
+		if fn.Expression() == nil {
+			b.WriteString(name)
+		} else {
+			arg := fn.Expression().GetText()
+			fmt.Fprintf(&b, "%s(%s)", name, tstatsFieldName(obj, arg))
+			if fn.AS() == nil {
+				fmt.Fprintf(&b, ` AS "%s(%s)"`, name, arg) // Keep the stats output name
+			}
+		}
+		if fn.AS() != nil && fn.FieldName() != nil {
+			b.WriteString(" AS " + fn.FieldName().GetText())
+		} else if fn.AS() != nil {
+			b.WriteString(" AS " + fn.QUOTED_STRING().GetText())
+		}
+	}
+	fmt.Fprintf(&b, " from datamodel=%s.%s", obj.model.Name, obj.Name)
+
+	var where []string
+	for _, expr := range w.filters {
+		if s := w.render(expr, obj, true); s != "" {
+			where = append(where, s)
+		}
+	}
+	if len(where) > 0 {
+		b.WriteString(" where " + strings.Join(where, " "))
+	}
+
+	var renames []string
+	if by := fieldListNames(w.stats.FieldList()); len(by) > 0 {
+		b.WriteString(" by")
+		for _, f := range by {
+			name := tstatsFieldName(obj, f)
+			b.WriteString(" " + name)
+			if name != f {
+				renames = append(renames, name+" AS "+f)
+			}
+		}
+	}
+	if len(renames) > 0 {
+		b.WriteString(" | rename " + strings.Join(renames, ", "))
+	}
+	for _, stage := range w.rest {
+		b.WriteString(" | " + stage)
+	}
+	return b.String()
+}
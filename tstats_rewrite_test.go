@@ -0,0 +1,122 @@
+package spl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRewriteAsTstats(t *testing.T) {
+	c := loadTestDataModels(t)
+	tests := []struct {
+		name      string
+		query     string
+		opts      TstatsRewriteOptions
+		want      string
+		dataModel string
+	}{
+		{
+			name:      "constraint filters are dropped",
+			query:     `index=main tag=process tag=report process_name="cmd.exe" | stats count by dest, user`,
+			opts:      TstatsRewriteOptions{SummariesOnly: true},
+			want:      `| tstats summariesonly=t count from datamodel=Endpoint.Processes where index=main Processes.process_name="cmd.exe" by Processes.dest Processes.user | rename Processes.dest AS dest, Processes.user AS user`,
+			dataModel: "Endpoint.Processes",
+		},
+		{
+			name:      "functions, OR groups and later stages",
+			query:     `process_name=cmd.exe OR process_name=powershell.exe NOT user=SYSTEM | stats values(process) dc(dest) AS hosts by user | where hosts > 5`,
+			want:      `| tstats summariesonly=f values(Processes.process) AS "values(process)" dc(Processes.dest) AS hosts from datamodel=Endpoint.Processes where Processes.process_name=cmd.exe OR Processes.process_name=powershell.exe NOT Processes.user=SYSTEM by Processes.user | rename Processes.user AS user | where hosts > 5`,
+			dataModel: "Endpoint.Processes",
+		},
+		{
+			name:      "child object chosen by its constraint",
+			query:     `tag=authentication action=failure | stats count by src`,
+			want:      `| tstats summariesonly=f count from datamodel=Authentication.Failed_Authentication by Authentication.src | rename Authentication.src AS src`,
+			dataModel: "Authentication.Failed_Authentication",
+		},
+		{
+			name:      "explicit target",
+			query:     `user=admin* | stats count`,
+			opts:      TstatsRewriteOptions{Target: "Authentication.Authentication"},
+			want:      `| tstats summariesonly=f count from datamodel=Authentication.Authentication where Authentication.user=admin*`,
+			dataModel: "Authentication.Authentication",
+		},
+		{
+			name:      "indexed field",
+			query:     `user::admin | stats count`,
+			opts:      TstatsRewriteOptions{Target: "Authentication.Authentication"},
+			want:      `| tstats summariesonly=f count from datamodel=Authentication.Authentication where Authentication.user::admin`,
+			dataModel: "Authentication.Authentication",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rw := c.RewriteAsTstats(tt.query, tt.opts)
+			if len(rw.Reasons) > 0 {
+				t.Fatalf("Unexpected reasons: %v", rw.Reasons)
+			}
+			if rw.Query != tt.want {
+				t.Errorf("Query =\n  %s\nwant\n  %s", rw.Query, tt.want)
+			}
+			if rw.DataModel != tt.dataModel {
+				t.Errorf("DataModel = %s, want %s", rw.DataModel, tt.dataModel)
+			}
+			if result := ExtractConditions(rw.Query); len(result.Errors) > 0 {
+				t.Errorf("Rewritten query does not parse: %v", result.Errors)
+			} else if res := c.Resolve(result); len(res) != 1 || len(res[0].Errors) > 0 {
+				t.Errorf("Rewritten query does not resolve: %+v", res)
+			}
+		})
+	}
+}
+
+func TestRewriteAsTstats_Reasons(t *testing.T) {
+	c := loadTestDataModels(t)
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`index=main | rex field=cmd "(?<exe>\w+)" | stats count by exe`, "rex"},
+		{`index=main "mimikatz" | stats count by dest`, "_raw"},
+		{`index=main | eval x=1 | stats count by x`, "eval before stats"},
+		{`index=main process_name=cmd.exe | table dest`, "no stats command"},
+		{"`endpoint` | stats count by dest", "macro"},
+		{`index=main | stats list(process) by dest`, "list is not supported"},
+		{`index=main | stats sum(eval(bytes*8)) by dest`, "is an expression"},
+		{`index=main parent_process=explorer.exe | stats count by dest`, "no data model object defines all of the fields parent_process, dest"},
+		{`index=main | stats count by user`, "several data model objects"},
+		{`index=main | stats count by dest`, ""},
+	}
+	for _, tt := range tests {
+		rw := c.RewriteAsTstats(tt.query, TstatsRewriteOptions{})
+		if tt.want == "" {
+			if len(rw.Reasons) > 0 {
+				t.Errorf("%s: unexpected reasons %v", tt.query, rw.Reasons)
+			}
+			continue
+		}
+		if rw.Query != "" || len(rw.Reasons) == 0 {
+			t.Errorf("%s: expected reasons, got query %q", tt.query, rw.Query)
+			continue
+		}
+		if !strings.Contains(strings.Join(rw.Reasons, "; "), tt.want) {
+			t.Errorf("%s: reasons %v, want one containing %q", tt.query, rw.Reasons, tt.want)
+		}
+	}
+}
+
+func TestRewriteAsTstats_ChoosesObject(t *testing.T) {
+	c := loadTestDataModels(t)
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`index=main | stats count by service_name`, "Endpoint.Services"},
+		{`index=main | stats count by src`, "Authentication.Authentication"},                       // Parent: the search does not filter on failures
+		{`index=main action=failure | stats count by src`, "Authentication.Failed_Authentication"}, // Child: its constraint is repeated
+	}
+	for _, tt := range tests {
+		if rw := c.RewriteAsTstats(tt.query, TstatsRewriteOptions{}); rw.DataModel != tt.want {
+			t.Errorf("%s: DataModel = %q, want %s (reasons %v)", tt.query, rw.DataModel, tt.want, rw.Reasons)
+		}
+	}
+}
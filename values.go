@@ -0,0 +1,132 @@
+package spl
+
+import (
+	"net"
+	"regexp"
+	"strings"
+)
+
+// ValueKind is the type of a condition's value
+type ValueKind string
+
+const (
+	ValueString   ValueKind = "string"   // A plain string, matched as a whole
+	ValueNumber   ValueKind = "number"   // Integer or decimal
+	ValueWildcard ValueKind = "wildcard" // Search pattern with * (see WildcardRegexp)
+	ValueLike     ValueKind = "like"     // like() pattern with % and _ (see LikeRegexp)
+	ValueTime     ValueKind = "time"     // earliest/latest bound (see ParseTimeBound)
+	ValueCIDR     ValueKind = "cidr"     // Network such as 10.0.0.0/8
+	ValueRegex    ValueKind = "regex"    // Regular expression (regex command, match())
+	ValueNull     ValueKind = "null"     // isnull()/isnotnull() checks
+)
+
+// timeValueFields are the fields whose values are time bounds
+var timeValueFields = map[string]bool{
+	"earliest": true, "latest": true, "_index_earliest": true, "_index_latest": true,
+}
+
+// valueEscapes decodes the backslash escapes of a search value
+var valueEscapes = strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\'`, `'`)
+
+// UnescapeValue returns a value as it is matched: surrounding quotes are
+// removed and \\, \" and \' are decoded. Condition.Value and Alternatives
+// keep the escapes as written, so "C:\\Windows\\*" is C:\\Windows\\* there
+// and C:\Windows\* here.
+func UnescapeValue(raw string) string {
+	if isQuotedLiteral(raw) {
+		return unquoteSPLString(raw)
+	}
+	if !strings.Contains(raw, `\`) {
+		return raw
+	}
+	return valueEscapes.Replace(raw)
+}
+
+// stripQuotes removes one pair of surrounding quotes, keeping escapes
+func stripQuotes(text string) string {
+	if isQuotedLiteral(text) {
+		return text[1 : len(text)-1]
+	}
+	return text
+}
+
+// setValue records the value token of a condition. In eval and where
+// expressions a * is an ordinary character, not a wildcard.
+func setValue(cond *Condition, raw string, expression bool) {
+	cond.RawValue = raw
+	cond.Quoted = isQuotedLiteral(raw)
+	cond.Unescaped = UnescapeValue(raw)
+	cond.ValueKind = valueKind(cond.Field, cond.Operator, cond.Unescaped, expression)
+}
+
+// valueKind classifies an unescaped value by the operator, the field and
+// then the value's own syntax
+func valueKind(field, op, value string, expression bool) ValueKind {
+	switch op {
+	case "matches":
+		return ValueRegex
+	case "cidrmatch":
+		return ValueCIDR
+	case "isnull", "isnotnull":
+		return ValueNull
+	case "like":
+		return ValueLike
+	}
+	if timeValueFields[strings.ToLower(field)] && ParseTimeBound(value).Kind != TimeBoundInvalid {
+		return ValueTime
+	}
+	if !expression && strings.Contains(value, "*") {
+		return ValueWildcard
+	}
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return ValueCIDR
+	}
+	if _, ok := parseNumber(value); ok {
+		return ValueNumber
+	}
+	return ValueString
+}
+
+// WildcardRegexp converts a search wildcard pattern, where * matches any
+// run of characters, into an anchored Go regular expression. Every other
+// character is literal, so Unescaped values can be passed as they are.
+func WildcardRegexp(pattern string, caseSensitive bool) string {
+	parts := strings.Split(pattern, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	flags := "(?s)"
+	if !caseSensitive {
+		flags = "(?is)"
+	}
+	return flags + "^" + strings.Join(parts, ".*") + "$"
+}
+
+// CompileWildcard compiles a search wildcard pattern (see WildcardRegexp)
+func CompileWildcard(pattern string, caseSensitive bool) (*regexp.Regexp, error) {
+	return regexp.Compile(WildcardRegexp(pattern, caseSensitive))
+}
+
+// LikeRegexp converts a like() pattern, where % matches any run of
+// characters and _ any single character, into an anchored, case-sensitive
+// Go regular expression. Every other character, * included, is literal.
+func LikeRegexp(pattern string) string {
+	var b strings.Builder
+	b.WriteString("(?s)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// likeToWildcard converts the % of a like() pattern for Condition.Value.
+// Search wildcards have no single-character form, so _ is left as written.
+var likeToWildcard = strings.NewReplacer("%", "*")
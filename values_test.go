@@ -0,0 +1,134 @@
+package spl
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestConditionValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		field     string
+		value     string
+		raw       string
+		unescaped string
+		quoted    bool
+		kind      ValueKind
+	}{
+		{"plain", `index=main user=admin`, "user", "admin", "admin", "admin", false, ValueString},
+		{"quoted", `index=main user="John Smith"`, "user", "John Smith", `"John Smith"`, "John Smith", true, ValueString},
+		{"escaped quotes", `index=main cmd="\"a\""`, "cmd", `\"a\"`, `"\"a\""`, `"a"`, true, ValueString},
+		{"windows path", `index=main Image="C:\\Windows\\*"`, "Image", `C:\\Windows\\*`, `"C:\\Windows\\*"`, `C:\Windows\*`, true, ValueWildcard},
+		{"number", `index=main EventCode=4688`, "EventCode", "4688", "4688", "4688", false, ValueNumber},
+		{"quoted number", `index=main EventCode="4688"`, "EventCode", "4688", `"4688"`, "4688", true, ValueNumber},
+		{"cidr", `index=fw src="10.0.0.0/8"`, "src", "10.0.0.0/8", `"10.0.0.0/8"`, "10.0.0.0/8", true, ValueCIDR},
+		{"unquoted cidr", `index=fw src=10.0.0.0/8`, "src", "10.0.0.0/8", "10.0.0.0/8", "10.0.0.0/8", false, ValueCIDR},
+		{"unquoted ip", `index=fw src=10.0.0.1 action=allowed`, "src", "10.0.0.1", "10.0.0.1", "10.0.0.1", false, ValueString},
+		{"exponent", `index=main | where bytes>1.5e3`, "bytes", "1.5e3", "1.5e3", "1.5e3", false, ValueNumber},
+		{"cidrmatch", `index=fw | where cidrmatch("10.0.0.0/8", src)`, "src", "10.0.0.0/8", `"10.0.0.0/8"`, "10.0.0.0/8", true, ValueCIDR},
+		{"match", `index=web | where match(uri, "\\.php$")`, "uri", `\\.php$`, `"\\.php$"`, `\.php$`, true, ValueRegex},
+		{"regex command", `index=web | regex uri="^/admin"`, "uri", "^/admin", `"^/admin"`, "^/admin", true, ValueRegex},
+		{"like", `index=web | where like(uri, "/api/%")`, "uri", "/api/*", `"/api/%"`, "/api/%", true, ValueLike},
+		{"like keeps _", `index=web | where like(user, "adm_n%")`, "user", "adm_n*", `"adm_n%"`, "adm_n%", true, ValueLike},
+		{"isnull", `index=web | where isnull(user)`, "user", "", "", "", false, ValueNull},
+		{"where keeps * literal", `index=web | where uri="/a*"`, "uri", "/a*", `"/a*"`, "/a*", true, ValueString},
+		{"keyword", `index=web "failed \"login\""`, "_raw", `failed \"login\"`, `"failed \"login\""`, `failed "login"`, true, ValueString},
+		{"in list", `index=main Image IN ("C:\\Temp\\*", "x")`, "Image", `C:\\Temp\\*`, `"C:\\Temp\\*"`, `C:\Temp\*`, true, ValueWildcard},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ExtractConditions(tc.query)
+			if len(result.Errors) > 0 {
+				t.Fatalf("unexpected errors: %v", result.Errors)
+			}
+			for _, c := range result.Conditions {
+				if c.Field != tc.field {
+					continue
+				}
+				if c.Value != tc.value || c.RawValue != tc.raw || c.Unescaped != tc.unescaped || c.Quoted != tc.quoted || c.ValueKind != tc.kind {
+					t.Errorf("got Value=%q RawValue=%q Unescaped=%q Quoted=%v ValueKind=%q\nwant Value=%q RawValue=%q Unescaped=%q Quoted=%v ValueKind=%q",
+						c.Value, c.RawValue, c.Unescaped, c.Quoted, c.ValueKind, tc.value, tc.raw, tc.unescaped, tc.quoted, tc.kind)
+				}
+				return
+			}
+			t.Errorf("no condition on %s in %+v", tc.field, result.Conditions)
+		})
+	}
+}
+
+func TestConditionValues_FieldComparisonHasNoKind(t *testing.T) {
+	result := ExtractConditions(`index=fw | where src_ip=dest_ip`)
+	for _, c := range result.Conditions {
+		if c.Field == "src_ip" && (c.ValueKind != "" || c.RawValue != "") {
+			t.Errorf("field comparison = %+v, want no value kind", c)
+		}
+	}
+}
+
+func TestWildcardRegexp(t *testing.T) {
+	tests := []struct {
+		pattern       string
+		caseSensitive bool
+		input         string
+		want          bool
+	}{
+		{`C:\Windows\*`, false, `c:\windows\system32\cmd.exe`, true},
+		{`C:\Windows\*`, true, `c:\windows\system32\cmd.exe`, false},
+		{`*.exe`, false, "evil.EXE", true},
+		{`*.exe`, false, "evil.exe.txt", false},
+		{`a.b`, false, "axb", false},
+		{`*`, true, "", true},
+		{`line*`, false, "line1\nline2", true},
+	}
+	for _, tc := range tests {
+		re, err := CompileWildcard(tc.pattern, tc.caseSensitive)
+		if err != nil {
+			t.Fatalf("CompileWildcard(%q): %v", tc.pattern, err)
+		}
+		if got := re.MatchString(tc.input); got != tc.want {
+			t.Errorf("%s matches %q = %v, want %v", WildcardRegexp(tc.pattern, tc.caseSensitive), tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestLikeRegexp(t *testing.T) {
+	tests := []struct {
+		pattern string
+		input   string
+		want    bool
+	}{
+		{"adm_n%", "admin", true},
+		{"adm_n%", "adm1n-backup", true},
+		{"adm_n%", "admn", false},
+		{"adm_n%", "ADMIN", false},
+		{"%.exe", "evil.exe", true},
+		{"%.exe", "evilxexe", false},
+		{"a*b", "a*b", true},
+		{"a*b", "axxb", false},
+		{"%", "line1\nline2", true},
+	}
+	for _, tc := range tests {
+		re := regexp.MustCompile(LikeRegexp(tc.pattern))
+		if got := re.MatchString(tc.input); got != tc.want {
+			t.Errorf("%s matches %q = %v, want %v", LikeRegexp(tc.pattern), tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestUnescapeValue(t *testing.T) {
+	tests := map[string]string{
+		`"C:\\Windows\\*"`: `C:\Windows\*`,
+		`C:\\Windows\\*`:   `C:\Windows\*`,
+		`"\"a\""`:          `"a"`,
+		`'it\'s'`:          `it's`,
+		`plain`:            `plain`,
+		`"a\nb"`:           `a\nb`,
+	}
+	for raw, want := range tests {
+		if got := UnescapeValue(raw); got != want {
+			t.Errorf("UnescapeValue(%s) = %s, want %s", raw, got, want)
+		}
+	}
+}